@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/repository"
+	"github.com/ozontech/seq-ui/internal/pkg/service/dashboards"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+	"github.com/ozontech/seq-ui/logger"
+)
+
+const importDashboardsCmd = "import-dashboards"
+
+// runImportDashboards imports all dashboard bundles from the directory and exits.
+// It is intended for provisioning dashboards kept in git, e.g. from an init container.
+func runImportDashboards(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet(importDashboardsCmd, flag.ExitOnError)
+	var (
+		cfgPath  = fs.String("config", defaultConfig, "application config")
+		dir      = fs.String("dir", "", "directory with dashboard bundles (*.json) returned by export")
+		owner    = fs.String("owner", "", "name of the user owning created dashboards")
+		conflict = fs.String("conflict", string(types.DashboardImportConflictSkip),
+			"policy for existing dashboards: skip, overwrite or new_uuid")
+	)
+	_ = fs.Parse(args)
+
+	if *dir == "" {
+		logger.Fatal("-dir flag is required")
+	}
+	if *owner == "" {
+		logger.Fatal("-owner flag is required")
+	}
+
+	cfg, err := config.FromFile(*cfgPath)
+	if err != nil {
+		logger.Fatal("read config file error", zap.Error(err))
+	}
+
+	db, err := initDb(ctx, cfg.Server.DB)
+	if err != nil {
+		logger.Fatal("failed to init db", zap.Error(err))
+	}
+	if db == nil {
+		logger.Fatal("dashboards import requires db")
+	}
+	defer db.Close()
+
+	repo := repository.New(db, cfg.Server.DB.RequestTimeout)
+	profiles.InitProfiles(repo.UserProfiles.GetOrCreate)
+	svc := dashboards.New(repo.Dashboards, nil)
+
+	bundles, err := readDashboardBundles(*dir)
+	if err != nil {
+		logger.Fatal("failed to read dashboard bundles", zap.Error(err))
+	}
+
+	ctx = context.WithValue(ctx, types.UserKey{}, *owner)
+	for _, f := range bundles {
+		results, err := svc.ImportDashboards(ctx, types.ImportDashboardsRequest{
+			Bundle:   f.bundle,
+			Conflict: types.DashboardImportConflict(*conflict),
+		})
+		if err != nil {
+			logger.Fatal("failed to import dashboards", zap.String("file", f.path), zap.Error(err))
+		}
+
+		for _, r := range results {
+			logger.Info("dashboard imported",
+				zap.String("file", f.path),
+				zap.String("source_uuid", r.SourceUUID),
+				zap.String("uuid", r.UUID),
+				zap.String("status", string(r.Status)),
+			)
+		}
+	}
+
+	logger.Info("dashboards import finished", zap.Int("files", len(bundles)))
+}
+
+type dashboardBundleFile struct {
+	path   string
+	bundle types.DashboardsBundle
+}
+
+// readDashboardBundles reads all *.json files of the directory in lexical order.
+func readDashboardBundles(dir string) ([]dashboardBundleFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []dashboardBundleFile
+	for _, e := range entries {
+		if e.IsDir() || !strings.EqualFold(filepath.Ext(e.Name()), ".json") {
+			continue
+		}
+
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var b types.DashboardsBundle
+		if err = json.Unmarshal(data, &b); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", path, err)
+		}
+		files = append(files, dashboardBundleFile{path: path, bundle: b})
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("no *.json files found")
+	}
+
+	return files, nil
+}
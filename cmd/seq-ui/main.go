@@ -18,24 +18,35 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ozontech/seq-ui/internal/api"
+	audit_v1 "github.com/ozontech/seq-ui/internal/api/audit/v1"
+	auth_api "github.com/ozontech/seq-ui/internal/api/auth"
 	dashboards_v1 "github.com/ozontech/seq-ui/internal/api/dashboards/v1"
 	errorgroups_v1 "github.com/ozontech/seq-ui/internal/api/errorgroups/v1"
+	jwks_api "github.com/ozontech/seq-ui/internal/api/jwks"
 	massexport_v1 "github.com/ozontech/seq-ui/internal/api/massexport/v1"
 	seqapi_v1 "github.com/ozontech/seq-ui/internal/api/seqapi/v1"
+	tokens_v1 "github.com/ozontech/seq-ui/internal/api/tokens/v1"
 	userprofile_v1 "github.com/ozontech/seq-ui/internal/api/userprofile/v1"
+	"github.com/ozontech/seq-ui/internal/app/auth"
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/querycost"
+	"github.com/ozontech/seq-ui/internal/app/querypolicy"
 	"github.com/ozontech/seq-ui/internal/app/server"
 	"github.com/ozontech/seq-ui/internal/pkg/cache"
 	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
 	"github.com/ozontech/seq-ui/internal/pkg/repository"
 	repositorych "github.com/ozontech/seq-ui/internal/pkg/repository_ch"
 	asyncsearches "github.com/ozontech/seq-ui/internal/pkg/service/async_searches"
+	"github.com/ozontech/seq-ui/internal/pkg/service/audit"
 	"github.com/ozontech/seq-ui/internal/pkg/service/dashboards"
 	"github.com/ozontech/seq-ui/internal/pkg/service/errorgroups"
+	"github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/digest"
 	"github.com/ozontech/seq-ui/internal/pkg/service/massexport"
 	"github.com/ozontech/seq-ui/internal/pkg/service/massexport/filestore"
 	"github.com/ozontech/seq-ui/internal/pkg/service/massexport/sessionstore"
 	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
+	"github.com/ozontech/seq-ui/internal/pkg/service/tokens"
 	"github.com/ozontech/seq-ui/internal/pkg/service/userprofile"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/tracing"
@@ -64,6 +75,11 @@ func main() {
 	)
 	defer cancel()
 
+	if len(os.Args) > 1 && os.Args[1] == importDashboardsCmd {
+		runImportDashboards(ctx, os.Args[2:])
+		return
+	}
+
 	run(ctx)
 }
 
@@ -90,9 +106,9 @@ func run(ctx context.Context) {
 			zap.Float64("sampler_param", tracingCfg.SamplerParam))
 	}
 
-	registrar := initApp(ctx, cfg)
+	registrar, tokensSvc, auditSvc, oidcLogin := initApp(ctx, cfg)
 
-	serv, err := server.New(ctx, cfg.Server, registrar)
+	serv, err := server.New(ctx, cfg.Server, registrar, tokensSvc, auditSvc, oidcLogin)
 	if err != nil {
 		logger.Fatal("app init error", zap.Error(err))
 	}
@@ -104,7 +120,9 @@ func run(ctx context.Context) {
 	}
 }
 
-func initApp(ctx context.Context, cfg config.Config) *api.Registrar {
+func initApp(
+	ctx context.Context, cfg config.Config,
+) (*api.Registrar, tokens.Service, audit.Service, auth.SessionProvider) {
 	logger.Info("initializing seq-db clients")
 	seqDBClients, err := initSeqDBClients(ctx, cfg)
 	if err != nil {
@@ -123,9 +141,47 @@ func initApp(ctx context.Context, cfg config.Config) *api.Registrar {
 		)
 	}
 
-	var massExportV1 *massexport_v1.MassExport
+	// mass export runs in background without user, so it restricts queries on start and uses unrestricted client
+	exportClient := defaultClient
+
+	var queryPolicy *querypolicy.Engine
+	if policyCfg := cfg.Handlers.SeqAPI.QueryPolicy; policyCfg != nil {
+		queryPolicy, err = querypolicy.New(ctx, *policyCfg)
+		if err != nil {
+			logger.Fatal("failed to init query policies", zap.Error(err))
+		}
+		for id, client := range seqDBClients {
+			seqDBClients[id] = querypolicy.NewClient(client, queryPolicy)
+		}
+		defaultClient = seqDBClients[defaultClientID]
+		logger.Info("query policies initialized")
+	}
+
+	// the cost is charged before the query policy is applied, so it's estimated by the query of the user
+	if costCfg := cfg.Handlers.SeqAPI.QueryCost; costCfg != nil {
+		costLimiter, err := querycost.New(ctx, *costCfg)
+		if err != nil {
+			logger.Fatal("failed to init query cost limiter", zap.Error(err))
+		}
+		for id, client := range seqDBClients {
+			seqDBClients[id] = querycost.NewClient(client, costLimiter)
+		}
+		defaultClient = seqDBClients[defaultClientID]
+		logger.Info("query cost limiter initialized")
+	}
+
+	var (
+		massExportV1 *massexport_v1.MassExport
+		fileStore    filestore.FileStore
+	)
 	if cfg.Handlers.MassExport != nil {
-		exportServer, err := initExportService(ctx, *cfg.Handlers.MassExport, defaultClient)
+		fileStore, err = filestore.NewS3(cfg.Handlers.MassExport.FileStore.S3)
+		if err != nil {
+			logger.Fatal("can't init file store", zap.Error(err))
+		}
+		logger.Info("file store initialized")
+
+		exportServer, err := initExportService(ctx, *cfg.Handlers.MassExport, fileStore, exportClient, queryPolicy)
 		if err != nil {
 			logger.Fatal("can't init export server", zap.Error(err))
 		}
@@ -151,40 +207,146 @@ func initApp(ctx context.Context, cfg config.Config) *api.Registrar {
 		logger.Fatal("failed to init db", zap.Error(err))
 	}
 
+	logger.Info("initializing clickhouse")
+	ch, err := initClickHouse(ctx, cfg.Server.CH)
+	if err != nil {
+		logger.Fatal("failed to init clickhouse", zap.Error(err))
+	}
+
+	var errorGroupsRepo errorgroups.Repository
+	switch cfg.Handlers.ErrorGroups.Storage {
+	case config.ErrorGroupsStorageClickHouse:
+		if ch != nil {
+			errorGroupsRepo = repositorych.New(ch, cfg.Server.CH.Sharded, cfg.Handlers.ErrorGroups.QueryFilter)
+		}
+	case config.ErrorGroupsStoragePostgres:
+		if db == nil {
+			logger.Fatal("error groups postgres storage requires db")
+		}
+		errorGroupsRepo = repository.NewErrorGroups(ctx, db, cfg.Server.DB.RequestTimeout, cfg.Handlers.ErrorGroups.QueryFilter)
+		logger.Info("error groups use postgres storage")
+	}
+
+	var jwtProvider auth.JWTProvider
+	if cfg.Server.JWTSecretKey != "" || cfg.Server.JWT != nil {
+		jwtProvider, err = auth.NewJWTProviderWithKeys(ctx, cfg.Server.JWTSecretKey, cfg.Server.JWT)
+		if err != nil {
+			logger.Fatal("failed to init jwt provider", zap.Error(err))
+		}
+	}
+
+	var errorGroupsSvc errorgroups.Service
+	if errorGroupsRepo != nil {
+		errorGroupsSvc = errorgroups.New(errorGroupsRepo, cfg.Handlers.ErrorGroups)
+	}
+
 	var (
+		repo                 *repository.Repository
 		asyncSearchesService asyncsearches.Service
+		queryHistoryRecorder queryhistory.Recorder
+		tokensSvc            tokens.Service
 		userProfileV1        *userprofile_v1.UserProfile
 		dashboardsV1         *dashboards_v1.Dashboards
+		tokensV1             *tokens_v1.Tokens
 	)
 	if db != nil {
-		repo := repository.New(db, cfg.Server.DB.RequestTimeout)
-		userProfilesSvc := userprofile.New(repo.UserProfiles, repo.FavoriteQueries)
-		dashboardsSvc := dashboards.New(repo.Dashboards)
+		repo = repository.New(db, cfg.Server.DB.RequestTimeout)
+		var webhookAllowlist []string
+		if digestCfg := cfg.Handlers.ErrorGroups.Digest; digestCfg != nil {
+			webhookAllowlist = digestCfg.WebhookAllowlist
+		}
+		userProfilesSvc := userprofile.New(
+			repo.UserProfiles, repo.FavoriteQueries, repo.ErrorGroupsSubscriptions, repo.QueryHistory, webhookAllowlist,
+		)
+		dashboardsRenderer, err := dashboards.NewRenderer(
+			cfg.Handlers.Dashboards.Render, cfg.Handlers.SeqAPI, seqDBClients, inmemWithRedisCache, errorGroupsSvc,
+		)
+		if err != nil {
+			logger.Fatal("failed to init dashboards renderer", zap.Error(err))
+		}
+		dashboardsSvc := dashboards.New(repo.Dashboards, dashboardsRenderer)
 		profiles.InitProfiles(repo.UserProfiles.GetOrCreate)
 
 		userProfileV1 = userprofile_v1.New(userProfilesSvc)
 		dashboardsV1 = dashboards_v1.New(dashboardsSvc)
 
 		asyncSearchesService = asyncsearches.New(ctx, repo, defaultClient, cfg.Handlers.AsyncSearch)
-	}
 
-	seqApiV1 := seqapi_v1.New(cfg.Handlers.SeqAPI, seqDBClients, inmemWithRedisCache, redisCache, asyncSearchesService)
+		if historyCfg := cfg.Handlers.QueryHistory; historyCfg != nil {
+			queryHistoryRecorder = queryhistory.New(ctx, repo.QueryHistory, *historyCfg)
+		}
 
-	logger.Info("initializing clickhouse")
-	ch, err := initClickHouse(ctx, cfg.Server.CH)
-	if err != nil {
-		logger.Fatal("failed to init clickhouse", zap.Error(err))
+		if tokensCfg := cfg.Handlers.APITokens; tokensCfg != nil {
+			tokensSvc = tokens.New(repo.APITokens, jwtProvider, redisCache, *tokensCfg)
+			tokensV1 = tokens_v1.New(tokensSvc)
+			logger.Info("api tokens initialized")
+		}
+	} else if cfg.Handlers.APITokens != nil {
+		logger.Fatal("api tokens require db")
 	}
 
+	seqApiV1 := seqapi_v1.New(
+		cfg.Handlers.SeqAPI, seqDBClients, inmemWithRedisCache, redisCache, asyncSearchesService, queryHistoryRecorder,
+	)
+
 	var errorGroupsV1 *errorgroups_v1.ErrorGroups
-	if ch != nil {
-		repo := repositorych.New(ch, cfg.Server.CH.Sharded, cfg.Handlers.ErrorGroups.QueryFilter)
-		svc := errorgroups.New(repo, cfg.Handlers.ErrorGroups.LogTagsMapping)
+	if errorGroupsSvc != nil {
+		errorGroupsV1 = errorgroups_v1.New(errorGroupsSvc)
+
+		if digestCfg := cfg.Handlers.ErrorGroups.Digest; digestCfg != nil {
+			if repo == nil {
+				logger.Fatal("error groups digest requires db")
+			}
+			digest.New(ctx, *digestCfg, errorGroupsSvc, repo.ErrorGroupsSubscriptions, fileStore)
+			logger.Info("error groups digest initialized")
+		}
+	}
+
+	var (
+		auditSvc audit.Service
+		auditV1  *audit_v1.Audit
+	)
+	if auditCfg := cfg.Handlers.Audit; auditCfg != nil {
+		var auditRepo repository.AuditLog
+		if repo != nil {
+			auditRepo = repo.AuditLog
+		}
+		auditSvc, err = audit.New(ctx, *auditCfg, auditRepo)
+		if err != nil {
+			logger.Fatal("failed to init audit", zap.Error(err))
+		}
+		// audit log can be read only from postgres
+		if auditCfg.Sink == config.AuditSinkPostgres {
+			auditV1 = audit_v1.New(auditSvc)
+		}
+		logger.Info("audit initialized", zap.String("sink", auditCfg.Sink))
+	}
 
-		errorGroupsV1 = errorgroups_v1.New(svc)
+	var (
+		oidcLogin auth.SessionProvider
+		authAPI   *auth_api.API
+	)
+	if oidcCfg := cfg.Server.OIDC; oidcCfg != nil && oidcCfg.Login != nil {
+		login, err := auth.NewOIDCLogin(ctx, oidcCfg)
+		if err != nil {
+			logger.Fatal("failed to init oidc login", zap.Error(err))
+		}
+		oidcLogin = login
+		authAPI = auth_api.New(login)
+		logger.Info("oidc login initialized")
+	}
+
+	var jwksAPI *jwks_api.API
+	if jwtCfg := cfg.Server.JWT; jwtCfg != nil && len(jwtCfg.Keys) > 0 {
+		jwksAPI = jwks_api.New(jwtProvider)
+		logger.Info("jwks initialized")
 	}
 
-	return api.NewRegistrar(seqApiV1, userProfileV1, dashboardsV1, massExportV1, errorGroupsV1)
+	registrar := api.NewRegistrar(
+		seqApiV1, userProfileV1, dashboardsV1, massExportV1, errorGroupsV1, tokensV1, auditV1, authAPI, jwksAPI,
+	)
+
+	return registrar, tokensSvc, auditSvc, oidcLogin
 }
 
 func initSeqDBClients(ctx context.Context, cfg config.Config) (map[string]seqdb.Client, error) {
@@ -251,20 +413,20 @@ func initDb(ctx context.Context, cfg *config.DB) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
-func initExportService(ctx context.Context, cfg config.MassExport, client seqdb.Client) (massexport.Service, error) {
+func initExportService(
+	ctx context.Context,
+	cfg config.MassExport,
+	fileStore filestore.FileStore,
+	client seqdb.Client,
+	queryPolicy *querypolicy.Engine,
+) (massexport.Service, error) {
 	sessionStore, err := sessionstore.NewRedisSessionStore(ctx, cfg.SessionStore)
 	if err != nil {
 		return nil, fmt.Errorf("init session store: %w", err)
 	}
 	logger.Info("session store initialized")
 
-	fileStore, err := filestore.NewS3(cfg.FileStore.S3)
-	if err != nil {
-		return nil, fmt.Errorf("init file store: %w", err)
-	}
-	logger.Info("file store initialized")
-
-	return massexport.NewService(ctx, cfg, sessionStore, fileStore, client)
+	return massexport.NewService(ctx, cfg, sessionStore, fileStore, client, queryPolicy)
 }
 
 func initClickHouse(ctx context.Context, cfg *config.CH) (driver.Conn, error) {
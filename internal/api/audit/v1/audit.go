@@ -0,0 +1,29 @@
+package audit_v1
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	grpc_api "github.com/ozontech/seq-ui/internal/api/audit/v1/grpc"
+	http_api "github.com/ozontech/seq-ui/internal/api/audit/v1/http"
+	"github.com/ozontech/seq-ui/internal/pkg/service/audit"
+)
+
+type Audit struct {
+	grpcAPI *grpc_api.API
+	httpAPI *http_api.API
+}
+
+func New(svc audit.Service) *Audit {
+	return &Audit{
+		grpcAPI: grpc_api.New(svc),
+		httpAPI: http_api.New(svc),
+	}
+}
+
+func (a *Audit) GRPCServer() *grpc_api.API {
+	return a.grpcAPI
+}
+
+func (a *Audit) HTTPRouter() chi.Router {
+	return a.httpAPI.Router()
+}
@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"github.com/ozontech/seq-ui/internal/pkg/service/audit"
+	api "github.com/ozontech/seq-ui/pkg/audit/v1"
+)
+
+type API struct {
+	api.UnimplementedAuditServiceServer
+
+	service audit.Service
+}
+
+func New(svc audit.Service) *API {
+	return &API{
+		service: svc,
+	}
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/audit/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) GetAuditLog(ctx context.Context, req *audit.GetAuditLogRequest) (*audit.GetAuditLogResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "audit_v1_get_audit_log")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "user_name",
+			Value: attribute.StringValue(req.GetUserName()),
+		},
+		attribute.KeyValue{
+			Key:   "actions",
+			Value: attribute.StringSliceValue(req.GetActions()),
+		},
+		attribute.KeyValue{
+			Key:   "limit",
+			Value: attribute.IntValue(int(req.GetLimit())),
+		},
+		attribute.KeyValue{
+			Key:   "offset",
+			Value: attribute.IntValue(int(req.GetOffset())),
+		},
+	)
+
+	svcReq := types.GetAuditLogRequest{
+		UserName: req.UserName,
+		Limit:    req.GetLimit(),
+		Offset:   req.GetOffset(),
+	}
+	for _, action := range req.GetActions() {
+		svcReq.Actions = append(svcReq.Actions, types.AuditAction(action))
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		svcReq.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		svcReq.To = &to
+	}
+
+	res, err := a.service.GetAuditLog(ctx, svcReq)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &audit.GetAuditLogResponse{
+		Records: res.ToProto(),
+	}, nil
+}
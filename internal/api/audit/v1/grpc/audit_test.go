@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/audit/v1"
+)
+
+func TestGetAuditLog(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	from := now.Add(-time.Hour)
+	userName := "user"
+	res := types.AuditRecords{
+		{ID: 1, UserName: userName, Action: types.AuditActionSearch, Query: "q", From: &from, To: &now, CreatedAt: now},
+		{ID: 2, UserName: userName, Action: types.AuditActionTokenRevoke, Target: "token-id", CreatedAt: now},
+	}
+
+	tests := []struct {
+		name string
+
+		req      *audit.GetAuditLogRequest
+		want     *audit.GetAuditLogResponse
+		wantCode codes.Code
+
+		svcReq types.GetAuditLogRequest
+		svcErr error
+	}{
+		{
+			name: "ok",
+			req: &audit.GetAuditLogRequest{
+				UserName: proto.String(userName),
+				Actions:  []string{"search", "token_revoke"},
+				From:     timestamppb.New(from),
+				To:       timestamppb.New(now),
+				Limit:    10,
+				Offset:   5,
+			},
+			want: &audit.GetAuditLogResponse{
+				Records: res.ToProto(),
+			},
+			wantCode: codes.OK,
+			svcReq: types.GetAuditLogRequest{
+				UserName: &userName,
+				Actions:  []types.AuditAction{types.AuditActionSearch, types.AuditActionTokenRevoke},
+				From:     &from,
+				To:       &now,
+				Limit:    10,
+				Offset:   5,
+			},
+		},
+		{
+			name:     "err_permission_denied",
+			req:      &audit.GetAuditLogRequest{},
+			wantCode: codes.PermissionDenied,
+			svcErr:   types.NewErrPermissionDenied("get audit log"),
+		},
+		{
+			name:     "err_svc",
+			req:      &audit.GetAuditLogRequest{},
+			wantCode: codes.Internal,
+			svcErr:   errSomethingWrong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				GetAuditLog(gomock.Any(), tt.svcReq).
+				Return(res, tt.svcErr).
+				Times(1)
+
+			got, err := api.GetAuditLog(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+			require.True(t, proto.Equal(tt.want, got))
+		})
+	}
+}
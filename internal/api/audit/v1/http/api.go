@@ -0,0 +1,25 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/ozontech/seq-ui/internal/pkg/service/audit"
+)
+
+type API struct {
+	service audit.Service
+}
+
+func New(svc audit.Service) *API {
+	return &API{
+		service: svc,
+	}
+}
+
+func (a *API) Router() chi.Router {
+	mux := chi.NewMux()
+
+	mux.Get("/log", a.serveGetAuditLog)
+
+	return mux
+}
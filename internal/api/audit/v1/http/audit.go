@@ -0,0 +1,146 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetAuditLog go doc.
+//
+//	@Router		/audit/v1/log [get]
+//	@ID			audit_v1_getAuditLog
+//	@Tags		audit_v1
+//	@Param		user_name	query		string				false	"User name"
+//	@Param		action		query		[]string			false	"Actions"	collectionFormat(multi)
+//	@Param		from		query		string				false	"From"		Format(date-time)
+//	@Param		to			query		string				false	"To"		Format(date-time)
+//	@Param		limit		query		int					false	"Limit"		Format(int32)
+//	@Param		offset		query		int					false	"Offset"	Format(int32)
+//	@Success	200			{object}	getAuditLogResponse	"A successful response"
+//	@Failure	default		{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetAuditLog(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "audit_v1_get_audit_log")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	params := r.URL.Query()
+	req := types.GetAuditLogRequest{}
+	if params.Has("user_name") {
+		userName := params.Get("user_name")
+		req.UserName = &userName
+	}
+	for _, action := range params["action"] {
+		req.Actions = append(req.Actions, types.AuditAction(action))
+	}
+	if params.Has("from") {
+		from, err := time.Parse(time.RFC3339, params.Get("from"))
+		if err != nil {
+			wr.Error(errors.New("incorrect 'from' format"), http.StatusBadRequest)
+			return
+		}
+		req.From = &from
+	}
+	if params.Has("to") {
+		to, err := time.Parse(time.RFC3339, params.Get("to"))
+		if err != nil {
+			wr.Error(errors.New("incorrect 'to' format"), http.StatusBadRequest)
+			return
+		}
+		req.To = &to
+	}
+	if params.Has("limit") {
+		limit, err := strconv.ParseInt(params.Get("limit"), 10, 32)
+		if err != nil {
+			wr.Error(errors.New("incorrect 'limit' format"), http.StatusBadRequest)
+			return
+		}
+		req.Limit = int32(limit)
+	}
+	if params.Has("offset") {
+		offset, err := strconv.ParseInt(params.Get("offset"), 10, 32)
+		if err != nil {
+			wr.Error(errors.New("incorrect 'offset' format"), http.StatusBadRequest)
+			return
+		}
+		req.Offset = int32(offset)
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "user_name",
+			Value: attribute.StringValue(params.Get("user_name")),
+		},
+		attribute.KeyValue{
+			Key:   "actions",
+			Value: attribute.StringSliceValue(params["action"]),
+		},
+		attribute.KeyValue{
+			Key:   "limit",
+			Value: attribute.IntValue(int(req.Limit)),
+		},
+		attribute.KeyValue{
+			Key:   "offset",
+			Value: attribute.IntValue(int(req.Offset)),
+		},
+	)
+
+	records, err := a.service.GetAuditLog(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getAuditLogResponse{
+		Records: newAuditRecords(records),
+	})
+}
+
+type auditRecord struct {
+	ID       string `json:"id" format:"int64"`
+	UserName string `json:"userName"`
+	Action   string `json:"action" enums:"search,histogram,aggregation,export,event_view,async_search,mass_export,dashboard_create,dashboard_update,dashboard_delete,dashboard_import,token_create,token_revoke"`
+	// Method is gRPC method or HTTP route of the request.
+	Method string     `json:"method"`
+	Env    string     `json:"env,omitempty"`
+	Query  string     `json:"query,omitempty"`
+	From   *time.Time `json:"from,omitempty" format:"date-time"`
+	To     *time.Time `json:"to,omitempty" format:"date-time"`
+	// Target is the object of the action, e.g. ID of the event or UUID of the dashboard.
+	Target    string    `json:"target,omitempty"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt" format:"date-time"`
+} //	@name	audit.v1.AuditRecord
+
+func newAuditRecords(rs types.AuditRecords) []auditRecord {
+	res := make([]auditRecord, len(rs))
+	for i, r := range rs {
+		res[i] = auditRecord{
+			ID:        strconv.FormatInt(r.ID, 10),
+			UserName:  r.UserName,
+			Action:    string(r.Action),
+			Method:    r.Method,
+			Env:       r.Env,
+			Query:     r.Query,
+			From:      r.From,
+			To:        r.To,
+			Target:    r.Target,
+			Status:    r.Status,
+			CreatedAt: r.CreatedAt,
+		}
+	}
+	return res
+}
+
+type getAuditLogResponse struct {
+	Records []auditRecord `json:"records"`
+} //	@name	audit.v1.GetAuditLogResponse
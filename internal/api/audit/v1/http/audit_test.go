@@ -0,0 +1,90 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeGetAuditLog(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	from := now.Add(-time.Hour)
+	userName := "user"
+	res := types.AuditRecords{
+		{ID: 1, UserName: userName, Action: types.AuditActionSearch, Query: "q", From: &from, To: &now, CreatedAt: now},
+		{ID: 2, UserName: userName, Action: types.AuditActionTokenRevoke, Target: "token-id", CreatedAt: now},
+	}
+
+	tests := []struct {
+		name string
+
+		target  string
+		want    getAuditLogResponse
+		wantErr bool
+
+		svcReq *types.GetAuditLogRequest
+		svcErr error
+	}{
+		{
+			name: "ok",
+			target: "/audit/v1/log?user_name=user&action=search&action=token_revoke" +
+				"&from=" + from.Format(time.RFC3339) + "&to=" + now.Format(time.RFC3339) + "&limit=10&offset=5",
+			want: getAuditLogResponse{
+				Records: newAuditRecords(res),
+			},
+			svcReq: &types.GetAuditLogRequest{
+				UserName: &userName,
+				Actions:  []types.AuditAction{types.AuditActionSearch, types.AuditActionTokenRevoke},
+				From:     &from,
+				To:       &now,
+				Limit:    10,
+				Offset:   5,
+			},
+		},
+		{
+			name:    "err_from",
+			target:  "/audit/v1/log?from=yesterday",
+			wantErr: true,
+		},
+		{
+			name:    "err_limit",
+			target:  "/audit/v1/log?limit=many",
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			target:  "/audit/v1/log",
+			wantErr: true,
+			svcReq:  &types.GetAuditLogRequest{},
+			svcErr:  errSomethingWrong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.svcReq != nil {
+				mockedSvc.EXPECT().
+					GetAuditLog(gomock.Any(), *tt.svcReq).
+					Return(res, tt.svcErr).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getAuditLogResponse]{
+				Method:  http.MethodGet,
+				Target:  tt.target,
+				Handler: api.serveGetAuditLog,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package auth_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+const (
+	stateCookieSuffix = "_state"
+	stateCookiePath   = "/auth"
+	stateCookieMaxAge = 600 // seconds, same as login state ttl
+)
+
+// Login is the browser login flow.
+type Login interface {
+	Config() config.OIDCLogin
+	LoginURL(ctx context.Context, redirect string) (string, string, error)
+	Callback(ctx context.Context, state, code string) (string, string, error)
+	Logout(ctx context.Context, sessionID string) (string, error)
+}
+
+// API contains the handlers of the browser login flow.
+type API struct {
+	login Login
+	cfg   config.OIDCLogin
+}
+
+func New(login Login) *API {
+	return &API{
+		login: login,
+		cfg:   login.Config(),
+	}
+}
+
+func (a *API) Router() chi.Router {
+	mux := chi.NewMux()
+
+	mux.Get("/login", a.serveLogin)
+	mux.Get("/callback", a.serveCallback)
+	mux.Get("/logout", a.serveLogout)
+
+	return mux
+}
+
+// serveLogin go doc.
+//
+//	@Router		/auth/login [get]
+//	@ID			auth_login
+//	@Tags		auth
+//	@Param		redirect	query		string			false	"Path to return to after the login, `/` by default"
+//	@Success	302			{object}	nil				"Redirect to the OIDC provider"
+//	@Failure	default		{object}	httputil.Error	"An unexpected error response"
+func (a *API) serveLogin(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "auth_login")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	redirect := r.URL.Query().Get("redirect")
+	if redirect == "" {
+		redirect = "/"
+	}
+	if !isLocalPath(redirect) {
+		wr.Error(errors.New("redirect must be a local path"), http.StatusBadRequest)
+		return
+	}
+
+	loginURL, state, err := a.login.LoginURL(ctx, redirect)
+	if err != nil {
+		wr.Error(fmt.Errorf("failed to start login: %w", err), http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, a.cookie(a.cfg.CookieName+stateCookieSuffix, state, stateCookiePath, stateCookieMaxAge))
+	http.Redirect(w, r, loginURL, http.StatusFound)
+}
+
+// serveCallback go doc.
+//
+//	@Router		/auth/callback [get]
+//	@ID			auth_callback
+//	@Tags		auth
+//	@Param		code	query		string			true	"Authorization code"
+//	@Param		state	query		string			true	"Login state"
+//	@Success	302		{object}	nil				"Redirect to the path passed to login"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+func (a *API) serveCallback(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "auth_callback")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	params := r.URL.Query()
+	if errCode := params.Get("error"); errCode != "" {
+		wr.Error(fmt.Errorf("login failed: %s %s", errCode, params.Get("error_description")), http.StatusUnauthorized)
+		return
+	}
+
+	// the state must be the one issued to this browser, otherwise the login can be forged
+	state := params.Get("state")
+	stateCookie, err := r.Cookie(a.cfg.CookieName + stateCookieSuffix)
+	if err != nil || state == "" || stateCookie.Value != state {
+		wr.Error(errors.New("invalid login state"), http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, a.cookie(a.cfg.CookieName+stateCookieSuffix, "", stateCookiePath, -1))
+
+	sessionID, redirect, err := a.login.Callback(ctx, state, params.Get("code"))
+	if err != nil {
+		logger.Error("login callback failed", zap.Error(err))
+		wr.Error(errors.New("login failed"), http.StatusUnauthorized)
+		return
+	}
+
+	http.SetCookie(w, a.cookie(a.cfg.CookieName, sessionID, "/", int(a.cfg.SessionTTL.Seconds())))
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// serveLogout go doc.
+//
+//	@Router		/auth/logout [get]
+//	@ID			auth_logout
+//	@Tags		auth
+//	@Success	302		{object}	nil				"Redirect to the OIDC provider or the post logout URL"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+func (a *API) serveLogout(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "auth_logout")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var endSessionURL string
+	if c, err := r.Cookie(a.cfg.CookieName); err == nil && c.Value != "" {
+		endSessionURL, err = a.login.Logout(ctx, c.Value)
+		if err != nil {
+			wr.Error(fmt.Errorf("failed to logout: %w", err), http.StatusInternalServerError)
+			return
+		}
+	}
+	http.SetCookie(w, a.cookie(a.cfg.CookieName, "", "/", -1))
+
+	redirect := endSessionURL
+	if redirect == "" {
+		redirect = a.cfg.PostLogoutRedirectURL
+	}
+	if redirect == "" {
+		redirect = "/"
+	}
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// cookie returns the HttpOnly cookie, the cookie is deleted if maxAge is negative.
+func (a *API) cookie(name, value, path string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   a.cfg.CookieDomain,
+		MaxAge:   maxAge,
+		Secure:   !a.cfg.InsecureCookie,
+		HttpOnly: true,
+		// Lax doesn't send the cookie in cross-site POST requests, which protects the APIs from CSRF
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// isLocalPath checks that the redirect stays on the same host.
+func isLocalPath(p string) bool {
+	return strings.HasPrefix(p, "/") && !strings.HasPrefix(p, "//") && !strings.HasPrefix(p, "/\\")
+}
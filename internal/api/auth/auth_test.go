@@ -0,0 +1,238 @@
+package auth_api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+type loginStub struct {
+	loginRedirect string
+	callbackState string
+	callbackErr   error
+	logoutSession string
+	endSessionURL string
+}
+
+func (s *loginStub) Config() config.OIDCLogin {
+	return config.OIDCLogin{
+		CookieName:            "seq_ui_session",
+		SessionTTL:            time.Hour,
+		PostLogoutRedirectURL: "https://seq-ui.example.com/bye",
+	}
+}
+
+func (s *loginStub) LoginURL(_ context.Context, redirect string) (string, string, error) {
+	s.loginRedirect = redirect
+	return "https://oidc.example.com/auth?state=state", "state", nil
+}
+
+func (s *loginStub) Callback(_ context.Context, state, _ string) (string, string, error) {
+	s.callbackState = state
+	if s.callbackErr != nil {
+		return "", "", s.callbackErr
+	}
+	return "session-id", "/dashboards", nil
+}
+
+func (s *loginStub) Logout(_ context.Context, sessionID string) (string, error) {
+	s.logoutSession = sessionID
+	return s.endSessionURL, nil
+}
+
+func findCookie(resp *http.Response, name string) *http.Cookie {
+	for _, c := range resp.Cookies() {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestServeLogin(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		wantPath string
+	}{
+		{
+			name:     "ok",
+			target:   "/auth/login?redirect=/dashboards",
+			wantCode: http.StatusFound,
+			wantPath: "/dashboards",
+		},
+		{
+			name:     "ok_default",
+			target:   "/auth/login",
+			wantCode: http.StatusFound,
+			wantPath: "/",
+		},
+		{
+			name:     "err_external_redirect",
+			target:   "/auth/login?redirect=//evil.example.com",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			login := &loginStub{}
+			api := New(login)
+
+			rec := httptest.NewRecorder()
+			api.serveLogin(rec, httptest.NewRequest(http.MethodGet, tt.target, http.NoBody))
+			resp := rec.Result()
+			defer resp.Body.Close()
+
+			require.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantCode != http.StatusFound {
+				return
+			}
+			require.Equal(t, tt.wantPath, login.loginRedirect)
+			require.Equal(t, "https://oidc.example.com/auth?state=state", resp.Header.Get("Location"))
+
+			c := findCookie(resp, "seq_ui_session_state")
+			require.NotNil(t, c)
+			require.Equal(t, "state", c.Value)
+			require.True(t, c.HttpOnly)
+			require.True(t, c.Secure)
+		})
+	}
+}
+
+func TestServeCallback(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		stateCookie string
+		callbackErr error
+		wantCode    int
+	}{
+		{
+			name:        "ok",
+			target:      "/auth/callback?code=code&state=state",
+			stateCookie: "state",
+			wantCode:    http.StatusFound,
+		},
+		{
+			name:     "err_no_state_cookie",
+			target:   "/auth/callback?code=code&state=state",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:        "err_state_mismatch",
+			target:      "/auth/callback?code=code&state=state",
+			stateCookie: "other",
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "err_provider",
+			target:      "/auth/callback?error=access_denied&state=state",
+			stateCookie: "state",
+			wantCode:    http.StatusUnauthorized,
+		},
+		{
+			name:        "err_callback",
+			target:      "/auth/callback?code=code&state=state",
+			stateCookie: "state",
+			callbackErr: errors.New("invalid code"),
+			wantCode:    http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			login := &loginStub{callbackErr: tt.callbackErr}
+			api := New(login)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, http.NoBody)
+			if tt.stateCookie != "" {
+				req.AddCookie(&http.Cookie{Name: "seq_ui_session_state", Value: tt.stateCookie})
+			}
+			rec := httptest.NewRecorder()
+			api.serveCallback(rec, req)
+			resp := rec.Result()
+			defer resp.Body.Close()
+
+			require.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantCode != http.StatusFound {
+				return
+			}
+			require.Equal(t, "state", login.callbackState)
+			require.Equal(t, "/dashboards", resp.Header.Get("Location"))
+
+			c := findCookie(resp, "seq_ui_session")
+			require.NotNil(t, c)
+			require.Equal(t, "session-id", c.Value)
+			require.Equal(t, 3600, c.MaxAge)
+			require.True(t, c.HttpOnly)
+			require.Equal(t, http.SameSiteLaxMode, c.SameSite)
+		})
+	}
+}
+
+func TestServeLogout(t *testing.T) {
+	tests := []struct {
+		name          string
+		sessionCookie string
+		endSessionURL string
+		wantSession   string
+		wantLocation  string
+	}{
+		{
+			name:          "ok_end_session",
+			sessionCookie: "session-id",
+			endSessionURL: "https://oidc.example.com/logout",
+			wantSession:   "session-id",
+			wantLocation:  "https://oidc.example.com/logout",
+		},
+		{
+			name:          "ok_post_logout_redirect",
+			sessionCookie: "session-id",
+			wantSession:   "session-id",
+			wantLocation:  "https://seq-ui.example.com/bye",
+		},
+		{
+			name:         "ok_no_session",
+			wantLocation: "https://seq-ui.example.com/bye",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			login := &loginStub{endSessionURL: tt.endSessionURL}
+			api := New(login)
+
+			req := httptest.NewRequest(http.MethodGet, "/auth/logout", http.NoBody)
+			if tt.sessionCookie != "" {
+				req.AddCookie(&http.Cookie{Name: "seq_ui_session", Value: tt.sessionCookie})
+			}
+			rec := httptest.NewRecorder()
+			api.serveLogout(rec, req)
+			resp := rec.Result()
+			defer resp.Body.Close()
+
+			require.Equal(t, http.StatusFound, resp.StatusCode)
+			require.Equal(t, tt.wantLocation, resp.Header.Get("Location"))
+			require.Equal(t, tt.wantSession, login.logoutSession)
+
+			c := findCookie(resp, "seq_ui_session")
+			require.NotNil(t, c)
+			require.Negative(t, c.MaxAge)
+		})
+	}
+}
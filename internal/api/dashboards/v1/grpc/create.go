@@ -23,8 +23,10 @@ func (a *API) Create(ctx context.Context, req *dashboards.CreateRequest) (*dashb
 	)
 
 	request := types.CreateDashboardRequest{
-		Name: req.Name,
-		Meta: req.Meta,
+		Name:     req.Name,
+		Meta:     req.Meta,
+		FolderID: req.FolderId,
+		Tags:     req.Tags,
 	}
 
 	uuid, err := a.service.CreateDashboard(ctx, request)
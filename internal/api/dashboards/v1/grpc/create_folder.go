@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) CreateFolder(ctx context.Context, req *dashboards.CreateFolderRequest) (*dashboards.CreateFolderResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_create_folder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(req.GetName()),
+		},
+	)
+
+	request := types.CreateDashboardFolderRequest{
+		Name:     req.Name,
+		ParentID: req.ParentId,
+	}
+
+	id, err := a.service.CreateDashboardFolder(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.CreateFolderResponse{
+		Id: id,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestCreateFolder(t *testing.T) {
+	type mockArgs struct {
+		req  types.CreateDashboardFolderRequest
+		resp int64
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.CreateFolderRequest
+		want     *dashboards.CreateFolderResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.CreateFolderRequest{
+				Name:     "api",
+				ParentId: &testFolderID,
+			},
+			want: &dashboards.CreateFolderResponse{
+				Id: 4,
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.CreateDashboardFolderRequest{
+					Name:     "api",
+					ParentID: &testFolderID,
+				},
+				resp: 4,
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.CreateFolderRequest{
+				Name: "api",
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.CreateDashboardFolderRequest{
+					Name: "api",
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					CreateDashboardFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.CreateFolder(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
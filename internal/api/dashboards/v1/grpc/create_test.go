@@ -47,6 +47,28 @@ func TestCreate(t *testing.T) {
 				resp: testDashboardUUID,
 			},
 		},
+		{
+			name: "ok_with_folder_and_tags",
+			req: &dashboards.CreateRequest{
+				Name:     testDashboardName,
+				Meta:     testDashboardMeta,
+				FolderId: &testFolderID,
+				Tags:     []string{"prod", "api"},
+			},
+			want: &dashboards.CreateResponse{
+				Uuid: testDashboardUUID,
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.CreateDashboardRequest{
+					Name:     testDashboardName,
+					Meta:     testDashboardMeta,
+					FolderID: &testFolderID,
+					Tags:     []string{"prod", "api"},
+				},
+				resp: testDashboardUUID,
+			},
+		},
 		{
 			name: "err_svc",
 			req: &dashboards.CreateRequest{
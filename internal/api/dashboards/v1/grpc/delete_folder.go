@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) DeleteFolder(ctx context.Context, req *dashboards.DeleteFolderRequest) (*dashboards.DeleteFolderResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_delete_folder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(req.GetId()),
+		},
+	)
+
+	request := types.DeleteDashboardFolderRequest{
+		ID: req.Id,
+	}
+
+	if err := a.service.DeleteDashboardFolder(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.DeleteFolderResponse{}, nil
+}
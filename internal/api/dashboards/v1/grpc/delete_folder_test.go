@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestDeleteFolder(t *testing.T) {
+	type mockArgs struct {
+		req types.DeleteDashboardFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.DeleteFolderRequest
+		want     *dashboards.DeleteFolderResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.DeleteFolderRequest{
+				Id: testFolderID,
+			},
+			want:     &dashboards.DeleteFolderResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.DeleteDashboardFolderRequest{
+					ID: testFolderID,
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.DeleteFolderRequest{
+				Id: testFolderID,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.DeleteDashboardFolderRequest{
+					ID: testFolderID,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					DeleteDashboardFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.DeleteFolder(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
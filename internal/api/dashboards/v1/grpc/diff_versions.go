@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) DiffVersions(ctx context.Context, req *dashboards.DiffVersionsRequest) (*dashboards.DiffVersionsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_diff_versions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+		attribute.KeyValue{
+			Key:   "from_version",
+			Value: attribute.Int64Value(req.GetFromVersion()),
+		},
+		attribute.KeyValue{
+			Key:   "to_version",
+			Value: attribute.Int64Value(req.GetToVersion()),
+		},
+	)
+
+	request := types.DiffDashboardVersionsRequest{
+		UUID:        req.Uuid,
+		FromVersion: req.FromVersion,
+		ToVersion:   req.ToVersion,
+	}
+
+	diff, err := a.service.DiffDashboardVersions(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	changes := make([]*dashboards.DiffVersionsResponse_Change, len(diff.Changes))
+	for i, c := range diff.Changes {
+		changes[i] = &dashboards.DiffVersionsResponse_Change{
+			Path:     c.Path,
+			Op:       dashboards.DiffVersionsResponse_Op(c.Op),
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		}
+	}
+
+	return &dashboards.DiffVersionsResponse{
+		From:    versionInfoToProto(diff.From),
+		To:      versionInfoToProto(diff.To),
+		Changes: changes,
+	}, nil
+}
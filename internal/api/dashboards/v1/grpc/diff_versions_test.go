@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestDiffVersions(t *testing.T) {
+	var (
+		fromInfo = types.DashboardVersionInfo{Version: 1, Name: testDashboardName, AuthorName: "owner", CreatedAt: testCreatedAt}
+		toInfo   = types.DashboardVersionInfo{Version: 2, Name: testDashboardName, AuthorName: "editor", CreatedAt: testCreatedAt}
+	)
+
+	type mockArgs struct {
+		req  types.DiffDashboardVersionsRequest
+		resp types.DashboardVersionsDiff
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.DiffVersionsRequest
+		want     *dashboards.DiffVersionsResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.DiffVersionsRequest{
+				Uuid:        testDashboardUUID,
+				FromVersion: 1,
+				ToVersion:   2,
+			},
+			want: &dashboards.DiffVersionsResponse{
+				From: &dashboards.VersionInfo{
+					Version:    1,
+					Name:       testDashboardName,
+					AuthorName: "owner",
+					CreatedAt:  timestamppb.New(testCreatedAt),
+				},
+				To: &dashboards.VersionInfo{
+					Version:    2,
+					Name:       testDashboardName,
+					AuthorName: "editor",
+					CreatedAt:  timestamppb.New(testCreatedAt),
+				},
+				Changes: []*dashboards.DiffVersionsResponse_Change{
+					{Path: "/columns", Op: dashboards.DiffVersionsResponse_OP_ADDED, NewValue: `["level"]`},
+					{Path: "/query", Op: dashboards.DiffVersionsResponse_OP_CHANGED, OldValue: `"a"`, NewValue: `"b"`},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.DiffDashboardVersionsRequest{
+					UUID:        testDashboardUUID,
+					FromVersion: 1,
+					ToVersion:   2,
+				},
+				resp: types.DashboardVersionsDiff{
+					From: fromInfo,
+					To:   toInfo,
+					Changes: []types.DashboardDiffChange{
+						{Path: "/columns", Op: types.DashboardDiffOpAdded, NewValue: `["level"]`},
+						{Path: "/query", Op: types.DashboardDiffOpChanged, OldValue: `"a"`, NewValue: `"b"`},
+					},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.DiffVersionsRequest{
+				Uuid:        testDashboardUUID,
+				FromVersion: 1,
+				ToVersion:   2,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.DiffDashboardVersionsRequest{
+					UUID:        testDashboardUUID,
+					FromVersion: 1,
+					ToVersion:   2,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					DiffDashboardVersions(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.DiffVersions(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
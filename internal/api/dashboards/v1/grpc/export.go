@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) Export(ctx context.Context, req *dashboards.ExportRequest) (*dashboards.ExportResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuids",
+			Value: attribute.StringSliceValue(req.GetUuids()),
+		},
+	)
+
+	bundle, err := a.service.ExportDashboards(ctx, req.Uuids)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, grpcutil.ProcessError(fmt.Errorf("failed to marshal bundle: %w", err))
+	}
+
+	return &dashboards.ExportResponse{
+		Bundle: string(data),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestExport(t *testing.T) {
+	type mockArgs struct {
+		uuids []string
+		resp  types.DashboardsBundle
+		err   error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.ExportRequest
+		want     *dashboards.ExportResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.ExportRequest{
+				Uuids: []string{testDashboardUUID},
+			},
+			want: &dashboards.ExportResponse{
+				Bundle: `{"version":1,"dashboards":[{"uuid":"064dc707-02b8-7000-8201-02a7f396738a","name":"my_dashboard","meta":"my_meta","tags":["prod"]}]}`,
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				uuids: []string{testDashboardUUID},
+				resp: types.DashboardsBundle{
+					Version: types.DashboardsBundleVersion,
+					Dashboards: []types.DashboardsBundleItem{
+						{UUID: testDashboardUUID, Name: testDashboardName, Meta: testDashboardMeta, Tags: []string{"prod"}},
+					},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.ExportRequest{
+				Uuids: []string{testDashboardUUID},
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				uuids: []string{testDashboardUUID},
+				err:   errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ExportDashboards(gomock.Any(), tt.mockArgs.uuids).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.Export(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
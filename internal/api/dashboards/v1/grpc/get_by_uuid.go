@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ozontech/seq-ui/internal/api/grpcutil"
 	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
@@ -30,5 +31,8 @@ func (a *API) GetByUUID(ctx context.Context, req *dashboards.GetByUUIDRequest) (
 		Name:      d.Name,
 		Meta:      d.Meta,
 		OwnerName: d.OwnerName,
+		FolderId:  d.FolderID,
+		Tags:      d.Tags,
+		UpdatedAt: timestamppb.New(d.UpdatedAt),
 	}, nil
 }
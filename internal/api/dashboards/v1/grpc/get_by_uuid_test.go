@@ -8,6 +8,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
@@ -41,6 +42,9 @@ func TestGetByUUID(t *testing.T) {
 				Name:      testDashboardName,
 				Meta:      testDashboardMeta,
 				OwnerName: dashboardOwner,
+				FolderId:  &testFolderID,
+				Tags:      []string{"prod"},
+				UpdatedAt: timestamppb.New(testCreatedAt),
 			},
 			wantCode: codes.OK,
 			mockArgs: &mockArgs{
@@ -49,6 +53,9 @@ func TestGetByUUID(t *testing.T) {
 					Name:      testDashboardName,
 					Meta:      testDashboardMeta,
 					OwnerName: dashboardOwner,
+					FolderID:  &testFolderID,
+					Tags:      []string{"prod"},
+					UpdatedAt: testCreatedAt,
 				},
 			},
 		},
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) GetFolders(ctx context.Context, _ *dashboards.GetFoldersRequest) (*dashboards.GetFoldersResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_get_folders")
+	defer span.End()
+
+	fs, err := a.service.GetDashboardFolders(ctx)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	folders := make([]*dashboards.Folder, len(fs))
+	for i, f := range fs {
+		folders[i] = folderToProto(f)
+	}
+
+	return &dashboards.GetFoldersResponse{
+		Folders: folders,
+	}, nil
+}
+
+func folderToProto(f types.DashboardFolder) *dashboards.Folder {
+	return &dashboards.Folder{
+		Id:        f.ID,
+		ParentId:  f.ParentID,
+		Name:      f.Name,
+		OwnerName: f.OwnerName,
+	}
+}
@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestGetFolders(t *testing.T) {
+	type mockArgs struct {
+		resp types.DashboardFolders
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *dashboards.GetFoldersResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: &dashboards.GetFoldersResponse{
+				Folders: []*dashboards.Folder{
+					{Id: testFolderID, Name: "services", OwnerName: "user1"},
+					{Id: 4, ParentId: &testFolderID, Name: "api", OwnerName: "user2"},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				resp: types.DashboardFolders{
+					{ID: testFolderID, Name: "services", OwnerID: 1, OwnerName: "user1"},
+					{ID: 4, ParentID: &testFolderID, Name: "api", OwnerID: 2, OwnerName: "user2"},
+				},
+			},
+		},
+		{
+			name:     "err_svc",
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetDashboardFolders(gomock.Any()).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.GetFolders(context.Background(), &dashboards.GetFoldersRequest{})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) GetSharing(ctx context.Context, req *dashboards.GetSharingRequest) (*dashboards.GetSharingResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_get_sharing")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "uuid",
+		Value: attribute.StringValue(req.GetUuid()),
+	})
+
+	acl, err := a.service.GetDashboardSharing(ctx, req.Uuid)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	collaborators := make([]*dashboards.Collaborator, len(acl.Collaborators))
+	for i, c := range acl.Collaborators {
+		collaborators[i] = c.ToProto()
+	}
+
+	return &dashboards.GetSharingResponse{
+		OwnerName:     acl.OwnerName,
+		Visibility:    acl.Visibility.ToProto(),
+		Team:          acl.Team,
+		Collaborators: collaborators,
+	}, nil
+}
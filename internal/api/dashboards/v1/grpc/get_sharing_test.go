@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestGetSharing(t *testing.T) {
+	type mockArgs struct {
+		resp types.DashboardACL
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.GetSharingRequest
+		want     *dashboards.GetSharingResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.GetSharingRequest{
+				Uuid: testDashboardUUID,
+			},
+			want: &dashboards.GetSharingResponse{
+				OwnerName:  "owner",
+				Visibility: dashboards.Visibility_VISIBILITY_PRIVATE,
+				Collaborators: []*dashboards.Collaborator{
+					{
+						Kind: dashboards.Collaborator_KIND_GROUP,
+						Name: "team1",
+						Role: dashboards.Role_ROLE_EDITOR,
+					},
+					{
+						Kind: dashboards.Collaborator_KIND_USER,
+						Name: "user1",
+						Role: dashboards.Role_ROLE_VIEWER,
+					},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				resp: types.DashboardACL{
+					OwnerID:    1,
+					OwnerName:  "owner",
+					Visibility: types.DashboardVisibilityPrivate,
+					Collaborators: types.DashboardCollaborators{
+						{Kind: types.DashboardCollaboratorGroup, Name: "team1", Role: types.DashboardRoleEditor},
+						{Kind: types.DashboardCollaboratorUser, Name: "user1", Role: types.DashboardRoleViewer},
+					},
+				},
+			},
+		},
+		{
+			name: "err_permission_denied",
+			req: &dashboards.GetSharingRequest{
+				Uuid: testDashboardUUID,
+			},
+			wantCode: codes.PermissionDenied,
+			mockArgs: &mockArgs{
+				err: types.NewErrPermissionDenied("get dashboard sharing"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetDashboardSharing(gomock.Any(), testDashboardUUID).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.GetSharing(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
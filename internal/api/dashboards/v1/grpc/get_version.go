@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) GetVersion(ctx context.Context, req *dashboards.GetVersionRequest) (*dashboards.GetVersionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_get_version")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+		attribute.KeyValue{
+			Key:   "version",
+			Value: attribute.Int64Value(req.GetVersion()),
+		},
+	)
+
+	request := types.GetDashboardVersionRequest{
+		UUID:    req.Uuid,
+		Version: req.Version,
+	}
+
+	v, err := a.service.GetDashboardVersion(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.GetVersionResponse{
+		Info: versionInfoToProto(v.DashboardVersionInfo),
+		Meta: v.Meta,
+	}, nil
+}
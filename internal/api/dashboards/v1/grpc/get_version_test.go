@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestGetVersion(t *testing.T) {
+	type mockArgs struct {
+		req  types.GetDashboardVersionRequest
+		resp types.DashboardVersion
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.GetVersionRequest
+		want     *dashboards.GetVersionResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.GetVersionRequest{
+				Uuid:    testDashboardUUID,
+				Version: 1,
+			},
+			want: &dashboards.GetVersionResponse{
+				Info: &dashboards.VersionInfo{
+					Version:    1,
+					Name:       testDashboardName,
+					AuthorName: "owner",
+					CreatedAt:  timestamppb.New(testCreatedAt),
+				},
+				Meta: testDashboardMeta,
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.GetDashboardVersionRequest{
+					UUID:    testDashboardUUID,
+					Version: 1,
+				},
+				resp: types.DashboardVersion{
+					DashboardVersionInfo: types.DashboardVersionInfo{
+						Version:    1,
+						Name:       testDashboardName,
+						AuthorName: "owner",
+						CreatedAt:  testCreatedAt,
+					},
+					Meta: testDashboardMeta,
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.GetVersionRequest{
+				Uuid:    testDashboardUUID,
+				Version: 1,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.GetDashboardVersionRequest{
+					UUID:    testDashboardUUID,
+					Version: 1,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetDashboardVersion(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.GetVersion(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) Import(ctx context.Context, req *dashboards.ImportRequest) (*dashboards.ImportResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_import")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "conflict",
+			Value: attribute.StringValue(req.GetConflict().String()),
+		},
+	)
+
+	request := types.ImportDashboardsRequest{
+		Conflict: types.DashboardImportConflictFromProto(req.Conflict),
+	}
+	if err := json.Unmarshal([]byte(req.Bundle), &request.Bundle); err != nil {
+		return nil, grpcutil.ProcessError(types.NewErrInvalidRequestField(fmt.Sprintf("invalid 'bundle': %s", err)))
+	}
+
+	results, err := a.service.ImportDashboards(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	resp := &dashboards.ImportResponse{
+		Results: make([]*dashboards.ImportResponse_Result, len(results)),
+	}
+	for i, r := range results {
+		resp.Results[i] = &dashboards.ImportResponse_Result{
+			SourceUuid: r.SourceUUID,
+			Uuid:       r.UUID,
+			Status:     r.Status.ToProto(),
+		}
+	}
+
+	return resp, nil
+}
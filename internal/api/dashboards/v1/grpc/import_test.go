@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestImport(t *testing.T) {
+	const (
+		bundle = `{"version":1,"dashboards":[{"uuid":"064dc707-02b8-7000-8201-02a7f396738a","name":"my_dashboard","meta":"my_meta","tags":["prod"]}]}`
+		newID  = "064dc707-12b9-7000-a238-682b044c908b"
+	)
+
+	type mockArgs struct {
+		req  types.ImportDashboardsRequest
+		resp types.DashboardImportResults
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.ImportRequest
+		want     *dashboards.ImportResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.ImportRequest{
+				Bundle:   bundle,
+				Conflict: dashboards.ImportConflict_IMPORT_CONFLICT_NEW_UUID,
+			},
+			want: &dashboards.ImportResponse{
+				Results: []*dashboards.ImportResponse_Result{
+					{SourceUuid: testDashboardUUID, Uuid: newID, Status: dashboards.ImportResponse_STATUS_CREATED},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.ImportDashboardsRequest{
+					Bundle: types.DashboardsBundle{
+						Version: types.DashboardsBundleVersion,
+						Dashboards: []types.DashboardsBundleItem{
+							{UUID: testDashboardUUID, Name: testDashboardName, Meta: testDashboardMeta, Tags: []string{"prod"}},
+						},
+					},
+					Conflict: types.DashboardImportConflictNewUUID,
+				},
+				resp: types.DashboardImportResults{
+					{SourceUUID: testDashboardUUID, UUID: newID, Status: types.DashboardImportStatusCreated},
+				},
+			},
+		},
+		{
+			name: "err_bundle",
+			req: &dashboards.ImportRequest{
+				Bundle: "{",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.ImportRequest{
+				Bundle: `{"version":1}`,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.ImportDashboardsRequest{
+					Bundle:   types.DashboardsBundle{Version: types.DashboardsBundleVersion},
+					Conflict: types.DashboardImportConflictSkip,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ImportDashboards(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.Import(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
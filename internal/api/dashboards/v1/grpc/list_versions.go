@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) ListVersions(ctx context.Context, req *dashboards.ListVersionsRequest) (*dashboards.ListVersionsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_list_versions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+		attribute.KeyValue{
+			Key:   "limit",
+			Value: attribute.IntValue(int(req.GetLimit())),
+		},
+		attribute.KeyValue{
+			Key:   "offset",
+			Value: attribute.IntValue(int(req.GetOffset())),
+		},
+	)
+
+	request := types.ListDashboardVersionsRequest{
+		UUID:   req.Uuid,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	}
+
+	vis, err := a.service.ListDashboardVersions(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	versions := make([]*dashboards.VersionInfo, len(vis))
+	for i, v := range vis {
+		versions[i] = versionInfoToProto(v)
+	}
+
+	return &dashboards.ListVersionsResponse{
+		Versions: versions,
+	}, nil
+}
+
+func versionInfoToProto(v types.DashboardVersionInfo) *dashboards.VersionInfo {
+	return &dashboards.VersionInfo{
+		Version:    v.Version,
+		Name:       v.Name,
+		AuthorName: v.AuthorName,
+		CreatedAt:  timestamppb.New(v.CreatedAt),
+	}
+}
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestListVersions(t *testing.T) {
+	type mockArgs struct {
+		req  types.ListDashboardVersionsRequest
+		resp types.DashboardVersionInfos
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.ListVersionsRequest
+		want     *dashboards.ListVersionsResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.ListVersionsRequest{
+				Uuid:   testDashboardUUID,
+				Limit:  int32(testLimit),
+				Offset: int32(testOffset),
+			},
+			want: &dashboards.ListVersionsResponse{
+				Versions: []*dashboards.VersionInfo{
+					{
+						Version:    2,
+						Name:       testDashboardName,
+						AuthorName: "editor",
+						CreatedAt:  timestamppb.New(testCreatedAt),
+					},
+					{
+						Version:    1,
+						Name:       testDashboardName,
+						AuthorName: "owner",
+						CreatedAt:  timestamppb.New(testCreatedAt),
+					},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.ListDashboardVersionsRequest{
+					UUID:   testDashboardUUID,
+					Limit:  testLimit,
+					Offset: testOffset,
+				},
+				resp: types.DashboardVersionInfos{
+					{Version: 2, Name: testDashboardName, AuthorName: "editor", CreatedAt: testCreatedAt},
+					{Version: 1, Name: testDashboardName, AuthorName: "owner", CreatedAt: testCreatedAt},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.ListVersionsRequest{
+				Uuid:   testDashboardUUID,
+				Limit:  int32(testLimit),
+				Offset: int32(testOffset),
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.ListDashboardVersionsRequest{
+					UUID:   testDashboardUUID,
+					Limit:  testLimit,
+					Offset: testOffset,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ListDashboardVersions(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.ListVersions(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
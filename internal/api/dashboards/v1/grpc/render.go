@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) Render(ctx context.Context, req *dashboards.RenderRequest) (*dashboards.RenderResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_render")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+		attribute.KeyValue{
+			Key:   "from",
+			Value: tracing.TimestampToStringValue(req.GetFrom()),
+		},
+		attribute.KeyValue{
+			Key:   "to",
+			Value: tracing.TimestampToStringValue(req.GetTo()),
+		},
+	)
+
+	request := types.RenderDashboardRequest{
+		UUID:      req.Uuid,
+		Variables: req.Variables,
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		request.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		request.To = &to
+	}
+
+	panels, err := a.service.RenderDashboard(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.RenderResponse{
+		Panels: panels.ToProto(),
+	}, nil
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestRender(t *testing.T) {
+	from := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	value := 1.5
+
+	type mockArgs struct {
+		req  types.RenderDashboardRequest
+		resp types.DashboardPanelsData
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.RenderRequest
+		want     *dashboards.RenderResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.RenderRequest{
+				Uuid:      testDashboardUUID,
+				From:      timestamppb.New(from),
+				To:        timestamppb.New(to),
+				Variables: map[string]string{"service": "api"},
+			},
+			want: &dashboards.RenderResponse{
+				Panels: []*dashboards.PanelData{
+					{
+						Id: "p1", From: timestamppb.New(from), To: timestamppb.New(to),
+						Histogram: []*dashboards.PanelData_HistogramBucket{{Key: 1714554000000, DocCount: 3}},
+					},
+					{
+						Id: "p2", From: timestamppb.New(from), To: timestamppb.New(to),
+						Aggregations: []*dashboards.PanelData_Aggregation{{
+							Buckets: []*dashboards.PanelData_AggregationBucket{{Key: "svc1", Value: &value}},
+						}},
+					},
+					{Id: "p3", From: timestamppb.New(from), To: timestamppb.New(to), Error: "query too heavy"},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.RenderDashboardRequest{
+					UUID: testDashboardUUID, From: &from, To: &to,
+					Variables: map[string]string{"service": "api"},
+				},
+				resp: types.DashboardPanelsData{
+					{
+						ID: "p1", From: from, To: to,
+						Histogram: []types.DashboardHistogramBucket{{Key: 1714554000000, DocCount: 3}},
+					},
+					{
+						ID: "p2", From: from, To: to,
+						Aggregations: []types.DashboardAggregation{{
+							Buckets: []types.DashboardAggregationBucket{{Key: "svc1", Value: &value}},
+						}},
+					},
+					{ID: "p3", From: from, To: to, Error: "query too heavy"},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.RenderRequest{
+				Uuid: testDashboardUUID,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.RenderDashboardRequest{UUID: testDashboardUUID},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					RenderDashboard(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.Render(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
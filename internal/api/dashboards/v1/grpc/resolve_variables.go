@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) ResolveVariables(ctx context.Context, req *dashboards.ResolveVariablesRequest) (*dashboards.ResolveVariablesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_resolve_variables")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+	)
+
+	vars, err := a.service.ResolveDashboardVariables(ctx, types.ResolveDashboardVariablesRequest{
+		UUID:      req.Uuid,
+		Variables: req.Variables,
+	})
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.ResolveVariablesResponse{
+		Variables: vars.ToProto(),
+	}, nil
+}
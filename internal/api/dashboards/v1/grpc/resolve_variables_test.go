@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestResolveVariables(t *testing.T) {
+	type mockArgs struct {
+		req  types.ResolveDashboardVariablesRequest
+		resp types.DashboardVariablesOptions
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.ResolveVariablesRequest
+		want     *dashboards.ResolveVariablesResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.ResolveVariablesRequest{
+				Uuid:      testDashboardUUID,
+				Variables: map[string]string{"env": "prod"},
+			},
+			want: &dashboards.ResolveVariablesResponse{
+				Variables: []*dashboards.VariableOptions{
+					{Name: "env", Value: "prod", Options: []string{"prod", "staging"}},
+					{Name: "service", Error: "error groups are not configured"},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.ResolveDashboardVariablesRequest{
+					UUID:      testDashboardUUID,
+					Variables: map[string]string{"env": "prod"},
+				},
+				resp: types.DashboardVariablesOptions{
+					{Name: "env", Value: "prod", Options: []string{"prod", "staging"}},
+					{Name: "service", Error: "error groups are not configured"},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.ResolveVariablesRequest{
+				Uuid: testDashboardUUID,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.ResolveDashboardVariablesRequest{UUID: testDashboardUUID},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ResolveDashboardVariables(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.ResolveVariables(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) Restore(ctx context.Context, req *dashboards.RestoreRequest) (*dashboards.RestoreResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_restore")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+		attribute.KeyValue{
+			Key:   "version",
+			Value: attribute.Int64Value(req.GetVersion()),
+		},
+	)
+
+	request := types.RestoreDashboardVersionRequest{
+		UUID:    req.Uuid,
+		Version: req.Version,
+	}
+
+	if err := a.service.RestoreDashboardVersion(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.RestoreResponse{}, nil
+}
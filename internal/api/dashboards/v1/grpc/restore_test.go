@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestRestore(t *testing.T) {
+	type mockArgs struct {
+		req types.RestoreDashboardVersionRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.RestoreRequest
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.RestoreRequest{
+				Uuid:    testDashboardUUID,
+				Version: 3,
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.RestoreDashboardVersionRequest{
+					UUID:    testDashboardUUID,
+					Version: 3,
+				},
+			},
+		},
+		{
+			name: "err_not_found",
+			req: &dashboards.RestoreRequest{
+				Uuid:    testDashboardUUID,
+				Version: 3,
+			},
+			wantCode: codes.NotFound,
+			mockArgs: &mockArgs{
+				req: types.RestoreDashboardVersionRequest{
+					UUID:    testDashboardUUID,
+					Version: 3,
+				},
+				err: types.NewErrNotFound("dashboard version"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					RestoreDashboardVersion(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			_, err := api.Restore(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
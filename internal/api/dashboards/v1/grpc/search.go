@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ozontech/seq-ui/internal/api/grpcutil"
 	"github.com/ozontech/seq-ui/internal/app/types"
@@ -44,25 +45,36 @@ func (a *API) Search(ctx context.Context, req *dashboards.SearchRequest) (*dashb
 		Query:  req.Query,
 		Limit:  int(req.Limit),
 		Offset: int(req.Offset),
+		Sort:   types.DashboardsSortFromProto(req.Sort),
 	}
 
 	if req.Filter != nil {
 		request.Filter = &types.SearchDashboardsFilter{
 			OwnerName: req.Filter.OwnerName,
+			Tags:      req.Filter.Tags,
+			FolderID:  req.Filter.FolderId,
+			Starred:   req.Filter.Starred,
 		}
 	}
 
-	infos, err := a.service.SearchDashboards(ctx, request)
+	results, err := a.service.SearchDashboards(ctx, request)
 	if err != nil {
 		return nil, grpcutil.ProcessError(err)
 	}
 
-	ds := make([]*dashboards.SearchResponse_Dashboard, len(infos))
-	for i, d := range infos {
+	ds := make([]*dashboards.SearchResponse_Dashboard, len(results))
+	for i, d := range results {
 		ds[i] = &dashboards.SearchResponse_Dashboard{
 			Uuid:      d.UUID,
 			Name:      d.Name,
 			OwnerName: d.OwnerName,
+			FolderId:  d.FolderID,
+			Tags:      d.Tags,
+			Starred:   d.Starred,
+			UpdatedAt: timestamppb.New(d.UpdatedAt),
+		}
+		if d.ViewedAt != nil {
+			ds[i].ViewedAt = timestamppb.New(*d.ViewedAt)
 		}
 	}
 
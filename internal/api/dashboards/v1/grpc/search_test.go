@@ -8,6 +8,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
@@ -20,7 +21,7 @@ func TestSearch(t *testing.T) {
 
 	type mockArgs struct {
 		req  types.SearchDashboardsRequest
-		resp types.DashboardInfosWithOwner
+		resp types.DashboardSearchResults
 		err  error
 	}
 
@@ -42,8 +43,22 @@ func TestSearch(t *testing.T) {
 			},
 			want: &dashboards.SearchResponse{
 				Dashboards: []*dashboards.SearchResponse_Dashboard{
-					{Uuid: "064dc707-02b8-7000-8201-02a7f396738a", Name: "my test dashboard", OwnerName: "user1"},
-					{Uuid: "064dc707-12b9-7000-a238-682b044c908b", Name: "tested", OwnerName: "user2"},
+					{
+						Uuid:      "064dc707-02b8-7000-8201-02a7f396738a",
+						Name:      "my test dashboard",
+						OwnerName: "user1",
+						Tags:      []string{"prod"},
+						Starred:   true,
+						UpdatedAt: timestamppb.New(testCreatedAt),
+						ViewedAt:  timestamppb.New(testCreatedAt),
+					},
+					{
+						Uuid:      "064dc707-12b9-7000-a238-682b044c908b",
+						Name:      "tested",
+						OwnerName: "user2",
+						Tags:      []string{},
+						UpdatedAt: timestamppb.New(testCreatedAt),
+					},
 				},
 			},
 			wantCode: codes.OK,
@@ -52,21 +67,32 @@ func TestSearch(t *testing.T) {
 					Query:  "test",
 					Limit:  testLimit,
 					Offset: testOffset,
+					Sort:   types.DashboardsSortName,
 				},
-				resp: types.DashboardInfosWithOwner{
+				resp: types.DashboardSearchResults{
 					{
-						DashboardInfo: types.DashboardInfo{
-							UUID: "064dc707-02b8-7000-8201-02a7f396738a",
-							Name: "my test dashboard",
+						DashboardInfoWithOwner: types.DashboardInfoWithOwner{
+							DashboardInfo: types.DashboardInfo{
+								UUID: "064dc707-02b8-7000-8201-02a7f396738a",
+								Name: "my test dashboard",
+							},
+							OwnerName: "user1",
 						},
-						OwnerName: "user1",
+						Tags:      []string{"prod"},
+						Starred:   true,
+						UpdatedAt: testCreatedAt,
+						ViewedAt:  &testCreatedAt,
 					},
 					{
-						DashboardInfo: types.DashboardInfo{
-							UUID: "064dc707-12b9-7000-a238-682b044c908b",
-							Name: "tested",
+						DashboardInfoWithOwner: types.DashboardInfoWithOwner{
+							DashboardInfo: types.DashboardInfo{
+								UUID: "064dc707-12b9-7000-a238-682b044c908b",
+								Name: "tested",
+							},
+							OwnerName: "user2",
 						},
-						OwnerName: "user2",
+						Tags:      []string{},
+						UpdatedAt: testCreatedAt,
 					},
 				},
 			},
@@ -79,11 +105,23 @@ func TestSearch(t *testing.T) {
 				Offset: int32(testOffset),
 				Filter: &dashboards.SearchRequest_Filter{
 					OwnerName: &userName,
+					Tags:      []string{"prod"},
+					FolderId:  &testFolderID,
+					Starred:   true,
 				},
+				Sort: dashboards.Sort_SORT_VIEWED,
 			},
 			want: &dashboards.SearchResponse{
 				Dashboards: []*dashboards.SearchResponse_Dashboard{
-					{Uuid: "064dc707-02b8-7000-8201-02a7f396738a", Name: "my test dashboard", OwnerName: userName},
+					{
+						Uuid:      "064dc707-02b8-7000-8201-02a7f396738a",
+						Name:      "my test dashboard",
+						OwnerName: userName,
+						FolderId:  &testFolderID,
+						Tags:      []string{"prod"},
+						Starred:   true,
+						UpdatedAt: timestamppb.New(testCreatedAt),
+					},
 				},
 			},
 			wantCode: codes.OK,
@@ -94,15 +132,25 @@ func TestSearch(t *testing.T) {
 					Offset: testOffset,
 					Filter: &types.SearchDashboardsFilter{
 						OwnerName: &userName,
+						Tags:      []string{"prod"},
+						FolderID:  &testFolderID,
+						Starred:   true,
 					},
+					Sort: types.DashboardsSortViewed,
 				},
-				resp: types.DashboardInfosWithOwner{
+				resp: types.DashboardSearchResults{
 					{
-						DashboardInfo: types.DashboardInfo{
-							UUID: "064dc707-02b8-7000-8201-02a7f396738a",
-							Name: "my test dashboard",
+						DashboardInfoWithOwner: types.DashboardInfoWithOwner{
+							DashboardInfo: types.DashboardInfo{
+								UUID: "064dc707-02b8-7000-8201-02a7f396738a",
+								Name: "my test dashboard",
+							},
+							OwnerName: userName,
 						},
-						OwnerName: userName,
+						FolderID:  &testFolderID,
+						Tags:      []string{"prod"},
+						Starred:   true,
+						UpdatedAt: testCreatedAt,
 					},
 				},
 			},
@@ -120,6 +168,7 @@ func TestSearch(t *testing.T) {
 					Query:  "test",
 					Limit:  testLimit,
 					Offset: testOffset,
+					Sort:   types.DashboardsSortName,
 				},
 				err: errSomethingWrong,
 			},
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) Star(ctx context.Context, req *dashboards.StarRequest) (*dashboards.StarResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_star")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+	)
+
+	if err := a.service.StarDashboard(ctx, req.Uuid); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.StarResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestStar(t *testing.T) {
+	type mockArgs struct {
+		uuid string
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.StarRequest
+		want     *dashboards.StarResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.StarRequest{
+				Uuid: testDashboardUUID,
+			},
+			want:     &dashboards.StarResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				uuid: testDashboardUUID,
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.StarRequest{
+				Uuid: testDashboardUUID,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				uuid: testDashboardUUID,
+				err:  errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					StarDashboard(gomock.Any(), tt.mockArgs.uuid).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.Star(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -15,8 +16,10 @@ var (
 	testDashboardUUID = "064dc707-02b8-7000-8201-02a7f396738a"
 	testDashboardName = "my_dashboard"
 	testDashboardMeta = "my_meta"
+	testFolderID      = int64(3)
 	testLimit         = 2
 	testOffset        = 0
+	testCreatedAt     = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
 )
 
 func setupTestAPI(t *testing.T) (*API, *mock.MockService) {
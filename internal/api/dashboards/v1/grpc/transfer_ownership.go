@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) TransferOwnership(ctx context.Context, req *dashboards.TransferOwnershipRequest) (*dashboards.TransferOwnershipResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_transfer_ownership")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+		attribute.KeyValue{
+			Key:   "new_owner_name",
+			Value: attribute.StringValue(req.GetNewOwnerName()),
+		},
+	)
+
+	request := types.TransferDashboardOwnershipRequest{
+		UUID:         req.Uuid,
+		NewOwnerName: req.NewOwnerName,
+	}
+
+	if err := a.service.TransferDashboardOwnership(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.TransferOwnershipResponse{}, nil
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestTransferOwnership(t *testing.T) {
+	type mockArgs struct {
+		req types.TransferDashboardOwnershipRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.TransferOwnershipRequest
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.TransferOwnershipRequest{
+				Uuid:         testDashboardUUID,
+				NewOwnerName: "user1",
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.TransferDashboardOwnershipRequest{
+					UUID:         testDashboardUUID,
+					NewOwnerName: "user1",
+				},
+			},
+		},
+		{
+			name: "err_not_found",
+			req: &dashboards.TransferOwnershipRequest{
+				Uuid:         testDashboardUUID,
+				NewOwnerName: "user1",
+			},
+			wantCode: codes.NotFound,
+			mockArgs: &mockArgs{
+				req: types.TransferDashboardOwnershipRequest{
+					UUID:         testDashboardUUID,
+					NewOwnerName: "user1",
+				},
+				err: types.NewErrNotFound("user profile"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					TransferDashboardOwnership(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			_, err := api.TransferOwnership(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
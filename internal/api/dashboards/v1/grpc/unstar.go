@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) Unstar(ctx context.Context, req *dashboards.UnstarRequest) (*dashboards.UnstarResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_unstar")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+	)
+
+	if err := a.service.UnstarDashboard(ctx, req.Uuid); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.UnstarResponse{}, nil
+}
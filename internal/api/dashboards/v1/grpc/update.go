@@ -27,9 +27,13 @@ func (a *API) Update(ctx context.Context, req *dashboards.UpdateRequest) (*dashb
 	)
 
 	request := types.UpdateDashboardRequest{
-		UUID: req.Uuid,
-		Name: req.Name,
-		Meta: req.Meta,
+		UUID:     req.Uuid,
+		Name:     req.Name,
+		Meta:     req.Meta,
+		FolderID: req.FolderId,
+	}
+	if req.Tags != nil {
+		request.Tags = &req.Tags.Values
 	}
 
 	if err := a.service.UpdateDashboard(ctx, request); err != nil {
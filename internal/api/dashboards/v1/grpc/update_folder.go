@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) UpdateFolder(ctx context.Context, req *dashboards.UpdateFolderRequest) (*dashboards.UpdateFolderResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_update_folder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(req.GetId()),
+		},
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(req.GetName()),
+		},
+	)
+
+	request := types.UpdateDashboardFolderRequest{
+		ID:       req.Id,
+		Name:     req.Name,
+		ParentID: req.ParentId,
+	}
+
+	if err := a.service.UpdateDashboardFolder(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.UpdateFolderResponse{}, nil
+}
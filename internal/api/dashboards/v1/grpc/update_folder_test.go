@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestUpdateFolder(t *testing.T) {
+	var (
+		folderName = "api"
+		rootID     = int64(0)
+	)
+
+	type mockArgs struct {
+		req types.UpdateDashboardFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.UpdateFolderRequest
+		want     *dashboards.UpdateFolderResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.UpdateFolderRequest{
+				Id:       testFolderID,
+				Name:     &folderName,
+				ParentId: &rootID,
+			},
+			want:     &dashboards.UpdateFolderResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardFolderRequest{
+					ID:       testFolderID,
+					Name:     &folderName,
+					ParentID: &rootID,
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.UpdateFolderRequest{
+				Id:   testFolderID,
+				Name: &folderName,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardFolderRequest{
+					ID:   testFolderID,
+					Name: &folderName,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateDashboardFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.UpdateFolder(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) UpdateSharing(ctx context.Context, req *dashboards.UpdateSharingRequest) (*dashboards.UpdateSharingResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "dashboards_v1_update_sharing")
+	defer span.End()
+
+	request := types.UpdateDashboardSharingRequest{
+		UUID:          req.Uuid,
+		Visibility:    types.DashboardVisibilityFromProto(req.Visibility),
+		Team:          req.Team,
+		Collaborators: make(types.DashboardCollaborators, 0, len(req.Collaborators)),
+	}
+	for _, c := range req.Collaborators {
+		request.Collaborators = append(request.Collaborators, types.DashboardCollaboratorFromProto(c))
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(req.GetUuid()),
+		},
+		attribute.KeyValue{
+			Key:   "visibility",
+			Value: attribute.StringValue(string(request.Visibility)),
+		},
+		attribute.KeyValue{
+			Key:   "team",
+			Value: attribute.StringValue(req.GetTeam()),
+		},
+		attribute.KeyValue{
+			Key:   "collaborators",
+			Value: attribute.IntValue(len(request.Collaborators)),
+		},
+	)
+
+	if err := a.service.UpdateDashboardSharing(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &dashboards.UpdateSharingResponse{}, nil
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
+func TestUpdateSharing(t *testing.T) {
+	type mockArgs struct {
+		req types.UpdateDashboardSharingRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *dashboards.UpdateSharingRequest
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &dashboards.UpdateSharingRequest{
+				Uuid:       testDashboardUUID,
+				Visibility: dashboards.Visibility_VISIBILITY_TEAM,
+				Team:       "team1",
+				Collaborators: []*dashboards.Collaborator{
+					{
+						Kind: dashboards.Collaborator_KIND_USER,
+						Name: "user1",
+						Role: dashboards.Role_ROLE_EDITOR,
+					},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardSharingRequest{
+					UUID:       testDashboardUUID,
+					Visibility: types.DashboardVisibilityTeam,
+					Team:       "team1",
+					Collaborators: types.DashboardCollaborators{
+						{Kind: types.DashboardCollaboratorUser, Name: "user1", Role: types.DashboardRoleEditor},
+					},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: &dashboards.UpdateSharingRequest{
+				Uuid: testDashboardUUID,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardSharingRequest{
+					UUID:          testDashboardUUID,
+					Visibility:    types.DashboardVisibilityPublic,
+					Collaborators: types.DashboardCollaborators{},
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateDashboardSharing(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			_, err := api.UpdateSharing(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -45,6 +45,25 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ok_folder_and_tags",
+			req: &dashboards.UpdateRequest{
+				Uuid:     testDashboardUUID,
+				FolderId: &testFolderID,
+				Tags: &dashboards.UpdateRequest_Tags{
+					Values: []string{"prod"},
+				},
+			},
+			want:     &dashboards.UpdateResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardRequest{
+					UUID:     testDashboardUUID,
+					FolderID: &testFolderID,
+					Tags:     &[]string{"prod"},
+				},
+			},
+		},
 		{
 			name: "err_svc",
 			req: &dashboards.UpdateRequest{
@@ -1,6 +1,11 @@
 package http
 
 import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/go-chi/chi/v5"
 
 	"github.com/ozontech/seq-ui/internal/app/types"
@@ -27,6 +32,23 @@ func (a *API) Router() chi.Router {
 	mux.Patch("/{uuid}", a.serveUpdate)
 	mux.Delete("/{uuid}", a.serveDelete)
 	mux.Post("/search", a.serveSearch)
+	mux.Post("/{uuid}/versions", a.serveListVersions)
+	mux.Post("/{uuid}/versions/diff", a.serveDiffVersions)
+	mux.Get("/{uuid}/versions/{version}", a.serveGetVersion)
+	mux.Post("/{uuid}/versions/{version}/restore", a.serveRestore)
+	mux.Get("/{uuid}/sharing", a.serveGetSharing)
+	mux.Put("/{uuid}/sharing", a.serveUpdateSharing)
+	mux.Post("/{uuid}/transfer", a.serveTransferOwnership)
+	mux.Post("/{uuid}/star", a.serveStar)
+	mux.Delete("/{uuid}/star", a.serveUnstar)
+	mux.Get("/folders", a.serveGetFolders)
+	mux.Post("/folders", a.serveCreateFolder)
+	mux.Patch("/folders/{id}", a.serveUpdateFolder)
+	mux.Delete("/folders/{id}", a.serveDeleteFolder)
+	mux.Post("/export", a.serveExport)
+	mux.Post("/import", a.serveImport)
+	mux.Post("/{uuid}/render", a.serveRender)
+	mux.Post("/{uuid}/variables", a.serveResolveVariables)
 
 	return mux
 }
@@ -76,9 +98,12 @@ func newInfosWithOwner(t types.DashboardInfosWithOwner) infosWithOwner {
 }
 
 type dashboard struct {
-	Name      string `json:"name"`
-	Meta      string `json:"meta"`
-	OwnerName string `json:"owner_name"`
+	Name      string    `json:"name"`
+	Meta      string    `json:"meta"`
+	OwnerName string    `json:"owner_name"`
+	FolderID  *int64    `json:"folder_id,omitempty" format:"int64"`
+	Tags      []string  `json:"tags"`
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
 } //	@name	dashboards.v1.Dashboard
 
 func newDashboard(d types.Dashboard) dashboard {
@@ -86,5 +111,147 @@ func newDashboard(d types.Dashboard) dashboard {
 		Name:      d.Name,
 		Meta:      d.Meta,
 		OwnerName: d.OwnerName,
+		FolderID:  d.FolderID,
+		Tags:      d.Tags,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+type searchResult struct {
+	infoWithOwner
+	FolderID  *int64     `json:"folder_id,omitempty" format:"int64"`
+	Tags      []string   `json:"tags"`
+	Starred   bool       `json:"starred"`
+	UpdatedAt time.Time  `json:"updated_at" format:"date-time"`
+	ViewedAt  *time.Time `json:"viewed_at,omitempty" format:"date-time"`
+} //	@name	dashboards.v1.SearchResult
+
+type searchResults []searchResult
+
+func newSearchResults(t types.DashboardSearchResults) searchResults {
+	res := make(searchResults, len(t))
+	for i, d := range t {
+		res[i] = searchResult{
+			infoWithOwner: newInfoWithOwner(d.DashboardInfoWithOwner),
+			FolderID:      d.FolderID,
+			Tags:          d.Tags,
+			Starred:       d.Starred,
+			UpdatedAt:     d.UpdatedAt,
+			ViewedAt:      d.ViewedAt,
+		}
+	}
+	return res
+}
+
+type folder struct {
+	ID        int64  `json:"id" format:"int64"`
+	ParentID  *int64 `json:"parent_id,omitempty" format:"int64"`
+	Name      string `json:"name"`
+	OwnerName string `json:"owner_name"`
+} //	@name	dashboards.v1.Folder
+
+func newFolders(t types.DashboardFolders) []folder {
+	res := make([]folder, len(t))
+	for i, f := range t {
+		res[i] = folder{
+			ID:        f.ID,
+			ParentID:  f.ParentID,
+			Name:      f.Name,
+			OwnerName: f.OwnerName,
+		}
+	}
+	return res
+}
+
+func parseFolderID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, errors.New("incorrect 'id' format")
+	}
+	return id, nil
+}
+
+type versionInfo struct {
+	Version    int64     `json:"version" format:"int64"`
+	Name       string    `json:"name"`
+	AuthorName string    `json:"author_name"`
+	CreatedAt  time.Time `json:"created_at" format:"date-time"`
+} //	@name	dashboards.v1.VersionInfo
+
+func newVersionInfo(v types.DashboardVersionInfo) versionInfo {
+	return versionInfo{
+		Version:    v.Version,
+		Name:       v.Name,
+		AuthorName: v.AuthorName,
+		CreatedAt:  v.CreatedAt,
 	}
 }
+
+func parseVersion(r *http.Request) (int64, error) {
+	version, err := strconv.ParseInt(chi.URLParam(r, "version"), 10, 64)
+	if err != nil {
+		return 0, errors.New("incorrect 'version' format")
+	}
+	return version, nil
+}
+
+type collaborator struct {
+	Kind string `json:"kind" enums:"user,group"`
+	// User name or group name depending on the kind.
+	Name string `json:"name"`
+	Role string `json:"role" enums:"viewer,editor"`
+} //	@name	dashboards.v1.Collaborator
+
+func newCollaborators(t types.DashboardCollaborators) []collaborator {
+	res := make([]collaborator, len(t))
+	for i, c := range t {
+		res[i] = collaborator{
+			Kind: string(c.Kind),
+			Name: c.Name,
+			Role: string(c.Role),
+		}
+	}
+	return res
+}
+
+func (c collaborator) toDomain() types.DashboardCollaborator {
+	return types.DashboardCollaborator{
+		Kind: types.DashboardCollaboratorKind(c.Kind),
+		Name: c.Name,
+		Role: types.DashboardRole(c.Role),
+	}
+}
+
+type bundleDashboard struct {
+	UUID string   `json:"uuid"`
+	Name string   `json:"name"`
+	Meta string   `json:"meta"`
+	Tags []string `json:"tags"`
+} //	@name	dashboards.v1.BundleDashboard
+
+type bundle struct {
+	Version    int               `json:"version"`
+	Dashboards []bundleDashboard `json:"dashboards"`
+} //	@name	dashboards.v1.Bundle
+
+func newBundle(t types.DashboardsBundle) bundle {
+	res := bundle{
+		Version:    t.Version,
+		Dashboards: make([]bundleDashboard, len(t.Dashboards)),
+	}
+	for i, d := range t.Dashboards {
+		res.Dashboards[i] = bundleDashboard(d)
+	}
+	return res
+}
+
+func (b bundle) toDomain() types.DashboardsBundle {
+	res := types.DashboardsBundle{
+		Version:    b.Version,
+		Dashboards: make([]types.DashboardsBundleItem, len(b.Dashboards)),
+	}
+	for i, d := range b.Dashboards {
+		res.Dashboards[i] = types.DashboardsBundleItem(d)
+	}
+	return res
+}
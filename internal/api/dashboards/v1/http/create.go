@@ -41,8 +41,10 @@ func (a *API) serveCreate(w http.ResponseWriter, r *http.Request) {
 	)
 
 	req := types.CreateDashboardRequest{
-		Name: httpReq.Name,
-		Meta: httpReq.Meta,
+		Name:     httpReq.Name,
+		Meta:     httpReq.Meta,
+		FolderID: httpReq.FolderID,
+		Tags:     httpReq.Tags,
 	}
 
 	uuid, err := a.service.CreateDashboard(ctx, req)
@@ -55,8 +57,10 @@ func (a *API) serveCreate(w http.ResponseWriter, r *http.Request) {
 }
 
 type createRequest struct {
-	Name string `json:"name"`
-	Meta string `json:"meta"`
+	Name     string   `json:"name"`
+	Meta     string   `json:"meta"`
+	FolderID *int64   `json:"folder_id,omitempty" format:"int64"`
+	Tags     []string `json:"tags,omitempty"`
 } //	@name	dashboards.v1.CreateRequest
 
 type createResponse struct {
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveCreateFolder go doc.
+//
+//	@Router		/dashboards/v1/folders [post]
+//	@ID			dashboards_v1_createFolder
+//	@Tags		dashboards_v1
+//	@Param		body	body		createFolderRequest		true	"Request body"
+//	@Success	200		{object}	createFolderResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error			"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveCreateFolder(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_create_folder")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq createFolderRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(httpReq.Name),
+		},
+	)
+
+	req := types.CreateDashboardFolderRequest{
+		Name:     httpReq.Name,
+		ParentID: httpReq.ParentID,
+	}
+
+	id, err := a.service.CreateDashboardFolder(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(createFolderResponse{ID: id})
+}
+
+type createFolderRequest struct {
+	Name     string `json:"name"`
+	ParentID *int64 `json:"parent_id,omitempty" format:"int64"`
+} //	@name	dashboards.v1.CreateFolderRequest
+
+type createFolderResponse struct {
+	ID int64 `json:"id" format:"int64"`
+} //	@name	dashboards.v1.CreateFolderResponse
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeCreateFolder(t *testing.T) {
+	parentID := int64(3)
+
+	type mockArgs struct {
+		req  types.CreateDashboardFolderRequest
+		resp int64
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     createFolderRequest
+		want    createFolderResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  createFolderRequest{Name: "api", ParentID: &parentID},
+			want: createFolderResponse{ID: 4},
+			mockArgs: &mockArgs{
+				req: types.CreateDashboardFolderRequest{
+					Name:     "api",
+					ParentID: &parentID,
+				},
+				resp: 4,
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     createFolderRequest{Name: "api"},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.CreateDashboardFolderRequest{
+					Name: "api",
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					CreateDashboardFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[createFolderRequest, createFolderResponse]{
+				Method:  http.MethodPost,
+				Target:  "/dashboards/v1/folders",
+				Req:     tt.req,
+				Handler: api.serveCreateFolder,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
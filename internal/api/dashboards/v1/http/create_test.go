@@ -15,6 +15,7 @@ func TestServeCreate(t *testing.T) {
 		dashboardUUID = "064dc707-02b8-7000-8201-02a7f396738a"
 		dashboardMeta = "my_meta"
 		dashboardName = "my_dashboard"
+		folderID      = int64(3)
 	)
 
 	type mockArgs struct {
@@ -44,6 +45,20 @@ func TestServeCreate(t *testing.T) {
 				resp: dashboardUUID,
 			},
 		},
+		{
+			name: "ok_with_folder_and_tags",
+			req:  createRequest{Name: dashboardName, Meta: dashboardMeta, FolderID: &folderID, Tags: []string{"prod"}},
+			want: createResponse{UUID: dashboardUUID},
+			mockArgs: &mockArgs{
+				req: types.CreateDashboardRequest{
+					Name:     dashboardName,
+					Meta:     dashboardMeta,
+					FolderID: &folderID,
+					Tags:     []string{"prod"},
+				},
+				resp: dashboardUUID,
+			},
+		},
 		{
 			name:    "err_svc",
 			req:     createRequest{Name: dashboardName, Meta: dashboardMeta},
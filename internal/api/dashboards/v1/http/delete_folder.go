@@ -0,0 +1,51 @@
+package http
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveDeleteFolder go doc.
+//
+//	@Router		/dashboards/v1/folders/{id} [delete]
+//	@ID			dashboards_v1_deleteFolder
+//	@Tags		dashboards_v1
+//	@Param		id		path		int				true	"Folder ID"
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveDeleteFolder(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_delete_folder")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := parseFolderID(r)
+	if err != nil {
+		wr.Error(err, http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(id),
+		},
+	)
+
+	req := types.DeleteDashboardFolderRequest{
+		ID: id,
+	}
+
+	if err = a.service.DeleteDashboardFolder(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
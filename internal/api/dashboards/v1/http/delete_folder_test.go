@@ -0,0 +1,77 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeDeleteFolder(t *testing.T) {
+	type mockArgs struct {
+		req types.DeleteDashboardFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		id      string
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			id:   "4",
+			mockArgs: &mockArgs{
+				req: types.DeleteDashboardFolderRequest{
+					ID: 4,
+				},
+			},
+		},
+		{
+			name:    "err_id",
+			id:      "abc",
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			id:      "4",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.DeleteDashboardFolderRequest{
+					ID: 4,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					DeleteDashboardFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodDelete,
+				Target:  fmt.Sprintf("/dashboards/v1/folders/%s", tt.id),
+				Handler: withFolderID(api.serveDeleteFolder, tt.id),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveDiffVersions go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/versions/diff [post]
+//	@ID			dashboards_v1_diffVersions
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string					true	"Dashboard UUID"
+//	@Param		body	body		diffVersionsRequest		true	"Request body"
+//	@Success	200		{object}	diffVersionsResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error			"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveDiffVersions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_diff_versions")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+
+	var httpReq diffVersionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+		attribute.KeyValue{
+			Key:   "from_version",
+			Value: attribute.Int64Value(httpReq.FromVersion),
+		},
+		attribute.KeyValue{
+			Key:   "to_version",
+			Value: attribute.Int64Value(httpReq.ToVersion),
+		},
+	)
+
+	req := types.DiffDashboardVersionsRequest{
+		UUID:        uuid,
+		FromVersion: httpReq.FromVersion,
+		ToVersion:   httpReq.ToVersion,
+	}
+
+	diff, err := a.service.DiffDashboardVersions(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	changes := make([]diffChange, len(diff.Changes))
+	for i, c := range diff.Changes {
+		changes[i] = diffChange{
+			Path:     c.Path,
+			Op:       newDiffOp(c.Op),
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		}
+	}
+
+	wr.WriteJson(diffVersionsResponse{
+		From:    newVersionInfo(diff.From),
+		To:      newVersionInfo(diff.To),
+		Changes: changes,
+	})
+}
+
+type diffOp string //	@name	dashboards.v1.DiffOp
+
+const (
+	diffOpChanged diffOp = "changed"
+	diffOpAdded   diffOp = "added"
+	diffOpRemoved diffOp = "removed"
+)
+
+func newDiffOp(op types.DashboardDiffOp) diffOp {
+	switch op {
+	case types.DashboardDiffOpAdded:
+		return diffOpAdded
+	case types.DashboardDiffOpRemoved:
+		return diffOpRemoved
+	default:
+		return diffOpChanged
+	}
+}
+
+type diffVersionsRequest struct {
+	FromVersion int64 `json:"from_version" format:"int64"`
+	ToVersion   int64 `json:"to_version" format:"int64"`
+} //	@name	dashboards.v1.DiffVersionsRequest
+
+type diffChange struct {
+	// JSON pointer to the changed value in the dashboard meta.
+	Path string `json:"path"`
+	Op   diffOp `json:"op"`
+	// JSON-encoded values, empty if absent.
+	OldValue string `json:"old_value,omitempty"`
+	NewValue string `json:"new_value,omitempty"`
+} //	@name	dashboards.v1.DiffChange
+
+type diffVersionsResponse struct {
+	From    versionInfo  `json:"from"`
+	To      versionInfo  `json:"to"`
+	Changes []diffChange `json:"changes"`
+} //	@name	dashboards.v1.DiffVersionsResponse
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeDiffVersions(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	var (
+		fromInfo = types.DashboardVersionInfo{Version: 1, Name: "dashboard1", AuthorName: "owner", CreatedAt: testCreatedAt}
+		toInfo   = types.DashboardVersionInfo{Version: 2, Name: "dashboard2", AuthorName: "editor", CreatedAt: testCreatedAt}
+	)
+
+	type mockArgs struct {
+		req  types.DiffDashboardVersionsRequest
+		resp types.DashboardVersionsDiff
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     diffVersionsRequest
+		want    diffVersionsResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  diffVersionsRequest{FromVersion: 1, ToVersion: 2},
+			want: diffVersionsResponse{
+				From: newVersionInfo(fromInfo),
+				To:   newVersionInfo(toInfo),
+				Changes: []diffChange{
+					{Path: "/columns", Op: diffOpAdded, NewValue: `["level"]`},
+					{Path: "/histogram", Op: diffOpRemoved, OldValue: `false`},
+					{Path: "/query", Op: diffOpChanged, OldValue: `"a"`, NewValue: `"b"`},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.DiffDashboardVersionsRequest{
+					UUID:        dashboardUUID,
+					FromVersion: 1,
+					ToVersion:   2,
+				},
+				resp: types.DashboardVersionsDiff{
+					From: fromInfo,
+					To:   toInfo,
+					Changes: []types.DashboardDiffChange{
+						{Path: "/columns", Op: types.DashboardDiffOpAdded, NewValue: `["level"]`},
+						{Path: "/histogram", Op: types.DashboardDiffOpRemoved, OldValue: `false`},
+						{Path: "/query", Op: types.DashboardDiffOpChanged, OldValue: `"a"`, NewValue: `"b"`},
+					},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     diffVersionsRequest{FromVersion: 1, ToVersion: 2},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.DiffDashboardVersionsRequest{
+					UUID:        dashboardUUID,
+					FromVersion: 1,
+					ToVersion:   2,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					DiffDashboardVersions(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[diffVersionsRequest, diffVersionsResponse]{
+				Method:  http.MethodPost,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/versions/diff", dashboardUUID),
+				Req:     tt.req,
+				Handler: withUUID(api.serveDiffVersions, dashboardUUID),
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
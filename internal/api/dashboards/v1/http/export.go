@@ -0,0 +1,53 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveExport go doc.
+//
+//	@Router		/dashboards/v1/export [post]
+//	@ID			dashboards_v1_export
+//	@Tags		dashboards_v1
+//	@Param		body	body		exportRequest	true	"Request body"
+//	@Success	200		{object}	bundle			"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveExport(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_export")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq exportRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuids",
+			Value: attribute.StringSliceValue(httpReq.UUIDs),
+		},
+	)
+
+	b, err := a.service.ExportDashboards(ctx, httpReq.UUIDs)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(newBundle(b))
+}
+
+type exportRequest struct {
+	UUIDs []string `json:"uuids"`
+} //	@name	dashboards.v1.ExportRequest
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeExport(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		uuids []string
+		resp  types.DashboardsBundle
+		err   error
+	}
+
+	tests := []struct {
+		name string
+
+		req     exportRequest
+		want    bundle
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  exportRequest{UUIDs: []string{dashboardUUID}},
+			want: bundle{
+				Version: types.DashboardsBundleVersion,
+				Dashboards: []bundleDashboard{
+					{UUID: dashboardUUID, Name: "dashboard1", Meta: "meta1", Tags: []string{"prod"}},
+				},
+			},
+			mockArgs: &mockArgs{
+				uuids: []string{dashboardUUID},
+				resp: types.DashboardsBundle{
+					Version: types.DashboardsBundleVersion,
+					Dashboards: []types.DashboardsBundleItem{
+						{UUID: dashboardUUID, Name: "dashboard1", Meta: "meta1", Tags: []string{"prod"}},
+					},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     exportRequest{UUIDs: []string{dashboardUUID}},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				uuids: []string{dashboardUUID},
+				err:   errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ExportDashboards(gomock.Any(), tt.mockArgs.uuids).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[exportRequest, bundle]{
+				Method:  http.MethodPost,
+				Target:  "/dashboards/v1/export",
+				Req:     tt.req,
+				Handler: api.serveExport,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
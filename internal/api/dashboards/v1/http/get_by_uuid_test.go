@@ -34,6 +34,8 @@ func TestServeGetByUUID(t *testing.T) {
 				Name:      "dashboard1",
 				Meta:      "meta1",
 				OwnerName: "owner",
+				Tags:      []string{"prod"},
+				UpdatedAt: testCreatedAt,
 			},
 			mockArgs: &mockArgs{
 				uuid: dashboardUUID,
@@ -41,6 +43,8 @@ func TestServeGetByUUID(t *testing.T) {
 					OwnerName: "owner",
 					Name:      "dashboard1",
 					Meta:      "meta1",
+					Tags:      []string{"prod"},
+					UpdatedAt: testCreatedAt,
 				},
 			},
 		},
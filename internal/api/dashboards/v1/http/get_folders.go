@@ -0,0 +1,37 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetFolders go doc.
+//
+//	@Router		/dashboards/v1/folders [get]
+//	@ID			dashboards_v1_getFolders
+//	@Tags		dashboards_v1
+//	@Success	200		{object}	getFoldersResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetFolders(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_get_folders")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	folders, err := a.service.GetDashboardFolders(ctx)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getFoldersResponse{
+		Folders: newFolders(folders),
+	})
+}
+
+type getFoldersResponse struct {
+	Folders []folder `json:"folders"`
+} //	@name	dashboards.v1.GetFoldersResponse
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeGetFolders(t *testing.T) {
+	parentID := int64(3)
+
+	type mockArgs struct {
+		resp types.DashboardFolders
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want    getFoldersResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: getFoldersResponse{
+				Folders: []folder{
+					{ID: parentID, Name: "services", OwnerName: "user1"},
+					{ID: 4, ParentID: &parentID, Name: "api", OwnerName: "user2"},
+				},
+			},
+			mockArgs: &mockArgs{
+				resp: types.DashboardFolders{
+					{ID: parentID, Name: "services", OwnerID: 1, OwnerName: "user1"},
+					{ID: 4, ParentID: &parentID, Name: "api", OwnerID: 2, OwnerName: "user2"},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetDashboardFolders(gomock.Any()).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getFoldersResponse]{
+				Method:  http.MethodGet,
+				Target:  "/dashboards/v1/folders",
+				Handler: api.serveGetFolders,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetSharing go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/sharing [get]
+//	@ID			dashboards_v1_getSharing
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string				true	"Dashboard UUID"
+//	@Success	200		{object}	getSharingResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetSharing(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_get_sharing")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "uuid",
+		Value: attribute.StringValue(uuid),
+	})
+
+	acl, err := a.service.GetDashboardSharing(ctx, uuid)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getSharingResponse{
+		OwnerName:     acl.OwnerName,
+		Visibility:    string(acl.Visibility),
+		Team:          acl.Team,
+		Collaborators: newCollaborators(acl.Collaborators),
+	})
+}
+
+type getSharingResponse struct {
+	OwnerName     string         `json:"owner_name"`
+	Visibility    string         `json:"visibility" enums:"public,team,private"`
+	Team          string         `json:"team,omitempty"`
+	Collaborators []collaborator `json:"collaborators"`
+} //	@name	dashboards.v1.GetSharingResponse
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeGetSharing(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		resp types.DashboardACL
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want    getSharingResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: getSharingResponse{
+				OwnerName:  "owner",
+				Visibility: "team",
+				Team:       "team1",
+				Collaborators: []collaborator{
+					{Kind: "group", Name: "team2", Role: "editor"},
+					{Kind: "user", Name: "user1", Role: "viewer"},
+				},
+			},
+			mockArgs: &mockArgs{
+				resp: types.DashboardACL{
+					OwnerID:    1,
+					OwnerName:  "owner",
+					Visibility: types.DashboardVisibilityTeam,
+					Team:       "team1",
+					Collaborators: types.DashboardCollaborators{
+						{Kind: types.DashboardCollaboratorGroup, Name: "team2", Role: types.DashboardRoleEditor},
+						{Kind: types.DashboardCollaboratorUser, Name: "user1", Role: types.DashboardRoleViewer},
+					},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetDashboardSharing(gomock.Any(), dashboardUUID).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getSharingResponse]{
+				Method:  http.MethodGet,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/sharing", dashboardUUID),
+				Handler: withUUID(api.serveGetSharing, dashboardUUID),
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetVersion go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/versions/{version} [get]
+//	@ID			dashboards_v1_getVersion
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string				true	"Dashboard UUID"
+//	@Param		version	path		string				true	"Dashboard version"	Format(int64)
+//	@Success	200		{object}	getVersionResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetVersion(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_get_version")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+	version, err := parseVersion(r)
+	if err != nil {
+		wr.Error(err, http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+		attribute.KeyValue{
+			Key:   "version",
+			Value: attribute.Int64Value(version),
+		},
+	)
+
+	req := types.GetDashboardVersionRequest{
+		UUID:    uuid,
+		Version: version,
+	}
+
+	v, err := a.service.GetDashboardVersion(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getVersionResponse{
+		Info: newVersionInfo(v.DashboardVersionInfo),
+		Meta: v.Meta,
+	})
+}
+
+type getVersionResponse struct {
+	Info versionInfo `json:"info"`
+	Meta string      `json:"meta"`
+} //	@name	dashboards.v1.GetVersionResponse
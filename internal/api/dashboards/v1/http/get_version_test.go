@@ -0,0 +1,96 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeGetVersion(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		req  types.GetDashboardVersionRequest
+		resp types.DashboardVersion
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		version string
+		want    getVersionResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:    "ok",
+			version: "1",
+			want: getVersionResponse{
+				Info: versionInfo{Version: 1, Name: "dashboard1", AuthorName: "owner", CreatedAt: testCreatedAt},
+				Meta: "meta1",
+			},
+			mockArgs: &mockArgs{
+				req: types.GetDashboardVersionRequest{
+					UUID:    dashboardUUID,
+					Version: 1,
+				},
+				resp: types.DashboardVersion{
+					DashboardVersionInfo: types.DashboardVersionInfo{
+						Version:    1,
+						Name:       "dashboard1",
+						AuthorName: "owner",
+						CreatedAt:  testCreatedAt,
+					},
+					Meta: "meta1",
+				},
+			},
+		},
+		{
+			name:    "err_version",
+			version: "abc",
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			version: "1",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.GetDashboardVersionRequest{
+					UUID:    dashboardUUID,
+					Version: 1,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetDashboardVersion(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getVersionResponse]{
+				Method:  http.MethodGet,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/versions/%s", dashboardUUID, tt.version),
+				Handler: withUUIDAndVersion(api.serveGetVersion, dashboardUUID, tt.version),
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveImport go doc.
+//
+//	@Router		/dashboards/v1/import [post]
+//	@ID			dashboards_v1_import
+//	@Tags		dashboards_v1
+//	@Param		body	body		importRequest	true	"Request body"
+//	@Success	200		{object}	importResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveImport(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_import")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq importRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "conflict",
+			Value: attribute.StringValue(httpReq.Conflict),
+		},
+	)
+
+	req := types.ImportDashboardsRequest{
+		Bundle:   httpReq.Bundle.toDomain(),
+		Conflict: types.DashboardImportConflict(httpReq.Conflict),
+	}
+
+	results, err := a.service.ImportDashboards(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	resp := importResponse{
+		Results: make([]importResult, len(results)),
+	}
+	for i, r := range results {
+		resp.Results[i] = importResult{
+			SourceUUID: r.SourceUUID,
+			UUID:       r.UUID,
+			Status:     string(r.Status),
+		}
+	}
+
+	wr.WriteJson(resp)
+}
+
+type importRequest struct {
+	Bundle bundle `json:"bundle"`
+	// Policy for the dashboards that already exist.
+	Conflict string `json:"conflict,omitempty" default:"skip" enums:"skip,overwrite,new_uuid"`
+} //	@name	dashboards.v1.ImportRequest
+
+type importResult struct {
+	SourceUUID string `json:"source_uuid"`
+	UUID       string `json:"uuid"`
+	Status     string `json:"status" enums:"created,overwritten,skipped"`
+} //	@name	dashboards.v1.ImportResult
+
+type importResponse struct {
+	Results []importResult `json:"results"`
+} //	@name	dashboards.v1.ImportResponse
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeImport(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	b := bundle{
+		Version: types.DashboardsBundleVersion,
+		Dashboards: []bundleDashboard{
+			{UUID: dashboardUUID, Name: "dashboard1", Meta: "meta1", Tags: []string{"prod"}},
+		},
+	}
+	domainBundle := types.DashboardsBundle{
+		Version: types.DashboardsBundleVersion,
+		Dashboards: []types.DashboardsBundleItem{
+			{UUID: dashboardUUID, Name: "dashboard1", Meta: "meta1", Tags: []string{"prod"}},
+		},
+	}
+
+	type mockArgs struct {
+		req  types.ImportDashboardsRequest
+		resp types.DashboardImportResults
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     importRequest
+		want    importResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  importRequest{Bundle: b, Conflict: "overwrite"},
+			want: importResponse{
+				Results: []importResult{
+					{SourceUUID: dashboardUUID, UUID: dashboardUUID, Status: "overwritten"},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.ImportDashboardsRequest{
+					Bundle:   domainBundle,
+					Conflict: types.DashboardImportConflictOverwrite,
+				},
+				resp: types.DashboardImportResults{
+					{SourceUUID: dashboardUUID, UUID: dashboardUUID, Status: types.DashboardImportStatusOverwritten},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     importRequest{Bundle: b},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.ImportDashboardsRequest{
+					Bundle: domainBundle,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ImportDashboards(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[importRequest, importResponse]{
+				Method:  http.MethodPost,
+				Target:  "/dashboards/v1/import",
+				Req:     tt.req,
+				Handler: api.serveImport,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
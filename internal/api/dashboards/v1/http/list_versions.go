@@ -0,0 +1,84 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveListVersions go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/versions [post]
+//	@ID			dashboards_v1_listVersions
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string					true	"Dashboard UUID"
+//	@Param		body	body		listVersionsRequest		true	"Request body"
+//	@Success	200		{object}	listVersionsResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error			"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveListVersions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_list_versions")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+
+	var httpReq listVersionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+		attribute.KeyValue{
+			Key:   "limit",
+			Value: attribute.IntValue(httpReq.Limit),
+		},
+		attribute.KeyValue{
+			Key:   "offset",
+			Value: attribute.IntValue(httpReq.Offset),
+		},
+	)
+
+	req := types.ListDashboardVersionsRequest{
+		UUID:   uuid,
+		Limit:  httpReq.Limit,
+		Offset: httpReq.Offset,
+	}
+
+	vis, err := a.service.ListDashboardVersions(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	versions := make([]versionInfo, len(vis))
+	for i, v := range vis {
+		versions[i] = newVersionInfo(v)
+	}
+
+	wr.WriteJson(listVersionsResponse{
+		Versions: versions,
+	})
+}
+
+type listVersionsRequest struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+} //	@name	dashboards.v1.ListVersionsRequest
+
+type listVersionsResponse struct {
+	Versions []versionInfo `json:"versions"`
+} //	@name	dashboards.v1.ListVersionsResponse
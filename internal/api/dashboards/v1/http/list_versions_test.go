@@ -0,0 +1,97 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeListVersions(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		req  types.ListDashboardVersionsRequest
+		resp types.DashboardVersionInfos
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     listVersionsRequest
+		want    listVersionsResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: listVersionsRequest{
+				Limit:  testLimit,
+				Offset: testOffset,
+			},
+			want: listVersionsResponse{
+				Versions: []versionInfo{
+					{Version: 2, Name: "dashboard2", AuthorName: "editor", CreatedAt: testCreatedAt},
+					{Version: 1, Name: "dashboard1", AuthorName: "owner", CreatedAt: testCreatedAt},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.ListDashboardVersionsRequest{
+					UUID:   dashboardUUID,
+					Limit:  testLimit,
+					Offset: testOffset,
+				},
+				resp: types.DashboardVersionInfos{
+					{Version: 2, Name: "dashboard2", AuthorName: "editor", CreatedAt: testCreatedAt},
+					{Version: 1, Name: "dashboard1", AuthorName: "owner", CreatedAt: testCreatedAt},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: listVersionsRequest{
+				Limit:  testLimit,
+				Offset: testOffset,
+			},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.ListDashboardVersionsRequest{
+					UUID:   dashboardUUID,
+					Limit:  testLimit,
+					Offset: testOffset,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ListDashboardVersions(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[listVersionsRequest, listVersionsResponse]{
+				Method:  http.MethodPost,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/versions", dashboardUUID),
+				Req:     tt.req,
+				Handler: withUUID(api.serveListVersions, dashboardUUID),
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveRender go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/render [post]
+//	@ID			dashboards_v1_render
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string			true	"Dashboard UUID"
+//	@Param		body	body		renderRequest	true	"Request body"
+//	@Success	200		{object}	renderResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveRender(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_render")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq renderRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	uuid := chi.URLParam(r, "uuid")
+
+	attributes := []attribute.KeyValue{
+		{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+	}
+	if httpReq.From != nil && httpReq.To != nil {
+		attributes = append(attributes,
+			attribute.String("from", httpReq.From.Format(time.DateTime)),
+			attribute.String("to", httpReq.To.Format(time.DateTime)),
+		)
+	}
+	span.SetAttributes(attributes...)
+
+	panels, err := a.service.RenderDashboard(ctx, types.RenderDashboardRequest{
+		UUID:      uuid,
+		From:      httpReq.From,
+		To:        httpReq.To,
+		Variables: httpReq.Variables,
+	})
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(renderResponse{
+		Panels: newPanelsData(panels),
+	})
+}
+
+type renderRequest struct {
+	// From and To override time range of all panels.
+	From *time.Time `json:"from,omitempty" format:"date-time"`
+	To   *time.Time `json:"to,omitempty" format:"date-time"`
+	// Variables are values of the dashboard variables, default values are used for missing ones.
+	Variables map[string]string `json:"variables,omitempty"`
+} //	@name	dashboards.v1.RenderRequest
+
+type histogramBucket struct {
+	Key      uint64 `json:"key,string"`
+	DocCount uint64 `json:"doc_count,string"`
+} //	@name	dashboards.v1.HistogramBucket
+
+type aggregationBucket struct {
+	Key       string     `json:"key"`
+	Value     *float64   `json:"value"`
+	NotExists int64      `json:"not_exists,omitempty"`
+	Quantiles []float64  `json:"quantiles,omitempty"`
+	Ts        *time.Time `json:"ts,omitempty" format:"date-time"`
+} //	@name	dashboards.v1.AggregationBucket
+
+type aggregation struct {
+	Buckets          []aggregationBucket `json:"buckets"`
+	NotExists        int64               `json:"not_exists,omitempty"`
+	TargetBucketRate string              `json:"target_bucket_rate,omitempty"`
+} //	@name	dashboards.v1.Aggregation
+
+type panelData struct {
+	ID           string            `json:"id"`
+	From         time.Time         `json:"from" format:"date-time"`
+	To           time.Time         `json:"to" format:"date-time"`
+	Histogram    []histogramBucket `json:"histogram,omitempty"`
+	Aggregations []aggregation     `json:"aggregations,omitempty"`
+	Error        string            `json:"error,omitempty"`
+} //	@name	dashboards.v1.PanelData
+
+func newPanelData(d types.DashboardPanelData) panelData {
+	res := panelData{
+		ID:    d.ID,
+		From:  d.From,
+		To:    d.To,
+		Error: d.Error,
+	}
+
+	if len(d.Histogram) > 0 {
+		res.Histogram = make([]histogramBucket, len(d.Histogram))
+		for i, b := range d.Histogram {
+			res.Histogram[i] = histogramBucket(b)
+		}
+	}
+
+	if len(d.Aggregations) > 0 {
+		res.Aggregations = make([]aggregation, len(d.Aggregations))
+		for i, a := range d.Aggregations {
+			buckets := make([]aggregationBucket, len(a.Buckets))
+			for j, b := range a.Buckets {
+				buckets[j] = aggregationBucket(b)
+			}
+			res.Aggregations[i] = aggregation{
+				Buckets:          buckets,
+				NotExists:        a.NotExists,
+				TargetBucketRate: a.TargetBucketRate,
+			}
+		}
+	}
+
+	return res
+}
+
+func newPanelsData(d types.DashboardPanelsData) []panelData {
+	res := make([]panelData, len(d))
+	for i, p := range d {
+		res[i] = newPanelData(p)
+	}
+	return res
+}
+
+type renderResponse struct {
+	Panels []panelData `json:"panels"`
+} //	@name	dashboards.v1.RenderResponse
@@ -0,0 +1,107 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeRender(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+	from := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	value := 1.5
+
+	type mockArgs struct {
+		req  types.RenderDashboardRequest
+		resp types.DashboardPanelsData
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     renderRequest
+		want    renderResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  renderRequest{From: &from, To: &to, Variables: map[string]string{"service": "api"}},
+			want: renderResponse{
+				Panels: []panelData{
+					{
+						ID: "p1", From: from, To: to,
+						Histogram: []histogramBucket{{Key: 1714554000000, DocCount: 3}},
+					},
+					{
+						ID: "p2", From: from, To: to,
+						Aggregations: []aggregation{{
+							Buckets: []aggregationBucket{{Key: "svc1", Value: &value}},
+						}},
+					},
+					{ID: "p3", From: from, To: to, Error: "query too heavy"},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.RenderDashboardRequest{
+					UUID: dashboardUUID, From: &from, To: &to,
+					Variables: map[string]string{"service": "api"},
+				},
+				resp: types.DashboardPanelsData{
+					{
+						ID: "p1", From: from, To: to,
+						Histogram: []types.DashboardHistogramBucket{{Key: 1714554000000, DocCount: 3}},
+					},
+					{
+						ID: "p2", From: from, To: to,
+						Aggregations: []types.DashboardAggregation{{
+							Buckets: []types.DashboardAggregationBucket{{Key: "svc1", Value: &value}},
+						}},
+					},
+					{ID: "p3", From: from, To: to, Error: "query too heavy"},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.RenderDashboardRequest{UUID: dashboardUUID},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					RenderDashboard(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[renderRequest, renderResponse]{
+				Method:  http.MethodPost,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/render", dashboardUUID),
+				Req:     tt.req,
+				Handler: withUUID(api.serveRender, dashboardUUID),
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
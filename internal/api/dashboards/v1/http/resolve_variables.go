@@ -0,0 +1,88 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveResolveVariables go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/variables [post]
+//	@ID			dashboards_v1_resolve_variables
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string						true	"Dashboard UUID"
+//	@Param		body	body		resolveVariablesRequest		true	"Request body"
+//	@Success	200		{object}	resolveVariablesResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error				"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveResolveVariables(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_resolve_variables")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq resolveVariablesRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	uuid := chi.URLParam(r, "uuid")
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+	)
+
+	vars, err := a.service.ResolveDashboardVariables(ctx, types.ResolveDashboardVariablesRequest{
+		UUID:      uuid,
+		Variables: httpReq.Variables,
+	})
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(resolveVariablesResponse{
+		Variables: newVariablesOptions(vars),
+	})
+}
+
+type resolveVariablesRequest struct {
+	// Variables are values of the variables which other variables refer to.
+	Variables map[string]string `json:"variables,omitempty"`
+} //	@name	dashboards.v1.ResolveVariablesRequest
+
+type variableOptions struct {
+	Name    string   `json:"name"`
+	Value   string   `json:"value"`
+	Options []string `json:"options"`
+	Error   string   `json:"error,omitempty"`
+} //	@name	dashboards.v1.VariableOptions
+
+func newVariablesOptions(t types.DashboardVariablesOptions) []variableOptions {
+	res := make([]variableOptions, len(t))
+	for i, v := range t {
+		res[i] = variableOptions{
+			Name:    v.Name,
+			Value:   v.Value,
+			Options: v.Options,
+			Error:   v.Error,
+		}
+	}
+	return res
+}
+
+type resolveVariablesResponse struct {
+	Variables []variableOptions `json:"variables"`
+} //	@name	dashboards.v1.ResolveVariablesResponse
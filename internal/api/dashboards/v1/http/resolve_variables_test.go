@@ -0,0 +1,85 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeResolveVariables(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		req  types.ResolveDashboardVariablesRequest
+		resp types.DashboardVariablesOptions
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     resolveVariablesRequest
+		want    resolveVariablesResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  resolveVariablesRequest{Variables: map[string]string{"env": "prod"}},
+			want: resolveVariablesResponse{
+				Variables: []variableOptions{
+					{Name: "env", Value: "prod", Options: []string{"prod", "staging"}},
+					{Name: "service", Error: "error groups are not configured"},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.ResolveDashboardVariablesRequest{
+					UUID:      dashboardUUID,
+					Variables: map[string]string{"env": "prod"},
+				},
+				resp: types.DashboardVariablesOptions{
+					{Name: "env", Value: "prod", Options: []string{"prod", "staging"}},
+					{Name: "service", Error: "error groups are not configured"},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.ResolveDashboardVariablesRequest{UUID: dashboardUUID},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ResolveDashboardVariables(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[resolveVariablesRequest, resolveVariablesResponse]{
+				Method:  http.MethodPost,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/variables", dashboardUUID),
+				Req:     tt.req,
+				Handler: withUUID(api.serveResolveVariables, dashboardUUID),
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveRestore go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/versions/{version}/restore [post]
+//	@ID			dashboards_v1_restore
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string			true	"Dashboard UUID"
+//	@Param		version	path		string			true	"Dashboard version"	Format(int64)
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveRestore(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_restore")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+	version, err := parseVersion(r)
+	if err != nil {
+		wr.Error(err, http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+		attribute.KeyValue{
+			Key:   "version",
+			Value: attribute.Int64Value(version),
+		},
+	)
+
+	req := types.RestoreDashboardVersionRequest{
+		UUID:    uuid,
+		Version: version,
+	}
+
+	if err = a.service.RestoreDashboardVersion(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeRestore(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		req types.RestoreDashboardVersionRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		version string
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:    "ok",
+			version: "3",
+			mockArgs: &mockArgs{
+				req: types.RestoreDashboardVersionRequest{
+					UUID:    dashboardUUID,
+					Version: 3,
+				},
+			},
+		},
+		{
+			name:    "err_version",
+			version: "",
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			version: "3",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.RestoreDashboardVersionRequest{
+					UUID:    dashboardUUID,
+					Version: 3,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().RestoreDashboardVersion(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodPost,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/versions/%s/restore", dashboardUUID, tt.version),
+				Handler: withUUIDAndVersion(api.serveRestore, dashboardUUID, tt.version),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
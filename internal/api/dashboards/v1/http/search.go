@@ -61,26 +61,35 @@ func (a *API) serveSearch(w http.ResponseWriter, r *http.Request) {
 		Query:  httpReq.Query,
 		Limit:  httpReq.Limit,
 		Offset: httpReq.Offset,
+		Sort:   types.DashboardsSort(httpReq.Sort),
 	}
 	if httpReq.Filter != nil {
 		req.Filter = &types.SearchDashboardsFilter{
 			OwnerName: httpReq.Filter.OwnerName,
+			Tags:      httpReq.Filter.Tags,
+			FolderID:  httpReq.Filter.FolderID,
+			Starred:   httpReq.Filter.Starred,
 		}
 	}
 
-	dis, err := a.service.SearchDashboards(ctx, req)
+	results, err := a.service.SearchDashboards(ctx, req)
 	if err != nil {
 		httputil.ProcessError(wr, err)
 		return
 	}
 
 	wr.WriteJson(searchResponse{
-		Dashboards: newInfosWithOwner(dis),
+		Dashboards: newSearchResults(results),
 	})
 }
 
 type searchFilter struct {
 	OwnerName *string `json:"owner_name,omitempty"`
+	// Dashboards must have all of the tags.
+	Tags []string `json:"tags,omitempty"`
+	// Zero means dashboards outside of any folder.
+	FolderID *int64 `json:"folder_id,omitempty" format:"int64"`
+	Starred  bool   `json:"starred,omitempty"`
 } //	@name	dashboards.v1.SearchFilter
 
 type searchRequest struct {
@@ -88,8 +97,9 @@ type searchRequest struct {
 	Limit  int           `json:"limit"`
 	Offset int           `json:"offset"`
 	Filter *searchFilter `json:"filter,omitempty"`
+	Sort   string        `json:"sort,omitempty" default:"name" enums:"name,updated,viewed"`
 } //	@name	dashboards.v1.SearchRequest
 
 type searchResponse struct {
-	Dashboards infosWithOwner `json:"dashboards"`
+	Dashboards searchResults `json:"dashboards"`
 } //	@name	dashboards.v1.SearchResponse
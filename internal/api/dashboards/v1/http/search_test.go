@@ -13,10 +13,11 @@ import (
 func TestServeSearch(t *testing.T) {
 	userName := "unnamed"
 	query := "test-query"
+	folderID := int64(3)
 
 	type mockArgs struct {
 		req  types.SearchDashboardsRequest
-		resp types.DashboardInfosWithOwner
+		resp types.DashboardSearchResults
 		err  error
 	}
 
@@ -33,9 +34,19 @@ func TestServeSearch(t *testing.T) {
 			name: "ok",
 			req:  searchRequest{Query: query, Limit: testLimit, Offset: testOffset},
 			want: searchResponse{
-				Dashboards: infosWithOwner{
-					{info: info{UUID: "064dc707-02b8-7000-8201-02a7f396738a", Name: "my test dashboard"}, OwnerName: "user1"},
-					{info: info{UUID: "064dc707-12b9-7000-a238-682b044c908b", Name: "tested"}, OwnerName: "user2"},
+				Dashboards: searchResults{
+					{
+						infoWithOwner: infoWithOwner{info: info{UUID: "064dc707-02b8-7000-8201-02a7f396738a", Name: "my test dashboard"}, OwnerName: "user1"},
+						Tags:          []string{"prod"},
+						Starred:       true,
+						UpdatedAt:     testCreatedAt,
+						ViewedAt:      &testCreatedAt,
+					},
+					{
+						infoWithOwner: infoWithOwner{info: info{UUID: "064dc707-12b9-7000-a238-682b044c908b", Name: "tested"}, OwnerName: "user2"},
+						Tags:          []string{},
+						UpdatedAt:     testCreatedAt,
+					},
 				},
 			},
 			mockArgs: &mockArgs{
@@ -44,20 +55,30 @@ func TestServeSearch(t *testing.T) {
 					Limit:  testLimit,
 					Offset: testOffset,
 				},
-				resp: types.DashboardInfosWithOwner{
+				resp: types.DashboardSearchResults{
 					{
-						DashboardInfo: types.DashboardInfo{
-							UUID: "064dc707-02b8-7000-8201-02a7f396738a",
-							Name: "my test dashboard",
+						DashboardInfoWithOwner: types.DashboardInfoWithOwner{
+							DashboardInfo: types.DashboardInfo{
+								UUID: "064dc707-02b8-7000-8201-02a7f396738a",
+								Name: "my test dashboard",
+							},
+							OwnerName: "user1",
 						},
-						OwnerName: "user1",
+						Tags:      []string{"prod"},
+						Starred:   true,
+						UpdatedAt: testCreatedAt,
+						ViewedAt:  &testCreatedAt,
 					},
 					{
-						DashboardInfo: types.DashboardInfo{
-							UUID: "064dc707-12b9-7000-a238-682b044c908b",
-							Name: "tested",
+						DashboardInfoWithOwner: types.DashboardInfoWithOwner{
+							DashboardInfo: types.DashboardInfo{
+								UUID: "064dc707-12b9-7000-a238-682b044c908b",
+								Name: "tested",
+							},
+							OwnerName: "user2",
 						},
-						OwnerName: "user2",
+						Tags:      []string{},
+						UpdatedAt: testCreatedAt,
 					},
 				},
 			},
@@ -68,11 +89,23 @@ func TestServeSearch(t *testing.T) {
 				Query:  query,
 				Limit:  testLimit,
 				Offset: testOffset,
-				Filter: &searchFilter{OwnerName: &userName},
+				Filter: &searchFilter{
+					OwnerName: &userName,
+					Tags:      []string{"prod"},
+					FolderID:  &folderID,
+					Starred:   true,
+				},
+				Sort: "updated",
 			},
 			want: searchResponse{
-				Dashboards: infosWithOwner{
-					{info: info{UUID: "064dc707-02b8-7000-8201-02a7f396738a", Name: "my test dashboard"}, OwnerName: userName},
+				Dashboards: searchResults{
+					{
+						infoWithOwner: infoWithOwner{info: info{UUID: "064dc707-02b8-7000-8201-02a7f396738a", Name: "my test dashboard"}, OwnerName: userName},
+						FolderID:      &folderID,
+						Tags:          []string{"prod"},
+						Starred:       true,
+						UpdatedAt:     testCreatedAt,
+					},
 				},
 			},
 			mockArgs: &mockArgs{
@@ -82,15 +115,25 @@ func TestServeSearch(t *testing.T) {
 					Offset: testOffset,
 					Filter: &types.SearchDashboardsFilter{
 						OwnerName: &userName,
+						Tags:      []string{"prod"},
+						FolderID:  &folderID,
+						Starred:   true,
 					},
+					Sort: types.DashboardsSortUpdated,
 				},
-				resp: types.DashboardInfosWithOwner{
+				resp: types.DashboardSearchResults{
 					{
-						DashboardInfo: types.DashboardInfo{
-							UUID: "064dc707-02b8-7000-8201-02a7f396738a",
-							Name: "my test dashboard",
+						DashboardInfoWithOwner: types.DashboardInfoWithOwner{
+							DashboardInfo: types.DashboardInfo{
+								UUID: "064dc707-02b8-7000-8201-02a7f396738a",
+								Name: "my test dashboard",
+							},
+							OwnerName: userName,
 						},
-						OwnerName: userName,
+						FolderID:  &folderID,
+						Tags:      []string{"prod"},
+						Starred:   true,
+						UpdatedAt: testCreatedAt,
 					},
 				},
 			},
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+)
+
+func TestServeStar(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	tests := []struct {
+		name string
+
+		wantErr bool
+		mockErr error
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockErr: errSomethingWrong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				StarDashboard(gomock.Any(), dashboardUUID).
+				Return(tt.mockErr).
+				Times(1)
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodPost,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/star", dashboardUUID),
+				Handler: withUUID(api.serveStar, dashboardUUID),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
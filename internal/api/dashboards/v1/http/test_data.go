@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/mock/gomock"
@@ -17,6 +18,7 @@ var (
 	errSomethingWrong = errors.New("something happened wrong")
 	testLimit         = 2
 	testOffset        = 0
+	testCreatedAt     = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
 )
 
 func setupTestAPI(t *testing.T) (*API, *mock.MockService) {
@@ -33,3 +35,22 @@ func withUUID(h http.HandlerFunc, uuid string) http.HandlerFunc {
 		h(w, r)
 	}
 }
+
+func withUUIDAndVersion(h http.HandlerFunc, uuid, version string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rCtx := chi.NewRouteContext()
+		rCtx.URLParams.Add("uuid", uuid)
+		rCtx.URLParams.Add("version", version)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+		h(w, r)
+	}
+}
+
+func withFolderID(h http.HandlerFunc, id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rCtx := chi.NewRouteContext()
+		rCtx.URLParams.Add("id", id)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+		h(w, r)
+	}
+}
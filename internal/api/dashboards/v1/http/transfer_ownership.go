@@ -0,0 +1,66 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveTransferOwnership go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/transfer [post]
+//	@ID			dashboards_v1_transferOwnership
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string						true	"Dashboard UUID"
+//	@Param		body	body		transferOwnershipRequest	true	"Request body"
+//	@Success	200		{object}	nil							"A successful response"
+//	@Failure	default	{object}	httputil.Error				"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveTransferOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_transfer_ownership")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+
+	var httpReq transferOwnershipRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+		attribute.KeyValue{
+			Key:   "new_owner_name",
+			Value: attribute.StringValue(httpReq.NewOwnerName),
+		},
+	)
+
+	req := types.TransferDashboardOwnershipRequest{
+		UUID:         uuid,
+		NewOwnerName: httpReq.NewOwnerName,
+	}
+
+	if err := a.service.TransferDashboardOwnership(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type transferOwnershipRequest struct {
+	NewOwnerName string `json:"new_owner_name"`
+} //	@name	dashboards.v1.TransferOwnershipRequest
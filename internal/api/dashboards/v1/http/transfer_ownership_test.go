@@ -0,0 +1,75 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeTransferOwnership(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		req types.TransferDashboardOwnershipRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     transferOwnershipRequest
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  transferOwnershipRequest{NewOwnerName: "user1"},
+			mockArgs: &mockArgs{
+				req: types.TransferDashboardOwnershipRequest{
+					UUID:         dashboardUUID,
+					NewOwnerName: "user1",
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     transferOwnershipRequest{NewOwnerName: "user1"},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.TransferDashboardOwnershipRequest{
+					UUID:         dashboardUUID,
+					NewOwnerName: "user1",
+				},
+				err: types.NewErrPermissionDenied("transfer dashboard ownership"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().TransferDashboardOwnership(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[transferOwnershipRequest, struct{}]{
+				Method:  http.MethodPost,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/transfer", dashboardUUID),
+				Req:     tt.req,
+				Handler: withUUID(api.serveTransferOwnership, dashboardUUID),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
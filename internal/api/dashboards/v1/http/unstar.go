@@ -0,0 +1,43 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveUnstar go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/star [delete]
+//	@ID			dashboards_v1_unstar
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string			true	"Dashboard UUID"
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveUnstar(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_unstar")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+	)
+
+	if err := a.service.UnstarDashboard(ctx, uuid); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -49,9 +49,11 @@ func (a *API) serveUpdate(w http.ResponseWriter, r *http.Request) {
 	)
 
 	req := types.UpdateDashboardRequest{
-		UUID: uuid,
-		Name: httpReq.Name,
-		Meta: httpReq.Meta,
+		UUID:     uuid,
+		Name:     httpReq.Name,
+		Meta:     httpReq.Meta,
+		FolderID: httpReq.FolderID,
+		Tags:     httpReq.Tags,
 	}
 
 	err := a.service.UpdateDashboard(ctx, req)
@@ -66,6 +68,10 @@ func (a *API) serveUpdate(w http.ResponseWriter, r *http.Request) {
 type updateRequest struct {
 	Name *string `json:"name"`
 	Meta *string `json:"meta"`
+	// Zero moves the dashboard out of any folder.
+	FolderID *int64 `json:"folder_id" format:"int64"`
+	// Replaces all tags of the dashboard.
+	Tags *[]string `json:"tags"`
 } //	@name	dashboards.v1.UpdateRequest
 
 func (r updateRequest) GetName() string {
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveUpdateFolder go doc.
+//
+//	@Router		/dashboards/v1/folders/{id} [patch]
+//	@ID			dashboards_v1_updateFolder
+//	@Tags		dashboards_v1
+//	@Param		id		path		int					true	"Folder ID"
+//	@Param		body	body		updateFolderRequest	true	"Request body"
+//	@Success	200		{object}	nil					"A successful response"
+//	@Failure	default	{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveUpdateFolder(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_update_folder")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := parseFolderID(r)
+	if err != nil {
+		wr.Error(err, http.StatusBadRequest)
+		return
+	}
+
+	var httpReq updateFolderRequest
+	if err = json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(id),
+		},
+	)
+
+	req := types.UpdateDashboardFolderRequest{
+		ID:       id,
+		Name:     httpReq.Name,
+		ParentID: httpReq.ParentID,
+	}
+
+	if err = a.service.UpdateDashboardFolder(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type updateFolderRequest struct {
+	Name *string `json:"name"`
+	// Zero moves the folder to the root.
+	ParentID *int64 `json:"parent_id" format:"int64"`
+} //	@name	dashboards.v1.UpdateFolderRequest
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeUpdateFolder(t *testing.T) {
+	folderName := "api"
+	rootID := int64(0)
+
+	type mockArgs struct {
+		req types.UpdateDashboardFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		id      string
+		req     updateFolderRequest
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			id:   "4",
+			req:  updateFolderRequest{Name: &folderName, ParentID: &rootID},
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardFolderRequest{
+					ID:       4,
+					Name:     &folderName,
+					ParentID: &rootID,
+				},
+			},
+		},
+		{
+			name:    "err_id",
+			id:      "abc",
+			req:     updateFolderRequest{Name: &folderName},
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			id:      "4",
+			req:     updateFolderRequest{Name: &folderName},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardFolderRequest{
+					ID:   4,
+					Name: &folderName,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateDashboardFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[updateFolderRequest, struct{}]{
+				Method:  http.MethodPatch,
+				Target:  fmt.Sprintf("/dashboards/v1/folders/%s", tt.id),
+				Req:     tt.req,
+				Handler: withFolderID(api.serveUpdateFolder, tt.id),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
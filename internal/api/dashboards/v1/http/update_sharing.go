@@ -0,0 +1,83 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveUpdateSharing go doc.
+//
+//	@Router		/dashboards/v1/{uuid}/sharing [put]
+//	@ID			dashboards_v1_updateSharing
+//	@Tags		dashboards_v1
+//	@Param		uuid	path		string					true	"Dashboard UUID"
+//	@Param		body	body		updateSharingRequest	true	"Request body"
+//	@Success	200		{object}	nil						"A successful response"
+//	@Failure	default	{object}	httputil.Error			"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveUpdateSharing(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "dashboards_v1_update_sharing")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	uuid := chi.URLParam(r, "uuid")
+
+	var httpReq updateSharingRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "uuid",
+			Value: attribute.StringValue(uuid),
+		},
+		attribute.KeyValue{
+			Key:   "visibility",
+			Value: attribute.StringValue(httpReq.Visibility),
+		},
+		attribute.KeyValue{
+			Key:   "team",
+			Value: attribute.StringValue(httpReq.Team),
+		},
+		attribute.KeyValue{
+			Key:   "collaborators",
+			Value: attribute.IntValue(len(httpReq.Collaborators)),
+		},
+	)
+
+	req := types.UpdateDashboardSharingRequest{
+		UUID:          uuid,
+		Visibility:    types.DashboardVisibility(httpReq.Visibility),
+		Team:          httpReq.Team,
+		Collaborators: make(types.DashboardCollaborators, 0, len(httpReq.Collaborators)),
+	}
+	for _, c := range httpReq.Collaborators {
+		req.Collaborators = append(req.Collaborators, c.toDomain())
+	}
+
+	if err := a.service.UpdateDashboardSharing(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type updateSharingRequest struct {
+	Visibility string `json:"visibility" default:"public" enums:"public,team,private"`
+	// Group which members can view the dashboard, required for team visibility.
+	Team string `json:"team,omitempty"`
+	// Replaces all collaborators of the dashboard.
+	Collaborators []collaborator `json:"collaborators"`
+} //	@name	dashboards.v1.UpdateSharingRequest
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeUpdateSharing(t *testing.T) {
+	dashboardUUID := "064dc707-02b8-7000-8201-02a7f396738a"
+
+	type mockArgs struct {
+		req types.UpdateDashboardSharingRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     updateSharingRequest
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: updateSharingRequest{
+				Visibility: "private",
+				Collaborators: []collaborator{
+					{Kind: "user", Name: "user1", Role: "editor"},
+					{Kind: "group", Name: "team1", Role: "viewer"},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardSharingRequest{
+					UUID:       dashboardUUID,
+					Visibility: types.DashboardVisibilityPrivate,
+					Collaborators: types.DashboardCollaborators{
+						{Kind: types.DashboardCollaboratorUser, Name: "user1", Role: types.DashboardRoleEditor},
+						{Kind: types.DashboardCollaboratorGroup, Name: "team1", Role: types.DashboardRoleViewer},
+					},
+				},
+			},
+		},
+		{
+			name: "err_svc",
+			req: updateSharingRequest{
+				Visibility: "team",
+			},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardSharingRequest{
+					UUID:          dashboardUUID,
+					Visibility:    types.DashboardVisibilityTeam,
+					Collaborators: types.DashboardCollaborators{},
+				},
+				err: types.NewErrInvalidRequestField("'team' must be set for team visibility"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().UpdateDashboardSharing(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[updateSharingRequest, struct{}]{
+				Method:  http.MethodPut,
+				Target:  fmt.Sprintf("/dashboards/v1/%s/sharing", dashboardUUID),
+				Req:     tt.req,
+				Handler: withUUID(api.serveUpdateSharing, dashboardUUID),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -16,6 +16,8 @@ func TestServeUpdate(t *testing.T) {
 		dashboardUUID = "064dc707-02b8-7000-8201-02a7f396738a"
 		dashboardMeta = "my_meta"
 		dashboardName = "my_dashboard"
+		folderID      = int64(0)
+		tags          = []string{"prod"}
 	)
 
 	type mockArgs struct {
@@ -42,6 +44,17 @@ func TestServeUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ok_folder_and_tags",
+			req:  updateRequest{FolderID: &folderID, Tags: &tags},
+			mockArgs: &mockArgs{
+				req: types.UpdateDashboardRequest{
+					UUID:     dashboardUUID,
+					FolderID: &folderID,
+					Tags:     &tags,
+				},
+			},
+		},
 		{
 			name:    "err_svc",
 			req:     updateRequest{Name: &dashboardName, Meta: &dashboardMeta},
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) CompareReleases(ctx context.Context, req *errorgroups.CompareReleasesRequest) (*errorgroups.CompareReleasesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "errorgroups_v1_compare_releases")
+	defer span.End()
+
+	attributes := []attribute.KeyValue{
+		{Key: "service", Value: attribute.StringValue(req.Service)},
+		{Key: "baseline_release", Value: attribute.StringValue(req.BaselineRelease)},
+		{Key: "candidate_release", Value: attribute.StringValue(req.CandidateRelease)},
+		{Key: "exposure", Value: attribute.StringValue(req.Exposure.String())},
+		{Key: "limit", Value: attribute.IntValue(int(req.Limit))},
+		{Key: "offset", Value: attribute.IntValue(int(req.Offset))},
+	}
+	if req.Env != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "env", Value: attribute.StringValue(*req.Env)})
+	}
+	if req.Source != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "source", Value: attribute.StringValue(*req.Source)})
+	}
+	if req.SignificanceLevel != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "significance_level", Value: attribute.Float64Value(*req.SignificanceLevel)})
+	}
+	span.SetAttributes(attributes...)
+
+	var statuses []types.ReleaseCompareStatus
+	for _, s := range req.Statuses {
+		statuses = append(statuses, types.ReleaseCompareStatus(s))
+	}
+
+	result, err := a.service.CompareReleases(ctx, types.CompareReleasesRequest{
+		Service:           req.Service,
+		BaselineRelease:   req.BaselineRelease,
+		CandidateRelease:  req.CandidateRelease,
+		Env:               req.Env,
+		Source:            req.Source,
+		Exposure:          types.ReleaseExposureKind(req.Exposure),
+		SignificanceLevel: req.SignificanceLevel,
+		Statuses:          statuses,
+		Limit:             req.Limit,
+		Offset:            req.Offset,
+		BaselineRequests:  req.BaselineRequests,
+		CandidateRequests: req.CandidateRequests,
+	})
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	groups := make([]*errorgroups.CompareReleasesResponse_Group, 0, len(result.Groups))
+	for _, g := range result.Groups {
+		groups = append(groups, &errorgroups.CompareReleasesResponse_Group{
+			Hash:               g.Hash,
+			Message:            g.Message,
+			Source:             g.Source,
+			BaselineSeenTotal:  g.BaselineSeenTotal,
+			CandidateSeenTotal: g.CandidateSeenTotal,
+			BaselineRate:       g.BaselineRate,
+			CandidateRate:      g.CandidateRate,
+			RateRatio:          g.RateRatio,
+			PValue:             g.PValue,
+			Significant:        g.Significant,
+			Status:             errorgroups.CompareStatus(g.Status),
+		})
+	}
+
+	return &errorgroups.CompareReleasesResponse{
+		Baseline:  releaseExposureToProto(result.Baseline),
+		Candidate: releaseExposureToProto(result.Candidate),
+		Summary: &errorgroups.CompareReleasesResponse_Summary{
+			New:       result.Summary.New,
+			Regressed: result.Summary.Regressed,
+			Fixed:     result.Summary.Fixed,
+			Unchanged: result.Summary.Unchanged,
+		},
+		Total:  result.Total,
+		Groups: groups,
+	}, nil
+}
+
+func releaseExposureToProto(e types.ReleaseExposure) *errorgroups.CompareReleasesResponse_Release {
+	return &errorgroups.CompareReleasesResponse_Release{
+		Release:     e.Release,
+		SeenTotal:   e.SeenTotal,
+		Exposure:    e.Exposure,
+		FirstSeenAt: timestamppb.New(e.FirstSeenAt),
+		LastSeenAt:  timestamppb.New(e.LastSeenAt),
+	}
+}
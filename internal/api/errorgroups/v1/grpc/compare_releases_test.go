@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+	errorgroups_v1 "github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+)
+
+func TestCompareReleases(t *testing.T) {
+	var (
+		service   = "test-service"
+		baseline  = "v1"
+		candidate = "v2"
+		env       = "test-env"
+		alpha     = 0.01
+		now       = time.Now()
+		someErr   = errors.New("some err")
+
+		baselineRequests  uint64 = 100
+		candidateRequests uint64 = 50
+	)
+
+	type mockArgs struct {
+		req types.CompareReleasesRequest
+
+		result types.CompareReleasesResult
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req     *errorgroups_v1.CompareReleasesRequest
+		want    *errorgroups_v1.CompareReleasesResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: &errorgroups_v1.CompareReleasesRequest{
+				Service:           service,
+				BaselineRelease:   baseline,
+				CandidateRelease:  candidate,
+				Env:               &env,
+				Exposure:          errorgroups_v1.Exposure_EXPOSURE_REQUESTS,
+				SignificanceLevel: &alpha,
+				Statuses:          []errorgroups_v1.CompareStatus{errorgroups_v1.CompareStatus_COMPARE_STATUS_REGRESSED},
+				Limit:             10,
+				BaselineRequests:  &baselineRequests,
+				CandidateRequests: &candidateRequests,
+			},
+			want: &errorgroups_v1.CompareReleasesResponse{
+				Baseline: &errorgroups_v1.CompareReleasesResponse_Release{
+					Release:     baseline,
+					SeenTotal:   100,
+					Exposure:    100,
+					FirstSeenAt: timestamppb.New(now),
+					LastSeenAt:  timestamppb.New(now),
+				},
+				Candidate: &errorgroups_v1.CompareReleasesResponse_Release{
+					Release:     candidate,
+					SeenTotal:   50,
+					Exposure:    50,
+					FirstSeenAt: timestamppb.New(now),
+					LastSeenAt:  timestamppb.New(now),
+				},
+				Summary: &errorgroups_v1.CompareReleasesResponse_Summary{Regressed: 1, Unchanged: 2},
+				Total:   1,
+				Groups: []*errorgroups_v1.CompareReleasesResponse_Group{
+					{
+						Hash:               1,
+						Message:            "msg",
+						Source:             "src",
+						BaselineSeenTotal:  10,
+						CandidateSeenTotal: 40,
+						BaselineRate:       0.1,
+						CandidateRate:      0.8,
+						RateRatio:          8,
+						PValue:             0.001,
+						Significant:        true,
+						Status:             errorgroups_v1.CompareStatus_COMPARE_STATUS_REGRESSED,
+					},
+				},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.CompareReleasesRequest{
+					Service:           service,
+					BaselineRelease:   baseline,
+					CandidateRelease:  candidate,
+					Env:               &env,
+					Exposure:          types.ExposureRequests,
+					SignificanceLevel: &alpha,
+					Statuses:          []types.ReleaseCompareStatus{types.CompareStatusRegressed},
+					Limit:             10,
+					BaselineRequests:  &baselineRequests,
+					CandidateRequests: &candidateRequests,
+				},
+
+				result: types.CompareReleasesResult{
+					Baseline:  types.ReleaseExposure{Release: baseline, SeenTotal: 100, Exposure: 100, FirstSeenAt: now, LastSeenAt: now},
+					Candidate: types.ReleaseExposure{Release: candidate, SeenTotal: 50, Exposure: 50, FirstSeenAt: now, LastSeenAt: now},
+					Summary:   types.CompareReleasesSummary{Regressed: 1, Unchanged: 2},
+					Total:     1,
+					Groups: []types.CompareGroup{
+						{
+							Hash:               1,
+							Message:            "msg",
+							Source:             "src",
+							BaselineSeenTotal:  10,
+							CandidateSeenTotal: 40,
+							BaselineRate:       0.1,
+							CandidateRate:      0.8,
+							RateRatio:          8,
+							PValue:             0.001,
+							Significant:        true,
+							Status:             types.CompareStatusRegressed,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     &errorgroups_v1.CompareReleasesRequest{},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.CompareReleasesRequest{},
+
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					CompareReleases(gomock.Any(), ma.req).
+					Return(ma.result, ma.err).
+					Times(1)
+			}
+
+			got, err := api.CompareReleases(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -43,6 +43,12 @@ func (a *API) GetHist(ctx context.Context, req *errorgroups.GetHistRequest) (*er
 		trRaw, _ := json.Marshal(req.TimeRange)
 		attributes = append(attributes, attribute.KeyValue{Key: "time_range", Value: attribute.StringValue(string(trRaw))})
 	}
+	if req.Interval != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "interval", Value: attribute.StringValue(req.Interval.AsDuration().String())})
+	}
+	if req.GroupBy != errorgroups.HistGroupBy_HIST_GROUP_BY_NONE {
+		attributes = append(attributes, attribute.KeyValue{Key: "group_by", Value: attribute.StringValue(req.GroupBy.String())})
+	}
 	span.SetAttributes(attributes...)
 
 	request := types.GetErrorHistRequest{
@@ -52,6 +58,8 @@ func (a *API) GetHist(ctx context.Context, req *errorgroups.GetHistRequest) (*er
 		Source:    req.Source,
 		Release:   req.Release,
 		TimeRange: parseTimeRange(req),
+		Interval:  req.GetInterval().AsDuration(),
+		GroupBy:   types.ErrorHistGroupBy(req.GroupBy),
 	}
 	hist, err := a.service.GetHist(ctx, request)
 	if err != nil {
@@ -62,17 +70,30 @@ func (a *API) GetHist(ctx context.Context, req *errorgroups.GetHistRequest) (*er
 }
 
 func histToProto(source types.ErrorHist) *errorgroups.GetHistResponse {
-	buckets := make([]*errorgroups.Bucket, 0, len(source.Buckets))
+	resp := &errorgroups.GetHistResponse{
+		Buckets:  bucketsToProto(source.Buckets),
+		Interval: source.Interval,
+	}
+
+	for _, s := range source.Series {
+		resp.Series = append(resp.Series, &errorgroups.Series{
+			Key:     s.Key,
+			Buckets: bucketsToProto(s.Buckets),
+		})
+	}
+
+	return resp
+}
 
-	for _, b := range source.Buckets {
+func bucketsToProto(source []types.ErrorHistBucket) []*errorgroups.Bucket {
+	buckets := make([]*errorgroups.Bucket, 0, len(source))
+
+	for _, b := range source {
 		buckets = append(buckets, &errorgroups.Bucket{
 			Time:  timestamppb.New(b.Time),
 			Count: b.Count,
 		})
 	}
 
-	return &errorgroups.GetHistResponse{
-		Buckets:  buckets,
-		Interval: source.Interval,
-	}
+	return buckets
 }
@@ -167,6 +167,49 @@ func TestGetHist(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ok_interval_group_by",
+
+			req: &errorgroups_v1.GetHistRequest{
+				Service:  &service,
+				Duration: durationpb.New(duration),
+				Interval: durationpb.New(time.Minute),
+				GroupBy:  errorgroups_v1.HistGroupBy_HIST_GROUP_BY_ENV,
+			},
+			want: &errorgroups_v1.GetHistResponse{
+				Buckets: []*errorgroups_v1.Bucket{},
+				Series: []*errorgroups_v1.Series{
+					{
+						Key: env,
+						Buckets: []*errorgroups_v1.Bucket{
+							{Time: timestamppb.New(twoMinutesAgo), Count: 20},
+						},
+					},
+				},
+				Interval: 60,
+			},
+
+			mockArgs: &mockArgs{
+				req: types.GetErrorHistRequest{
+					Service: &service,
+					TimeRange: &types.TimeRange{
+						Duration: duration,
+					},
+					Interval: time.Minute,
+					GroupBy:  types.HistGroupByEnv,
+				},
+
+				hist: types.ErrorHist{
+					Series: []types.ErrorHistSeries{
+						{
+							Key:     env,
+							Buckets: []types.ErrorHistBucket{{Time: twoMinutesAgo, Count: 20}},
+						},
+					},
+					Interval: 60,
+				},
+			},
+		},
 		{
 			name: "err_svc",
 
@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) GetMergedGroups(ctx context.Context, req *errorgroups.GetMergedGroupsRequest) (*errorgroups.GetMergedGroupsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "errorgroups_v1_get_merged_groups")
+	defer span.End()
+
+	if req.TargetHash != nil {
+		span.SetAttributes(attribute.KeyValue{
+			Key:   "target_hash",
+			Value: attribute.StringValue(strconv.FormatUint(*req.TargetHash, 10)),
+		})
+	}
+
+	request := types.GetMergedErrorGroupsRequest{
+		TargetHash: req.TargetHash,
+	}
+	merges, err := a.service.GetMergedErrorGroups(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	res := make([]*errorgroups.GetMergedGroupsResponse_Merge, 0, len(merges))
+	for _, m := range merges {
+		res = append(res, &errorgroups.GetMergedGroupsResponse_Merge{
+			GroupHash:  m.Hash,
+			TargetHash: m.TargetHash,
+			CreatedBy:  m.CreatedBy,
+			CreatedAt:  timestamppb.New(m.CreatedAt),
+		})
+	}
+
+	return &errorgroups.GetMergedGroupsResponse{Merges: res}, nil
+}
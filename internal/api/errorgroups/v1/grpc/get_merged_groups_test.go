@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+	errorgroups_v1 "github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+)
+
+func TestGetMergedGroups(t *testing.T) {
+	var (
+		targetHash = uint64(1)
+		createdAt  = time.Now()
+		someErr    = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		req types.GetMergedErrorGroupsRequest
+
+		merges []types.ErrorGroupMerge
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req     *errorgroups_v1.GetMergedGroupsRequest
+		want    *errorgroups_v1.GetMergedGroupsResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: &errorgroups_v1.GetMergedGroupsRequest{
+				TargetHash: &targetHash,
+			},
+			want: &errorgroups_v1.GetMergedGroupsResponse{
+				Merges: []*errorgroups_v1.GetMergedGroupsResponse_Merge{
+					{GroupHash: 2, TargetHash: 1, CreatedBy: "user", CreatedAt: timestamppb.New(createdAt)},
+				},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.GetMergedErrorGroupsRequest{
+					TargetHash: &targetHash,
+				},
+
+				merges: []types.ErrorGroupMerge{
+					{Hash: 2, TargetHash: 1, CreatedBy: "user", CreatedAt: createdAt},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     &errorgroups_v1.GetMergedGroupsRequest{},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.GetMergedErrorGroupsRequest{},
+
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					GetMergedErrorGroups(gomock.Any(), ma.req).
+					Return(ma.merges, ma.err).
+					Times(1)
+			}
+
+			got, err := api.GetMergedGroups(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) GetSimilarGroups(ctx context.Context, req *errorgroups.GetSimilarGroupsRequest) (*errorgroups.GetSimilarGroupsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "errorgroups_v1_get_similar_groups")
+	defer span.End()
+
+	attributes := []attribute.KeyValue{
+		{Key: "service", Value: attribute.StringValue(req.Service)},
+		{Key: "group_hash", Value: attribute.StringValue(strconv.FormatUint(req.GroupHash, 10))},
+		{Key: "limit", Value: attribute.IntValue(int(req.Limit))},
+	}
+	if req.Env != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "env", Value: attribute.StringValue(*req.Env)})
+	}
+	if req.MinSimilarity != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "min_similarity", Value: attribute.Float64Value(*req.MinSimilarity)})
+	}
+	span.SetAttributes(attributes...)
+
+	request := types.GetSimilarErrorGroupsRequest{
+		Service:       req.Service,
+		GroupHash:     req.GroupHash,
+		Env:           req.Env,
+		Limit:         req.Limit,
+		MinSimilarity: req.MinSimilarity,
+	}
+	groups, err := a.service.GetSimilarErrorGroups(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	res := make([]*errorgroups.GetSimilarGroupsResponse_Group, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, &errorgroups.GetSimilarGroupsResponse_Group{
+			Hash:       g.Hash,
+			Message:    g.Message,
+			Source:     g.Source,
+			SeenTotal:  g.Count,
+			Similarity: g.Similarity,
+		})
+	}
+
+	return &errorgroups.GetSimilarGroupsResponse{Groups: res}, nil
+}
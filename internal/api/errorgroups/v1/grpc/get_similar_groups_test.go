@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+	errorgroups_v1 "github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+)
+
+func TestGetSimilarGroups(t *testing.T) {
+	var (
+		service       = "test-service"
+		env           = "test-env"
+		minSimilarity = 0.5
+		someErr       = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		req types.GetSimilarErrorGroupsRequest
+
+		groups []types.SimilarErrorGroup
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req     *errorgroups_v1.GetSimilarGroupsRequest
+		want    *errorgroups_v1.GetSimilarGroupsResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: &errorgroups_v1.GetSimilarGroupsRequest{
+				Service:       service,
+				GroupHash:     1,
+				Env:           &env,
+				Limit:         10,
+				MinSimilarity: &minSimilarity,
+			},
+			want: &errorgroups_v1.GetSimilarGroupsResponse{
+				Groups: []*errorgroups_v1.GetSimilarGroupsResponse_Group{
+					{Hash: 2, Message: "msg 2", Source: "src", SeenTotal: 20, Similarity: 0.9},
+				},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.GetSimilarErrorGroupsRequest{
+					Service:       service,
+					GroupHash:     1,
+					Env:           &env,
+					Limit:         10,
+					MinSimilarity: &minSimilarity,
+				},
+
+				groups: []types.SimilarErrorGroup{
+					{Hash: 2, Message: "msg 2", Source: "src", Count: 20, Similarity: 0.9},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     &errorgroups_v1.GetSimilarGroupsRequest{},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.GetSimilarErrorGroupsRequest{},
+
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					GetSimilarErrorGroups(gomock.Any(), ma.req).
+					Return(ma.groups, ma.err).
+					Times(1)
+			}
+
+			got, err := api.GetSimilarGroups(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
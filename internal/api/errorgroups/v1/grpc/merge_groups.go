@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) MergeGroups(ctx context.Context, req *errorgroups.MergeGroupsRequest) (*errorgroups.MergeGroupsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "errorgroups_v1_merge_groups")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{Key: "target_hash", Value: attribute.StringValue(strconv.FormatUint(req.TargetHash, 10))},
+		attribute.KeyValue{Key: "group_hashes", Value: attribute.StringSliceValue(hashesToStrings(req.GroupHashes))},
+	)
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	request := types.MergeErrorGroupsRequest{
+		TargetHash: req.TargetHash,
+		Hashes:     req.GroupHashes,
+		User:       userName,
+	}
+	if err = a.service.MergeErrorGroups(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &errorgroups.MergeGroupsResponse{}, nil
+}
+
+func hashesToStrings(hashes []uint64) []string {
+	res := make([]string, 0, len(hashes))
+	for _, h := range hashes {
+		res = append(res, strconv.FormatUint(h, 10))
+	}
+	return res
+}
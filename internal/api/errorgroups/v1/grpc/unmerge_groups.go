@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) UnmergeGroups(ctx context.Context, req *errorgroups.UnmergeGroupsRequest) (*errorgroups.UnmergeGroupsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "errorgroups_v1_unmerge_groups")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{Key: "group_hashes", Value: attribute.StringSliceValue(hashesToStrings(req.GroupHashes))},
+	)
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	request := types.UnmergeErrorGroupsRequest{
+		Hashes: req.GroupHashes,
+		User:   userName,
+	}
+	if err = a.service.UnmergeErrorGroups(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &errorgroups.UnmergeGroupsResponse{}, nil
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+	errorgroups_v1 "github.com/ozontech/seq-ui/pkg/errorgroups/v1"
+)
+
+func TestUnmergeGroups(t *testing.T) {
+	var (
+		user    = "test-user"
+		someErr = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		req types.UnmergeErrorGroupsRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     *errorgroups_v1.UnmergeGroupsRequest
+		noUser  bool
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: &errorgroups_v1.UnmergeGroupsRequest{
+				GroupHashes: []uint64{2, 3},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.UnmergeErrorGroupsRequest{
+					Hashes: []uint64{2, 3},
+					User:   user,
+				},
+			},
+		},
+		{
+			name: "err_no_user",
+
+			req: &errorgroups_v1.UnmergeGroupsRequest{
+				GroupHashes: []uint64{2},
+			},
+			noUser:  true,
+			wantErr: true,
+		},
+		{
+			name: "err_svc",
+
+			req:     &errorgroups_v1.UnmergeGroupsRequest{},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.UnmergeErrorGroupsRequest{
+					User: user,
+				},
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					UnmergeErrorGroups(gomock.Any(), ma.req).
+					Return(ma.err).
+					Times(1)
+			}
+
+			ctx := context.Background()
+			if !tt.noUser {
+				ctx = context.WithValue(ctx, types.UserKey{}, user)
+			}
+
+			_, err := api.UnmergeGroups(ctx, tt.req)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
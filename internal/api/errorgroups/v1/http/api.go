@@ -24,12 +24,18 @@ func (a *API) Router() chi.Router {
 	mux := chi.NewMux()
 
 	mux.Post("/groups", a.serveGetGroups)
+	mux.Post("/groups/export", a.serveExportGroups)
 	mux.Post("/top_groups", a.serveGetTopGroups)
 	mux.Post("/hist", a.serveGetHist)
 	mux.Post("/details", a.serveGetDetails)
 	mux.Post("/releases", a.serveGetReleases)
 	mux.Post("/services", a.serveGetServices)
 	mux.Post("/diff_by_releases", a.serveDiffByReleases)
+	mux.Post("/compare_releases", a.serveCompareReleases)
+	mux.Post("/merge", a.serveMergeGroups)
+	mux.Post("/unmerge", a.serveUnmergeGroups)
+	mux.Post("/merged", a.serveGetMergedGroups)
+	mux.Post("/similar", a.serveGetSimilarGroups)
 
 	return mux
 }
@@ -82,3 +88,15 @@ func parseGroupHash(groupHash *string) (*uint64, error) {
 	parsedGroupHash, err := strconv.ParseUint(*groupHash, 10, 64)
 	return &parsedGroupHash, err
 }
+
+func parseGroupHashes(groupHashes []string) ([]uint64, error) {
+	parsed := make([]uint64, 0, len(groupHashes))
+	for _, h := range groupHashes {
+		hash, err := strconv.ParseUint(h, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, hash)
+	}
+	return parsed, nil
+}
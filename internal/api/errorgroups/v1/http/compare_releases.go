@@ -0,0 +1,238 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveCompareReleases go doc.
+//
+//	@Router		/errorgroups/v1/compare_releases [post]
+//	@ID			errorgroups_v1_compare_releases
+//	@Tags		errorgroups_v1
+//	@Param		body	body		compareReleasesRequest	true	"Request body"
+//	@Success	200		{object}	compareReleasesResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error			"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveCompareReleases(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "errorgroups_v1_compare_releases")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq compareReleasesRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	attributes := []attribute.KeyValue{
+		{Key: "service", Value: attribute.StringValue(httpReq.Service)},
+		{Key: "baseline_release", Value: attribute.StringValue(httpReq.BaselineRelease)},
+		{Key: "candidate_release", Value: attribute.StringValue(httpReq.CandidateRelease)},
+		{Key: "exposure", Value: attribute.StringValue(string(httpReq.Exposure))},
+		{Key: "limit", Value: attribute.IntValue(int(httpReq.Limit))},
+		{Key: "offset", Value: attribute.IntValue(int(httpReq.Offset))},
+	}
+	if httpReq.Env != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "env", Value: attribute.StringValue(*httpReq.Env)})
+	}
+	if httpReq.Source != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "source", Value: attribute.StringValue(*httpReq.Source)})
+	}
+	if httpReq.SignificanceLevel != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "significance_level", Value: attribute.Float64Value(*httpReq.SignificanceLevel)})
+	}
+	span.SetAttributes(attributes...)
+
+	exp, err := httpReq.Exposure.toDomain()
+	if err != nil {
+		wr.Error(err, http.StatusBadRequest)
+		return
+	}
+
+	var statuses []types.ReleaseCompareStatus
+	for _, s := range httpReq.Statuses {
+		status, err := s.toDomain()
+		if err != nil {
+			wr.Error(err, http.StatusBadRequest)
+			return
+		}
+		statuses = append(statuses, status)
+	}
+
+	result, err := a.service.CompareReleases(ctx, types.CompareReleasesRequest{
+		Service:           httpReq.Service,
+		BaselineRelease:   httpReq.BaselineRelease,
+		CandidateRelease:  httpReq.CandidateRelease,
+		Env:               httpReq.Env,
+		Source:            httpReq.Source,
+		Exposure:          exp,
+		SignificanceLevel: httpReq.SignificanceLevel,
+		Statuses:          statuses,
+		Limit:             httpReq.Limit,
+		Offset:            httpReq.Offset,
+		BaselineRequests:  httpReq.BaselineRequests,
+		CandidateRequests: httpReq.CandidateRequests,
+	})
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	groups := make([]compareGroup, 0, len(result.Groups))
+	for _, g := range result.Groups {
+		groups = append(groups, compareGroup{
+			Hash:               strconv.FormatUint(g.Hash, 10),
+			Message:            g.Message,
+			Source:             g.Source,
+			BaselineSeenTotal:  g.BaselineSeenTotal,
+			CandidateSeenTotal: g.CandidateSeenTotal,
+			BaselineRate:       g.BaselineRate,
+			CandidateRate:      g.CandidateRate,
+			RateRatio:          g.RateRatio,
+			PValue:             g.PValue,
+			Significant:        g.Significant,
+			Status:             newCompareStatus(g.Status),
+		})
+	}
+
+	wr.WriteJson(compareReleasesResponse{
+		Baseline:  newReleaseExposure(result.Baseline),
+		Candidate: newReleaseExposure(result.Candidate),
+		Summary: compareSummary{
+			New:       result.Summary.New,
+			Regressed: result.Summary.Regressed,
+			Fixed:     result.Summary.Fixed,
+			Unchanged: result.Summary.Unchanged,
+		},
+		Total:  result.Total,
+		Groups: groups,
+	})
+}
+
+type exposure string //	@name	errorgroups.v1.Exposure
+
+const (
+	ExposureTime     exposure = "time"
+	ExposureRequests exposure = "requests"
+)
+
+func (e exposure) toDomain() (types.ReleaseExposureKind, error) {
+	switch e {
+	case "", ExposureTime:
+		return types.ExposureTime, nil
+	case ExposureRequests:
+		return types.ExposureRequests, nil
+	default:
+		return 0, fmt.Errorf("unknown exposure %q", e)
+	}
+}
+
+type compareStatus string //	@name	errorgroups.v1.CompareStatus
+
+const (
+	CompareStatusUnchanged compareStatus = "unchanged"
+	CompareStatusNew       compareStatus = "new"
+	CompareStatusRegressed compareStatus = "regressed"
+	CompareStatusFixed     compareStatus = "fixed"
+)
+
+func (s compareStatus) toDomain() (types.ReleaseCompareStatus, error) {
+	switch s {
+	case CompareStatusUnchanged:
+		return types.CompareStatusUnchanged, nil
+	case CompareStatusNew:
+		return types.CompareStatusNew, nil
+	case CompareStatusRegressed:
+		return types.CompareStatusRegressed, nil
+	case CompareStatusFixed:
+		return types.CompareStatusFixed, nil
+	default:
+		return 0, fmt.Errorf("unknown status %q", s)
+	}
+}
+
+func newCompareStatus(s types.ReleaseCompareStatus) compareStatus {
+	switch s {
+	case types.CompareStatusNew:
+		return CompareStatusNew
+	case types.CompareStatusRegressed:
+		return CompareStatusRegressed
+	case types.CompareStatusFixed:
+		return CompareStatusFixed
+	default:
+		return CompareStatusUnchanged
+	}
+}
+
+type compareReleasesRequest struct {
+	Service           string          `json:"service"`
+	BaselineRelease   string          `json:"baseline_release"`
+	CandidateRelease  string          `json:"candidate_release"`
+	Env               *string         `json:"env,omitempty"`
+	Source            *string         `json:"source,omitempty"`
+	Exposure          exposure        `json:"exposure"`
+	SignificanceLevel *float64        `json:"significance_level,omitempty"`
+	Statuses          []compareStatus `json:"statuses,omitempty"`
+	Limit             uint32          `json:"limit"`
+	Offset            uint32          `json:"offset"`
+	BaselineRequests  *uint64         `json:"baseline_requests,omitempty"`
+	CandidateRequests *uint64         `json:"candidate_requests,omitempty"`
+} //	@name	errorgroups.v1.CompareReleasesRequest
+
+type releaseExposure struct {
+	Release     string    `json:"release"`
+	SeenTotal   uint64    `json:"seen_total"`
+	Exposure    float64   `json:"exposure"`
+	FirstSeenAt time.Time `json:"first_seen_at" format:"date-time"`
+	LastSeenAt  time.Time `json:"last_seen_at" format:"date-time"`
+} //	@name	errorgroups.v1.ReleaseExposure
+
+func newReleaseExposure(e types.ReleaseExposure) releaseExposure {
+	return releaseExposure{
+		Release:     e.Release,
+		SeenTotal:   e.SeenTotal,
+		Exposure:    e.Exposure,
+		FirstSeenAt: e.FirstSeenAt,
+		LastSeenAt:  e.LastSeenAt,
+	}
+}
+
+type compareSummary struct {
+	New       uint64 `json:"new"`
+	Regressed uint64 `json:"regressed"`
+	Fixed     uint64 `json:"fixed"`
+	Unchanged uint64 `json:"unchanged"`
+} //	@name	errorgroups.v1.CompareSummary
+
+type compareGroup struct {
+	Hash               string        `json:"hash" format:"uint64"`
+	Message            string        `json:"message"`
+	Source             string        `json:"source"`
+	BaselineSeenTotal  uint64        `json:"baseline_seen_total"`
+	CandidateSeenTotal uint64        `json:"candidate_seen_total"`
+	BaselineRate       float64       `json:"baseline_rate"`
+	CandidateRate      float64       `json:"candidate_rate"`
+	RateRatio          float64       `json:"rate_ratio"`
+	PValue             float64       `json:"p_value"`
+	Significant        bool          `json:"significant"`
+	Status             compareStatus `json:"status"`
+} //	@name	errorgroups.v1.CompareGroup
+
+type compareReleasesResponse struct {
+	Baseline  releaseExposure `json:"baseline"`
+	Candidate releaseExposure `json:"candidate"`
+	Summary   compareSummary  `json:"summary"`
+	Total     uint64          `json:"total"`
+	Groups    []compareGroup  `json:"groups"`
+} //	@name	errorgroups.v1.CompareReleasesResponse
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func TestServeCompareReleases(t *testing.T) {
+	var (
+		service   = "test-service"
+		baseline  = "v1"
+		candidate = "v2"
+		source    = "test-source"
+		now       = time.Now().UTC()
+		someErr   = errors.New("some err")
+
+		baselineRequests  uint64 = 2000
+		candidateRequests uint64 = 1000
+	)
+
+	type mockArgs struct {
+		req types.CompareReleasesRequest
+
+		result types.CompareReleasesResult
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req     compareReleasesRequest
+		want    compareReleasesResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: compareReleasesRequest{
+				Service:           service,
+				BaselineRelease:   baseline,
+				CandidateRelease:  candidate,
+				Source:            &source,
+				Exposure:          ExposureRequests,
+				Statuses:          []compareStatus{CompareStatusNew, CompareStatusFixed},
+				Limit:             5,
+				Offset:            5,
+				BaselineRequests:  &baselineRequests,
+				CandidateRequests: &candidateRequests,
+			},
+			want: compareReleasesResponse{
+				Baseline:  releaseExposure{Release: baseline, SeenTotal: 10, Exposure: 2000, FirstSeenAt: now, LastSeenAt: now},
+				Candidate: releaseExposure{Release: candidate, SeenTotal: 5, Exposure: 1000, FirstSeenAt: now, LastSeenAt: now},
+				Summary:   compareSummary{New: 1, Fixed: 1},
+				Total:     2,
+				Groups: []compareGroup{
+					{Hash: "1", Message: "msg", Source: source, CandidateSeenTotal: 5, CandidateRate: 0.005, PValue: 0.01, Significant: true, Status: CompareStatusNew},
+				},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.CompareReleasesRequest{
+					Service:           service,
+					BaselineRelease:   baseline,
+					CandidateRelease:  candidate,
+					Source:            &source,
+					Exposure:          types.ExposureRequests,
+					Statuses:          []types.ReleaseCompareStatus{types.CompareStatusNew, types.CompareStatusFixed},
+					Limit:             5,
+					Offset:            5,
+					BaselineRequests:  &baselineRequests,
+					CandidateRequests: &candidateRequests,
+				},
+
+				result: types.CompareReleasesResult{
+					Baseline:  types.ReleaseExposure{Release: baseline, SeenTotal: 10, Exposure: 2000, FirstSeenAt: now, LastSeenAt: now},
+					Candidate: types.ReleaseExposure{Release: candidate, SeenTotal: 5, Exposure: 1000, FirstSeenAt: now, LastSeenAt: now},
+					Summary:   types.CompareReleasesSummary{New: 1, Fixed: 1},
+					Total:     2,
+					Groups: []types.CompareGroup{
+						{Hash: 1, Message: "msg", Source: source, CandidateSeenTotal: 5, CandidateRate: 0.005, PValue: 0.01, Significant: true, Status: types.CompareStatusNew},
+					},
+				},
+			},
+		},
+		{
+			name: "err_unknown_exposure",
+
+			req: compareReleasesRequest{
+				Service:  service,
+				Exposure: "events",
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_unknown_status",
+
+			req: compareReleasesRequest{
+				Service:  service,
+				Statuses: []compareStatus{"unknown"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_svc",
+
+			req:     compareReleasesRequest{},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.CompareReleasesRequest{},
+
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					CompareReleases(gomock.Any(), ma.req).
+					Return(ma.result, ma.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[compareReleasesRequest, compareReleasesResponse]{
+				Method: http.MethodPost,
+				Target: "/errorgroups/v1/compare_releases",
+				Req:    tt.req,
+
+				Handler: api.serveCompareReleases,
+
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
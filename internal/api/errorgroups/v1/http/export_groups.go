@@ -0,0 +1,83 @@
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+const exportGroupsMaxLimit = 10000
+
+var exportGroupsHeader = []string{"hash", "source", "message", "seen_total", "first_seen_at", "last_seen_at"}
+
+// serveExportGroups go doc.
+//
+//	@Router		/errorgroups/v1/groups/export [post]
+//	@ID			errorgroups_v1_export_groups
+//	@Tags		errorgroups_v1
+//	@Param		body	body	getGroupsRequest	true	"Request body"
+//	@Produce	text/csv
+//	@Success	200		{string}	string			"Error groups in CSV format"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveExportGroups(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "errorgroups_v1_export_groups")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq getGroupsRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(httpReq.attributes()...)
+
+	req, err := httpReq.toDomain()
+	if err != nil {
+		wr.Error(fmt.Errorf("failed to parse time range: %w", err), http.StatusBadRequest)
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = exportGroupsMaxLimit
+	}
+	if req.Limit > exportGroupsMaxLimit {
+		wr.Error(fmt.Errorf("too many groups are requested: count=%d, max=%d",
+			req.Limit, exportGroupsMaxLimit),
+			http.StatusBadRequest)
+		return
+	}
+	// total is not exported
+	req.WithTotal = false
+
+	groups, _, err := a.getGroups(ctx, req, httpReq.Filter)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="error_groups.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	csvWriter := csv.NewWriter(w)
+	_ = csvWriter.Write(exportGroupsHeader)
+	for _, g := range groups {
+		_ = csvWriter.Write([]string{
+			strconv.FormatUint(g.Hash, 10),
+			g.Source,
+			g.Message,
+			strconv.FormatUint(g.Count, 10),
+			g.FirstSeenAt.UTC().Format(time.RFC3339),
+			g.LastSeenAt.UTC().Format(time.RFC3339),
+		})
+	}
+	csvWriter.Flush()
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func TestServeExportGroups(t *testing.T) {
+	var (
+		service  = "test-service"
+		duration = "1h"
+		firstAt  = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+		lastAt   = time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)
+		someErr  = errors.New("some err")
+	)
+
+	groups := []types.ErrorGroup{
+		{Hash: 1, Source: "src", Message: "simple error", Count: 10, FirstSeenAt: firstAt, LastSeenAt: lastAt},
+		{Hash: 2, Source: "src", Message: "error, with \"quotes\"", Count: 5, FirstSeenAt: firstAt, LastSeenAt: lastAt},
+	}
+
+	type mockArgs struct {
+		req    types.GetErrorGroupsRequest
+		isNew  bool
+		groups []types.ErrorGroup
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req        getGroupsRequest
+		wantBody   string
+		wantStatus int
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: getGroupsRequest{
+				Service:   service,
+				Duration:  &duration,
+				WithTotal: true,
+			},
+			wantBody: "" +
+				"hash,source,message,seen_total,first_seen_at,last_seen_at\n" +
+				"1,src,simple error,10,2024-01-01T10:00:00Z,2024-01-01T11:00:00Z\n" +
+				"2,src,\"error, with \"\"quotes\"\"\",5,2024-01-01T10:00:00Z,2024-01-01T11:00:00Z\n",
+			wantStatus: http.StatusOK,
+			mockArgs: &mockArgs{
+				req: types.GetErrorGroupsRequest{
+					Service:   service,
+					TimeRange: &types.TimeRange{Duration: time.Hour},
+					Limit:     exportGroupsMaxLimit,
+				},
+				groups: groups,
+			},
+		},
+		{
+			name: "ok_new",
+			req: getGroupsRequest{
+				Service: service,
+				Limit:   1,
+				Filter:  &groupsFilter{IsNew: true},
+			},
+			wantBody: "" +
+				"hash,source,message,seen_total,first_seen_at,last_seen_at\n" +
+				"1,src,simple error,10,2024-01-01T10:00:00Z,2024-01-01T11:00:00Z\n",
+			wantStatus: http.StatusOK,
+			mockArgs: &mockArgs{
+				req: types.GetErrorGroupsRequest{
+					Service: service,
+					Limit:   1,
+				},
+				isNew:  true,
+				groups: groups[:1],
+			},
+		},
+		{
+			name: "err_limit",
+			req: getGroupsRequest{
+				Service: service,
+				Limit:   exportGroupsMaxLimit + 1,
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "err_svc",
+			req: getGroupsRequest{
+				Service: service,
+			},
+			wantStatus: http.StatusInternalServerError,
+			mockArgs: &mockArgs{
+				req: types.GetErrorGroupsRequest{
+					Service: service,
+					Limit:   exportGroupsMaxLimit,
+				},
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				if ma.isNew {
+					mockedSvc.EXPECT().
+						GetNewErrorGroups(gomock.Any(), ma.req).
+						Return(ma.groups, uint64(0), ma.err).
+						Times(1)
+				} else {
+					mockedSvc.EXPECT().
+						GetErrorGroups(gomock.Any(), ma.req).
+						Return(ma.groups, uint64(0), ma.err).
+						Times(1)
+				}
+			}
+
+			reqBody, err := json.Marshal(tt.req)
+			require.NoError(t, err)
+
+			httputil.DoTestHTTP(t, httputil.TestDataHTTP{
+				Req:          httptest.NewRequest(http.MethodPost, "/errorgroups/v1/groups/export", bytes.NewReader(reqBody)),
+				Handler:      api.serveExportGroups,
+				WantRespBody: tt.wantBody,
+				WantStatus:   tt.wantStatus,
+			})
+		})
+	}
+}
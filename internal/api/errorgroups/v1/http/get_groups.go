@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -35,63 +36,15 @@ func (a *API) serveGetGroups(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	attributes := []attribute.KeyValue{
-		{Key: "service", Value: attribute.StringValue(httpReq.Service)},
-		{Key: "limit", Value: attribute.IntValue(int(httpReq.Limit))},
-		{Key: "offset", Value: attribute.IntValue(int(httpReq.Offset))},
-		{Key: "order", Value: attribute.StringValue(string(httpReq.Order))},
-		{Key: "with_total", Value: attribute.BoolValue(httpReq.WithTotal)},
-	}
-	if httpReq.Env != nil {
-		attributes = append(attributes, attribute.KeyValue{Key: "env", Value: attribute.StringValue(*httpReq.Env)})
-	}
-	if httpReq.Release != nil {
-		attributes = append(attributes, attribute.KeyValue{Key: "release", Value: attribute.StringValue(*httpReq.Release)})
-	}
-	if httpReq.Duration != nil {
-		attributes = append(attributes, attribute.KeyValue{Key: "duration", Value: attribute.StringValue(*httpReq.Duration)})
-	}
-	if httpReq.Source != nil {
-		attributes = append(attributes, attribute.KeyValue{Key: "source", Value: attribute.StringValue(*httpReq.Source)})
-	}
-	if httpReq.Filter != nil {
-		filterRaw, _ := json.Marshal(httpReq.Filter)
-		attributes = append(attributes, attribute.KeyValue{Key: "filter", Value: attribute.StringValue(string(filterRaw))})
-	}
-	if httpReq.TimeRange != nil {
-		trRaw, _ := json.Marshal(httpReq.TimeRange)
-		attributes = append(attributes, attribute.KeyValue{Key: "time_range", Value: attribute.StringValue(string(trRaw))})
-	}
-	span.SetAttributes(attributes...)
+	span.SetAttributes(httpReq.attributes()...)
 
-	tr, err := parseTimeRange(httpReq.TimeRange, httpReq.Duration)
+	req, err := httpReq.toDomain()
 	if err != nil {
 		wr.Error(fmt.Errorf("failed to parse time range: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	req := types.GetErrorGroupsRequest{
-		Service:   httpReq.Service,
-		Env:       httpReq.Env,
-		Source:    httpReq.Source,
-		Release:   httpReq.Release,
-		TimeRange: tr,
-		Limit:     httpReq.Limit,
-		Offset:    httpReq.Offset,
-		Order:     httpReq.Order.toDomain(),
-		WithTotal: httpReq.WithTotal,
-	}
-
-	var (
-		groups []types.ErrorGroup
-		total  uint64
-	)
-	if httpReq.Filter != nil && httpReq.Filter.IsNew {
-		groups, total, err = a.service.GetNewErrorGroups(ctx, req)
-	} else {
-		groups, total, err = a.service.GetErrorGroups(ctx, req)
-	}
-
+	groups, total, err := a.getGroups(ctx, req, httpReq.Filter)
 	if err != nil {
 		httputil.ProcessError(wr, err)
 		return
@@ -103,6 +56,17 @@ func (a *API) serveGetGroups(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (a *API) getGroups(
+	ctx context.Context,
+	req types.GetErrorGroupsRequest,
+	filter *groupsFilter,
+) ([]types.ErrorGroup, uint64, error) {
+	if filter != nil && filter.IsNew {
+		return a.service.GetNewErrorGroups(ctx, req)
+	}
+	return a.service.GetErrorGroups(ctx, req)
+}
+
 type order string //	@name	errorgroups.v1.Order
 
 const (
@@ -144,6 +108,56 @@ type getGroupsRequest struct {
 	Filter *groupsFilter `json:"filter,omitempty"`
 } //	@name	errorgroups.v1.GetGroupsRequest
 
+func (r getGroupsRequest) attributes() []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		{Key: "service", Value: attribute.StringValue(r.Service)},
+		{Key: "limit", Value: attribute.IntValue(int(r.Limit))},
+		{Key: "offset", Value: attribute.IntValue(int(r.Offset))},
+		{Key: "order", Value: attribute.StringValue(string(r.Order))},
+		{Key: "with_total", Value: attribute.BoolValue(r.WithTotal)},
+	}
+	if r.Env != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "env", Value: attribute.StringValue(*r.Env)})
+	}
+	if r.Release != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "release", Value: attribute.StringValue(*r.Release)})
+	}
+	if r.Duration != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "duration", Value: attribute.StringValue(*r.Duration)})
+	}
+	if r.Source != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "source", Value: attribute.StringValue(*r.Source)})
+	}
+	if r.Filter != nil {
+		filterRaw, _ := json.Marshal(r.Filter)
+		attributes = append(attributes, attribute.KeyValue{Key: "filter", Value: attribute.StringValue(string(filterRaw))})
+	}
+	if r.TimeRange != nil {
+		trRaw, _ := json.Marshal(r.TimeRange)
+		attributes = append(attributes, attribute.KeyValue{Key: "time_range", Value: attribute.StringValue(string(trRaw))})
+	}
+	return attributes
+}
+
+func (r getGroupsRequest) toDomain() (types.GetErrorGroupsRequest, error) {
+	tr, err := parseTimeRange(r.TimeRange, r.Duration)
+	if err != nil {
+		return types.GetErrorGroupsRequest{}, err
+	}
+
+	return types.GetErrorGroupsRequest{
+		Service:   r.Service,
+		Env:       r.Env,
+		Source:    r.Source,
+		Release:   r.Release,
+		TimeRange: tr,
+		Limit:     r.Limit,
+		Offset:    r.Offset,
+		Order:     r.Order.toDomain(),
+		WithTotal: r.WithTotal,
+	}, nil
+}
+
 type getGroupsResponse struct {
 	Total  uint64  `json:"total"`
 	Groups []group `json:"groups"`
@@ -57,6 +57,12 @@ func (a *API) serveGetHist(w http.ResponseWriter, r *http.Request) {
 		trRaw, _ := json.Marshal(httpReq.TimeRange)
 		attributes = append(attributes, attribute.KeyValue{Key: "time_range", Value: attribute.StringValue(string(trRaw))})
 	}
+	if httpReq.Interval != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "interval", Value: attribute.StringValue(*httpReq.Interval)})
+	}
+	if httpReq.GroupBy != "" {
+		attributes = append(attributes, attribute.KeyValue{Key: "group_by", Value: attribute.StringValue(string(httpReq.GroupBy))})
+	}
 	span.SetAttributes(attributes...)
 
 	parsedGroupHash, err := parseGroupHash(httpReq.GroupHash)
@@ -71,6 +77,15 @@ func (a *API) serveGetHist(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var interval time.Duration
+	if httpReq.Interval != nil {
+		interval, err = time.ParseDuration(*httpReq.Interval)
+		if err != nil {
+			wr.Error(fmt.Errorf("failed to parse interval: %w", err), http.StatusBadRequest)
+			return
+		}
+	}
+
 	req := types.GetErrorHistRequest{
 		Service:   httpReq.Service,
 		GroupHash: parsedGroupHash,
@@ -78,6 +93,8 @@ func (a *API) serveGetHist(w http.ResponseWriter, r *http.Request) {
 		Source:    httpReq.Source,
 		Release:   httpReq.Release,
 		TimeRange: tr,
+		Interval:  interval,
+		GroupBy:   httpReq.GroupBy.toDomain(),
 	}
 	hist, err := a.service.GetHist(ctx, req)
 	if err != nil {
@@ -97,31 +114,75 @@ type getHistRequest struct {
 	// Deprecated: Use time_range instead
 	Duration  *string    `json:"duration,omitempty" format:"duration" example:"1h"`
 	TimeRange *timeRange `json:"time_range,omitempty"`
+	// Interval of buckets, one of: 1m, 10m, 1h, 24h, 168h.
+	// Monthly buckets can't be requested, since months differ in length.
+	// If not set, interval is chosen by time range.
+	Interval *string     `json:"interval,omitempty" format:"duration" example:"1h"`
+	GroupBy  histGroupBy `json:"group_by,omitempty"`
 } //	@name	errorgroups.v1.GetHistRequest
 
+type histGroupBy string //	@name	errorgroups.v1.HistGroupBy
+
+const (
+	HistGroupByNone    histGroupBy = ""
+	HistGroupByRelease histGroupBy = "release"
+	HistGroupByEnv     histGroupBy = "env"
+)
+
+func (g histGroupBy) toDomain() types.ErrorHistGroupBy {
+	switch g {
+	case HistGroupByRelease:
+		return types.HistGroupByRelease
+	case HistGroupByEnv:
+		return types.HistGroupByEnv
+	default:
+		return types.HistGroupByNone
+	}
+}
+
 type getHistResponse struct {
 	Buckets []bucket `json:"buckets"`
 	// Interval between buckets in seconds.
 	Interval uint64 `json:"interval"`
+	// Filled instead of buckets if group_by is set.
+	Series []series `json:"series,omitempty"`
 } //	@name	errorgroups.v1.GetHistResponse
 
+type series struct {
+	Key     string   `json:"key"`
+	Buckets []bucket `json:"buckets"`
+} //	@name	errorgroups.v1.Series
+
 type bucket struct {
 	Time  time.Time `json:"time" format:"date-time"`
 	Count uint64    `json:"count"`
 } //	@name	errorgroups.v1.Bucket
 
 func newHistResp(source types.ErrorHist) getHistResponse {
-	buckets := make([]bucket, 0, len(source.Buckets))
+	resp := getHistResponse{
+		Buckets:  newBuckets(source.Buckets),
+		Interval: source.Interval,
+	}
+
+	for _, s := range source.Series {
+		resp.Series = append(resp.Series, series{
+			Key:     s.Key,
+			Buckets: newBuckets(s.Buckets),
+		})
+	}
 
-	for _, b := range source.Buckets {
+	return resp
+}
+
+func newBuckets(source []types.ErrorHistBucket) []bucket {
+	buckets := make([]bucket, 0, len(source))
+
+	for _, b := range source {
 		buckets = append(buckets, bucket{
 			Time:  b.Time,
 			Count: b.Count,
 		})
 	}
 
-	return getHistResponse{
-		Buckets:  buckets,
-		Interval: source.Interval,
-	}
+	return buckets
 }
@@ -26,6 +26,8 @@ func TestServeGetHist(t *testing.T) {
 		now           = time.Now().Truncate(0).UTC()
 		oneMinuteAgo  = now.Add(-1 * time.Minute)
 		twoMinutesAgo = now.Add(-2 * time.Minute)
+		intervalStr   = "1m"
+		wrongInterval = "1x"
 		someErr       = errors.New("some err")
 	)
 
@@ -183,6 +185,67 @@ func TestServeGetHist(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ok_interval_group_by",
+
+			req: getHistRequest{
+				Service:  &service,
+				Duration: &durationStr,
+				Interval: &intervalStr,
+				GroupBy:  HistGroupByRelease,
+			},
+			want: getHistResponse{
+				Buckets: []bucket{},
+				Series: []series{
+					{
+						Key: "v1",
+						Buckets: []bucket{
+							{Time: twoMinutesAgo, Count: 100},
+						},
+					},
+					{
+						Key: "v2",
+						Buckets: []bucket{
+							{Time: oneMinuteAgo, Count: 200},
+						},
+					},
+				},
+				Interval: 60,
+			},
+
+			mockArgs: &mockArgs{
+				req: types.GetErrorHistRequest{
+					Service: &service,
+					TimeRange: &types.TimeRange{
+						Duration: duration,
+					},
+					Interval: time.Minute,
+					GroupBy:  types.HistGroupByRelease,
+				},
+
+				hist: types.ErrorHist{
+					Series: []types.ErrorHistSeries{
+						{
+							Key:     "v1",
+							Buckets: []types.ErrorHistBucket{{Time: twoMinutesAgo, Count: 100}},
+						},
+						{
+							Key:     "v2",
+							Buckets: []types.ErrorHistBucket{{Time: oneMinuteAgo, Count: 200}},
+						},
+					},
+					Interval: 60,
+				},
+			},
+		},
+		{
+			name: "err_interval",
+
+			req: getHistRequest{
+				Interval: &wrongInterval,
+			},
+			wantErr: true,
+		},
 		{
 			name: "err_svc",
 
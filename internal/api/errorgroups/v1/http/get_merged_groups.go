@@ -0,0 +1,83 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetMergedGroups go doc.
+//
+//	@Router		/errorgroups/v1/merged [post]
+//	@ID			errorgroups_v1_get_merged_groups
+//	@Tags		errorgroups_v1
+//	@Param		body	body		getMergedGroupsRequest	true	"Request body"
+//	@Success	200		{object}	getMergedGroupsResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error			"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetMergedGroups(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "errorgroups_v1_get_merged_groups")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq getMergedGroupsRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	parsedTargetHash, err := parseGroupHash(httpReq.TargetHash)
+	if err != nil {
+		wr.Error(fmt.Errorf("failed to parse target_hash: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if httpReq.TargetHash != nil {
+		span.SetAttributes(attribute.KeyValue{Key: "target_hash", Value: attribute.StringValue(*httpReq.TargetHash)})
+	}
+
+	req := types.GetMergedErrorGroupsRequest{
+		TargetHash: parsedTargetHash,
+	}
+	merges, err := a.service.GetMergedErrorGroups(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	res := make([]groupMerge, 0, len(merges))
+	for _, m := range merges {
+		res = append(res, groupMerge{
+			GroupHash:  strconv.FormatUint(m.Hash, 10),
+			TargetHash: strconv.FormatUint(m.TargetHash, 10),
+			CreatedBy:  m.CreatedBy,
+			CreatedAt:  m.CreatedAt,
+		})
+	}
+
+	wr.WriteJson(getMergedGroupsResponse{Merges: res})
+}
+
+type getMergedGroupsRequest struct {
+	TargetHash *string `json:"target_hash,omitempty" format:"uint64"`
+} //	@name	errorgroups.v1.GetMergedGroupsRequest
+
+type groupMerge struct {
+	GroupHash  string    `json:"group_hash" format:"uint64"`
+	TargetHash string    `json:"target_hash" format:"uint64"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at" format:"date-time"`
+} //	@name	errorgroups.v1.GroupMerge
+
+type getMergedGroupsResponse struct {
+	Merges []groupMerge `json:"merges"`
+} //	@name	errorgroups.v1.GetMergedGroupsResponse
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func TestServeGetMergedGroups(t *testing.T) {
+	var (
+		targetHash    = uint64(1)
+		targetHashStr = "1"
+		createdAt     = time.Now().UTC()
+		someErr       = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		req types.GetMergedErrorGroupsRequest
+
+		merges []types.ErrorGroupMerge
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req     getMergedGroupsRequest
+		want    getMergedGroupsResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: getMergedGroupsRequest{
+				TargetHash: &targetHashStr,
+			},
+			want: getMergedGroupsResponse{
+				Merges: []groupMerge{
+					{GroupHash: "2", TargetHash: "1", CreatedBy: "user", CreatedAt: createdAt},
+					{GroupHash: "3", TargetHash: "1", CreatedBy: "user", CreatedAt: createdAt},
+				},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.GetMergedErrorGroupsRequest{
+					TargetHash: &targetHash,
+				},
+
+				merges: []types.ErrorGroupMerge{
+					{Hash: 2, TargetHash: 1, CreatedBy: "user", CreatedAt: createdAt},
+					{Hash: 3, TargetHash: 1, CreatedBy: "user", CreatedAt: createdAt},
+				},
+			},
+		},
+		{
+			name: "ok_empty",
+
+			req: getMergedGroupsRequest{},
+			want: getMergedGroupsResponse{
+				Merges: []groupMerge{},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.GetMergedErrorGroupsRequest{},
+			},
+		},
+		{
+			name: "err_svc",
+
+			req:     getMergedGroupsRequest{},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.GetMergedErrorGroupsRequest{},
+
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					GetMergedErrorGroups(gomock.Any(), ma.req).
+					Return(ma.merges, ma.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[getMergedGroupsRequest, getMergedGroupsResponse]{
+				Method: http.MethodPost,
+				Target: "/errorgroups/v1/merged",
+				Req:    tt.req,
+
+				Handler: api.serveGetMergedGroups,
+
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
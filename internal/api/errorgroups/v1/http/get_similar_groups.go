@@ -0,0 +1,101 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetSimilarGroups go doc.
+//
+//	@Router		/errorgroups/v1/similar [post]
+//	@ID			errorgroups_v1_get_similar_groups
+//	@Tags		errorgroups_v1
+//	@Param		body	body		getSimilarGroupsRequest		true	"Request body"
+//	@Success	200		{object}	getSimilarGroupsResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error				"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetSimilarGroups(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "errorgroups_v1_get_similar_groups")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq getSimilarGroupsRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	parsedGroupHash, err := parseGroupHash(&httpReq.GroupHash)
+	if err != nil {
+		wr.Error(fmt.Errorf("failed to parse group_hash: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	attributes := []attribute.KeyValue{
+		{Key: "service", Value: attribute.StringValue(httpReq.Service)},
+		{Key: "group_hash", Value: attribute.StringValue(httpReq.GroupHash)},
+		{Key: "limit", Value: attribute.IntValue(int(httpReq.Limit))},
+	}
+	if httpReq.Env != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "env", Value: attribute.StringValue(*httpReq.Env)})
+	}
+	if httpReq.MinSimilarity != nil {
+		attributes = append(attributes, attribute.KeyValue{Key: "min_similarity", Value: attribute.Float64Value(*httpReq.MinSimilarity)})
+	}
+	span.SetAttributes(attributes...)
+
+	req := types.GetSimilarErrorGroupsRequest{
+		Service:       httpReq.Service,
+		GroupHash:     *parsedGroupHash,
+		Env:           httpReq.Env,
+		Limit:         httpReq.Limit,
+		MinSimilarity: httpReq.MinSimilarity,
+	}
+	groups, err := a.service.GetSimilarErrorGroups(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	res := make([]similarGroup, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, similarGroup{
+			Hash:       strconv.FormatUint(g.Hash, 10),
+			Message:    g.Message,
+			Source:     g.Source,
+			SeenTotal:  g.Count,
+			Similarity: g.Similarity,
+		})
+	}
+
+	wr.WriteJson(getSimilarGroupsResponse{Groups: res})
+}
+
+type getSimilarGroupsRequest struct {
+	Service       string   `json:"service"`
+	GroupHash     string   `json:"group_hash" format:"uint64"`
+	Env           *string  `json:"env,omitempty"`
+	Limit         uint32   `json:"limit"`
+	MinSimilarity *float64 `json:"min_similarity,omitempty"`
+} //	@name	errorgroups.v1.GetSimilarGroupsRequest
+
+type similarGroup struct {
+	Hash       string  `json:"hash" format:"uint64"`
+	Message    string  `json:"message"`
+	Source     string  `json:"source"`
+	SeenTotal  uint64  `json:"seen_total"`
+	Similarity float64 `json:"similarity"`
+} //	@name	errorgroups.v1.SimilarGroup
+
+type getSimilarGroupsResponse struct {
+	Groups []similarGroup `json:"groups"`
+} //	@name	errorgroups.v1.GetSimilarGroupsResponse
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func TestServeGetSimilarGroups(t *testing.T) {
+	var (
+		service       = "test-service"
+		env           = "test-env"
+		minSimilarity = 0.5
+		someErr       = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		req types.GetSimilarErrorGroupsRequest
+
+		groups []types.SimilarErrorGroup
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req     getSimilarGroupsRequest
+		want    getSimilarGroupsResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: getSimilarGroupsRequest{
+				Service:       service,
+				GroupHash:     "1",
+				Env:           &env,
+				Limit:         10,
+				MinSimilarity: &minSimilarity,
+			},
+			want: getSimilarGroupsResponse{
+				Groups: []similarGroup{
+					{Hash: "2", Message: "msg 2", Source: "src", SeenTotal: 20, Similarity: 0.9},
+					{Hash: "3", Message: "msg 3", Source: "src", SeenTotal: 30, Similarity: 0.6},
+				},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.GetSimilarErrorGroupsRequest{
+					Service:       service,
+					GroupHash:     1,
+					Env:           &env,
+					Limit:         10,
+					MinSimilarity: &minSimilarity,
+				},
+
+				groups: []types.SimilarErrorGroup{
+					{Hash: 2, Message: "msg 2", Source: "src", Count: 20, Similarity: 0.9},
+					{Hash: 3, Message: "msg 3", Source: "src", Count: 30, Similarity: 0.6},
+				},
+			},
+		},
+		{
+			name: "err_parse_group_hash",
+
+			req: getSimilarGroupsRequest{
+				Service:   service,
+				GroupHash: "abc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_svc",
+
+			req: getSimilarGroupsRequest{
+				GroupHash: "1",
+			},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.GetSimilarErrorGroupsRequest{
+					GroupHash: 1,
+				},
+
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					GetSimilarErrorGroups(gomock.Any(), ma.req).
+					Return(ma.groups, ma.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[getSimilarGroupsRequest, getSimilarGroupsResponse]{
+				Method: http.MethodPost,
+				Target: "/errorgroups/v1/similar",
+				Req:    tt.req,
+
+				Handler: api.serveGetSimilarGroups,
+
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
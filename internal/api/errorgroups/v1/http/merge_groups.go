@@ -0,0 +1,72 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveMergeGroups go doc.
+//
+//	@Router		/errorgroups/v1/merge [post]
+//	@ID			errorgroups_v1_merge_groups
+//	@Tags		errorgroups_v1
+//	@Param		body	body		mergeGroupsRequest	true	"Request body"
+//	@Success	200		{object}	nil					"A successful response"
+//	@Failure	default	{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveMergeGroups(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "errorgroups_v1_merge_groups")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	var httpReq mergeGroupsRequest
+	if err = json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	parsedTargetHash, err := parseGroupHash(&httpReq.TargetHash)
+	if err != nil {
+		wr.Error(fmt.Errorf("failed to parse target_hash: %w", err), http.StatusBadRequest)
+		return
+	}
+	parsedGroupHashes, err := parseGroupHashes(httpReq.GroupHashes)
+	if err != nil {
+		wr.Error(fmt.Errorf("failed to parse group_hashes: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{Key: "target_hash", Value: attribute.StringValue(httpReq.TargetHash)},
+		attribute.KeyValue{Key: "group_hashes", Value: attribute.StringSliceValue(httpReq.GroupHashes)},
+	)
+
+	req := types.MergeErrorGroupsRequest{
+		TargetHash: *parsedTargetHash,
+		Hashes:     parsedGroupHashes,
+		User:       userName,
+	}
+	if err = a.service.MergeErrorGroups(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+}
+
+type mergeGroupsRequest struct {
+	TargetHash  string   `json:"target_hash" format:"uint64"`
+	GroupHashes []string `json:"group_hashes" format:"uint64"`
+} //	@name	errorgroups.v1.MergeGroupsRequest
@@ -0,0 +1,141 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func withUser(h http.HandlerFunc, userName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(context.WithValue(r.Context(), types.UserKey{}, userName))
+		h(w, r)
+	}
+}
+
+func TestServeMergeGroups(t *testing.T) {
+	var (
+		user    = "test-user"
+		someErr = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		req types.MergeErrorGroupsRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     mergeGroupsRequest
+		noUser  bool
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: mergeGroupsRequest{
+				TargetHash:  "1",
+				GroupHashes: []string{"2", "18446744073709551615"},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.MergeErrorGroupsRequest{
+					TargetHash: 1,
+					Hashes:     []uint64{2, 18446744073709551615},
+					User:       user,
+				},
+			},
+		},
+		{
+			name: "err_no_user",
+
+			req: mergeGroupsRequest{
+				TargetHash:  "1",
+				GroupHashes: []string{"2"},
+			},
+			noUser:  true,
+			wantErr: true,
+		},
+		{
+			name: "err_parse_target_hash",
+
+			req: mergeGroupsRequest{
+				TargetHash:  "abc",
+				GroupHashes: []string{"2"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_parse_group_hashes",
+
+			req: mergeGroupsRequest{
+				TargetHash:  "1",
+				GroupHashes: []string{"-2"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_svc",
+
+			req: mergeGroupsRequest{
+				TargetHash:  "1",
+				GroupHashes: []string{"2"},
+			},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.MergeErrorGroupsRequest{
+					TargetHash: 1,
+					Hashes:     []uint64{2},
+					User:       user,
+				},
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					MergeErrorGroups(gomock.Any(), ma.req).
+					Return(ma.err).
+					Times(1)
+			}
+
+			handler := api.serveMergeGroups
+			if !tt.noUser {
+				handler = withUser(handler, user)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[mergeGroupsRequest, struct{}]{
+				Method: http.MethodPost,
+				Target: "/errorgroups/v1/merge",
+				Req:    tt.req,
+
+				Handler: handler,
+
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
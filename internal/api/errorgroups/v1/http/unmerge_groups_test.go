@@ -0,0 +1,118 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func TestServeUnmergeGroups(t *testing.T) {
+	var (
+		user    = "test-user"
+		someErr = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		req types.UnmergeErrorGroupsRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     unmergeGroupsRequest
+		noUser  bool
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: unmergeGroupsRequest{
+				GroupHashes: []string{"2", "3"},
+			},
+
+			mockArgs: &mockArgs{
+				req: types.UnmergeErrorGroupsRequest{
+					Hashes: []uint64{2, 3},
+					User:   user,
+				},
+			},
+		},
+		{
+			name: "err_no_user",
+
+			req: unmergeGroupsRequest{
+				GroupHashes: []string{"2"},
+			},
+			noUser:  true,
+			wantErr: true,
+		},
+		{
+			name: "err_parse_group_hashes",
+
+			req: unmergeGroupsRequest{
+				GroupHashes: []string{"abc"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_svc",
+
+			req: unmergeGroupsRequest{
+				GroupHashes: []string{"2"},
+			},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				req: types.UnmergeErrorGroupsRequest{
+					Hashes: []uint64{2},
+					User:   user,
+				},
+				err: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedSvc := svc_mock.NewMockService(ctrl)
+
+			api := New(mockedSvc)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedSvc.EXPECT().
+					UnmergeErrorGroups(gomock.Any(), ma.req).
+					Return(ma.err).
+					Times(1)
+			}
+
+			handler := api.serveUnmergeGroups
+			if !tt.noUser {
+				handler = withUser(handler, user)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[unmergeGroupsRequest, struct{}]{
+				Method: http.MethodPost,
+				Target: "/errorgroups/v1/unmerge",
+				Req:    tt.req,
+
+				Handler: handler,
+
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
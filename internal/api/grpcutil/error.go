@@ -15,11 +15,12 @@ func ProcessError(err error) error {
 		return nil
 	case errors.Is(err, types.ErrUnauthenticated):
 		return status.Error(codes.Unauthenticated, types.ErrUnauthenticated.Error())
-	case errors.Is(err, types.ErrEmptyUpdateRequest) || errors.Is(err, types.ErrInvalidRequestField):
+	case errors.Is(err, types.ErrEmptyUpdateRequest) || errors.Is(err, types.ErrInvalidRequestField) ||
+		status.Code(err) == codes.InvalidArgument:
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, types.ErrNotFound):
 		return status.Error(codes.NotFound, err.Error())
-	case errors.Is(err, types.ErrPermissionDenied):
+	case errors.Is(err, types.ErrPermissionDenied) || status.Code(err) == codes.PermissionDenied:
 		return status.Error(codes.PermissionDenied, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
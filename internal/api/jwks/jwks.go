@@ -0,0 +1,52 @@
+package jwks_api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// jwksCacheControl allows the external verifiers to cache the keys for 5 minutes,
+// so the new key must be published at least 5 minutes before it signs the tokens.
+const jwksCacheControl = "public, max-age=300"
+
+// KeySet provides the public keys verifying the service tokens.
+type KeySet interface {
+	JWKS() []byte
+}
+
+// API publishes the public keys of the service tokens for the external verifiers.
+type API struct {
+	keySet KeySet
+}
+
+func New(keySet KeySet) *API {
+	return &API{
+		keySet: keySet,
+	}
+}
+
+func (a *API) Router() chi.Router {
+	mux := chi.NewMux()
+
+	mux.Get("/jwks.json", a.serveJWKS)
+
+	return mux
+}
+
+// serveJWKS go doc.
+//
+//	@Router		/.well-known/jwks.json [get]
+//	@ID			jwks
+//	@Tags		auth
+//	@Success	200	{object}	object	"JWK set of the public keys verifying the service tokens"
+func (a *API) serveJWKS(w http.ResponseWriter, r *http.Request) {
+	_, span := tracing.StartSpan(r.Context(), "jwks")
+	defer span.End()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", jwksCacheControl)
+	_, _ = w.Write(a.keySet.JWKS())
+}
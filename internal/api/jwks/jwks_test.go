@@ -0,0 +1,28 @@
+package jwks_api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type keySetStub []byte
+
+func (s keySetStub) JWKS() []byte {
+	return s
+}
+
+func TestServeJWKS(t *testing.T) {
+	jwks := `{"keys":[{"use":"sig","kty":"EC","kid":"key","crv":"P-256","alg":"ES256","x":"x","y":"y"}]}`
+	api := New(keySetStub(jwks))
+
+	rec := httptest.NewRecorder()
+	api.Router().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jwks.json", http.NoBody))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.Equal(t, jwksCacheControl, rec.Header().Get("Cache-Control"))
+	require.JSONEq(t, jwks, rec.Body.String())
+}
@@ -16,15 +16,21 @@ import (
 	"github.com/go-chi/chi/v5"
 	"google.golang.org/grpc"
 
+	audit_v1_api "github.com/ozontech/seq-ui/internal/api/audit/v1"
+	auth_api "github.com/ozontech/seq-ui/internal/api/auth"
 	dashboards_v1_api "github.com/ozontech/seq-ui/internal/api/dashboards/v1"
 	errorgroups_v1_api "github.com/ozontech/seq-ui/internal/api/errorgroups/v1"
+	jwks_api "github.com/ozontech/seq-ui/internal/api/jwks"
 	massexport_v1_api "github.com/ozontech/seq-ui/internal/api/massexport/v1"
 	seqapi_v1_api "github.com/ozontech/seq-ui/internal/api/seqapi/v1"
+	tokens_v1_api "github.com/ozontech/seq-ui/internal/api/tokens/v1"
 	userprofile_v1_api "github.com/ozontech/seq-ui/internal/api/userprofile/v1"
+	audit_v1 "github.com/ozontech/seq-ui/pkg/audit/v1"
 	dashboards_v1 "github.com/ozontech/seq-ui/pkg/dashboards/v1"
 	errorgroups_v1 "github.com/ozontech/seq-ui/pkg/errorgroups/v1"
 	massexport_v1 "github.com/ozontech/seq-ui/pkg/massexport/v1"
 	seqapi_v1 "github.com/ozontech/seq-ui/pkg/seqapi/v1"
+	tokens_v1 "github.com/ozontech/seq-ui/pkg/tokens/v1"
 	userprofile_v1 "github.com/ozontech/seq-ui/pkg/userprofile/v1"
 )
 
@@ -35,6 +41,10 @@ type Registrar struct {
 	dashboardsV1  *dashboards_v1_api.Dashboards
 	massExportV1  *massexport_v1_api.MassExport
 	errorGroupsV1 *errorgroups_v1_api.ErrorGroups
+	tokensV1      *tokens_v1_api.Tokens
+	auditV1       *audit_v1_api.Audit
+	authAPI       *auth_api.API
+	jwksAPI       *jwks_api.API
 }
 
 // NewRegistrar returns new registrar instance.
@@ -44,6 +54,10 @@ func NewRegistrar(
 	dashboardsV1 *dashboards_v1_api.Dashboards,
 	massExportV1 *massexport_v1_api.MassExport,
 	errorGroupsV1 *errorgroups_v1_api.ErrorGroups,
+	tokensV1 *tokens_v1_api.Tokens,
+	auditV1 *audit_v1_api.Audit,
+	authAPI *auth_api.API,
+	jwksAPI *jwks_api.API,
 ) *Registrar {
 	return &Registrar{
 		seqApiV1:      seqApiV1,
@@ -51,6 +65,10 @@ func NewRegistrar(
 		dashboardsV1:  dashboardsV1,
 		massExportV1:  massExportV1,
 		errorGroupsV1: errorGroupsV1,
+		tokensV1:      tokensV1,
+		auditV1:       auditV1,
+		authAPI:       authAPI,
+		jwksAPI:       jwksAPI,
 	}
 }
 
@@ -71,6 +89,12 @@ func (r *Registrar) RegisterGRPCHandlers(grpcServer *grpc.Server) {
 	if r.errorGroupsV1 != nil {
 		errorgroups_v1.RegisterErrorGroupsServiceServer(grpcServer, r.errorGroupsV1.GRPCServer())
 	}
+	if r.tokensV1 != nil {
+		tokens_v1.RegisterTokensServiceServer(grpcServer, r.tokensV1.GRPCServer())
+	}
+	if r.auditV1 != nil {
+		audit_v1.RegisterAuditServiceServer(grpcServer, r.auditV1.GRPCServer())
+	}
 }
 
 // RegisterHTTPHandlers registers all handlers for mux.
@@ -90,4 +114,16 @@ func (r *Registrar) RegisterHTTPHandlers(mux *chi.Mux) {
 	if r.errorGroupsV1 != nil {
 		mux.Mount("/errorgroups/v1", r.errorGroupsV1.HTTPRouter())
 	}
+	if r.tokensV1 != nil {
+		mux.Mount("/tokens/v1", r.tokensV1.HTTPRouter())
+	}
+	if r.auditV1 != nil {
+		mux.Mount("/audit/v1", r.auditV1.HTTPRouter())
+	}
+	if r.authAPI != nil {
+		mux.Mount("/auth", r.authAPI.Router())
+	}
+	if r.jwksAPI != nil {
+		mux.Mount("/.well-known", r.jwksAPI.Router())
+	}
 }
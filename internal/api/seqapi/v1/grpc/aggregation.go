@@ -10,6 +10,8 @@ import (
 
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/aggregation_ts"
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/api_error"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -54,9 +56,9 @@ func (a *API) GetAggregation(ctx context.Context, req *seqapi.GetAggregationRequ
 
 	span.SetAttributes(attributes...)
 
-	params, err := a.GetParams(env)
+	params, err := a.GetParams(ctx, env)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	if err := api_error.CheckAggregationsCount(len(req.Aggregations), params.options.MaxAggregationsPerRequest); err != nil {
@@ -75,7 +77,16 @@ func (a *API) GetAggregation(ctx context.Context, req *seqapi.GetAggregationRequ
 		}
 	}
 
+	start := a.nowFn()
 	resp, err := params.client.GetAggregation(ctx, req)
+	a.recordQuery(ctx, types.QueryHistoryEntry{
+		Kind:  types.QueryHistoryKindAggregation,
+		Query: req.GetQuery(),
+		From:  fromRaw,
+		To:    toRaw,
+		Env:   env,
+		Total: queryhistory.AggregationsTotal(resp.GetAggregations()),
+	}, start, resp.GetError(), err)
 	if err != nil {
 		return nil, err
 	}
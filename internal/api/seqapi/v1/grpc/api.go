@@ -8,14 +8,19 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/envaccess"
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/internal/pkg/cache"
 	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
 	"github.com/ozontech/seq-ui/internal/pkg/mask"
 	asyncsearches "github.com/ozontech/seq-ui/internal/pkg/service/async_searches"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 )
@@ -39,6 +44,7 @@ type API struct {
 	redisCache          cache.Cache
 	nowFn               func() time.Time
 	asyncSearches       asyncsearches.Service
+	queryHistory        queryhistory.Recorder
 	envsResponse        *seqapi.GetEnvsResponse
 }
 
@@ -48,6 +54,7 @@ func New(
 	inmemWithRedisCache cache.Cache,
 	redisCache cache.Cache,
 	asyncSearches asyncsearches.Service,
+	queryHistory queryhistory.Recorder,
 ) *API {
 	var globalfCache *fieldsCache
 	if cfg.FieldsCacheTTL > 0 {
@@ -118,6 +125,7 @@ func New(
 		redisCache:          redisCache,
 		nowFn:               time.Now,
 		asyncSearches:       asyncSearches,
+		queryHistory:        queryHistory,
 		envsResponse:        parseEnvs(cfg),
 	}
 }
@@ -191,7 +199,8 @@ func (a *API) GetEnvFromContext(ctx context.Context) string {
 	return envValues[0]
 }
 
-func (a *API) GetParams(env string) (apiParams, error) {
+// GetParams returns params of the env, checking that the user from context may query it.
+func (a *API) GetParams(ctx context.Context, env string) (apiParams, error) {
 	if len(a.config.Envs) == 0 {
 		return a.params, nil
 	}
@@ -202,12 +211,26 @@ func (a *API) GetParams(env string) (apiParams, error) {
 
 	params, exists := a.paramsByEnv[env]
 	if !exists {
-		return apiParams{}, fmt.Errorf("env '%s' not found", env)
+		return apiParams{}, status.Error(codes.InvalidArgument, fmt.Sprintf("env '%s' not found", env))
+	}
+
+	if err := envaccess.Check(ctx, a.config, env); err != nil {
+		return apiParams{}, grpcutil.ProcessError(err)
 	}
 
 	return params, nil
 }
 
+// recordQuery records the seq-db request to the user's query history.
+func (a *API) recordQuery(ctx context.Context, e types.QueryHistoryEntry, start time.Time, respErr *seqapi.Error, err error) {
+	if a.queryHistory == nil {
+		return
+	}
+	e.Duration = a.nowFn().Sub(start)
+	e.ErrorCode = queryhistory.ErrorCode(respErr, err)
+	a.queryHistory.Record(ctx, e)
+}
+
 type fieldsCache struct {
 	ttl time.Duration
 
@@ -3,9 +3,6 @@ package grpc
 import (
 	"context"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -15,9 +12,9 @@ func (a *API) Status(ctx context.Context, req *seqapi.StatusRequest) (*seqapi.St
 	defer span.End()
 
 	env := a.GetEnvFromContext(ctx)
-	params, err := a.GetParams(env)
+	params, err := a.GetParams(ctx, env)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return params.client.Status(ctx, req)
@@ -5,10 +5,9 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/ozontech/seq-ui/internal/app/querypolicy"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
@@ -33,14 +32,17 @@ func (a *API) GetEvent(ctx context.Context, req *seqapi.GetEventRequest) (*seqap
 
 	span.SetAttributes(attributes...)
 
-	params, err := a.GetParams(env)
+	params, err := a.GetParams(ctx, env)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	if cached, err := a.inmemWithRedisCache.Get(ctx, req.Id); err == nil {
 		event := &seqapi.Event{}
 		if err = proto.Unmarshal([]byte(cached), event); err == nil {
+			if err = querypolicy.CheckEvent(ctx, params.client, event); err != nil {
+				return nil, err
+			}
 			if params.masker != nil {
 				params.masker.Mask(event.Data)
 			}
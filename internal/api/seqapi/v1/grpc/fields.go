@@ -4,8 +4,6 @@ import (
 	"context"
 
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
@@ -17,9 +15,9 @@ func (a *API) GetFields(ctx context.Context, req *seqapi.GetFieldsRequest) (*seq
 	defer span.End()
 
 	env := a.GetEnvFromContext(ctx)
-	params, err := a.GetParams(env)
+	params, err := a.GetParams(ctx, env)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	if params.fieldsCache == nil {
@@ -67,9 +65,9 @@ func (a *API) GetPinnedFields(ctx context.Context, _ *seqapi.GetFieldsRequest) (
 	defer span.End()
 
 	env := a.GetEnvFromContext(ctx)
-	params, err := a.GetParams(env)
+	params, err := a.GetParams(ctx, env)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return &seqapi.GetFieldsResponse{
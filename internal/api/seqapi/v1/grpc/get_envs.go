@@ -3,9 +3,16 @@ package grpc
 import (
 	"context"
 
+	"github.com/ozontech/seq-ui/internal/app/envaccess"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 )
 
-func (a *API) GetEnvs(_ context.Context, _ *seqapi.GetEnvsRequest) (*seqapi.GetEnvsResponse, error) {
-	return a.envsResponse, nil
+func (a *API) GetEnvs(ctx context.Context, _ *seqapi.GetEnvsRequest) (*seqapi.GetEnvsResponse, error) {
+	envs := make([]*seqapi.GetEnvsResponse_Env, 0, len(a.envsResponse.Envs))
+	for _, e := range a.envsResponse.Envs {
+		if e.Env == "" || envaccess.Allowed(ctx, a.config.Envs[e.Env].Access) {
+			envs = append(envs, e)
+		}
+	}
+	return &seqapi.GetEnvsResponse{Envs: envs}, nil
 }
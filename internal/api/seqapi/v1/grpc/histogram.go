@@ -4,9 +4,9 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel/attribute"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -46,12 +46,21 @@ func (a *API) GetHistogram(ctx context.Context, req *seqapi.GetHistogramRequest)
 
 	span.SetAttributes(attributes...)
 
-	params, err := a.GetParams(env)
+	params, err := a.GetParams(ctx, env)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
+	start := a.nowFn()
 	resp, err := params.client.GetHistogram(ctx, req)
+	a.recordQuery(ctx, types.QueryHistoryEntry{
+		Kind:  types.QueryHistoryKindHistogram,
+		Query: req.GetQuery(),
+		From:  req.GetFrom().AsTime(),
+		To:    req.GetTo().AsTime(),
+		Env:   env,
+		Total: queryhistory.HistogramTotal(resp.GetHistogram()),
+	}, start, resp.GetError(), err)
 	if err != nil {
 		return nil, err
 	}
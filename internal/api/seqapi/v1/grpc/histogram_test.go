@@ -11,7 +11,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/test"
+	"github.com/ozontech/seq-ui/internal/app/types"
 	mock_seqdb "github.com/ozontech/seq-ui/internal/pkg/client/seqdb/mock"
+	mock_queryhistory "github.com/ozontech/seq-ui/internal/pkg/service/queryhistory/mock"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 )
 
@@ -23,8 +25,9 @@ func TestGetHistogram(t *testing.T) {
 	tests := []struct {
 		name string
 
-		req  *seqapi.GetHistogramRequest
-		want *seqapi.GetHistogramResponse
+		req       *seqapi.GetHistogramRequest
+		want      *seqapi.GetHistogramResponse
+		wantEntry types.QueryHistoryEntry
 
 		clientErr error
 	}{
@@ -42,12 +45,25 @@ func TestGetHistogram(t *testing.T) {
 					Code: seqapi.ErrorCode_ERROR_CODE_NO,
 				},
 			},
+			wantEntry: types.QueryHistoryEntry{
+				Kind:  types.QueryHistoryKindHistogram,
+				Query: query,
+				From:  testTimestamp,
+				To:    testTimestamp.Add(time.Second),
+				Total: 15,
+			},
 		},
 		{
 			name: "err_client",
 			req: &seqapi.GetHistogramRequest{
 				Interval: interval,
 			},
+			wantEntry: types.QueryHistoryEntry{
+				Kind:      types.QueryHistoryKindHistogram,
+				From:      time.Unix(0, 0).UTC(),
+				To:        time.Unix(0, 0).UTC(),
+				ErrorCode: "Unknown",
+			},
 			clientErr: errSomethingWrong,
 		},
 	}
@@ -67,7 +83,14 @@ func TestGetHistogram(t *testing.T) {
 				Times(1)
 			seqData.Mocks.SeqDB = seqDbMock
 
+			queryHistoryMock := mock_queryhistory.NewMockRecorder(ctrl)
+			queryHistoryMock.EXPECT().
+				Record(gomock.Any(), tt.wantEntry).
+				Times(1)
+			seqData.Mocks.QueryHistory = queryHistoryMock
+
 			api := setupTestAPI(seqData)
+			api.nowFn = func() time.Time { return testTimestamp }
 			resp, err := api.GetHistogram(context.Background(), tt.req)
 
 			require.Equal(t, tt.clientErr, err)
@@ -5,10 +5,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/test"
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 )
 
@@ -59,3 +63,47 @@ func TestGetLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLimitsEnvAccess(t *testing.T) {
+	cfg := config.SeqAPI{
+		Envs: map[string]config.SeqAPIEnv{
+			"prod": {
+				SeqDB:   "prod",
+				Options: &config.SeqAPIOptions{MaxSearchLimit: 100},
+				Access:  &config.SeqAPIEnvAccess{Users: []string{"alice"}},
+			},
+		},
+		DefaultEnv: "prod",
+	}
+
+	tests := []struct {
+		name     string
+		user     string
+		wantCode codes.Code
+	}{
+		{
+			name:     "ok",
+			user:     "alice",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "err_denied",
+			user:     "bob",
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := setupTestAPI(test.APITestData{Cfg: cfg})
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("env", "prod"))
+			ctx = context.WithValue(ctx, types.UserKey{}, tt.user)
+
+			_, err := s.GetLimits(ctx, nil)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
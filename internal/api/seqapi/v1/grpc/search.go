@@ -10,6 +10,7 @@ import (
 
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/aggregation_ts"
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/api_error"
+	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -65,9 +66,9 @@ func (a *API) Search(ctx context.Context, req *seqapi.SearchRequest) (*seqapi.Se
 
 	span.SetAttributes(spanAttributes...)
 
-	params, err := a.GetParams(env)
+	params, err := a.GetParams(ctx, env)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	if req.Histogram != nil && req.Histogram.Interval != "" {
@@ -111,7 +112,16 @@ func (a *API) Search(ctx context.Context, req *seqapi.SearchRequest) (*seqapi.Se
 		}
 	}
 
+	start := a.nowFn()
 	resp, err := params.client.Search(ctx, req)
+	a.recordQuery(ctx, types.QueryHistoryEntry{
+		Kind:  types.QueryHistoryKindSearch,
+		Query: req.GetQuery(),
+		From:  fromRaw,
+		To:    toRaw,
+		Env:   env,
+		Total: resp.GetTotal(),
+	}, start, resp.GetError(), err)
 	if err != nil {
 		return nil, err
 	}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/ozontech/seq-ui/internal/api/httputil"
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/api_error"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -86,7 +88,16 @@ func (a *API) serveGetAggregation(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	start := a.nowFn()
 	resp, err := params.client.GetAggregation(ctx, httpReq.toProto())
+	a.recordQuery(ctx, types.QueryHistoryEntry{
+		Kind:  types.QueryHistoryKindAggregation,
+		Query: httpReq.Query,
+		From:  httpReq.From,
+		To:    httpReq.To,
+		Env:   env,
+		Total: queryhistory.AggregationsTotal(resp.GetAggregations()),
+	}, start, resp.GetError(), err)
 	if err != nil {
 		wr.Error(err, http.StatusInternalServerError)
 		return
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -11,12 +12,14 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/querycost"
 	"github.com/ozontech/seq-ui/internal/app/tokenlimiter"
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/internal/pkg/cache"
 	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
 	"github.com/ozontech/seq-ui/internal/pkg/mask"
 	asyncsearches "github.com/ozontech/seq-ui/internal/pkg/service/async_searches"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 )
@@ -39,6 +42,7 @@ type API struct {
 	redisCache          cache.Cache
 	nowFn               func() time.Time
 	asyncSearches       asyncsearches.Service
+	queryHistory        queryhistory.Recorder
 	envsResponse        getEnvsResponse
 }
 
@@ -48,6 +52,7 @@ func New(
 	inmemWithRedisCache cache.Cache,
 	redisCache cache.Cache,
 	asyncSearches asyncsearches.Service,
+	queryHistory queryhistory.Recorder,
 ) *API {
 	var globalfCache *fieldsCache
 	if cfg.FieldsCacheTTL > 0 {
@@ -132,6 +137,7 @@ func New(
 		redisCache:          redisCache,
 		nowFn:               time.Now,
 		asyncSearches:       asyncSearches,
+		queryHistory:        queryHistory,
 		envsResponse:        parseEnvs(cfg),
 	}
 }
@@ -140,6 +146,7 @@ func (a *API) Router() chi.Router {
 	mux := chi.NewMux()
 
 	mux.Use(a.envInterceptor)
+	mux.Use(querycost.HTTPHeadersInterceptor)
 
 	mux.Post("/aggregation", a.serveGetAggregation)
 	mux.Post("/aggregation_ts", a.serveGetAggregationTs)
@@ -251,6 +258,16 @@ func (a *API) GetEnvParams(env string) (apiParams, error) {
 	return params, nil
 }
 
+// recordQuery records the seq-db request to the user's query history.
+func (a *API) recordQuery(ctx context.Context, e types.QueryHistoryEntry, start time.Time, respErr *seqapi.Error, err error) {
+	if a.queryHistory == nil {
+		return
+	}
+	e.Duration = a.nowFn().Sub(start)
+	e.ErrorCode = queryhistory.ErrorCode(respErr, err)
+	a.queryHistory.Record(ctx, e)
+}
+
 func apiErrorCodeFromProto(proto seqapi.ErrorCode) apiErrorCode {
 	switch proto {
 	case seqapi.ErrorCode_ERROR_CODE_UNSPECIFIED:
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/querypolicy"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
@@ -57,6 +58,10 @@ func (a *API) serveGetEvent(w http.ResponseWriter, r *http.Request) {
 	if cached, err := a.inmemWithRedisCache.Get(ctx, id); err == nil {
 		e := &seqapi.Event{}
 		if err = proto.Unmarshal([]byte(cached), e); err == nil {
+			if err = querypolicy.CheckEvent(ctx, params.client, e); err != nil {
+				wr.Error(err, http.StatusForbidden)
+				return
+			}
 			if params.masker != nil {
 				params.masker.Mask(e.Data)
 			}
@@ -1,9 +1,11 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/envaccess"
 )
 
 // serveGetEnvs go doc.
@@ -15,7 +17,18 @@ import (
 //	@Success	200		{object}	getEnvsResponse	"A successful response"
 //	@Failure	default	{object}	httputil.Error	"An unexpected error response"
 func (a *API) serveGetEnvs(w http.ResponseWriter, r *http.Request) {
-	httputil.NewWriter(w).WriteJson(a.envsResponse)
+	httputil.NewWriter(w).WriteJson(a.allowedEnvs(r.Context()))
+}
+
+// allowedEnvs returns only the envs the user from context may query.
+func (a *API) allowedEnvs(ctx context.Context) getEnvsResponse {
+	envs := make([]envInfo, 0, len(a.envsResponse.Envs))
+	for _, e := range a.envsResponse.Envs {
+		if e.Env == "" || envaccess.Allowed(ctx, a.config.Envs[e.Env].Access) {
+			envs = append(envs, e)
+		}
+	}
+	return getEnvsResponse{Envs: envs}
 }
 
 type getEnvsResponse struct {
@@ -1,12 +1,16 @@
 package http
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/ozontech/seq-ui/internal/api/httputil"
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/test"
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
 )
 
 func TestServeGetEnvs(t *testing.T) {
@@ -163,3 +167,54 @@ func TestServeGetEnvs(t *testing.T) {
 		})
 	}
 }
+
+func TestServeGetEnvsAccess(t *testing.T) {
+	options := &config.SeqAPIOptions{MaxSearchLimit: 100}
+	cfg := config.SeqAPI{
+		Envs: map[string]config.SeqAPIEnv{
+			"prod": {
+				SeqDB:   "prod",
+				Options: options,
+				Access:  &config.SeqAPIEnvAccess{Users: []string{"alice"}},
+			},
+			"dev": {
+				SeqDB:   "dev",
+				Options: options,
+			},
+		},
+		DefaultEnv: "dev",
+	}
+	api := setupTestAPI(test.APITestData{Cfg: cfg})
+
+	tests := []struct {
+		name string
+		user string
+		want []string
+	}{
+		{
+			name: "allowed",
+			user: "alice",
+			want: []string{"dev", "prod"},
+		},
+		{
+			name: "filtered",
+			user: "bob",
+			want: []string{"dev"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.WithValue(context.Background(), types.UserKey{}, tt.user)
+			envs := api.allowedEnvs(ctx).Envs
+
+			got := make([]string, 0, len(envs))
+			for _, e := range envs {
+				got = append(got, e.Env)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
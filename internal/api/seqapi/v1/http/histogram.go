@@ -11,6 +11,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -74,7 +76,16 @@ func (a *API) serveGetHistogram(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	start := a.nowFn()
 	resp, err := params.client.GetHistogram(ctx, httpReq.toProto())
+	a.recordQuery(ctx, types.QueryHistoryEntry{
+		Kind:  types.QueryHistoryKindHistogram,
+		Query: httpReq.Query,
+		From:  httpReq.From,
+		To:    httpReq.To,
+		Env:   env,
+		Total: queryhistory.HistogramTotal(resp.GetHistogram()),
+	}, start, resp.GetError(), err)
 	if err != nil {
 		wr.Error(err, http.StatusInternalServerError)
 		return
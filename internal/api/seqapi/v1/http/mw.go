@@ -3,6 +3,9 @@ package http
 import (
 	"context"
 	"net/http"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/envaccess"
 )
 
 type envContextKey struct{}
@@ -17,6 +20,11 @@ func getEnvFromContext(ctx context.Context) string {
 func (a *API) envInterceptor(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		env := r.URL.Query().Get("env")
+		if err := envaccess.Check(r.Context(), a.config, env); err != nil {
+			httputil.ProcessError(httputil.NewWriter(w), err)
+			return
+		}
+
 		ctx := context.WithValue(r.Context(), envContextKey{}, env)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
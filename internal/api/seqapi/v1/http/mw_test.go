@@ -0,0 +1,80 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/test"
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestEnvInterceptor(t *testing.T) {
+	cfg := config.SeqAPI{
+		Envs: map[string]config.SeqAPIEnv{
+			"prod": {
+				SeqDB:   "prod",
+				Options: &config.SeqAPIOptions{},
+				Access:  &config.SeqAPIEnvAccess{Groups: []string{"sre"}},
+			},
+			"dev": {
+				SeqDB:   "dev",
+				Options: &config.SeqAPIOptions{},
+			},
+		},
+		DefaultEnv: "prod",
+	}
+
+	tests := []struct {
+		name   string
+		target string
+		groups []string
+		want   int
+	}{
+		{
+			name:   "ok_no_policy",
+			target: "/seqapi/v1/limits?env=dev",
+			want:   http.StatusOK,
+		},
+		{
+			name:   "ok_allowed",
+			target: "/seqapi/v1/limits?env=prod",
+			groups: []string{"sre"},
+			want:   http.StatusOK,
+		},
+		{
+			name:   "err_denied",
+			target: "/seqapi/v1/limits?env=prod",
+			groups: []string{"devs"},
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "err_denied_default_env",
+			target: "/seqapi/v1/limits",
+			want:   http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := setupTestAPI(test.APITestData{Cfg: cfg})
+			h := api.envInterceptor(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			ctx := context.WithValue(context.Background(), types.UserKey{}, "unnamed")
+			ctx = context.WithValue(ctx, types.UserGroupsKey{}, tt.groups)
+			req := httptest.NewRequest(http.MethodGet, tt.target, http.NoBody).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+			require.Equal(t, tt.want, w.Code)
+		})
+	}
+}
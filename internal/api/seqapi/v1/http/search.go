@@ -12,6 +12,7 @@ import (
 
 	"github.com/ozontech/seq-ui/internal/api/httputil"
 	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/api_error"
+	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -126,7 +127,16 @@ func (a *API) serveSearch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	start := a.nowFn()
 	resp, err := params.client.Search(ctx, httpReq.toProto())
+	a.recordQuery(ctx, types.QueryHistoryEntry{
+		Kind:  types.QueryHistoryKindSearch,
+		Query: httpReq.Query,
+		From:  httpReq.From,
+		To:    httpReq.To,
+		Env:   env,
+		Total: resp.GetTotal(),
+	}, start, resp.GetError(), err)
 	if err != nil {
 		wr.Error(err, http.StatusInternalServerError)
 		return
@@ -12,6 +12,7 @@ import (
 	"github.com/ozontech/seq-ui/internal/app/config"
 	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
 	asyncsearches "github.com/ozontech/seq-ui/internal/pkg/service/async_searches"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 )
 
 // Shared test data.
@@ -39,7 +40,12 @@ func setupTestAPI(data test.APITestData) *API {
 		asyncSvc = data.Mocks.AsyncSearchesSvc
 	}
 
-	return New(data.Cfg, seqDBClients, data.Mocks.Cache, data.Mocks.Cache, asyncSvc)
+	var queryHistory queryhistory.Recorder
+	if data.Mocks.QueryHistory != nil {
+		queryHistory = data.Mocks.QueryHistory
+	}
+
+	return New(data.Cfg, seqDBClients, data.Mocks.Cache, data.Mocks.Cache, asyncSvc, queryHistory)
 }
 
 func withQueryParamID(h http.HandlerFunc, id string) http.HandlerFunc {
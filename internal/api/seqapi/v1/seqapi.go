@@ -9,6 +9,7 @@ import (
 	"github.com/ozontech/seq-ui/internal/pkg/cache"
 	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
 	asyncsearches "github.com/ozontech/seq-ui/internal/pkg/service/async_searches"
+	"github.com/ozontech/seq-ui/internal/pkg/service/queryhistory"
 )
 
 type SeqAPI struct {
@@ -22,10 +23,11 @@ func New(
 	inmemWithRedisCache cache.Cache,
 	redisCache cache.Cache,
 	asyncSearches asyncsearches.Service,
+	queryHistory queryhistory.Recorder,
 ) *SeqAPI {
 	return &SeqAPI{
-		grpcAPI: grpc_api.New(cfg, seqDB, inmemWithRedisCache, redisCache, asyncSearches),
-		httpAPI: http_api.New(cfg, seqDB, inmemWithRedisCache, redisCache, asyncSearches),
+		grpcAPI: grpc_api.New(cfg, seqDB, inmemWithRedisCache, redisCache, asyncSearches, queryHistory),
+		httpAPI: http_api.New(cfg, seqDB, inmemWithRedisCache, redisCache, asyncSearches, queryHistory),
 	}
 }
 
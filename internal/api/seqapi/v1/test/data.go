@@ -10,6 +10,7 @@ import (
 	mock_cache "github.com/ozontech/seq-ui/internal/pkg/cache/mock"
 	mock_seqdb "github.com/ozontech/seq-ui/internal/pkg/client/seqdb/mock"
 	mock_asyncsearches "github.com/ozontech/seq-ui/internal/pkg/service/async_searches/mock"
+	mock_queryhistory "github.com/ozontech/seq-ui/internal/pkg/service/queryhistory/mock"
 	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
 )
 
@@ -23,6 +24,7 @@ type Mocks struct {
 	SeqDB            *mock_seqdb.MockClient
 	Cache            *mock_cache.MockCache
 	AsyncSearchesSvc *mock_asyncsearches.MockService
+	QueryHistory     *mock_queryhistory.MockRecorder
 }
 
 type APITestData struct {
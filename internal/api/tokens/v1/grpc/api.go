@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"github.com/ozontech/seq-ui/internal/pkg/service/tokens"
+	api "github.com/ozontech/seq-ui/pkg/tokens/v1"
+)
+
+type API struct {
+	api.UnimplementedTokensServiceServer
+
+	service tokens.Service
+}
+
+func New(svc tokens.Service) *API {
+	return &API{
+		service: svc,
+	}
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	mock "github.com/ozontech/seq-ui/internal/pkg/service/tokens/mock"
+)
+
+// Shared test data.
+var (
+	errSomethingWrong = errors.New("something happened wrong")
+)
+
+func setupTestAPI(t *testing.T) (*API, *mock.MockService) {
+	ctrl := gomock.NewController(t)
+	mockedSvc := mock.NewMockService(ctrl)
+	return New(mockedSvc), mockedSvc
+}
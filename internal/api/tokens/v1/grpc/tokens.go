@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/tokens/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+func (a *API) CreateToken(ctx context.Context, req *tokens.CreateTokenRequest) (*tokens.CreateTokenResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "tokens_v1_create_token")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(req.GetName()),
+		},
+		attribute.KeyValue{
+			Key:   "scopes",
+			Value: attribute.StringSliceValue(req.GetScopes()),
+		},
+		attribute.KeyValue{
+			Key:   "ttl",
+			Value: attribute.StringValue(req.GetTtl().AsDuration().String()),
+		},
+		attribute.KeyValue{
+			Key:   "user_name",
+			Value: attribute.StringValue(req.GetUserName()),
+		},
+	)
+
+	token, accessToken, err := a.service.CreateToken(ctx, types.CreateAPITokenRequest{
+		UserName: req.GetUserName(),
+		Name:     req.GetName(),
+		Scopes:   req.GetScopes(),
+		TTL:      req.GetTtl().AsDuration(),
+	})
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &tokens.CreateTokenResponse{
+		Token:       token.ToProto(),
+		AccessToken: accessToken,
+	}, nil
+}
+
+func (a *API) GetTokens(ctx context.Context, req *tokens.GetTokensRequest) (*tokens.GetTokensResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "tokens_v1_get_tokens")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "user_name",
+		Value: attribute.StringValue(req.GetUserName()),
+	})
+
+	res, err := a.service.GetTokens(ctx, types.GetAPITokensRequest{
+		UserName: req.GetUserName(),
+	})
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &tokens.GetTokensResponse{
+		Tokens: res.ToProto(),
+	}, nil
+}
+
+func (a *API) RevokeToken(ctx context.Context, req *tokens.RevokeTokenRequest) (*tokens.RevokeTokenResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "tokens_v1_revoke_token")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.StringValue(req.GetId()),
+	})
+
+	err := a.service.RevokeToken(ctx, types.RevokeAPITokenRequest{
+		ID: req.GetId(),
+	})
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &tokens.RevokeTokenResponse{}, nil
+}
@@ -0,0 +1,211 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/tokens/v1"
+)
+
+func TestCreateToken(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	token := types.APIToken{
+		ID:        "token-id",
+		UserName:  "user",
+		Name:      "ci",
+		Scopes:    []string{"seqapi"},
+		CreatedBy: "user",
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	tests := []struct {
+		name string
+
+		req      *tokens.CreateTokenRequest
+		want     *tokens.CreateTokenResponse
+		wantCode codes.Code
+
+		svcReq types.CreateAPITokenRequest
+		svcErr error
+	}{
+		{
+			name: "ok",
+			req: &tokens.CreateTokenRequest{
+				Name:   "ci",
+				Scopes: []string{"seqapi"},
+				Ttl:    durationpb.New(time.Hour),
+			},
+			want: &tokens.CreateTokenResponse{
+				Token:       token.ToProto(),
+				AccessToken: "signed",
+			},
+			wantCode: codes.OK,
+			svcReq: types.CreateAPITokenRequest{
+				Name:   "ci",
+				Scopes: []string{"seqapi"},
+				TTL:    time.Hour,
+			},
+		},
+		{
+			name: "err_invalid",
+			req: &tokens.CreateTokenRequest{
+				Scopes: []string{"seqapi"},
+			},
+			wantCode: codes.InvalidArgument,
+			svcReq: types.CreateAPITokenRequest{
+				Scopes: []string{"seqapi"},
+			},
+			svcErr: types.NewErrInvalidRequestField("empty token name"),
+		},
+		{
+			name: "err_svc",
+			req: &tokens.CreateTokenRequest{
+				Name:   "ci",
+				Scopes: []string{"seqapi"},
+			},
+			wantCode: codes.Internal,
+			svcReq: types.CreateAPITokenRequest{
+				Name:   "ci",
+				Scopes: []string{"seqapi"},
+			},
+			svcErr: errSomethingWrong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			var accessToken string
+			if tt.svcErr == nil {
+				accessToken = tt.want.AccessToken
+			}
+			mockedSvc.EXPECT().
+				CreateToken(gomock.Any(), tt.svcReq).
+				Return(token, accessToken, tt.svcErr).
+				Times(1)
+
+			got, err := api.CreateToken(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.True(t, proto.Equal(tt.want, got))
+		})
+	}
+}
+
+func TestGetTokens(t *testing.T) {
+	userName := "user"
+	revokedAt := time.Now()
+	res := types.APITokens{
+		{ID: "1", UserName: userName, Scopes: []string{"seqapi"}},
+		{ID: "2", UserName: userName, Scopes: []string{"dashboards"}, RevokedAt: &revokedAt},
+	}
+
+	tests := []struct {
+		name string
+
+		req      *tokens.GetTokensRequest
+		want     *tokens.GetTokensResponse
+		wantCode codes.Code
+
+		svcReq types.GetAPITokensRequest
+		svcErr error
+	}{
+		{
+			name:     "ok",
+			req:      &tokens.GetTokensRequest{},
+			want:     &tokens.GetTokensResponse{Tokens: res.ToProto()},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "ok_user",
+			req:      &tokens.GetTokensRequest{UserName: &userName},
+			want:     &tokens.GetTokensResponse{Tokens: res.ToProto()},
+			wantCode: codes.OK,
+			svcReq:   types.GetAPITokensRequest{UserName: userName},
+		},
+		{
+			name:     "err_permission_denied",
+			req:      &tokens.GetTokensRequest{UserName: &userName},
+			wantCode: codes.PermissionDenied,
+			svcReq:   types.GetAPITokensRequest{UserName: userName},
+			svcErr:   types.NewErrPermissionDenied("get api tokens of another user"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				GetTokens(gomock.Any(), tt.svcReq).
+				Return(res, tt.svcErr).
+				Times(1)
+
+			got, err := api.GetTokens(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.True(t, proto.Equal(tt.want, got))
+		})
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	tests := []struct {
+		name string
+
+		svcErr   error
+		wantCode codes.Code
+	}{
+		{
+			name:     "ok",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "err_not_found",
+			svcErr:   types.NewErrNotFound("api token"),
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "err_svc",
+			svcErr:   errSomethingWrong,
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				RevokeToken(gomock.Any(), types.RevokeAPITokenRequest{ID: "token-id"}).
+				Return(tt.svcErr).
+				Times(1)
+
+			_, err := api.RevokeToken(context.Background(), &tokens.RevokeTokenRequest{Id: "token-id"})
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
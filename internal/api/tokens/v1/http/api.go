@@ -0,0 +1,54 @@
+package http
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/tokens"
+)
+
+type API struct {
+	service tokens.Service
+}
+
+func New(svc tokens.Service) *API {
+	return &API{
+		service: svc,
+	}
+}
+
+func (a *API) Router() chi.Router {
+	mux := chi.NewMux()
+
+	mux.Post("/", a.serveCreateToken)
+	mux.Get("/", a.serveGetTokens)
+	mux.Delete("/{id}", a.serveRevokeToken)
+
+	return mux
+}
+
+type apiToken struct {
+	ID        string     `json:"id"`
+	UserName  string     `json:"userName"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	CreatedBy string     `json:"createdBy"`
+	CreatedAt time.Time  `json:"createdAt" format:"date-time"`
+	ExpiresAt time.Time  `json:"expiresAt" format:"date-time"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty" format:"date-time"`
+} //	@name	tokens.v1.Token
+
+func newAPIToken(t types.APIToken) apiToken {
+	return apiToken{
+		ID:        t.ID,
+		UserName:  t.UserName,
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		CreatedBy: t.CreatedBy,
+		CreatedAt: t.CreatedAt,
+		ExpiresAt: t.ExpiresAt,
+		RevokedAt: t.RevokedAt,
+	}
+}
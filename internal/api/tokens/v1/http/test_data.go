@@ -0,0 +1,33 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+
+	mock "github.com/ozontech/seq-ui/internal/pkg/service/tokens/mock"
+)
+
+// Shared test data.
+var (
+	errSomethingWrong = errors.New("something happened wrong")
+)
+
+func setupTestAPI(t *testing.T) (*API, *mock.MockService) {
+	ctrl := gomock.NewController(t)
+	mockedSvc := mock.NewMockService(ctrl)
+	return New(mockedSvc), mockedSvc
+}
+
+func withID(h http.HandlerFunc, id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rCtx := chi.NewRouteContext()
+		rCtx.URLParams.Add("id", id)
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+		h(w, r)
+	}
+}
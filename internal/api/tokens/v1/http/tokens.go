@@ -0,0 +1,172 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveCreateToken go doc.
+//
+//	@Router		/tokens/v1/ [post]
+//	@ID			tokens_v1_createToken
+//	@Tags		tokens_v1
+//	@Param		body	body		createTokenRequest	true	"Request body"
+//	@Success	200		{object}	createTokenResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveCreateToken(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "tokens_v1_create_token")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq createTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(httpReq.Name),
+		},
+		attribute.KeyValue{
+			Key:   "scopes",
+			Value: attribute.StringSliceValue(httpReq.Scopes),
+		},
+		attribute.KeyValue{
+			Key:   "ttl",
+			Value: attribute.StringValue(httpReq.TTL),
+		},
+		attribute.KeyValue{
+			Key:   "user_name",
+			Value: attribute.StringValue(httpReq.UserName),
+		},
+	)
+
+	var ttl time.Duration
+	if httpReq.TTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(httpReq.TTL); err != nil {
+			wr.Error(fmt.Errorf("failed to parse ttl: %w", err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	token, accessToken, err := a.service.CreateToken(ctx, types.CreateAPITokenRequest{
+		UserName: httpReq.UserName,
+		Name:     httpReq.Name,
+		Scopes:   httpReq.Scopes,
+		TTL:      ttl,
+	})
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(createTokenResponse{
+		Token:       newAPIToken(token),
+		AccessToken: accessToken,
+	})
+}
+
+// serveGetTokens go doc.
+//
+//	@Router		/tokens/v1/ [get]
+//	@ID			tokens_v1_getTokens
+//	@Tags		tokens_v1
+//	@Param		user	query		string				false	"Owner of the tokens, the current user by default"
+//	@Success	200		{object}	getTokensResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error		"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetTokens(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "tokens_v1_get_tokens")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	userName := r.URL.Query().Get("user")
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "user_name",
+		Value: attribute.StringValue(userName),
+	})
+
+	res, err := a.service.GetTokens(ctx, types.GetAPITokensRequest{
+		UserName: userName,
+	})
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	resp := getTokensResponse{
+		Tokens: make([]apiToken, 0, len(res)),
+	}
+	for _, t := range res {
+		resp.Tokens = append(resp.Tokens, newAPIToken(t))
+	}
+
+	wr.WriteJson(resp)
+}
+
+// serveRevokeToken go doc.
+//
+//	@Router		/tokens/v1/{id} [delete]
+//	@ID			tokens_v1_revokeToken
+//	@Tags		tokens_v1
+//	@Param		id		path		string			true	"Token ID"
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveRevokeToken(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "tokens_v1_revoke_token")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id := chi.URLParam(r, "id")
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.StringValue(id),
+	})
+
+	err := a.service.RevokeToken(ctx, types.RevokeAPITokenRequest{
+		ID: id,
+	})
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type createTokenRequest struct {
+	Name string `json:"name"`
+	// Scopes are the APIs the token grants access to.
+	Scopes []string `json:"scopes" enums:"seqapi,userprofile,dashboards,massexport,errorgroups"`
+	// TTL is the token lifetime, the default one from config is used if empty.
+	TTL string `json:"ttl" format:"duration" example:"720h"`
+	// UserName is the owner of the token, only admins can create tokens for other users.
+	UserName string `json:"userName,omitempty"`
+} //	@name	tokens.v1.CreateTokenRequest
+
+type createTokenResponse struct {
+	Token apiToken `json:"token"`
+	// AccessToken is the signed token, it is returned only once.
+	AccessToken string `json:"accessToken"`
+} //	@name	tokens.v1.CreateTokenResponse
+
+type getTokensResponse struct {
+	Tokens []apiToken `json:"tokens"`
+} //	@name	tokens.v1.GetTokensResponse
@@ -0,0 +1,211 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeCreateToken(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	token := types.APIToken{
+		ID:        "token-id",
+		UserName:  "api@ci",
+		Name:      "ci",
+		Scopes:    []string{"seqapi"},
+		CreatedBy: "admin",
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	type mockArgs struct {
+		req types.CreateAPITokenRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     createTokenRequest
+		want    createTokenResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: createTokenRequest{
+				Name:     "ci",
+				Scopes:   []string{"seqapi"},
+				TTL:      "1h",
+				UserName: "api@ci",
+			},
+			want: createTokenResponse{
+				Token:       newAPIToken(token),
+				AccessToken: "signed",
+			},
+			mockArgs: &mockArgs{
+				req: types.CreateAPITokenRequest{
+					UserName: "api@ci",
+					Name:     "ci",
+					Scopes:   []string{"seqapi"},
+					TTL:      time.Hour,
+				},
+			},
+		},
+		{
+			name: "err_ttl",
+			req: createTokenRequest{
+				Name:   "ci",
+				Scopes: []string{"seqapi"},
+				TTL:    "week",
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_svc",
+			req: createTokenRequest{
+				Name:   "ci",
+				Scopes: []string{"seqapi"},
+			},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.CreateAPITokenRequest{
+					Name:   "ci",
+					Scopes: []string{"seqapi"},
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					CreateToken(gomock.Any(), tt.mockArgs.req).
+					Return(token, tt.want.AccessToken, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[createTokenRequest, createTokenResponse]{
+				Method:  http.MethodPost,
+				Target:  "/tokens/v1/",
+				Req:     tt.req,
+				Handler: api.serveCreateToken,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeGetTokens(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	res := types.APITokens{
+		{ID: "1", UserName: "user", Scopes: []string{"seqapi"}, CreatedAt: now, ExpiresAt: now},
+		{ID: "2", UserName: "user", Scopes: []string{"dashboards"}, CreatedAt: now, ExpiresAt: now, RevokedAt: &now},
+	}
+
+	tests := []struct {
+		name string
+
+		target  string
+		want    getTokensResponse
+		wantErr bool
+
+		svcReq types.GetAPITokensRequest
+		svcErr error
+	}{
+		{
+			name:   "ok",
+			target: "/tokens/v1/",
+			want: getTokensResponse{
+				Tokens: []apiToken{newAPIToken(res[0]), newAPIToken(res[1])},
+			},
+		},
+		{
+			name:   "ok_user",
+			target: "/tokens/v1/?user=user",
+			want: getTokensResponse{
+				Tokens: []apiToken{newAPIToken(res[0]), newAPIToken(res[1])},
+			},
+			svcReq: types.GetAPITokensRequest{UserName: "user"},
+		},
+		{
+			name:    "err_svc",
+			target:  "/tokens/v1/",
+			wantErr: true,
+			svcErr:  errSomethingWrong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				GetTokens(gomock.Any(), tt.svcReq).
+				Return(res, tt.svcErr).
+				Times(1)
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getTokensResponse]{
+				Method:  http.MethodGet,
+				Target:  tt.target,
+				Handler: api.serveGetTokens,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeRevokeToken(t *testing.T) {
+	tests := []struct {
+		name string
+
+		svcErr  error
+		wantErr bool
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:    "err_not_found",
+			svcErr:  types.NewErrNotFound("api token"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				RevokeToken(gomock.Any(), types.RevokeAPITokenRequest{ID: "token-id"}).
+				Return(tt.svcErr).
+				Times(1)
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodDelete,
+				Target:  "/tokens/v1/token-id",
+				Handler: withID(api.serveRevokeToken, "token-id"),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package tokens_v1
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	grpc_api "github.com/ozontech/seq-ui/internal/api/tokens/v1/grpc"
+	http_api "github.com/ozontech/seq-ui/internal/api/tokens/v1/http"
+	"github.com/ozontech/seq-ui/internal/pkg/service/tokens"
+)
+
+type Tokens struct {
+	grpcAPI *grpc_api.API
+	httpAPI *http_api.API
+}
+
+func New(svc tokens.Service) *Tokens {
+	return &Tokens{
+		grpcAPI: grpc_api.New(svc),
+		httpAPI: http_api.New(svc),
+	}
+}
+
+func (t *Tokens) GRPCServer() *grpc_api.API {
+	return t.grpcAPI
+}
+
+func (t *Tokens) HTTPRouter() chi.Router {
+	return t.httpAPI.Router()
+}
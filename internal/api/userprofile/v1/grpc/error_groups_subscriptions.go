@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// GetErrorGroupsSubscriptions returns user's error groups digest subscriptions.
+func (a *API) GetErrorGroupsSubscriptions(
+	ctx context.Context,
+	_ *userprofile.GetErrorGroupsSubscriptionsRequest,
+) (*userprofile.GetErrorGroupsSubscriptionsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_get_error_groups_subscriptions")
+	defer span.End()
+
+	request := types.GetErrorGroupsSubscriptionsRequest{}
+
+	subscriptions, err := a.service.GetErrorGroupsSubscriptions(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.GetErrorGroupsSubscriptionsResponse{
+		Subscriptions: subscriptions.ToProto(),
+	}, nil
+}
+
+// CreateErrorGroupsSubscription subscribes user to error groups digest of the service.
+func (a *API) CreateErrorGroupsSubscription(
+	ctx context.Context,
+	req *userprofile.CreateErrorGroupsSubscriptionRequest,
+) (*userprofile.CreateErrorGroupsSubscriptionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_create_error_groups_subscription")
+	defer span.End()
+
+	request := types.CreateErrorGroupsSubscriptionRequest{
+		Service:    req.GetService(),
+		Env:        req.GetEnv(),
+		Period:     types.DigestPeriodFromProto(req.GetPeriod()),
+		Format:     types.DigestFormatFromProto(req.GetFormat()),
+		Delivery:   types.DigestDeliveryFromProto(req.GetDelivery()),
+		WebhookURL: req.GetWebhookUrl(),
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "service",
+			Value: attribute.StringValue(request.Service),
+		},
+		attribute.KeyValue{
+			Key:   "env",
+			Value: attribute.StringValue(request.Env),
+		},
+		attribute.KeyValue{
+			Key:   "period",
+			Value: attribute.StringValue(string(request.Period)),
+		},
+		attribute.KeyValue{
+			Key:   "format",
+			Value: attribute.StringValue(string(request.Format)),
+		},
+		attribute.KeyValue{
+			Key:   "delivery",
+			Value: attribute.StringValue(string(request.Delivery)),
+		},
+	)
+
+	id, err := a.service.CreateErrorGroupsSubscription(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.CreateErrorGroupsSubscriptionResponse{
+		Id: id,
+	}, nil
+}
+
+// DeleteErrorGroupsSubscription deletes user's error groups digest subscription.
+func (a *API) DeleteErrorGroupsSubscription(
+	ctx context.Context,
+	req *userprofile.DeleteErrorGroupsSubscriptionRequest,
+) (*userprofile.DeleteErrorGroupsSubscriptionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_delete_error_groups_subscription")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(req.GetId()),
+	})
+
+	request := types.DeleteErrorGroupsSubscriptionRequest{ID: req.GetId()}
+
+	if err := a.service.DeleteErrorGroupsSubscription(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.DeleteErrorGroupsSubscriptionResponse{}, nil
+}
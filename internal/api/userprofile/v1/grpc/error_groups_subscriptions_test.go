@@ -0,0 +1,260 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+)
+
+func TestGetErrorGroupsSubscriptions(t *testing.T) {
+	var (
+		env        = "prod"
+		webhookURL = "https://example.com/hook"
+		lastSentAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	type mockArgs struct {
+		resp types.ErrorGroupsSubscriptions
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *userprofile.GetErrorGroupsSubscriptionsResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: &userprofile.GetErrorGroupsSubscriptionsResponse{
+				Subscriptions: []*userprofile.ErrorGroupsSubscription{
+					{
+						Id:         1,
+						Service:    "svc1",
+						Env:        &env,
+						Period:     userprofile.DigestPeriod_DIGEST_PERIOD_DAILY,
+						Format:     userprofile.DigestFormat_DIGEST_FORMAT_MARKDOWN,
+						Delivery:   userprofile.DigestDelivery_DIGEST_DELIVERY_WEBHOOK,
+						WebhookUrl: &webhookURL,
+						LastSentAt: timestamppb.New(lastSentAt),
+					},
+					{
+						Id:       2,
+						Service:  "svc2",
+						Period:   userprofile.DigestPeriod_DIGEST_PERIOD_WEEKLY,
+						Format:   userprofile.DigestFormat_DIGEST_FORMAT_HTML,
+						Delivery: userprofile.DigestDelivery_DIGEST_DELIVERY_FILE_STORE,
+					},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				resp: types.ErrorGroupsSubscriptions{
+					{
+						ID:         1,
+						Service:    "svc1",
+						Env:        env,
+						Period:     types.DigestPeriodDaily,
+						Format:     types.DigestFormatMarkdown,
+						Delivery:   types.DigestDeliveryWebhook,
+						WebhookURL: webhookURL,
+						LastSentAt: &lastSentAt,
+					},
+					{
+						ID:       2,
+						Service:  "svc2",
+						Period:   types.DigestPeriodWeekly,
+						Format:   types.DigestFormatHTML,
+						Delivery: types.DigestDeliveryFileStore,
+					},
+				},
+			},
+		},
+		{
+			name:     "err_svc",
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetErrorGroupsSubscriptions(gomock.Any(), types.GetErrorGroupsSubscriptionsRequest{}).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.GetErrorGroupsSubscriptions(context.Background(), &userprofile.GetErrorGroupsSubscriptionsRequest{})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCreateErrorGroupsSubscription(t *testing.T) {
+	var (
+		id         int64 = 1
+		env              = "prod"
+		webhookURL       = "https://example.com/hook"
+	)
+
+	type mockArgs struct {
+		req  types.CreateErrorGroupsSubscriptionRequest
+		resp int64
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.CreateErrorGroupsSubscriptionRequest
+		want     *userprofile.CreateErrorGroupsSubscriptionResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &userprofile.CreateErrorGroupsSubscriptionRequest{
+				Service:    "svc",
+				Env:        &env,
+				Period:     userprofile.DigestPeriod_DIGEST_PERIOD_WEEKLY,
+				Format:     userprofile.DigestFormat_DIGEST_FORMAT_HTML,
+				Delivery:   userprofile.DigestDelivery_DIGEST_DELIVERY_WEBHOOK,
+				WebhookUrl: &webhookURL,
+			},
+			want: &userprofile.CreateErrorGroupsSubscriptionResponse{
+				Id: id,
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.CreateErrorGroupsSubscriptionRequest{
+					Service:    "svc",
+					Env:        env,
+					Period:     types.DigestPeriodWeekly,
+					Format:     types.DigestFormatHTML,
+					Delivery:   types.DigestDeliveryWebhook,
+					WebhookURL: webhookURL,
+				},
+				resp: id,
+			},
+		},
+		{
+			name: "err_svc",
+			req: &userprofile.CreateErrorGroupsSubscriptionRequest{
+				Service:  "svc",
+				Delivery: userprofile.DigestDelivery_DIGEST_DELIVERY_FILE_STORE,
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.CreateErrorGroupsSubscriptionRequest{
+					Service:  "svc",
+					Period:   types.DigestPeriodDaily,
+					Format:   types.DigestFormatMarkdown,
+					Delivery: types.DigestDeliveryFileStore,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					CreateErrorGroupsSubscription(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.CreateErrorGroupsSubscription(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeleteErrorGroupsSubscription(t *testing.T) {
+	type mockArgs struct {
+		req types.DeleteErrorGroupsSubscriptionRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.DeleteErrorGroupsSubscriptionRequest
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			req:      &userprofile.DeleteErrorGroupsSubscriptionRequest{Id: 1},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.DeleteErrorGroupsSubscriptionRequest{ID: 1},
+			},
+		},
+		{
+			name:     "err_svc",
+			req:      &userprofile.DeleteErrorGroupsSubscriptionRequest{Id: 1},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.DeleteErrorGroupsSubscriptionRequest{ID: 1},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					DeleteErrorGroupsSubscription(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			_, err := api.DeleteErrorGroupsSubscription(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
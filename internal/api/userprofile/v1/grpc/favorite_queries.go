@@ -56,6 +56,13 @@ func (a *API) CreateFavoriteQuery(ctx context.Context, req *userprofile.CreateFa
 	if req.Name != nil {
 		request.Name = *req.Name
 	}
+	if req.Env != nil {
+		request.Env = *req.Env
+	}
+	if req.LogColumns != nil {
+		request.LogColumns = req.LogColumns.LogColumns
+	}
+	request.FolderID = req.FolderId
 
 	fqID, err := a.service.GetOrCreateFavoriteQuery(ctx, request)
 	if err != nil {
@@ -67,6 +74,49 @@ func (a *API) CreateFavoriteQuery(ctx context.Context, req *userprofile.CreateFa
 	}, nil
 }
 
+// UpdateFavoriteQuery updates user's favorite query.
+func (a *API) UpdateFavoriteQuery(ctx context.Context, req *userprofile.UpdateFavoriteQueryRequest) (*userprofile.UpdateFavoriteQueryResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_update_favorite_query")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(req.GetId()),
+		},
+		attribute.KeyValue{
+			Key:   "query",
+			Value: attribute.StringValue(req.GetQuery()),
+		},
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(req.GetName()),
+		},
+		attribute.KeyValue{
+			Key:   "env",
+			Value: attribute.StringValue(req.GetEnv()),
+		},
+	)
+
+	request := types.UpdateFavoriteQueryRequest{
+		ID:           req.Id,
+		Query:        req.Query,
+		Name:         req.Name,
+		RelativeFrom: req.RelativeFrom,
+		Env:          req.Env,
+		FolderID:     req.FolderId,
+	}
+	if req.LogColumns != nil {
+		request.LogColumns = &req.LogColumns.LogColumns
+	}
+
+	if err := a.service.UpdateFavoriteQuery(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.UpdateFavoriteQueryResponse{}, nil
+}
+
 // DeleteFavoriteQuery deletes user's favorite query.
 func (a *API) DeleteFavoriteQuery(ctx context.Context, req *userprofile.DeleteFavoriteQueryRequest) (*userprofile.DeleteFavoriteQueryResponse, error) {
 	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_delete_favorite_query")
@@ -85,3 +135,22 @@ func (a *API) DeleteFavoriteQuery(ctx context.Context, req *userprofile.DeleteFa
 
 	return &userprofile.DeleteFavoriteQueryResponse{}, nil
 }
+
+// ReorderFavoriteQueries sets manual order of user's favorite queries.
+func (a *API) ReorderFavoriteQueries(ctx context.Context, req *userprofile.ReorderFavoriteQueriesRequest) (*userprofile.ReorderFavoriteQueriesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_reorder_favorite_queries")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "ids",
+		Value: attribute.Int64SliceValue(req.GetIds()),
+	})
+
+	request := types.ReorderFavoriteQueriesRequest{IDs: req.Ids}
+
+	if err := a.service.ReorderFavoriteQueries(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.ReorderFavoriteQueriesResponse{}, nil
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// PublishFavoriteQuery publishes user's favorite query to the team library.
+func (a *API) PublishFavoriteQuery(ctx context.Context, req *userprofile.PublishFavoriteQueryRequest) (*userprofile.PublishFavoriteQueryResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_publish_favorite_query")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(req.GetId()),
+		},
+		attribute.KeyValue{
+			Key:   "team",
+			Value: attribute.StringValue(req.GetTeam()),
+		},
+	)
+
+	request := types.PublishFavoriteQueryRequest{
+		ID:   req.Id,
+		Team: req.Team,
+	}
+
+	if err := a.service.PublishFavoriteQuery(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.PublishFavoriteQueryResponse{}, nil
+}
+
+// GetFavoriteQueriesLibrary returns favorite queries published to user's teams.
+func (a *API) GetFavoriteQueriesLibrary(ctx context.Context, req *userprofile.GetFavoriteQueriesLibraryRequest) (*userprofile.GetFavoriteQueriesLibraryResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_get_favorite_queries_library")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "team",
+		Value: attribute.StringValue(req.GetTeam()),
+	})
+
+	request := types.GetFavoriteQueriesLibraryRequest{Team: req.Team}
+
+	queries, err := a.service.GetFavoriteQueriesLibrary(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.GetFavoriteQueriesLibraryResponse{
+		Queries: queries.ToProto(),
+	}, nil
+}
+
+// SubscribeFavoriteQuery subscribes user to the favorite query from the team library.
+func (a *API) SubscribeFavoriteQuery(ctx context.Context, req *userprofile.SubscribeFavoriteQueryRequest) (*userprofile.SubscribeFavoriteQueryResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_subscribe_favorite_query")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(req.GetId()),
+	})
+
+	request := types.SubscribeFavoriteQueryRequest{ID: req.Id}
+
+	if err := a.service.SubscribeFavoriteQuery(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.SubscribeFavoriteQueryResponse{}, nil
+}
+
+// UnsubscribeFavoriteQuery unsubscribes user from the favorite query.
+func (a *API) UnsubscribeFavoriteQuery(ctx context.Context, req *userprofile.UnsubscribeFavoriteQueryRequest) (*userprofile.UnsubscribeFavoriteQueryResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_unsubscribe_favorite_query")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(req.GetId()),
+	})
+
+	request := types.UnsubscribeFavoriteQueryRequest{ID: req.Id}
+
+	if err := a.service.UnsubscribeFavoriteQuery(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.UnsubscribeFavoriteQueryResponse{}, nil
+}
@@ -0,0 +1,272 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+)
+
+func TestPublishFavoriteQuery(t *testing.T) {
+	type mockArgs struct {
+		req types.PublishFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.PublishFavoriteQueryRequest
+		want     *userprofile.PublishFavoriteQueryResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			req:      &userprofile.PublishFavoriteQueryRequest{Id: 1, Team: "backend"},
+			want:     &userprofile.PublishFavoriteQueryResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.PublishFavoriteQueryRequest{ID: 1, Team: "backend"},
+			},
+		},
+		{
+			name:     "err_permission_denied",
+			req:      &userprofile.PublishFavoriteQueryRequest{Id: 1, Team: "frontend"},
+			wantCode: codes.PermissionDenied,
+			mockArgs: &mockArgs{
+				req: types.PublishFavoriteQueryRequest{ID: 1, Team: "frontend"},
+				err: types.NewErrPermissionDenied("publish favorite query to team frontend"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					PublishFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.PublishFavoriteQuery(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetFavoriteQueriesLibrary(t *testing.T) {
+	var (
+		team      = "backend"
+		ownerName = "owner"
+	)
+
+	type mockArgs struct {
+		req  types.GetFavoriteQueriesLibraryRequest
+		resp types.FavoriteQueries
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.GetFavoriteQueriesLibraryRequest
+		want     *userprofile.GetFavoriteQueriesLibraryResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  &userprofile.GetFavoriteQueriesLibraryRequest{Team: &team},
+			want: &userprofile.GetFavoriteQueriesLibraryResponse{
+				Queries: []*userprofile.GetFavoriteQueriesResponse_Query{
+					{
+						Id:         1,
+						Query:      "level:error",
+						Team:       &team,
+						OwnerName:  &ownerName,
+						Subscribed: true,
+					},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.GetFavoriteQueriesLibraryRequest{Team: &team},
+				resp: types.FavoriteQueries{
+					{
+						ID:         1,
+						Query:      "level:error",
+						Team:       team,
+						OwnerName:  ownerName,
+						Subscribed: true,
+					},
+				},
+			},
+		},
+		{
+			name:     "err_svc",
+			req:      &userprofile.GetFavoriteQueriesLibraryRequest{},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetFavoriteQueriesLibrary(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.GetFavoriteQueriesLibrary(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSubscribeFavoriteQuery(t *testing.T) {
+	type mockArgs struct {
+		req types.SubscribeFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *userprofile.SubscribeFavoriteQueryResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			want:     &userprofile.SubscribeFavoriteQueryResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.SubscribeFavoriteQueryRequest{ID: 1},
+			},
+		},
+		{
+			name:     "err_not_found",
+			wantCode: codes.NotFound,
+			mockArgs: &mockArgs{
+				req: types.SubscribeFavoriteQueryRequest{ID: 1},
+				err: types.NewErrNotFound("favorite query"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					SubscribeFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.SubscribeFavoriteQuery(context.Background(), &userprofile.SubscribeFavoriteQueryRequest{Id: 1})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnsubscribeFavoriteQuery(t *testing.T) {
+	type mockArgs struct {
+		req types.UnsubscribeFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *userprofile.UnsubscribeFavoriteQueryResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			want:     &userprofile.UnsubscribeFavoriteQueryResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UnsubscribeFavoriteQueryRequest{ID: 1},
+			},
+		},
+		{
+			name:     "err_svc",
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.UnsubscribeFavoriteQueryRequest{ID: 1},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UnsubscribeFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.UnsubscribeFavoriteQuery(context.Background(), &userprofile.UnsubscribeFavoriteQueryRequest{Id: 1})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -275,3 +275,147 @@ func TestDeleteFavoriteQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateFavoriteQuery(t *testing.T) {
+	var (
+		queryID  int64 = 1
+		folderID int64 = 2
+		query          = "test"
+		env            = "prod"
+	)
+
+	type mockArgs struct {
+		req types.UpdateFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.UpdateFavoriteQueryRequest
+		want     *userprofile.UpdateFavoriteQueryResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &userprofile.UpdateFavoriteQueryRequest{
+				Id:         queryID,
+				Query:      &query,
+				Env:        &env,
+				LogColumns: &userprofile.LogColumns{LogColumns: []string{"level", "message"}},
+				FolderId:   &folderID,
+			},
+			want:     &userprofile.UpdateFavoriteQueryResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryRequest{
+					ID:         queryID,
+					Query:      &query,
+					Env:        &env,
+					LogColumns: &[]string{"level", "message"},
+					FolderID:   &folderID,
+				},
+			},
+		},
+		{
+			name: "err_not_found",
+			req: &userprofile.UpdateFavoriteQueryRequest{
+				Id:    queryID,
+				Query: &query,
+			},
+			wantCode: codes.NotFound,
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryRequest{
+					ID:    queryID,
+					Query: &query,
+				},
+				err: types.NewErrNotFound("favorite query"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.UpdateFavoriteQuery(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReorderFavoriteQueries(t *testing.T) {
+	ids := []int64{3, 1, 2}
+
+	type mockArgs struct {
+		req types.ReorderFavoriteQueriesRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *userprofile.ReorderFavoriteQueriesResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			want:     &userprofile.ReorderFavoriteQueriesResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.ReorderFavoriteQueriesRequest{IDs: ids},
+			},
+		},
+		{
+			name:     "err_svc",
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.ReorderFavoriteQueriesRequest{IDs: ids},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ReorderFavoriteQueries(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.ReorderFavoriteQueries(context.Background(), &userprofile.ReorderFavoriteQueriesRequest{Ids: ids})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
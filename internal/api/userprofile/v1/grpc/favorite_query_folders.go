@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// GetFavoriteQueryFolders returns user's favorite query folders.
+func (a *API) GetFavoriteQueryFolders(ctx context.Context, _ *userprofile.GetFavoriteQueryFoldersRequest) (*userprofile.GetFavoriteQueryFoldersResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_get_favorite_query_folders")
+	defer span.End()
+
+	request := types.GetFavoriteQueryFoldersRequest{}
+
+	folders, err := a.service.GetFavoriteQueryFolders(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.GetFavoriteQueryFoldersResponse{
+		Folders: folders.ToProto(),
+	}, nil
+}
+
+// CreateFavoriteQueryFolder creates user's favorite query folder.
+func (a *API) CreateFavoriteQueryFolder(ctx context.Context, req *userprofile.CreateFavoriteQueryFolderRequest) (*userprofile.CreateFavoriteQueryFolderResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_create_favorite_query_folder")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "name",
+		Value: attribute.StringValue(req.GetName()),
+	})
+
+	request := types.CreateFavoriteQueryFolderRequest{Name: req.Name}
+
+	id, err := a.service.CreateFavoriteQueryFolder(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.CreateFavoriteQueryFolderResponse{
+		Id: id,
+	}, nil
+}
+
+// UpdateFavoriteQueryFolder renames user's favorite query folder.
+func (a *API) UpdateFavoriteQueryFolder(ctx context.Context, req *userprofile.UpdateFavoriteQueryFolderRequest) (*userprofile.UpdateFavoriteQueryFolderResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_update_favorite_query_folder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(req.GetId()),
+		},
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(req.GetName()),
+		},
+	)
+
+	request := types.UpdateFavoriteQueryFolderRequest{
+		ID:   req.Id,
+		Name: req.Name,
+	}
+
+	if err := a.service.UpdateFavoriteQueryFolder(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.UpdateFavoriteQueryFolderResponse{}, nil
+}
+
+// DeleteFavoriteQueryFolder deletes user's favorite query folder, its queries are moved to the root.
+func (a *API) DeleteFavoriteQueryFolder(ctx context.Context, req *userprofile.DeleteFavoriteQueryFolderRequest) (*userprofile.DeleteFavoriteQueryFolderResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_delete_favorite_query_folder")
+	defer span.End()
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(req.GetId()),
+	})
+
+	request := types.DeleteFavoriteQueryFolderRequest{ID: req.Id}
+
+	if err := a.service.DeleteFavoriteQueryFolder(ctx, request); err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.DeleteFavoriteQueryFolderResponse{}, nil
+}
@@ -0,0 +1,257 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+)
+
+func TestGetFavoriteQueryFolders(t *testing.T) {
+	type mockArgs struct {
+		resp types.FavoriteQueryFolders
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *userprofile.GetFavoriteQueryFoldersResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: &userprofile.GetFavoriteQueryFoldersResponse{
+				Folders: []*userprofile.FavoriteQueryFolder{
+					{Id: 1, Name: "alerts"},
+					{Id: 2, Name: "billing"},
+				},
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				resp: types.FavoriteQueryFolders{
+					{ID: 1, Name: "alerts"},
+					{ID: 2, Name: "billing"},
+				},
+			},
+		},
+		{
+			name:     "err_svc",
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetFavoriteQueryFolders(gomock.Any(), types.GetFavoriteQueryFoldersRequest{}).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.GetFavoriteQueryFolders(context.Background(), &userprofile.GetFavoriteQueryFoldersRequest{})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCreateFavoriteQueryFolder(t *testing.T) {
+	type mockArgs struct {
+		req  types.CreateFavoriteQueryFolderRequest
+		resp int64
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.CreateFavoriteQueryFolderRequest
+		want     *userprofile.CreateFavoriteQueryFolderResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			req:      &userprofile.CreateFavoriteQueryFolderRequest{Name: "alerts"},
+			want:     &userprofile.CreateFavoriteQueryFolderResponse{Id: 1},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req:  types.CreateFavoriteQueryFolderRequest{Name: "alerts"},
+				resp: 1,
+			},
+		},
+		{
+			name:     "err_invalid_name",
+			req:      &userprofile.CreateFavoriteQueryFolderRequest{},
+			wantCode: codes.InvalidArgument,
+			mockArgs: &mockArgs{
+				req: types.CreateFavoriteQueryFolderRequest{},
+				err: types.NewErrInvalidRequestField("empty name"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					CreateFavoriteQueryFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.CreateFavoriteQueryFolder(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUpdateFavoriteQueryFolder(t *testing.T) {
+	type mockArgs struct {
+		req types.UpdateFavoriteQueryFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.UpdateFavoriteQueryFolderRequest
+		want     *userprofile.UpdateFavoriteQueryFolderResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			req:      &userprofile.UpdateFavoriteQueryFolderRequest{Id: 1, Name: "alerts"},
+			want:     &userprofile.UpdateFavoriteQueryFolderResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryFolderRequest{ID: 1, Name: "alerts"},
+			},
+		},
+		{
+			name:     "err_not_found",
+			req:      &userprofile.UpdateFavoriteQueryFolderRequest{Id: 1, Name: "alerts"},
+			wantCode: codes.NotFound,
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryFolderRequest{ID: 1, Name: "alerts"},
+				err: types.NewErrNotFound("favorite query folder"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateFavoriteQueryFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.UpdateFavoriteQueryFolder(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeleteFavoriteQueryFolder(t *testing.T) {
+	type mockArgs struct {
+		req types.DeleteFavoriteQueryFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *userprofile.DeleteFavoriteQueryFolderResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			want:     &userprofile.DeleteFavoriteQueryFolderResponse{},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.DeleteFavoriteQueryFolderRequest{ID: 1},
+			},
+		},
+		{
+			name:     "err_svc",
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.DeleteFavoriteQueryFolderRequest{ID: 1},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					DeleteFavoriteQueryFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.DeleteFavoriteQueryFolder(context.Background(), &userprofile.DeleteFavoriteQueryFolderRequest{Id: 1})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
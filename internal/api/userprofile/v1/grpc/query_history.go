@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// GetQueryHistory returns user's query history.
+func (a *API) GetQueryHistory(ctx context.Context, req *userprofile.GetQueryHistoryRequest) (*userprofile.GetQueryHistoryResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_get_query_history")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "query",
+			Value: attribute.StringValue(req.GetQuery()),
+		},
+		attribute.KeyValue{
+			Key:   "limit",
+			Value: attribute.IntValue(int(req.GetLimit())),
+		},
+		attribute.KeyValue{
+			Key:   "offset",
+			Value: attribute.IntValue(int(req.GetOffset())),
+		},
+	)
+
+	request := types.GetQueryHistoryRequest{
+		Query:  req.Query,
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+
+	entries, err := a.service.GetQueryHistory(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.GetQueryHistoryResponse{
+		Entries: entries.ToProto(),
+	}, nil
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+)
+
+func TestGetQueryHistory(t *testing.T) {
+	var (
+		query = "error"
+		env   = "prod"
+		code  = "ERROR_CODE_PARTIAL_RESPONSE"
+		from  = time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC)
+		to    = from.Add(time.Hour)
+	)
+
+	type mockArgs struct {
+		req  types.GetQueryHistoryRequest
+		resp types.QueryHistoryEntries
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.GetQueryHistoryRequest
+		want     *userprofile.GetQueryHistoryResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:     "ok",
+			req:      &userprofile.GetQueryHistoryRequest{Query: &query, Limit: 10, Offset: 5},
+			wantCode: codes.OK,
+			want: &userprofile.GetQueryHistoryResponse{
+				Entries: []*userprofile.GetQueryHistoryResponse_Entry{
+					{
+						Id:         2,
+						Kind:       userprofile.QueryHistoryKind_QUERY_HISTORY_KIND_HISTOGRAM,
+						Query:      "level:error",
+						From:       timestamppb.New(from),
+						To:         timestamppb.New(to),
+						Env:        &env,
+						DurationMs: 150,
+						Total:      42,
+						ErrorCode:  &code,
+						CreatedAt:  timestamppb.New(to),
+					},
+					{
+						Id:        1,
+						Kind:      userprofile.QueryHistoryKind_QUERY_HISTORY_KIND_SEARCH,
+						Query:     "message:error",
+						From:      timestamppb.New(from),
+						To:        timestamppb.New(to),
+						CreatedAt: timestamppb.New(from),
+					},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.GetQueryHistoryRequest{Query: &query, Limit: 10, Offset: 5},
+				resp: types.QueryHistoryEntries{
+					{
+						ID:        2,
+						Kind:      types.QueryHistoryKindHistogram,
+						Query:     "level:error",
+						From:      from,
+						To:        to,
+						Env:       env,
+						Duration:  150 * time.Millisecond,
+						Total:     42,
+						ErrorCode: code,
+						CreatedAt: to,
+					},
+					{
+						ID:        1,
+						Kind:      types.QueryHistoryKindSearch,
+						Query:     "message:error",
+						From:      from,
+						To:        to,
+						CreatedAt: from,
+					},
+				},
+			},
+		},
+		{
+			name:     "err_svc",
+			req:      &userprofile.GetQueryHistoryRequest{Limit: -1},
+			wantCode: codes.InvalidArgument,
+			mockArgs: &mockArgs{
+				req: types.GetQueryHistoryRequest{Limit: -1},
+				err: types.NewErrInvalidRequestField("'limit' must be in range [0, 1000]"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetQueryHistory(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			got, err := api.GetQueryHistory(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -13,10 +13,15 @@ import (
 )
 
 // GetUserProfile returns user's profile.
-func (a *API) GetUserProfile(ctx context.Context, _ *userprofile.GetUserProfileRequest) (*userprofile.GetUserProfileResponse, error) {
+func (a *API) GetUserProfile(ctx context.Context, req *userprofile.GetUserProfileRequest) (*userprofile.GetUserProfileResponse, error) {
 	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_get_user_profile")
 	defer span.End()
 
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "env",
+		Value: attribute.StringValue(req.GetEnv()),
+	})
+
 	userName, err := types.GetUserKey(ctx)
 	if err != nil {
 		return nil, grpcutil.ProcessError(err)
@@ -31,6 +36,10 @@ func (a *API) GetUserProfile(ctx context.Context, _ *userprofile.GetUserProfileR
 
 	profiles.SetID(userName, userProfile.ID)
 
+	if req.Env != nil {
+		userProfile.Preferences = userProfile.Preferences.ForEnv(*req.Env)
+	}
+
 	return userProfile.ToProto(), nil
 }
 
@@ -71,3 +80,44 @@ func (a *API) UpdateUserProfile(ctx context.Context, req *userprofile.UpdateUser
 
 	return &userprofile.UpdateUserProfileResponse{}, nil
 }
+
+// UpdateUserPreferences updates user's preferences listed in the update mask.
+func (a *API) UpdateUserPreferences(
+	ctx context.Context,
+	req *userprofile.UpdateUserPreferencesRequest,
+) (*userprofile.UpdateUserPreferencesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_update_user_preferences")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "update_mask",
+			Value: attribute.StringSliceValue(req.GetUpdateMask().GetPaths()),
+		},
+		attribute.KeyValue{
+			Key:   "env",
+			Value: attribute.StringValue(req.GetEnv()),
+		},
+	)
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	request := types.UpdateUserPreferencesRequest{
+		UserName:    userName,
+		Env:         req.GetEnv(),
+		Preferences: types.UserPreferencesFromProto(req.GetPreferences()),
+		Paths:       req.GetUpdateMask().GetPaths(),
+	}
+
+	prefs, err := a.service.UpdateUserPreferences(ctx, request)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return &userprofile.UpdateUserPreferencesResponse{
+		Preferences: prefs.ToProto(),
+	}, nil
+}
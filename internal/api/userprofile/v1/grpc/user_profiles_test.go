@@ -7,6 +7,8 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
@@ -19,6 +21,16 @@ func TestGetUserProfile(t *testing.T) {
 		timezone          = "UTC"
 		onboardingVersion = `{"name1": "ver1", "name2": "ver2"}`
 		logColumns        = []string{"val1", "val2"}
+		envLogColumns     = []string{"val3"}
+		env               = "prod"
+		prefs             = types.UserPreferences{
+			Version:    types.UserPreferencesVersion,
+			Timezone:   timezone,
+			LogColumns: logColumns,
+			Envs: map[string]types.EnvPreferences{
+				env: {LogColumns: envLogColumns},
+			},
+		}
 	)
 
 	type mockArgs struct {
@@ -30,6 +42,7 @@ func TestGetUserProfile(t *testing.T) {
 	tests := []struct {
 		name string
 
+		req      *userprofile.GetUserProfileRequest
 		want     *userprofile.GetUserProfileResponse
 		wantCode codes.Code
 
@@ -37,10 +50,34 @@ func TestGetUserProfile(t *testing.T) {
 	}{
 		{
 			name: "ok",
+			req:  &userprofile.GetUserProfileRequest{},
 			want: &userprofile.GetUserProfileResponse{
 				Timezone:          timezone,
 				OnboardingVersion: onboardingVersion,
 				LogColumns:        &userprofile.LogColumns{LogColumns: logColumns},
+				Preferences:       prefs.ToProto(),
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.GetOrCreateUserProfileRequest{
+					UserName: userName,
+				},
+				resp: types.UserProfile{
+					ID:                1,
+					UserName:          userName,
+					OnboardingVersion: onboardingVersion,
+					Preferences:       prefs,
+				},
+			},
+		},
+		{
+			name: "ok_env",
+			req:  &userprofile.GetUserProfileRequest{Env: &env},
+			want: &userprofile.GetUserProfileResponse{
+				Timezone:          timezone,
+				OnboardingVersion: onboardingVersion,
+				LogColumns:        &userprofile.LogColumns{LogColumns: envLogColumns},
+				Preferences:       prefs.ForEnv(env).ToProto(),
 			},
 			wantCode: codes.OK,
 			mockArgs: &mockArgs{
@@ -50,14 +87,14 @@ func TestGetUserProfile(t *testing.T) {
 				resp: types.UserProfile{
 					ID:                1,
 					UserName:          userName,
-					Timezone:          timezone,
 					OnboardingVersion: onboardingVersion,
-					LogColumns:        types.LogColumns{LogColumns: logColumns},
+					Preferences:       prefs,
 				},
 			},
 		},
 		{
 			name:     "err_svc",
+			req:      &userprofile.GetUserProfileRequest{},
 			wantCode: codes.Internal,
 			mockArgs: &mockArgs{
 				req: types.GetOrCreateUserProfileRequest{
@@ -87,14 +124,14 @@ func TestGetUserProfile(t *testing.T) {
 			}
 
 			ctx := withUser(userName)
-			got, err := api.GetUserProfile(ctx, &userprofile.GetUserProfileRequest{})
+			got, err := api.GetUserProfile(ctx, tt.req)
 
 			require.Equal(t, tt.wantCode, status.Code(err))
 			if tt.wantCode != codes.OK {
 				return
 			}
 
-			require.Equal(t, tt.want, got)
+			require.True(t, proto.Equal(tt.want, got))
 		})
 	}
 }
@@ -180,3 +217,141 @@ func TestUpdateUserProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateUserPreferences(t *testing.T) {
+	var (
+		userName = "unnamed"
+		env      = "prod"
+		prefs    = types.UserPreferences{
+			PageSize: 100,
+			Theme:    types.ThemeDark,
+		}
+		updated = types.UserPreferences{
+			Version:  types.UserPreferencesVersion,
+			Timezone: "UTC",
+			PageSize: 100,
+			Theme:    types.ThemeDark,
+		}
+	)
+
+	type mockArgs struct {
+		req  types.UpdateUserPreferencesRequest
+		resp types.UserPreferences
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req      *userprofile.UpdateUserPreferencesRequest
+		want     *userprofile.UpdateUserPreferencesResponse
+		wantCode codes.Code
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: &userprofile.UpdateUserPreferencesRequest{
+				Preferences: prefs.ToProto(),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"page_size", "theme"}},
+			},
+			want: &userprofile.UpdateUserPreferencesResponse{
+				Preferences: updated.ToProto(),
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UpdateUserPreferencesRequest{
+					UserName:    userName,
+					Preferences: prefs,
+					Paths:       []string{"page_size", "theme"},
+				},
+				resp: updated,
+			},
+		},
+		{
+			name: "ok_env",
+			req: &userprofile.UpdateUserPreferencesRequest{
+				Preferences: &userprofile.Preferences{
+					Envs: map[string]*userprofile.EnvPreferences{
+						env: {LogColumns: []string{"message"}},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"log_columns"}},
+				Env:        &env,
+			},
+			want: &userprofile.UpdateUserPreferencesResponse{
+				Preferences: updated.ToProto(),
+			},
+			wantCode: codes.OK,
+			mockArgs: &mockArgs{
+				req: types.UpdateUserPreferencesRequest{
+					UserName: userName,
+					Env:      env,
+					Preferences: types.UserPreferences{
+						Envs: map[string]types.EnvPreferences{
+							env: {LogColumns: []string{"message"}},
+						},
+					},
+					Paths: []string{"log_columns"},
+				},
+				resp: updated,
+			},
+		},
+		{
+			name: "err_invalid",
+			req: &userprofile.UpdateUserPreferencesRequest{
+				Preferences: &userprofile.Preferences{},
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"unknown"}},
+			},
+			wantCode: codes.InvalidArgument,
+			mockArgs: &mockArgs{
+				req: types.UpdateUserPreferencesRequest{
+					UserName: userName,
+					Paths:    []string{"unknown"},
+				},
+				err: types.NewErrInvalidRequestField("unknown preferences path"),
+			},
+		},
+		{
+			name: "err_svc",
+			req: &userprofile.UpdateUserPreferencesRequest{
+				Preferences: prefs.ToProto(),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"page_size"}},
+			},
+			wantCode: codes.Internal,
+			mockArgs: &mockArgs{
+				req: types.UpdateUserPreferencesRequest{
+					UserName:    userName,
+					Preferences: prefs,
+					Paths:       []string{"page_size"},
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateUserPreferences(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			ctx := withUser(userName)
+			got, err := api.UpdateUserPreferences(ctx, tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.True(t, proto.Equal(tt.want, got))
+		})
+	}
+}
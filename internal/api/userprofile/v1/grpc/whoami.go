@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ozontech/seq-ui/internal/api/grpcutil"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// WhoAmI returns the authenticated user with its effective permissions.
+func (a *API) WhoAmI(ctx context.Context, _ *userprofile.WhoAmIRequest) (*userprofile.WhoAmIResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "userprofile_v1_whoami")
+	defer span.End()
+
+	res, err := a.service.WhoAmI(ctx)
+	if err != nil {
+		return nil, grpcutil.ProcessError(err)
+	}
+
+	return res.ToProto(), nil
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
+)
+
+func TestWhoAmI(t *testing.T) {
+	type mockArgs struct {
+		resp types.WhoAmI
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want     *userprofile.WhoAmIResponse
+		wantCode codes.Code
+
+		mockArgs mockArgs
+	}{
+		{
+			name: "ok",
+			want: &userprofile.WhoAmIResponse{
+				UserName:    "unnamed",
+				Groups:      []string{"sre"},
+				RbacEnabled: true,
+				Roles:       []string{"exporter"},
+				Permissions: []string{"massexport:start"},
+			},
+			wantCode: codes.OK,
+			mockArgs: mockArgs{
+				resp: types.WhoAmI{
+					UserName:    "unnamed",
+					Groups:      []string{"sre"},
+					RBACEnabled: true,
+					Roles:       []string{"exporter"},
+					Permissions: []string{"massexport:start"},
+				},
+			},
+		},
+		{
+			name:     "err_unauthenticated",
+			wantCode: codes.Unauthenticated,
+			mockArgs: mockArgs{
+				err: types.ErrUnauthenticated,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				WhoAmI(gomock.Any()).
+				Return(tt.mockArgs.resp, tt.mockArgs.err).
+				Times(1)
+
+			got, err := api.WhoAmI(context.Background(), &userprofile.WhoAmIRequest{})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -22,13 +22,41 @@ func (a *API) Router() chi.Router {
 	mux.Route("/profile", func(r chi.Router) {
 		r.Get("/", a.serveGetUserProfile)
 		r.Patch("/", a.serveUpdateUserProfile)
+		r.Patch("/preferences", a.serveUpdateUserPreferences)
 	})
 
 	mux.Route("/queries/favorite", func(r chi.Router) {
 		r.Get("/", a.serveGetFavoriteQueries)
 		r.Post("/", a.serveCreateFavoriteQuery)
+		r.Put("/order", a.serveReorderFavoriteQueries)
 
+		r.Route("/folders", func(r chi.Router) {
+			r.Get("/", a.serveGetFavoriteQueryFolders)
+			r.Post("/", a.serveCreateFavoriteQueryFolder)
+			r.Patch("/{id}", a.serveUpdateFavoriteQueryFolder)
+			r.Delete("/{id}", a.serveDeleteFavoriteQueryFolder)
+		})
+
+		r.Patch("/{id}", a.serveUpdateFavoriteQuery)
 		r.Delete("/{id}", a.serveDeleteFavoriteQuery)
+		r.Post("/{id}/publish", a.servePublishFavoriteQuery)
+	})
+
+	mux.Route("/queries/library", func(r chi.Router) {
+		r.Get("/", a.serveGetFavoriteQueriesLibrary)
+		r.Post("/{id}/subscription", a.serveSubscribeFavoriteQuery)
+		r.Delete("/{id}/subscription", a.serveUnsubscribeFavoriteQuery)
+	})
+
+	mux.Get("/queries/history", a.serveGetQueryHistory)
+
+	mux.Get("/whoami", a.serveWhoAmI)
+
+	mux.Route("/errorgroups/subscriptions", func(r chi.Router) {
+		r.Get("/", a.serveGetErrorGroupsSubscriptions)
+		r.Post("/", a.serveCreateErrorGroupsSubscription)
+
+		r.Delete("/{id}", a.serveDeleteErrorGroupsSubscription)
 	})
 
 	return mux
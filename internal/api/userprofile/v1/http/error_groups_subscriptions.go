@@ -0,0 +1,198 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetErrorGroupsSubscriptions go doc.
+//
+//	@Router		/userprofile/v1/errorgroups/subscriptions [get]
+//	@ID			userprofile_v1_getErrorGroupsSubscriptions
+//	@Tags		userprofile_v1
+//	@Success	200		{object}	getErrorGroupsSubscriptionsResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error						"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetErrorGroupsSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_get_error_groups_subscriptions")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	req := types.GetErrorGroupsSubscriptionsRequest{}
+	subscriptions, err := a.service.GetErrorGroupsSubscriptions(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getErrorGroupsSubscriptionsResponse{
+		Subscriptions: newErrorGroupsSubscriptions(subscriptions),
+	})
+}
+
+// serveCreateErrorGroupsSubscription go doc.
+//
+//	@Router		/userprofile/v1/errorgroups/subscriptions [post]
+//	@ID			userprofile_v1_createErrorGroupsSubscription
+//	@Tags		userprofile_v1
+//	@Param		body	body		createErrorGroupsSubscriptionRequest	true	"Request body"
+//	@Success	200		{object}	createErrorGroupsSubscriptionResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error							"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveCreateErrorGroupsSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_create_error_groups_subscription")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq createErrorGroupsSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	req := types.CreateErrorGroupsSubscriptionRequest{
+		Service:    httpReq.Service,
+		Env:        httpReq.Env,
+		Period:     types.DigestPeriod(httpReq.Period),
+		Format:     types.DigestFormat(httpReq.Format),
+		Delivery:   types.DigestDelivery(httpReq.Delivery),
+		WebhookURL: httpReq.WebhookURL,
+	}
+	if req.Period == "" {
+		req.Period = types.DigestPeriodDaily
+	}
+	if req.Format == "" {
+		req.Format = types.DigestFormatMarkdown
+	}
+	if req.Delivery == "" {
+		req.Delivery = types.DigestDeliveryWebhook
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "service",
+			Value: attribute.StringValue(req.Service),
+		},
+		attribute.KeyValue{
+			Key:   "env",
+			Value: attribute.StringValue(req.Env),
+		},
+		attribute.KeyValue{
+			Key:   "period",
+			Value: attribute.StringValue(string(req.Period)),
+		},
+		attribute.KeyValue{
+			Key:   "format",
+			Value: attribute.StringValue(string(req.Format)),
+		},
+		attribute.KeyValue{
+			Key:   "delivery",
+			Value: attribute.StringValue(string(req.Delivery)),
+		},
+	)
+
+	id, err := a.service.CreateErrorGroupsSubscription(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(createErrorGroupsSubscriptionResponse{
+		ID: strconv.FormatInt(id, 10),
+	})
+}
+
+// serveDeleteErrorGroupsSubscription go doc.
+//
+//	@Router		/userprofile/v1/errorgroups/subscriptions/{id} [delete]
+//	@ID			userprofile_v1_deleteErrorGroupsSubscription
+//	@Tags		userprofile_v1
+//	@Param		id		path		string			true	"Subscription ID"	Format(int64)
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveDeleteErrorGroupsSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_delete_error_groups_subscription")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		wr.Error(errors.New("incorrect 'id' format"), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(id),
+	})
+
+	req := types.DeleteErrorGroupsSubscriptionRequest{ID: id}
+
+	err = a.service.DeleteErrorGroupsSubscription(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type errorGroupsSubscription struct {
+	ID         string     `json:"id" format:"int64"`
+	Service    string     `json:"service"`
+	Env        string     `json:"env,omitempty"`
+	Period     string     `json:"period" enums:"daily,weekly"`
+	Format     string     `json:"format" enums:"markdown,html"`
+	Delivery   string     `json:"delivery" enums:"webhook,file_store"`
+	WebhookURL string     `json:"webhookUrl,omitempty"`
+	LastSentAt *time.Time `json:"lastSentAt,omitempty" format:"date-time"`
+} //	@name	userprofile.v1.ErrorGroupsSubscription
+
+func newErrorGroupsSubscriptions(t types.ErrorGroupsSubscriptions) []errorGroupsSubscription {
+	res := make([]errorGroupsSubscription, len(t))
+	for i, s := range t {
+		res[i] = errorGroupsSubscription{
+			ID:         strconv.FormatInt(s.ID, 10),
+			Service:    s.Service,
+			Env:        s.Env,
+			Period:     string(s.Period),
+			Format:     string(s.Format),
+			Delivery:   string(s.Delivery),
+			WebhookURL: s.WebhookURL,
+			LastSentAt: s.LastSentAt,
+		}
+	}
+	return res
+}
+
+type getErrorGroupsSubscriptionsResponse struct {
+	Subscriptions []errorGroupsSubscription `json:"subscriptions"`
+} //	@name	userprofile.v1.GetErrorGroupsSubscriptionsResponse
+
+type createErrorGroupsSubscriptionRequest struct {
+	Service    string `json:"service"`
+	Env        string `json:"env,omitempty"`
+	Period     string `json:"period,omitempty" default:"daily" enums:"daily,weekly"`
+	Format     string `json:"format,omitempty" default:"markdown" enums:"markdown,html"`
+	Delivery   string `json:"delivery,omitempty" default:"webhook" enums:"webhook,file_store"`
+	WebhookURL string `json:"webhookUrl,omitempty"`
+} //	@name	userprofile.v1.CreateErrorGroupsSubscriptionRequest
+
+type createErrorGroupsSubscriptionResponse struct {
+	ID string `json:"id" format:"int64"`
+} //	@name	userprofile.v1.CreateErrorGroupsSubscriptionResponse
@@ -0,0 +1,261 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeGetErrorGroupsSubscriptions(t *testing.T) {
+	lastSentAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockArgs struct {
+		resp types.ErrorGroupsSubscriptions
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want    getErrorGroupsSubscriptionsResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: getErrorGroupsSubscriptionsResponse{
+				Subscriptions: []errorGroupsSubscription{
+					{
+						ID:         "1",
+						Service:    "svc1",
+						Env:        "prod",
+						Period:     "daily",
+						Format:     "markdown",
+						Delivery:   "webhook",
+						WebhookURL: "https://example.com/hook",
+						LastSentAt: &lastSentAt,
+					},
+					{
+						ID:       "2",
+						Service:  "svc2",
+						Period:   "weekly",
+						Format:   "html",
+						Delivery: "file_store",
+					},
+				},
+			},
+			mockArgs: &mockArgs{
+				resp: types.ErrorGroupsSubscriptions{
+					{
+						ID:         1,
+						Service:    "svc1",
+						Env:        "prod",
+						Period:     types.DigestPeriodDaily,
+						Format:     types.DigestFormatMarkdown,
+						Delivery:   types.DigestDeliveryWebhook,
+						WebhookURL: "https://example.com/hook",
+						LastSentAt: &lastSentAt,
+					},
+					{
+						ID:       2,
+						Service:  "svc2",
+						Period:   types.DigestPeriodWeekly,
+						Format:   types.DigestFormatHTML,
+						Delivery: types.DigestDeliveryFileStore,
+					},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetErrorGroupsSubscriptions(gomock.Any(), types.GetErrorGroupsSubscriptionsRequest{}).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getErrorGroupsSubscriptionsResponse]{
+				Method:  http.MethodGet,
+				Target:  "/userprofile/v1/errorgroups/subscriptions",
+				Handler: api.serveGetErrorGroupsSubscriptions,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeCreateErrorGroupsSubscription(t *testing.T) {
+	type mockArgs struct {
+		req  types.CreateErrorGroupsSubscriptionRequest
+		resp int64
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     createErrorGroupsSubscriptionRequest
+		want    createErrorGroupsSubscriptionResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: createErrorGroupsSubscriptionRequest{
+				Service:  "svc",
+				Env:      "prod",
+				Period:   "weekly",
+				Format:   "html",
+				Delivery: "file_store",
+			},
+			want: createErrorGroupsSubscriptionResponse{ID: "1"},
+			mockArgs: &mockArgs{
+				req: types.CreateErrorGroupsSubscriptionRequest{
+					Service:  "svc",
+					Env:      "prod",
+					Period:   types.DigestPeriodWeekly,
+					Format:   types.DigestFormatHTML,
+					Delivery: types.DigestDeliveryFileStore,
+				},
+				resp: 1,
+			},
+		},
+		{
+			name: "ok_defaults",
+			req: createErrorGroupsSubscriptionRequest{
+				Service:    "svc",
+				WebhookURL: "https://example.com/hook",
+			},
+			want: createErrorGroupsSubscriptionResponse{ID: "2"},
+			mockArgs: &mockArgs{
+				req: types.CreateErrorGroupsSubscriptionRequest{
+					Service:    "svc",
+					Period:     types.DigestPeriodDaily,
+					Format:     types.DigestFormatMarkdown,
+					Delivery:   types.DigestDeliveryWebhook,
+					WebhookURL: "https://example.com/hook",
+				},
+				resp: 2,
+			},
+		},
+		{
+			name: "err_svc",
+			req: createErrorGroupsSubscriptionRequest{
+				Service: "svc",
+				Period:  "monthly",
+			},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.CreateErrorGroupsSubscriptionRequest{
+					Service:  "svc",
+					Period:   "monthly",
+					Format:   types.DigestFormatMarkdown,
+					Delivery: types.DigestDeliveryWebhook,
+				},
+				err: types.ErrInvalidRequestField,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					CreateErrorGroupsSubscription(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[createErrorGroupsSubscriptionRequest, createErrorGroupsSubscriptionResponse]{
+				Method:  http.MethodPost,
+				Target:  "/userprofile/v1/errorgroups/subscriptions",
+				Req:     tt.req,
+				Handler: api.serveCreateErrorGroupsSubscription,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeDeleteErrorGroupsSubscription(t *testing.T) {
+	type mockArgs struct {
+		req types.DeleteErrorGroupsSubscriptionRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			mockArgs: &mockArgs{
+				req: types.DeleteErrorGroupsSubscriptionRequest{ID: 100},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.DeleteErrorGroupsSubscriptionRequest{ID: 100},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			mockedSvc.EXPECT().
+				DeleteErrorGroupsSubscription(gomock.Any(), tt.mockArgs.req).
+				Return(tt.mockArgs.err).
+				Times(1)
+
+			id := strconv.FormatInt(tt.mockArgs.req.ID, 10)
+			handler := withID(api.serveDeleteErrorGroupsSubscription, id)
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodDelete,
+				Target:  fmt.Sprintf("/userprofile/v1/errorgroups/subscriptions/%s", id),
+				Handler: handler,
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
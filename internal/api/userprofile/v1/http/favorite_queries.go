@@ -91,6 +91,18 @@ func (a *API) serveCreateFavoriteQuery(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	if httpReq.Env != nil {
+		req.Env = *httpReq.Env
+	}
+	req.LogColumns = httpReq.LogColumns
+	if httpReq.FolderID != nil {
+		folderID, err := strconv.ParseInt(*httpReq.FolderID, 10, 64)
+		if err != nil {
+			wr.Error(errors.New("incorrect favorite query 'folderId' format"), http.StatusBadRequest)
+			return
+		}
+		req.FolderID = &folderID
+	}
 
 	fqID, err := a.service.GetOrCreateFavoriteQuery(ctx, req)
 	if err != nil {
@@ -103,6 +115,85 @@ func (a *API) serveCreateFavoriteQuery(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// serveUpdateFavoriteQuery go doc.
+//
+//	@Router		/userprofile/v1/queries/favorite/{id} [patch]
+//	@ID			userprofile_v1_updateFavoriteQuery
+//	@Tags		userprofile_v1
+//	@Param		id		path		string						true	"Favorite Query ID"	Format(int64)
+//	@Param		body	body		updateFavoriteQueryRequest	true	"Request body"
+//	@Success	200		{object}	nil							"A successful response"
+//	@Failure	default	{object}	httputil.Error				"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveUpdateFavoriteQuery(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_update_favorite_query")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		wr.Error(errors.New("incorrect 'id' format"), http.StatusBadRequest)
+		return
+	}
+
+	var httpReq updateFavoriteQueryRequest
+	if err = json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(id),
+		},
+		attribute.KeyValue{
+			Key:   "query",
+			Value: attribute.StringValue(httpReq.GetQuery()),
+		},
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(httpReq.GetName()),
+		},
+		attribute.KeyValue{
+			Key:   "env",
+			Value: attribute.StringValue(httpReq.GetEnv()),
+		},
+	)
+
+	req := types.UpdateFavoriteQueryRequest{
+		ID:         id,
+		Query:      httpReq.Query,
+		Name:       httpReq.Name,
+		Env:        httpReq.Env,
+		LogColumns: httpReq.LogColumns,
+	}
+	if httpReq.RelativeFrom != nil {
+		relativeFrom, err := strconv.ParseUint(*httpReq.RelativeFrom, 10, 64)
+		if err != nil {
+			wr.Error(errors.New("incorrect favorite query 'relativeFrom' format"), http.StatusBadRequest)
+			return
+		}
+		req.RelativeFrom = &relativeFrom
+	}
+	if httpReq.FolderID != nil {
+		folderID, err := strconv.ParseInt(*httpReq.FolderID, 10, 64)
+		if err != nil {
+			wr.Error(errors.New("incorrect favorite query 'folderId' format"), http.StatusBadRequest)
+			return
+		}
+		req.FolderID = &folderID
+	}
+
+	if err = a.service.UpdateFavoriteQuery(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 // serveDeleteFavoriteQuery go doc.
 //
 //	@Router		/userprofile/v1/queries/favorite/{id} [delete]
@@ -140,22 +231,79 @@ func (a *API) serveDeleteFavoriteQuery(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// serveReorderFavoriteQueries go doc.
+//
+//	@Router		/userprofile/v1/queries/favorite/order [put]
+//	@ID			userprofile_v1_reorderFavoriteQueries
+//	@Tags		userprofile_v1
+//	@Param		body	body		reorderFavoriteQueriesRequest	true	"Request body"
+//	@Success	200		{object}	nil								"A successful response"
+//	@Failure	default	{object}	httputil.Error					"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveReorderFavoriteQueries(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_reorder_favorite_queries")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq reorderFavoriteQueriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	req := types.ReorderFavoriteQueriesRequest{IDs: make([]int64, len(httpReq.IDs))}
+	for i, id := range httpReq.IDs {
+		var err error
+		if req.IDs[i], err = strconv.ParseInt(id, 10, 64); err != nil {
+			wr.Error(errors.New("incorrect 'ids' format"), http.StatusBadRequest)
+			return
+		}
+	}
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "ids",
+		Value: attribute.Int64SliceValue(req.IDs),
+	})
+
+	if err := a.service.ReorderFavoriteQueries(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 type favoriteQuery struct {
-	ID           string `json:"id" format:"int64"`
-	Query        string `json:"query"`
-	Name         string `json:"name,omitempty"`
-	RelativeFrom string `json:"relativeFrom,omitempty" format:"uint64"`
+	ID           string   `json:"id" format:"int64"`
+	Query        string   `json:"query"`
+	Name         string   `json:"name,omitempty"`
+	RelativeFrom string   `json:"relativeFrom,omitempty" format:"uint64"`
+	FolderID     string   `json:"folderId,omitempty" format:"int64"`
+	Env          string   `json:"env,omitempty"`
+	LogColumns   []string `json:"logColumns,omitempty"`
+	Team         string   `json:"team,omitempty"`
+	OwnerName    string   `json:"ownerName,omitempty"`
+	Subscribed   bool     `json:"subscribed,omitempty"`
 } //	@name	userprofile.v1.FavoriteQuery
 
 func newFavoriteQuery(t types.FavoriteQuery) favoriteQuery {
 	fq := favoriteQuery{
-		ID:    strconv.FormatInt(t.ID, 10),
-		Query: t.Query,
-		Name:  t.Name,
+		ID:         strconv.FormatInt(t.ID, 10),
+		Query:      t.Query,
+		Name:       t.Name,
+		Env:        t.Env,
+		LogColumns: t.LogColumns,
+		Team:       t.Team,
+		OwnerName:  t.OwnerName,
+		Subscribed: t.Subscribed,
 	}
 	if t.RelativeFrom != 0 {
 		fq.RelativeFrom = strconv.FormatUint(t.RelativeFrom, 10)
 	}
+	if t.FolderID != nil {
+		fq.FolderID = strconv.FormatInt(*t.FolderID, 10)
+	}
 	return fq
 }
 
@@ -174,9 +322,12 @@ type getFavoriteQueriesResponse struct {
 } //	@name	userprofile.v1.GetFavoriteQueriesResponse
 
 type createFavoriteQueryRequest struct {
-	Query        string  `json:"query"`
-	Name         *string `json:"name"`
-	RelativeFrom *string `json:"relativeFrom" format:"uint64"`
+	Query        string   `json:"query"`
+	Name         *string  `json:"name"`
+	RelativeFrom *string  `json:"relativeFrom" format:"uint64"`
+	Env          *string  `json:"env"`
+	LogColumns   []string `json:"logColumns"`
+	FolderID     *string  `json:"folderId" format:"int64"`
 } //	@name	userprofile.v1.CreateFavoriteQueryRequest
 
 func (r createFavoriteQueryRequest) GetName() string {
@@ -196,3 +347,38 @@ func (r createFavoriteQueryRequest) GetRelativeFrom() string {
 type createFavoriteQueryResponse struct {
 	ID string `json:"id" format:"int64"`
 } //	@name	userprofile.v1.CreateFavoriteQueryResponse
+
+type updateFavoriteQueryRequest struct {
+	Query        *string   `json:"query"`
+	Name         *string   `json:"name"`
+	RelativeFrom *string   `json:"relativeFrom" format:"uint64"`
+	Env          *string   `json:"env"`
+	LogColumns   *[]string `json:"logColumns"`
+	// "0" moves the query to the root.
+	FolderID *string `json:"folderId" format:"int64"`
+} //	@name	userprofile.v1.UpdateFavoriteQueryRequest
+
+func (r updateFavoriteQueryRequest) GetQuery() string {
+	if r.Query != nil {
+		return *r.Query
+	}
+	return ""
+}
+
+func (r updateFavoriteQueryRequest) GetName() string {
+	if r.Name != nil {
+		return *r.Name
+	}
+	return ""
+}
+
+func (r updateFavoriteQueryRequest) GetEnv() string {
+	if r.Env != nil {
+		return *r.Env
+	}
+	return ""
+}
+
+type reorderFavoriteQueriesRequest struct {
+	IDs []string `json:"ids" format:"int64"`
+} //	@name	userprofile.v1.ReorderFavoriteQueriesRequest
@@ -0,0 +1,186 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// servePublishFavoriteQuery go doc.
+//
+//	@Router		/userprofile/v1/queries/favorite/{id}/publish [post]
+//	@ID			userprofile_v1_publishFavoriteQuery
+//	@Tags		userprofile_v1
+//	@Param		id		path		string						true	"Favorite Query ID"	Format(int64)
+//	@Param		body	body		publishFavoriteQueryRequest	true	"Request body"
+//	@Success	200		{object}	nil							"A successful response"
+//	@Failure	default	{object}	httputil.Error				"An unexpected error response"
+//	@Security	bearer
+func (a *API) servePublishFavoriteQuery(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_publish_favorite_query")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		wr.Error(errors.New("incorrect 'id' format"), http.StatusBadRequest)
+		return
+	}
+
+	var httpReq publishFavoriteQueryRequest
+	if err = json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(id),
+		},
+		attribute.KeyValue{
+			Key:   "team",
+			Value: attribute.StringValue(httpReq.Team),
+		},
+	)
+
+	req := types.PublishFavoriteQueryRequest{
+		ID:   id,
+		Team: httpReq.Team,
+	}
+
+	if err = a.service.PublishFavoriteQuery(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveGetFavoriteQueriesLibrary go doc.
+//
+//	@Router		/userprofile/v1/queries/library [get]
+//	@ID			userprofile_v1_getFavoriteQueriesLibrary
+//	@Tags		userprofile_v1
+//	@Param		team	query		string								false	"Team"
+//	@Success	200		{object}	getFavoriteQueriesLibraryResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error						"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetFavoriteQueriesLibrary(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_get_favorite_queries_library")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	req := types.GetFavoriteQueriesLibraryRequest{}
+	if r.URL.Query().Has("team") {
+		team := r.URL.Query().Get("team")
+		req.Team = &team
+
+		span.SetAttributes(attribute.KeyValue{
+			Key:   "team",
+			Value: attribute.StringValue(team),
+		})
+	}
+
+	fqs, err := a.service.GetFavoriteQueriesLibrary(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getFavoriteQueriesLibraryResponse{
+		Queries: newFavoriteQueries(fqs),
+	})
+}
+
+// serveSubscribeFavoriteQuery go doc.
+//
+//	@Router		/userprofile/v1/queries/library/{id}/subscription [post]
+//	@ID			userprofile_v1_subscribeFavoriteQuery
+//	@Tags		userprofile_v1
+//	@Param		id		path		string			true	"Favorite Query ID"	Format(int64)
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveSubscribeFavoriteQuery(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_subscribe_favorite_query")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		wr.Error(errors.New("incorrect 'id' format"), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(id),
+	})
+
+	req := types.SubscribeFavoriteQueryRequest{ID: id}
+
+	if err = a.service.SubscribeFavoriteQuery(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveUnsubscribeFavoriteQuery go doc.
+//
+//	@Router		/userprofile/v1/queries/library/{id}/subscription [delete]
+//	@ID			userprofile_v1_unsubscribeFavoriteQuery
+//	@Tags		userprofile_v1
+//	@Param		id		path		string			true	"Favorite Query ID"	Format(int64)
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveUnsubscribeFavoriteQuery(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_unsubscribe_favorite_query")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		wr.Error(errors.New("incorrect 'id' format"), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(id),
+	})
+
+	req := types.UnsubscribeFavoriteQueryRequest{ID: id}
+
+	if err = a.service.UnsubscribeFavoriteQuery(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type publishFavoriteQueryRequest struct {
+	// Empty team unpublishes the query.
+	Team string `json:"team"`
+} //	@name	userprofile.v1.PublishFavoriteQueryRequest
+
+type getFavoriteQueriesLibraryResponse struct {
+	Queries favoriteQueries `json:"queries"`
+} //	@name	userprofile.v1.GetFavoriteQueriesLibraryResponse
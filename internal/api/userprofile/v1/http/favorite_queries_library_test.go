@@ -0,0 +1,243 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServePublishFavoriteQuery(t *testing.T) {
+	type mockArgs struct {
+		req types.PublishFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     publishFavoriteQueryRequest
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  publishFavoriteQueryRequest{Team: "backend"},
+			mockArgs: &mockArgs{
+				req: types.PublishFavoriteQueryRequest{ID: 1, Team: "backend"},
+			},
+		},
+		{
+			name:    "err_permission_denied",
+			req:     publishFavoriteQueryRequest{Team: "frontend"},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.PublishFavoriteQueryRequest{ID: 1, Team: "frontend"},
+				err: types.NewErrPermissionDenied("publish favorite query to team frontend"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					PublishFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[publishFavoriteQueryRequest, struct{}]{
+				Method:  http.MethodPost,
+				Target:  "/userprofile/v1/queries/favorite/1/publish",
+				Req:     tt.req,
+				Handler: withID(api.servePublishFavoriteQuery, "1"),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeGetFavoriteQueriesLibrary(t *testing.T) {
+	team := "backend"
+
+	type mockArgs struct {
+		req  types.GetFavoriteQueriesLibraryRequest
+		resp types.FavoriteQueries
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		target  string
+		want    getFavoriteQueriesLibraryResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:   "ok",
+			target: "/userprofile/v1/queries/library?team=backend",
+			want: getFavoriteQueriesLibraryResponse{
+				Queries: favoriteQueries{
+					{ID: "1", Query: "level:error", Team: team, OwnerName: "owner", Subscribed: true},
+					{ID: "2", Query: "level:warn", Team: team, OwnerName: "owner"},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.GetFavoriteQueriesLibraryRequest{Team: &team},
+				resp: types.FavoriteQueries{
+					{ID: 1, Query: "level:error", Team: team, OwnerName: "owner", Subscribed: true},
+					{ID: 2, Query: "level:warn", Team: team, OwnerName: "owner"},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			target:  "/userprofile/v1/queries/library",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetFavoriteQueriesLibrary(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getFavoriteQueriesLibraryResponse]{
+				Method:  http.MethodGet,
+				Target:  tt.target,
+				Handler: api.serveGetFavoriteQueriesLibrary,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeSubscribeFavoriteQuery(t *testing.T) {
+	type mockArgs struct {
+		req types.SubscribeFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			mockArgs: &mockArgs{
+				req: types.SubscribeFavoriteQueryRequest{ID: 1},
+			},
+		},
+		{
+			name:    "err_not_found",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.SubscribeFavoriteQueryRequest{ID: 1},
+				err: types.NewErrNotFound("favorite query"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					SubscribeFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodPost,
+				Target:  "/userprofile/v1/queries/library/1/subscription",
+				Handler: withID(api.serveSubscribeFavoriteQuery, "1"),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeUnsubscribeFavoriteQuery(t *testing.T) {
+	type mockArgs struct {
+		req types.UnsubscribeFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			mockArgs: &mockArgs{
+				req: types.UnsubscribeFavoriteQueryRequest{ID: 1},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.UnsubscribeFavoriteQueryRequest{ID: 1},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UnsubscribeFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodDelete,
+				Target:  "/userprofile/v1/queries/library/1/subscription",
+				Handler: withID(api.serveUnsubscribeFavoriteQuery, "1"),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
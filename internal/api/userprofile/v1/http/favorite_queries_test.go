@@ -14,7 +14,8 @@ import (
 
 func TestServeGetFavoriteQueries(t *testing.T) {
 	var (
-		relativeFrom = "300"
+		relativeFrom       = "300"
+		folderID     int64 = 2
 	)
 
 	type mockArgs struct {
@@ -39,6 +40,8 @@ func TestServeGetFavoriteQueries(t *testing.T) {
 					{ID: "2", Query: "test2", Name: "my query 2"},
 					{ID: "3", Query: "test3", RelativeFrom: relativeFrom},
 					{ID: "4", Query: "test4"},
+					{ID: "5", Query: "test5", FolderID: "2", Env: "prod", LogColumns: []string{"level"}},
+					{ID: "6", Query: "test6", Team: "backend", OwnerName: "owner", Subscribed: true},
 				},
 			},
 			mockArgs: &mockArgs{
@@ -47,6 +50,8 @@ func TestServeGetFavoriteQueries(t *testing.T) {
 					{ID: 2, Query: "test2", Name: "my query 2"},
 					{ID: 3, Query: "test3", RelativeFrom: 300},
 					{ID: 4, Query: "test4"},
+					{ID: 5, Query: "test5", FolderID: &folderID, Env: "prod", LogColumns: []string{"level"}},
+					{ID: 6, Query: "test6", Team: "backend", OwnerName: "owner", Subscribed: true},
 				},
 			},
 		},
@@ -217,3 +222,144 @@ func TestServeDeleteFavoriteQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestServeUpdateFavoriteQuery(t *testing.T) {
+	var (
+		query               = "test"
+		relativeFrom        = "300"
+		folderID            = "0"
+		relativeFromU       = uint64(300)
+		rootID        int64 = 0
+	)
+
+	type mockArgs struct {
+		req types.UpdateFavoriteQueryRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     updateFavoriteQueryRequest
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: updateFavoriteQueryRequest{
+				Query:        &query,
+				RelativeFrom: &relativeFrom,
+				LogColumns:   &[]string{"level"},
+				FolderID:     &folderID,
+			},
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryRequest{
+					ID:           100,
+					Query:        &query,
+					RelativeFrom: &relativeFromU,
+					LogColumns:   &[]string{"level"},
+					FolderID:     &rootID,
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     updateFavoriteQueryRequest{Query: &query},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryRequest{
+					ID:    100,
+					Query: &query,
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateFavoriteQuery(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[updateFavoriteQueryRequest, struct{}]{
+				Method:  http.MethodPatch,
+				Target:  "/userprofile/v1/queries/favorite/100",
+				Req:     tt.req,
+				Handler: withID(api.serveUpdateFavoriteQuery, "100"),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeReorderFavoriteQueries(t *testing.T) {
+	type mockArgs struct {
+		req types.ReorderFavoriteQueriesRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		req     reorderFavoriteQueriesRequest
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  reorderFavoriteQueriesRequest{IDs: []string{"3", "1", "2"}},
+			mockArgs: &mockArgs{
+				req: types.ReorderFavoriteQueriesRequest{IDs: []int64{3, 1, 2}},
+			},
+		},
+		{
+			name:    "err_ids_format",
+			req:     reorderFavoriteQueriesRequest{IDs: []string{"3", "one"}},
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			req:     reorderFavoriteQueriesRequest{IDs: []string{"1"}},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.ReorderFavoriteQueriesRequest{IDs: []int64{1}},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					ReorderFavoriteQueries(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[reorderFavoriteQueriesRequest, struct{}]{
+				Method:  http.MethodPut,
+				Target:  "/userprofile/v1/queries/favorite/order",
+				Req:     tt.req,
+				Handler: api.serveReorderFavoriteQueries,
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
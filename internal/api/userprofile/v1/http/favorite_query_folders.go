@@ -0,0 +1,201 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetFavoriteQueryFolders go doc.
+//
+//	@Router		/userprofile/v1/queries/favorite/folders [get]
+//	@ID			userprofile_v1_getFavoriteQueryFolders
+//	@Tags		userprofile_v1
+//	@Success	200		{object}	getFavoriteQueryFoldersResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error					"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetFavoriteQueryFolders(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_get_favorite_query_folders")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	req := types.GetFavoriteQueryFoldersRequest{}
+	folders, err := a.service.GetFavoriteQueryFolders(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getFavoriteQueryFoldersResponse{
+		Folders: newFavoriteQueryFolders(folders),
+	})
+}
+
+// serveCreateFavoriteQueryFolder go doc.
+//
+//	@Router		/userprofile/v1/queries/favorite/folders [post]
+//	@ID			userprofile_v1_createFavoriteQueryFolder
+//	@Tags		userprofile_v1
+//	@Param		body	body		createFavoriteQueryFolderRequest	true	"Request body"
+//	@Success	200		{object}	createFavoriteQueryFolderResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error						"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveCreateFavoriteQueryFolder(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_create_favorite_query_folder")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq createFavoriteQueryFolderRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "name",
+		Value: attribute.StringValue(httpReq.Name),
+	})
+
+	req := types.CreateFavoriteQueryFolderRequest{Name: httpReq.Name}
+
+	id, err := a.service.CreateFavoriteQueryFolder(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(createFavoriteQueryFolderResponse{
+		ID: strconv.FormatInt(id, 10),
+	})
+}
+
+// serveUpdateFavoriteQueryFolder go doc.
+//
+//	@Router		/userprofile/v1/queries/favorite/folders/{id} [patch]
+//	@ID			userprofile_v1_updateFavoriteQueryFolder
+//	@Tags		userprofile_v1
+//	@Param		id		path		string								true	"Folder ID"	Format(int64)
+//	@Param		body	body		updateFavoriteQueryFolderRequest	true	"Request body"
+//	@Success	200		{object}	nil									"A successful response"
+//	@Failure	default	{object}	httputil.Error						"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveUpdateFavoriteQueryFolder(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_update_favorite_query_folder")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		wr.Error(errors.New("incorrect 'id' format"), http.StatusBadRequest)
+		return
+	}
+
+	var httpReq updateFavoriteQueryFolderRequest
+	if err = json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "id",
+			Value: attribute.Int64Value(id),
+		},
+		attribute.KeyValue{
+			Key:   "name",
+			Value: attribute.StringValue(httpReq.Name),
+		},
+	)
+
+	req := types.UpdateFavoriteQueryFolderRequest{
+		ID:   id,
+		Name: httpReq.Name,
+	}
+
+	if err = a.service.UpdateFavoriteQueryFolder(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveDeleteFavoriteQueryFolder go doc.
+//
+//	@Router		/userprofile/v1/queries/favorite/folders/{id} [delete]
+//	@ID			userprofile_v1_deleteFavoriteQueryFolder
+//	@Tags		userprofile_v1
+//	@Param		id		path		string			true	"Folder ID"	Format(int64)
+//	@Success	200		{object}	nil				"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveDeleteFavoriteQueryFolder(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_delete_favorite_query_folder")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		wr.Error(errors.New("incorrect 'id' format"), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.Int64Value(id),
+	})
+
+	req := types.DeleteFavoriteQueryFolderRequest{ID: id}
+
+	if err = a.service.DeleteFavoriteQueryFolder(ctx, req); err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type favoriteQueryFolder struct {
+	ID   string `json:"id" format:"int64"`
+	Name string `json:"name"`
+} //	@name	userprofile.v1.FavoriteQueryFolder
+
+func newFavoriteQueryFolders(t types.FavoriteQueryFolders) []favoriteQueryFolder {
+	res := make([]favoriteQueryFolder, len(t))
+	for i, f := range t {
+		res[i] = favoriteQueryFolder{
+			ID:   strconv.FormatInt(f.ID, 10),
+			Name: f.Name,
+		}
+	}
+	return res
+}
+
+type getFavoriteQueryFoldersResponse struct {
+	Folders []favoriteQueryFolder `json:"folders"`
+} //	@name	userprofile.v1.GetFavoriteQueryFoldersResponse
+
+type createFavoriteQueryFolderRequest struct {
+	Name string `json:"name"`
+} //	@name	userprofile.v1.CreateFavoriteQueryFolderRequest
+
+type createFavoriteQueryFolderResponse struct {
+	ID string `json:"id" format:"int64"`
+} //	@name	userprofile.v1.CreateFavoriteQueryFolderResponse
+
+type updateFavoriteQueryFolderRequest struct {
+	Name string `json:"name"`
+} //	@name	userprofile.v1.UpdateFavoriteQueryFolderRequest
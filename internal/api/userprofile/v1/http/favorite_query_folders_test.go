@@ -0,0 +1,253 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeGetFavoriteQueryFolders(t *testing.T) {
+	type mockArgs struct {
+		resp types.FavoriteQueryFolders
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want    getFavoriteQueryFoldersResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: getFavoriteQueryFoldersResponse{
+				Folders: []favoriteQueryFolder{
+					{ID: "1", Name: "alerts"},
+					{ID: "2", Name: "billing"},
+				},
+			},
+			mockArgs: &mockArgs{
+				resp: types.FavoriteQueryFolders{
+					{ID: 1, Name: "alerts"},
+					{ID: 2, Name: "billing"},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetFavoriteQueryFolders(gomock.Any(), types.GetFavoriteQueryFoldersRequest{}).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getFavoriteQueryFoldersResponse]{
+				Method:  http.MethodGet,
+				Target:  "/userprofile/v1/queries/favorite/folders",
+				Handler: api.serveGetFavoriteQueryFolders,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeCreateFavoriteQueryFolder(t *testing.T) {
+	type mockArgs struct {
+		req  types.CreateFavoriteQueryFolderRequest
+		resp int64
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     createFavoriteQueryFolderRequest
+		want    createFavoriteQueryFolderResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req:  createFavoriteQueryFolderRequest{Name: "alerts"},
+			want: createFavoriteQueryFolderResponse{ID: "1"},
+			mockArgs: &mockArgs{
+				req:  types.CreateFavoriteQueryFolderRequest{Name: "alerts"},
+				resp: 1,
+			},
+		},
+		{
+			name:    "err_svc",
+			req:     createFavoriteQueryFolderRequest{Name: "alerts"},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.CreateFavoriteQueryFolderRequest{Name: "alerts"},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					CreateFavoriteQueryFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[createFavoriteQueryFolderRequest, createFavoriteQueryFolderResponse]{
+				Method:  http.MethodPost,
+				Target:  "/userprofile/v1/queries/favorite/folders",
+				Req:     tt.req,
+				Handler: api.serveCreateFavoriteQueryFolder,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeUpdateFavoriteQueryFolder(t *testing.T) {
+	type mockArgs struct {
+		req types.UpdateFavoriteQueryFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		id      string
+		req     updateFavoriteQueryFolderRequest
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			id:   "1",
+			req:  updateFavoriteQueryFolderRequest{Name: "alerts"},
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryFolderRequest{ID: 1, Name: "alerts"},
+			},
+		},
+		{
+			name:    "err_id_format",
+			id:      "abc",
+			req:     updateFavoriteQueryFolderRequest{Name: "alerts"},
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			id:      "1",
+			req:     updateFavoriteQueryFolderRequest{Name: "alerts"},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.UpdateFavoriteQueryFolderRequest{ID: 1, Name: "alerts"},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateFavoriteQueryFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[updateFavoriteQueryFolderRequest, struct{}]{
+				Method:  http.MethodPatch,
+				Target:  "/userprofile/v1/queries/favorite/folders/" + tt.id,
+				Req:     tt.req,
+				Handler: withID(api.serveUpdateFavoriteQueryFolder, tt.id),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
+
+func TestServeDeleteFavoriteQueryFolder(t *testing.T) {
+	type mockArgs struct {
+		req types.DeleteFavoriteQueryFolderRequest
+		err error
+	}
+
+	tests := []struct {
+		name string
+
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			mockArgs: &mockArgs{
+				req: types.DeleteFavoriteQueryFolderRequest{ID: 1},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.DeleteFavoriteQueryFolderRequest{ID: 1},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					DeleteFavoriteQueryFolder(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, struct{}]{
+				Method:  http.MethodDelete,
+				Target:  "/userprofile/v1/queries/favorite/folders/1",
+				Handler: withID(api.serveDeleteFavoriteQueryFolder, "1"),
+				NoResp:  true,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveGetQueryHistory go doc.
+//
+//	@Router		/userprofile/v1/queries/history [get]
+//	@ID			userprofile_v1_getQueryHistory
+//	@Tags		userprofile_v1
+//	@Param		query	query		string					false	"Text to search in queries"
+//	@Param		limit	query		int						false	"Limit"		Format(int32)
+//	@Param		offset	query		int						false	"Offset"	Format(int32)
+//	@Success	200		{object}	getQueryHistoryResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error			"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveGetQueryHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_get_query_history")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	params := r.URL.Query()
+	req := types.GetQueryHistoryRequest{}
+	if params.Has("query") {
+		query := params.Get("query")
+		req.Query = &query
+	}
+	if params.Has("limit") {
+		limit, err := strconv.ParseInt(params.Get("limit"), 10, 32)
+		if err != nil {
+			wr.Error(errors.New("incorrect 'limit' format"), http.StatusBadRequest)
+			return
+		}
+		req.Limit = int32(limit)
+	}
+	if params.Has("offset") {
+		offset, err := strconv.ParseInt(params.Get("offset"), 10, 32)
+		if err != nil {
+			wr.Error(errors.New("incorrect 'offset' format"), http.StatusBadRequest)
+			return
+		}
+		req.Offset = int32(offset)
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "query",
+			Value: attribute.StringValue(params.Get("query")),
+		},
+		attribute.KeyValue{
+			Key:   "limit",
+			Value: attribute.IntValue(int(req.Limit)),
+		},
+		attribute.KeyValue{
+			Key:   "offset",
+			Value: attribute.IntValue(int(req.Offset)),
+		},
+	)
+
+	entries, err := a.service.GetQueryHistory(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(getQueryHistoryResponse{
+		Entries: newQueryHistoryEntries(entries),
+	})
+}
+
+type queryHistoryEntry struct {
+	ID         string    `json:"id" format:"int64"`
+	Kind       string    `json:"kind" enums:"search,histogram,aggregation"`
+	Query      string    `json:"query"`
+	From       time.Time `json:"from" format:"date-time"`
+	To         time.Time `json:"to" format:"date-time"`
+	Env        string    `json:"env,omitempty"`
+	DurationMs string    `json:"durationMs" format:"int64"`
+	Total      string    `json:"total" format:"int64"`
+	ErrorCode  string    `json:"errorCode,omitempty"`
+	CreatedAt  time.Time `json:"createdAt" format:"date-time"`
+} //	@name	userprofile.v1.QueryHistoryEntry
+
+func newQueryHistoryEntries(t types.QueryHistoryEntries) []queryHistoryEntry {
+	res := make([]queryHistoryEntry, len(t))
+	for i, e := range t {
+		res[i] = queryHistoryEntry{
+			ID:         strconv.FormatInt(e.ID, 10),
+			Kind:       string(e.Kind),
+			Query:      e.Query,
+			From:       e.From,
+			To:         e.To,
+			Env:        e.Env,
+			DurationMs: strconv.FormatInt(e.Duration.Milliseconds(), 10),
+			Total:      strconv.FormatInt(e.Total, 10),
+			ErrorCode:  e.ErrorCode,
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+	return res
+}
+
+type getQueryHistoryResponse struct {
+	Entries []queryHistoryEntry `json:"entries"`
+} //	@name	userprofile.v1.GetQueryHistoryResponse
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeGetQueryHistory(t *testing.T) {
+	var (
+		query = "error"
+		from  = time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC)
+		to    = from.Add(time.Hour)
+	)
+
+	type mockArgs struct {
+		req  types.GetQueryHistoryRequest
+		resp types.QueryHistoryEntries
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		target  string
+		want    getQueryHistoryResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name:   "ok",
+			target: "/userprofile/v1/queries/history?query=error&limit=10&offset=5",
+			want: getQueryHistoryResponse{
+				Entries: []queryHistoryEntry{
+					{
+						ID:         "2",
+						Kind:       "histogram",
+						Query:      "level:error",
+						From:       from,
+						To:         to,
+						Env:        "prod",
+						DurationMs: "150",
+						Total:      "42",
+						ErrorCode:  "ERROR_CODE_PARTIAL_RESPONSE",
+						CreatedAt:  to,
+					},
+					{
+						ID:         "1",
+						Kind:       "search",
+						Query:      "message:error",
+						From:       from,
+						To:         to,
+						DurationMs: "0",
+						Total:      "0",
+						CreatedAt:  from,
+					},
+				},
+			},
+			mockArgs: &mockArgs{
+				req: types.GetQueryHistoryRequest{Query: &query, Limit: 10, Offset: 5},
+				resp: types.QueryHistoryEntries{
+					{
+						ID:        2,
+						Kind:      types.QueryHistoryKindHistogram,
+						Query:     "level:error",
+						From:      from,
+						To:        to,
+						Env:       "prod",
+						Duration:  150 * time.Millisecond,
+						Total:     42,
+						ErrorCode: "ERROR_CODE_PARTIAL_RESPONSE",
+						CreatedAt: to,
+					},
+					{
+						ID:        1,
+						Kind:      types.QueryHistoryKindSearch,
+						Query:     "message:error",
+						From:      from,
+						To:        to,
+						CreatedAt: from,
+					},
+				},
+			},
+		},
+		{
+			name:    "err_limit_format",
+			target:  "/userprofile/v1/queries/history?limit=ten",
+			wantErr: true,
+		},
+		{
+			name:    "err_offset_format",
+			target:  "/userprofile/v1/queries/history?offset=-",
+			wantErr: true,
+		},
+		{
+			name:    "err_svc",
+			target:  "/userprofile/v1/queries/history",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					GetQueryHistory(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, getQueryHistoryResponse]{
+				Method:  http.MethodGet,
+				Target:  tt.target,
+				Handler: api.serveGetQueryHistory,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
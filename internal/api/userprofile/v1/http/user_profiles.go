@@ -18,6 +18,7 @@ import (
 //	@Router		/userprofile/v1/profile [get]
 //	@ID			userprofile_v1_getUserProfile
 //	@Tags		userprofile_v1
+//	@Param		env		query		string			false	"Environment to resolve per-env preferences for"
 //	@Success	200		{object}	userProfile		"A successful response"
 //	@Failure	default	{object}	httputil.Error	"An unexpected error response"
 //	@Security	bearer
@@ -27,6 +28,12 @@ func (a *API) serveGetUserProfile(w http.ResponseWriter, r *http.Request) {
 
 	wr := httputil.NewWriter(w)
 
+	env := r.URL.Query().Get("env")
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "env",
+		Value: attribute.StringValue(env),
+	})
+
 	userName, err := types.GetUserKey(ctx)
 	if err != nil {
 		httputil.ProcessError(wr, err)
@@ -45,6 +52,10 @@ func (a *API) serveGetUserProfile(w http.ResponseWriter, r *http.Request) {
 
 	profiles.SetID(userName, up.ID)
 
+	if env != "" {
+		up.Preferences = up.Preferences.ForEnv(env)
+	}
+
 	wr.WriteJson(newUserProfile(up))
 }
 
@@ -108,20 +119,156 @@ func (a *API) serveUpdateUserProfile(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// serveUpdateUserPreferences go doc.
+//
+//	@Router		/userprofile/v1/profile/preferences [patch]
+//	@ID			userprofile_v1_updateUserPreferences
+//	@Tags		userprofile_v1
+//	@Param		body	body		updateUserPreferencesRequest	true	"Request body"
+//	@Success	200		{object}	updateUserPreferencesResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error					"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveUpdateUserPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_update_user_preferences")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	var httpReq updateUserPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		wr.Error(fmt.Errorf("failed to parse request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   "update_mask",
+			Value: attribute.StringSliceValue(httpReq.UpdateMask),
+		},
+		attribute.KeyValue{
+			Key:   "env",
+			Value: attribute.StringValue(httpReq.Env),
+		},
+	)
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	req := types.UpdateUserPreferencesRequest{
+		UserName:    userName,
+		Env:         httpReq.Env,
+		Preferences: httpReq.Preferences.toType(),
+		Paths:       httpReq.UpdateMask,
+	}
+
+	prefs, err := a.service.UpdateUserPreferences(ctx, req)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(updateUserPreferencesResponse{
+		Preferences: newUserPreferences(prefs),
+	})
+}
+
 type userProfile struct {
-	Timezone          string   `json:"timezone"`
-	OnboardingVersion string   `json:"onboardingVersion"`
-	LogColumns        []string `json:"log_columns"`
+	Timezone          string          `json:"timezone"`
+	OnboardingVersion string          `json:"onboardingVersion"`
+	LogColumns        []string        `json:"log_columns"`
+	Preferences       userPreferences `json:"preferences"`
 } //	@name	userprofile.v1.UserProfile
 
 func newUserProfile(t types.UserProfile) userProfile {
 	return userProfile{
-		Timezone:          t.Timezone,
+		Timezone:          t.Preferences.Timezone,
 		OnboardingVersion: t.OnboardingVersion,
-		LogColumns:        t.LogColumns.LogColumns,
+		LogColumns:        t.Preferences.LogColumns,
+		Preferences:       newUserPreferences(t.Preferences),
 	}
 }
 
+type envPreferences struct {
+	LogColumns   []string `json:"logColumns"`
+	PinnedFields []string `json:"pinnedFields"`
+} //	@name	userprofile.v1.EnvPreferences
+
+type userPreferences struct {
+	Version    int    `json:"version"`
+	Timezone   string `json:"timezone"`
+	DefaultEnv string `json:"defaultEnv"`
+	// DefaultTimeRange is relative time range in seconds.
+	DefaultTimeRange uint64                    `json:"defaultTimeRange"`
+	PageSize         int32                     `json:"pageSize"`
+	DateFormat       string                    `json:"dateFormat"`
+	Theme            string                    `json:"theme" enums:"light,dark,system"`
+	LogColumns       []string                  `json:"logColumns"`
+	PinnedFields     []string                  `json:"pinnedFields"`
+	Envs             map[string]envPreferences `json:"envs,omitempty"`
+} //	@name	userprofile.v1.Preferences
+
+func newUserPreferences(p types.UserPreferences) userPreferences {
+	res := userPreferences{
+		Version:          p.Version,
+		Timezone:         p.Timezone,
+		DefaultEnv:       p.DefaultEnv,
+		DefaultTimeRange: p.DefaultTimeRange,
+		PageSize:         p.PageSize,
+		DateFormat:       p.DateFormat,
+		Theme:            string(p.Theme),
+		LogColumns:       p.LogColumns,
+		PinnedFields:     p.PinnedFields,
+	}
+	if len(p.Envs) > 0 {
+		res.Envs = make(map[string]envPreferences, len(p.Envs))
+		for env, ep := range p.Envs {
+			res.Envs[env] = envPreferences{
+				LogColumns:   ep.LogColumns,
+				PinnedFields: ep.PinnedFields,
+			}
+		}
+	}
+	return res
+}
+
+func (p userPreferences) toType() types.UserPreferences {
+	res := types.UserPreferences{
+		Timezone:         p.Timezone,
+		DefaultEnv:       p.DefaultEnv,
+		DefaultTimeRange: p.DefaultTimeRange,
+		PageSize:         p.PageSize,
+		DateFormat:       p.DateFormat,
+		Theme:            types.Theme(p.Theme),
+		LogColumns:       p.LogColumns,
+		PinnedFields:     p.PinnedFields,
+	}
+	if len(p.Envs) > 0 {
+		res.Envs = make(map[string]types.EnvPreferences, len(p.Envs))
+		for env, ep := range p.Envs {
+			res.Envs[env] = types.EnvPreferences{
+				LogColumns:   ep.LogColumns,
+				PinnedFields: ep.PinnedFields,
+			}
+		}
+	}
+	return res
+}
+
+type updateUserPreferencesRequest struct {
+	Preferences userPreferences `json:"preferences"`
+	// UpdateMask contains snake_case names of the preferences to update, e.g. "page_size".
+	UpdateMask []string `json:"updateMask"`
+	// Env scopes the update to the per-env preferences; only "log_columns" and "pinned_fields" are allowed.
+	Env string `json:"env,omitempty"`
+} //	@name	userprofile.v1.UpdateUserPreferencesRequest
+
+type updateUserPreferencesResponse struct {
+	Preferences userPreferences `json:"preferences"`
+} //	@name	userprofile.v1.UpdateUserPreferencesResponse
+
 type updateUserProfileRequest struct {
 	Timezone          *string `json:"timezone"`
 	OnboardingVersion *string `json:"onboardingVersion"`
@@ -17,6 +17,16 @@ func TestServeGetUserProfile(t *testing.T) {
 		timezone          = "UTC"
 		onboardingVersion = `{"name1": "ver1", "name2": "ver2"}`
 		logColumns        = []string{"val1", "val2"}
+		envLogColumns     = []string{"val3"}
+		env               = "prod"
+		prefs             = types.UserPreferences{
+			Version:    types.UserPreferencesVersion,
+			Timezone:   timezone,
+			LogColumns: logColumns,
+			Envs: map[string]types.EnvPreferences{
+				env: {LogColumns: envLogColumns},
+			},
+		}
 	)
 
 	type mockArgs struct {
@@ -28,17 +38,41 @@ func TestServeGetUserProfile(t *testing.T) {
 	tests := []struct {
 		name string
 
+		target  string
 		want    userProfile
 		wantErr bool
 
 		mockArgs *mockArgs
 	}{
 		{
-			name: "ok",
+			name:   "ok",
+			target: "/userprofile/v1/profile",
 			want: userProfile{
 				Timezone:          timezone,
 				OnboardingVersion: onboardingVersion,
 				LogColumns:        logColumns,
+				Preferences:       newUserPreferences(prefs),
+			},
+			mockArgs: &mockArgs{
+				req: types.GetOrCreateUserProfileRequest{
+					UserName: userName,
+				},
+				resp: types.UserProfile{
+					ID:                1,
+					UserName:          userName,
+					OnboardingVersion: onboardingVersion,
+					Preferences:       prefs,
+				},
+			},
+		},
+		{
+			name:   "ok_env",
+			target: "/userprofile/v1/profile?env=" + env,
+			want: userProfile{
+				Timezone:          timezone,
+				OnboardingVersion: onboardingVersion,
+				LogColumns:        envLogColumns,
+				Preferences:       newUserPreferences(prefs.ForEnv(env)),
 			},
 			mockArgs: &mockArgs{
 				req: types.GetOrCreateUserProfileRequest{
@@ -47,14 +81,14 @@ func TestServeGetUserProfile(t *testing.T) {
 				resp: types.UserProfile{
 					ID:                1,
 					UserName:          userName,
-					Timezone:          timezone,
 					OnboardingVersion: onboardingVersion,
-					LogColumns:        types.LogColumns{LogColumns: logColumns},
+					Preferences:       prefs,
 				},
 			},
 		},
 		{
 			name:    "err_svc",
+			target:  "/userprofile/v1/profile",
 			wantErr: true,
 			mockArgs: &mockArgs{
 				req: types.GetOrCreateUserProfileRequest{
@@ -85,7 +119,7 @@ func TestServeGetUserProfile(t *testing.T) {
 
 			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, userProfile]{
 				Method:  http.MethodGet,
-				Target:  "/userprofile/v1/profile",
+				Target:  tt.target,
 				Handler: withUser(api.serveGetUserProfile, userName),
 				Want:    tt.want,
 				WantErr: tt.wantErr,
@@ -179,3 +213,129 @@ func TestServeUpdateUserProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestServeUpdateUserPreferences(t *testing.T) {
+	var (
+		userName = "unnamed"
+		env      = "prod"
+		updated  = types.UserPreferences{
+			Version:  types.UserPreferencesVersion,
+			Timezone: "UTC",
+			PageSize: 100,
+			Theme:    types.ThemeDark,
+		}
+	)
+
+	type mockArgs struct {
+		req  types.UpdateUserPreferencesRequest
+		resp types.UserPreferences
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		req     updateUserPreferencesRequest
+		want    updateUserPreferencesResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			req: updateUserPreferencesRequest{
+				Preferences: userPreferences{
+					PageSize: 100,
+					Theme:    "dark",
+				},
+				UpdateMask: []string{"page_size", "theme"},
+			},
+			want: updateUserPreferencesResponse{
+				Preferences: newUserPreferences(updated),
+			},
+			mockArgs: &mockArgs{
+				req: types.UpdateUserPreferencesRequest{
+					UserName: userName,
+					Preferences: types.UserPreferences{
+						PageSize: 100,
+						Theme:    types.ThemeDark,
+					},
+					Paths: []string{"page_size", "theme"},
+				},
+				resp: updated,
+			},
+		},
+		{
+			name: "ok_env",
+			req: updateUserPreferencesRequest{
+				Preferences: userPreferences{
+					Envs: map[string]envPreferences{
+						env: {PinnedFields: []string{"level"}},
+					},
+				},
+				UpdateMask: []string{"pinned_fields"},
+				Env:        env,
+			},
+			want: updateUserPreferencesResponse{
+				Preferences: newUserPreferences(updated),
+			},
+			mockArgs: &mockArgs{
+				req: types.UpdateUserPreferencesRequest{
+					UserName: userName,
+					Env:      env,
+					Preferences: types.UserPreferences{
+						Envs: map[string]types.EnvPreferences{
+							env: {PinnedFields: []string{"level"}},
+						},
+					},
+					Paths: []string{"pinned_fields"},
+				},
+				resp: updated,
+			},
+		},
+		{
+			name: "err_svc",
+			req: updateUserPreferencesRequest{
+				Preferences: userPreferences{
+					PageSize: 100,
+				},
+				UpdateMask: []string{"page_size"},
+			},
+			wantErr: true,
+			mockArgs: &mockArgs{
+				req: types.UpdateUserPreferencesRequest{
+					UserName: userName,
+					Preferences: types.UserPreferences{
+						PageSize: 100,
+					},
+					Paths: []string{"page_size"},
+				},
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					UpdateUserPreferences(gomock.Any(), tt.mockArgs.req).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[updateUserPreferencesRequest, updateUserPreferencesResponse]{
+				Method:  http.MethodPatch,
+				Target:  "/userprofile/v1/profile/preferences",
+				Req:     tt.req,
+				Handler: withUser(api.serveUpdateUserPreferences, userName),
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
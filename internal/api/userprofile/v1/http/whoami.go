@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/tracing"
+)
+
+// serveWhoAmI go doc.
+//
+//	@Router		/userprofile/v1/whoami [get]
+//	@ID			userprofile_v1_whoAmI
+//	@Tags		userprofile_v1
+//	@Success	200		{object}	whoAmIResponse	"A successful response"
+//	@Failure	default	{object}	httputil.Error	"An unexpected error response"
+//	@Security	bearer
+func (a *API) serveWhoAmI(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "userprofile_v1_whoami")
+	defer span.End()
+
+	wr := httputil.NewWriter(w)
+
+	res, err := a.service.WhoAmI(ctx)
+	if err != nil {
+		httputil.ProcessError(wr, err)
+		return
+	}
+
+	wr.WriteJson(newWhoAmIResponse(res))
+}
+
+type whoAmIResponse struct {
+	UserName    string   `json:"userName"`
+	Groups      []string `json:"groups"`
+	RBACEnabled bool     `json:"rbacEnabled"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+} //	@name	userprofile.v1.WhoAmIResponse
+
+func newWhoAmIResponse(w types.WhoAmI) whoAmIResponse {
+	return whoAmIResponse{
+		UserName:    w.UserName,
+		Groups:      w.Groups,
+		RBACEnabled: w.RBACEnabled,
+		Roles:       w.Roles,
+		Permissions: w.Permissions,
+	}
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestServeWhoAmI(t *testing.T) {
+	type mockArgs struct {
+		resp types.WhoAmI
+		err  error
+	}
+
+	tests := []struct {
+		name string
+
+		want    whoAmIResponse
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+			want: whoAmIResponse{
+				UserName:    "unnamed",
+				Groups:      []string{"sre"},
+				RBACEnabled: true,
+				Roles:       []string{"exporter"},
+				Permissions: []string{"massexport:start"},
+			},
+			mockArgs: &mockArgs{
+				resp: types.WhoAmI{
+					UserName:    "unnamed",
+					Groups:      []string{"sre"},
+					RBACEnabled: true,
+					Roles:       []string{"exporter"},
+					Permissions: []string{"massexport:start"},
+				},
+			},
+		},
+		{
+			name:    "err_svc",
+			wantErr: true,
+			mockArgs: &mockArgs{
+				err: errSomethingWrong,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, mockedSvc := setupTestAPI(t)
+
+			if tt.mockArgs != nil {
+				mockedSvc.EXPECT().
+					WhoAmI(gomock.Any()).
+					Return(tt.mockArgs.resp, tt.mockArgs.err).
+					Times(1)
+			}
+
+			httputil.DoTestHTTPEx(t, httputil.TestDataHTTPEx[struct{}, whoAmIResponse]{
+				Method:  http.MethodGet,
+				Target:  "/userprofile/v1/whoami",
+				Handler: api.serveWhoAmI,
+				Want:    tt.want,
+				WantErr: tt.wantErr,
+			})
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+// APIKeyProvider verifies the static API keys of the services.
+type APIKeyProvider interface {
+	// Verify returns the name of the key.
+	Verify(key string) (string, error)
+}
+
+type apiKeyProvider struct {
+	// names contains the key names by SHA-256 of the keys,
+	// so the lookup time depends on the hash, not on the key itself.
+	names map[[sha256.Size]byte]string
+}
+
+// NewAPIKeyProvider returns the provider of the keys from config, the keys are stored as hashes.
+func NewAPIKeyProvider(keys []config.APIKey) (APIKeyProvider, error) {
+	names := make(map[[sha256.Size]byte]string, len(keys))
+	for _, k := range keys {
+		hash, err := hex.DecodeString(k.Hash)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid hash of api key %q: must be hex encoded SHA-256", k.Name)
+		}
+		if name, ok := names[[sha256.Size]byte(hash)]; ok {
+			return nil, fmt.Errorf("api keys %q and %q have the same hash", name, k.Name)
+		}
+		names[[sha256.Size]byte(hash)] = k.Name
+	}
+	return &apiKeyProvider{names: names}, nil
+}
+
+func (p *apiKeyProvider) Verify(key string) (string, error) {
+	if key == "" {
+		return "", errors.New("empty api key")
+	}
+	name, ok := p.names[sha256.Sum256([]byte(key))]
+	if !ok {
+		return "", errors.New("unknown api key")
+	}
+	return name, nil
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+func TestAPIKeyProvider(t *testing.T) {
+	hash := func(key string) string {
+		sum := sha256.Sum256([]byte(key))
+		return hex.EncodeToString(sum[:])
+	}
+
+	p, err := NewAPIKeyProvider([]config.APIKey{
+		{Name: "robot-1", Hash: hash("key-1")},
+		{Name: "robot-2", Hash: hash("key-2")},
+	})
+	require.NoError(t, err)
+
+	name, err := p.Verify("key-2")
+	require.NoError(t, err)
+	require.Equal(t, "robot-2", name)
+
+	_, err = p.Verify("key-3")
+	require.Error(t, err)
+	_, err = p.Verify("")
+	require.Error(t, err)
+
+	// invalid hash
+	_, err = NewAPIKeyProvider([]config.APIKey{{Name: "robot", Hash: "key"}})
+	require.Error(t, err)
+	// duplicate keys
+	_, err = NewAPIKeyProvider([]config.APIKey{
+		{Name: "robot-1", Hash: hash("key")},
+		{Name: "robot-2", Hash: hash("key")},
+	})
+	require.Error(t, err)
+}
@@ -1,15 +1,36 @@
 package auth
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v4"
 	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
 )
 
+const jwksTimeout = 5 * time.Second
+
 type JWTClaims struct {
 	Name string `json:"name"`
+	// Scopes are the APIs the token grants access to, set only for the personal API tokens.
+	Scopes []string `json:"scopes,omitempty"`
+	// External is set if the token was issued by the trusted issuer, not by seq-ui.
+	External bool `json:"-"`
 
 	jwt.StandardClaims
 }
@@ -17,26 +38,102 @@ type JWTClaims struct {
 type JWTProvider interface {
 	Verify(token string) (*JWTClaims, error)
 	IssueToken(name string, exp int64) (string, error)
+	IssueTokenWithClaims(claims JWTClaims) (string, error)
+	// JWKS returns the public keys verifying the issued tokens in JWK set format.
+	JWKS() []byte
+}
+
+// jwtKey is the asymmetric key, the private key is nil if the key only verifies the tokens.
+type jwtKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+type trustedIssuer struct {
+	keySet   *oidc.RemoteKeySet
+	audience string
 }
 
 type jwtProvider struct {
 	secretKey string
+	// signingKey signs the new tokens, the secret key is used if it's nil.
+	signingKey *jwtKey
+	keys       map[string]*jwtKey
+	jwks       []byte
+	issuers    map[string]trustedIssuer
 }
 
 func NewJWTProvider(secretKey string) JWTProvider {
 	return &jwtProvider{
 		secretKey: secretKey,
+		jwks:      []byte(`{"keys":[]}`),
+	}
+}
+
+// NewJWTProviderWithKeys returns the provider signing the tokens with the first of the asymmetric keys
+// or with the secret key if there are no keys. The tokens signed with the secret key, with any of the keys
+// or by the trusted issuers are verified.
+func NewJWTProviderWithKeys(ctx context.Context, secretKey string, cfg *config.JWT) (JWTProvider, error) {
+	p := &jwtProvider{
+		secretKey: secretKey,
+		keys:      map[string]*jwtKey{},
+		issuers:   map[string]trustedIssuer{},
+	}
+	if cfg == nil {
+		cfg = &config.JWT{}
+	}
+	jwks := jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(cfg.Keys))}
+	for _, k := range cfg.Keys {
+		key, err := parseJWTKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwt key %q: %w", k.KID, err)
+		}
+		if p.signingKey == nil && key.private != nil {
+			p.signingKey = key
+		}
+		p.keys[key.kid] = key
+		jwks.Keys = append(jwks.Keys, jose.JSONWebKey{
+			Key:       key.public,
+			KeyID:     key.kid,
+			Algorithm: key.method.Alg(),
+			Use:       "sig",
+		})
+	}
+
+	var err error
+	p.jwks, err = json.Marshal(jwks)
+	if err != nil {
+		return nil, fmt.Errorf("marshal jwks: %w", err)
+	}
+
+	if len(cfg.TrustedIssuers) > 0 {
+		keySetCtx := oidc.ClientContext(ctx, &http.Client{Timeout: jwksTimeout})
+		for _, iss := range cfg.TrustedIssuers {
+			p.issuers[iss.Issuer] = trustedIssuer{
+				keySet:   oidc.NewRemoteKeySet(keySetCtx, iss.JWKSURL),
+				audience: iss.Audience,
+			}
+		}
 	}
+	return p, nil
 }
 
-// Verify checks whether the token was created using the configuration of this provider and is still valid.
+// Verify checks whether the token was created using the configuration of this provider
+// or by one of the trusted issuers and is still valid.
 func (j *jwtProvider) Verify(token string) (*JWTClaims, error) {
-	parsedToken, err := jwt.ParseWithClaims(token, &JWTClaims{}, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+	if len(j.issuers) > 0 {
+		unverified := &JWTClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(token, unverified); err != nil {
+			return nil, err
 		}
-		return []byte(j.secretKey), nil
-	})
+		if iss, ok := j.issuers[unverified.Issuer]; ok {
+			return iss.verify(token)
+		}
+	}
+
+	parsedToken, err := jwt.ParseWithClaims(token, &JWTClaims{}, j.keyFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +146,72 @@ func (j *jwtProvider) Verify(token string) (*JWTClaims, error) {
 	return nil, errors.New("invalid token: invalid claims")
 }
 
+func (j *jwtProvider) keyFunc(t *jwt.Token) (any, error) {
+	switch t.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if j.secretKey == "" {
+			return nil, errors.New("tokens signed with secret key are disabled")
+		}
+		return []byte(j.secretKey), nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		kid, _ := t.Header["kid"].(string)
+		key, ok := j.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		// the algorithm must be the one of the key, otherwise the signature can't be trusted
+		if t.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method of key %q: %v", kid, t.Header["alg"])
+		}
+		return key.public, nil
+	}
+	return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+}
+
+// verify checks the token signature by the issuer JWKS, the keys are refetched if the key id is unknown.
+func (iss trustedIssuer) verify(token string) (*JWTClaims, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksTimeout)
+	defer cancel()
+
+	payload, err := iss.keySet.VerifySignature(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify signature: %w", err)
+	}
+
+	// the registered claims are used, since other issuers can set audience as array
+	claims := struct {
+		Name string `json:"name"`
+		jwt.RegisteredClaims
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if err := claims.Valid(); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if iss.audience != "" && !claims.VerifyAudience(iss.audience, true) {
+		return nil, errors.New("invalid token: unexpected audience")
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.Subject
+	}
+	res := &JWTClaims{
+		Name:     name,
+		External: true,
+		StandardClaims: jwt.StandardClaims{
+			Issuer:  claims.Issuer,
+			Subject: claims.Subject,
+			Id:      claims.ID,
+		},
+	}
+	if claims.ExpiresAt != nil {
+		res.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+	return res, nil
+}
+
 // IssueToken maps args to claims and creates a new signed token string.
 // Expiration must be passed as unix timestamp in seconds.
 func (j *jwtProvider) IssueToken(name string, exp int64) (string, error) {
@@ -59,10 +222,109 @@ func (j *jwtProvider) IssueToken(name string, exp int64) (string, error) {
 	if exp > 0 {
 		claims["exp"] = exp
 	}
+	return j.sign(claims)
+}
+
+// IssueTokenWithClaims creates a new signed token string with the provided claims.
+// If issued at is not set, the current time is used.
+func (j *jwtProvider) IssueTokenWithClaims(claims JWTClaims) (string, error) {
+	if claims.IssuedAt == 0 {
+		claims.IssuedAt = time.Now().Unix()
+	}
+	return j.sign(claims)
+}
+
+func (j *jwtProvider) sign(claims jwt.Claims) (string, error) {
+	if j.signingKey != nil {
+		token := jwt.NewWithClaims(j.signingKey.method, claims)
+		token.Header["kid"] = j.signingKey.kid
+		return token.SignedString(j.signingKey.private)
+	}
+	if j.secretKey == "" {
+		return "", errors.New("no key to sign the token")
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenStr, err := token.SignedString([]byte(j.secretKey))
-	if err != nil {
-		return "", err
+	return token.SignedString([]byte(j.secretKey))
+}
+
+func (j *jwtProvider) JWKS() []byte {
+	return j.jwks
+}
+
+func parseJWTKey(k config.JWTKey) (*jwtKey, error) {
+	key := &jwtKey{kid: k.KID}
+	if k.PrivateKey != "" {
+		block, err := readPEMBlock(k.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		if key.private, err = parsePrivateKey(block); err != nil {
+			return nil, err
+		}
+		key.public = key.private.Public()
+	} else {
+		block, err := readPEMBlock(k.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if key.public, err = parsePublicKey(block); err != nil {
+			return nil, err
+		}
+	}
+
+	switch public := key.public.(type) {
+	case *rsa.PublicKey:
+		key.method = jwt.SigningMethodRS256
+	case *ecdsa.PublicKey:
+		if public.Curve != elliptic.P256() {
+			return nil, errors.New("only P-256 curve is supported for ECDSA keys")
+		}
+		key.method = jwt.SigningMethodES256
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key.public)
+	}
+	return key, nil
+}
+
+// readPEMBlock decodes the PEM encoded content or the content of the PEM file.
+func readPEMBlock(content string) (*pem.Block, error) {
+	data := []byte(content)
+	if !strings.Contains(content, "-----BEGIN") {
+		var err error
+		if data, err = os.ReadFile(content); err != nil {
+			return nil, fmt.Errorf("can't read key file=%q: %w", content, err)
+		}
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("key is not PEM encoded")
+	}
+	return block, nil
+}
+
+func parsePrivateKey(block *pem.Block) (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("can't parse private key: PKCS #8, PKCS #1 or SEC 1 key expected")
+}
+
+func parsePublicKey(block *pem.Block) (crypto.PublicKey, error) {
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
 	}
-	return tokenStr, nil
+	return nil, errors.New("can't parse public key: PKIX or PKCS #1 key expected")
 }
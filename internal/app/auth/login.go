@@ -0,0 +1,312 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+const (
+	loginStateTTL = 10 * time.Minute
+	// sessionRefreshLeeway renews the tokens a bit before they expire.
+	sessionRefreshLeeway = 30 * time.Second
+)
+
+// SessionProvider verifies the browser sessions.
+type SessionProvider interface {
+	VerifySession(ctx context.Context, sessionID string) (OIDCToken, error)
+}
+
+// OIDCLogin implements the browser login flow: authorization code with PKCE.
+// The sessions are stored in redis and identified by the session cookie.
+type OIDCLogin struct {
+	oauth2Cfg     oauth2.Config
+	verifier      *oidc.IDTokenVerifier
+	endSessionURL string
+	httpClient    *http.Client
+
+	store        sessionStore
+	cfg          config.OIDCLogin
+	groupsClaims []string
+
+	refreshGroup singleflight.Group
+	nowFn        func() time.Time
+}
+
+func NewOIDCLogin(ctx context.Context, cfg *config.OIDC) (*OIDCLogin, error) {
+	httpClient, err := newHTTPClient(httpContextCfg{
+		rootCA:        cfg.RootCA,
+		caCert:        cfg.CACert,
+		privateKey:    cfg.PrivateKey,
+		sslSkipVerify: cfg.SSLSkipVerify,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, httpClient), cfg.Login.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("init OIDC provider: %w", err)
+	}
+
+	var providerClaims struct {
+		EndSessionURL string `json:"end_session_endpoint"`
+	}
+	if err = provider.Claims(&providerClaims); err != nil {
+		return nil, fmt.Errorf("parse OIDC provider claims: %w", err)
+	}
+
+	store, err := newRedisSessionStore(ctx, &cfg.Login.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("init session store: %w", err)
+	}
+
+	verifier := provider.Verifier(&oidc.Config{
+		ClientID:                   cfg.Login.ClientID,
+		InsecureSkipSignatureCheck: cfg.SkipVerify,
+	})
+
+	l := newOIDCLogin(*cfg.Login, cfg.GroupsClaims, provider.Endpoint(), verifier, store)
+	l.endSessionURL = providerClaims.EndSessionURL
+	l.httpClient = httpClient
+	return l, nil
+}
+
+func newOIDCLogin(
+	cfg config.OIDCLogin, groupsClaims []string,
+	endpoint oauth2.Endpoint, verifier *oidc.IDTokenVerifier, store sessionStore,
+) *OIDCLogin {
+	return &OIDCLogin{
+		oauth2Cfg: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			Endpoint:     endpoint,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+		},
+		verifier:     verifier,
+		store:        store,
+		cfg:          cfg,
+		groupsClaims: groupsClaims,
+		nowFn:        time.Now,
+	}
+}
+
+// Config returns the login config.
+func (l *OIDCLogin) Config() config.OIDCLogin {
+	return l.cfg
+}
+
+// LoginURL starts the login and returns the URL of the OIDC provider to redirect the user to
+// and the state, which must be bound to the browser. The redirect is returned on the login completion.
+func (l *OIDCLogin) LoginURL(ctx context.Context, redirect string) (string, string, error) {
+	state, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	err = l.store.setLoginState(ctx, state, loginState{
+		Verifier: verifier,
+		Nonce:    nonce,
+		Redirect: redirect,
+	}, loginStateTTL)
+	if err != nil {
+		return "", "", fmt.Errorf("save login state: %w", err)
+	}
+
+	return l.oauth2Cfg.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oidc.Nonce(nonce)), state, nil
+}
+
+// Callback completes the login with the code issued by the OIDC provider.
+// Returns ID of the new session and the redirect passed to LoginURL.
+func (l *OIDCLogin) Callback(ctx context.Context, state, code string) (string, string, error) {
+	ls, err := l.store.popLoginState(ctx, state)
+	if err != nil {
+		return "", "", err
+	}
+
+	token, err := l.oauth2Cfg.Exchange(l.clientContext(ctx), code, oauth2.VerifierOption(ls.Verifier))
+	if err != nil {
+		return "", "", fmt.Errorf("exchange code: %w", err)
+	}
+
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return "", "", errors.New("no id_token in token response")
+	}
+	idToken, err := l.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return "", "", fmt.Errorf("verify id_token: %w", err)
+	}
+	if idToken.Nonce != ls.Nonce {
+		return "", "", errors.New("invalid id_token nonce")
+	}
+
+	sess, err := l.newSession(idToken, rawIDToken, token)
+	if err != nil {
+		return "", "", err
+	}
+
+	sessionID, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+	if err = l.store.setSession(ctx, sessionID, sess, l.cfg.SessionTTL); err != nil {
+		return "", "", fmt.Errorf("save session: %w", err)
+	}
+
+	return sessionID, ls.Redirect, nil
+}
+
+// VerifySession returns the user of the session. The expired tokens are renewed with the refresh token,
+// the session is deleted if it's impossible.
+func (l *OIDCLogin) VerifySession(ctx context.Context, sessionID string) (OIDCToken, error) {
+	sess, err := l.store.getSession(ctx, sessionID)
+	if err != nil {
+		return OIDCToken{}, err
+	}
+
+	if l.nowFn().Add(sessionRefreshLeeway).After(sess.Expiry) {
+		if sess, err = l.refresh(ctx, sessionID, sess); err != nil {
+			return OIDCToken{}, err
+		}
+	}
+
+	return OIDCToken{
+		UserName: sess.UserName,
+		Groups:   sess.Groups,
+	}, nil
+}
+
+// Logout deletes the session. Returns the URL to end the session in the OIDC provider,
+// it's empty if the provider doesn't support it.
+func (l *OIDCLogin) Logout(ctx context.Context, sessionID string) (string, error) {
+	sess, err := l.store.getSession(ctx, sessionID)
+	if errors.Is(err, errSessionNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if err = l.store.deleteSession(ctx, sessionID); err != nil {
+		return "", fmt.Errorf("delete session: %w", err)
+	}
+
+	if l.endSessionURL == "" {
+		return "", nil
+	}
+	u, err := url.Parse(l.endSessionURL)
+	if err != nil {
+		return "", fmt.Errorf("parse end session url: %w", err)
+	}
+	q := u.Query()
+	q.Set("id_token_hint", sess.IDToken)
+	q.Set("client_id", l.cfg.ClientID)
+	if l.cfg.PostLogoutRedirectURL != "" {
+		q.Set("post_logout_redirect_uri", l.cfg.PostLogoutRedirectURL)
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// refresh renews the tokens of the session. Concurrent requests of the session share the single renewal,
+// since the refresh token can be rotated by the OIDC provider.
+func (l *OIDCLogin) refresh(ctx context.Context, sessionID string, sess session) (session, error) {
+	if sess.RefreshToken == "" {
+		_ = l.store.deleteSession(ctx, sessionID)
+		return session{}, errors.New("session expired")
+	}
+
+	v, err, _ := l.refreshGroup.Do(sessionKey(sessionID), func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+
+		token, err := l.oauth2Cfg.TokenSource(l.clientContext(ctx), &oauth2.Token{
+			RefreshToken: sess.RefreshToken,
+		}).Token()
+		if err != nil {
+			var retrieveErr *oauth2.RetrieveError
+			if errors.As(err, &retrieveErr) {
+				// refresh token is rejected, so the session can't be renewed anymore
+				_ = l.store.deleteSession(ctx, sessionID)
+			}
+			return session{}, fmt.Errorf("refresh token: %w", err)
+		}
+
+		newSess := sess
+		newSess.RefreshToken = token.RefreshToken
+		newSess.Expiry = token.Expiry
+		if rawIDToken, _ := token.Extra("id_token").(string); rawIDToken != "" {
+			idToken, err := l.verifier.Verify(ctx, rawIDToken)
+			if err != nil {
+				return session{}, fmt.Errorf("verify id_token: %w", err)
+			}
+			if newSess, err = l.newSession(idToken, rawIDToken, token); err != nil {
+				return session{}, err
+			}
+		}
+		if newSess.Expiry.IsZero() {
+			return session{}, errors.New("no expiry of refreshed token")
+		}
+
+		if err = l.store.setSession(ctx, sessionID, newSess, 0); err != nil {
+			return session{}, fmt.Errorf("save session: %w", err)
+		}
+		return newSess, nil
+	})
+	if err != nil {
+		return session{}, err
+	}
+	return v.(session), nil
+}
+
+func (l *OIDCLogin) newSession(idToken *oidc.IDToken, rawIDToken string, token *oauth2.Token) (session, error) {
+	oidcToken, err := newOIDCToken(idToken, l.groupsClaims)
+	if err != nil {
+		return session{}, err
+	}
+
+	expiry := token.Expiry
+	if expiry.IsZero() {
+		expiry = idToken.Expiry
+	}
+
+	return session{
+		UserName:     oidcToken.UserName,
+		Groups:       oidcToken.Groups,
+		IDToken:      rawIDToken,
+		RefreshToken: token.RefreshToken,
+		Expiry:       expiry,
+	}, nil
+}
+
+func (l *OIDCLogin) clientContext(ctx context.Context) context.Context {
+	if l.httpClient == nil {
+		return ctx
+	}
+	return oidc.ClientContext(ctx, l.httpClient)
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random string: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+type memSessionStore struct {
+	mu          sync.Mutex
+	sessions    map[string]session
+	loginStates map[string]loginState
+}
+
+func newMemSessionStore() *memSessionStore {
+	return &memSessionStore{
+		sessions:    map[string]session{},
+		loginStates: map[string]loginState{},
+	}
+}
+
+func (s *memSessionStore) setSession(_ context.Context, id string, sess session, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[id] = sess
+	return nil
+}
+
+func (s *memSessionStore) getSession(_ context.Context, id string) (session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[id]
+	if !ok {
+		return session{}, errSessionNotFound
+	}
+	return sess, nil
+}
+
+func (s *memSessionStore) deleteSession(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, id)
+	return nil
+}
+
+func (s *memSessionStore) setLoginState(_ context.Context, state string, ls loginState, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loginStates[state] = ls
+	return nil
+}
+
+func (s *memSessionStore) popLoginState(_ context.Context, state string) (loginState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ls, ok := s.loginStates[state]
+	if !ok {
+		return loginState{}, errSessionNotFound
+	}
+	delete(s.loginStates, state)
+	return ls, nil
+}
+
+// fakeOIDCServer is the token endpoint of the OIDC provider.
+type fakeOIDCServer struct {
+	t        *testing.T
+	key      *rsa.PrivateKey
+	issuer   string
+	clientID string
+
+	mu            sync.Mutex
+	codeChallenge string
+	nonce         string
+	groups        []string
+	refreshToken  string
+	rejectRefresh bool
+}
+
+func (f *fakeOIDCServer) idToken(nonce string) string {
+	claims := jwt.MapClaims{
+		"iss":                f.issuer,
+		"aud":                f.clientID,
+		"sub":                "user-id",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"iat":                time.Now().Unix(),
+		"preferred_username": "user",
+		"groups":             f.groups,
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(f.key)
+	require.NoError(f.t, err)
+	return token
+}
+
+func (f *fakeOIDCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	require.NoError(f.t, r.ParseForm())
+
+	var nonce string
+	switch r.Form.Get("grant_type") {
+	case "authorization_code":
+		hash := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+		if r.Form.Get("code") != "code" || base64.RawURLEncoding.EncodeToString(hash[:]) != f.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		nonce = f.nonce
+	case "refresh_token":
+		if f.rejectRefresh || r.Form.Get("refresh_token") != f.refreshToken {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+	}
+
+	f.refreshToken += "+"
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token":  "access",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"refresh_token": f.refreshToken,
+		"id_token":      f.idToken(nonce),
+	})
+}
+
+func setupTestLogin(t *testing.T) (*OIDCLogin, *fakeOIDCServer, *memSessionStore) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	fake := &fakeOIDCServer{
+		t:            t,
+		key:          key,
+		issuer:       "https://oidc.example.com",
+		clientID:     "seq-ui",
+		groups:       []string{"devs"},
+		refreshToken: "refresh",
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	verifier := oidc.NewVerifier(fake.issuer, &oidc.StaticKeySet{
+		PublicKeys: []crypto.PublicKey{&key.PublicKey},
+	}, &oidc.Config{ClientID: fake.clientID})
+
+	store := newMemSessionStore()
+	l := newOIDCLogin(config.OIDCLogin{
+		ClientID:              fake.clientID,
+		RedirectURL:           "https://seq-ui.example.com/auth/callback",
+		Scopes:                []string{"openid"},
+		PostLogoutRedirectURL: "https://seq-ui.example.com/",
+	}, []string{"groups"}, oauth2.Endpoint{
+		AuthURL:   fake.issuer + "/auth",
+		TokenURL:  srv.URL,
+		AuthStyle: oauth2.AuthStyleInParams,
+	}, verifier, store)
+	l.endSessionURL = fake.issuer + "/logout"
+
+	return l, fake, store
+}
+
+func TestOIDCLogin(t *testing.T) {
+	ctx := context.Background()
+	l, fake, store := setupTestLogin(t)
+
+	loginURL, state, err := l.LoginURL(ctx, "/dashboards")
+	require.NoError(t, err)
+
+	u, err := url.Parse(loginURL)
+	require.NoError(t, err)
+	q := u.Query()
+	require.Equal(t, state, q.Get("state"))
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+	require.Equal(t, "seq-ui", q.Get("client_id"))
+	require.NotEmpty(t, q.Get("nonce"))
+
+	fake.codeChallenge = q.Get("code_challenge")
+	fake.nonce = q.Get("nonce")
+
+	sessionID, redirect, err := l.Callback(ctx, state, "code")
+	require.NoError(t, err)
+	require.Equal(t, "/dashboards", redirect)
+
+	// login state can be used only once
+	_, _, err = l.Callback(ctx, state, "code")
+	require.Error(t, err)
+
+	user, err := l.VerifySession(ctx, sessionID)
+	require.NoError(t, err)
+	require.Equal(t, OIDCToken{UserName: "user", Groups: []string{"devs"}}, user)
+
+	// tokens are expired, so they are renewed
+	fake.groups = []string{"admins"}
+	l.nowFn = func() time.Time { return time.Now().Add(2 * time.Hour) }
+
+	user, err = l.VerifySession(ctx, sessionID)
+	require.NoError(t, err)
+	require.Equal(t, OIDCToken{UserName: "user", Groups: []string{"admins"}}, user)
+
+	sess, err := store.getSession(ctx, sessionID)
+	require.NoError(t, err)
+	require.Equal(t, fake.refreshToken, sess.RefreshToken)
+
+	endSessionURL, err := l.Logout(ctx, sessionID)
+	require.NoError(t, err)
+	u, err = url.Parse(endSessionURL)
+	require.NoError(t, err)
+	require.Equal(t, sess.IDToken, u.Query().Get("id_token_hint"))
+	require.Equal(t, "https://seq-ui.example.com/", u.Query().Get("post_logout_redirect_uri"))
+
+	_, err = l.VerifySession(ctx, sessionID)
+	require.Error(t, err)
+}
+
+func TestOIDCLoginCallbackErrors(t *testing.T) {
+	ctx := context.Background()
+	l, fake, _ := setupTestLogin(t)
+
+	_, _, err := l.Callback(ctx, "unknown", "code")
+	require.Error(t, err)
+
+	loginURL, state, err := l.LoginURL(ctx, "/")
+	require.NoError(t, err)
+	u, err := url.Parse(loginURL)
+	require.NoError(t, err)
+
+	// PKCE verifier doesn't match the challenge
+	fake.codeChallenge = "other"
+	fake.nonce = u.Query().Get("nonce")
+	_, _, err = l.Callback(ctx, state, "code")
+	require.Error(t, err)
+
+	loginURL, state, err = l.LoginURL(ctx, "/")
+	require.NoError(t, err)
+	u, err = url.Parse(loginURL)
+	require.NoError(t, err)
+
+	// nonce doesn't match
+	fake.codeChallenge = u.Query().Get("code_challenge")
+	fake.nonce = "other"
+	_, _, err = l.Callback(ctx, state, "code")
+	require.Error(t, err)
+}
+
+func TestOIDCLoginRefreshRejected(t *testing.T) {
+	ctx := context.Background()
+	l, fake, store := setupTestLogin(t)
+
+	require.NoError(t, store.setSession(ctx, "session-id", session{
+		UserName:     "user",
+		RefreshToken: fake.refreshToken,
+		Expiry:       time.Now().Add(-time.Minute),
+	}, time.Hour))
+	fake.rejectRefresh = true
+
+	_, err := l.VerifySession(ctx, "session-id")
+	require.Error(t, err)
+
+	// session can't be renewed anymore, so it's deleted
+	_, err = store.getSession(ctx, "session-id")
+	require.ErrorIs(t, err, errSessionNotFound)
+}
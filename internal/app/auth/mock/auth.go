@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ozontech/seq-ui/internal/app/auth (interfaces: OIDCProvider,JWTProvider)
+// Source: github.com/ozontech/seq-ui/internal/app/auth (interfaces: OIDCProvider,JWTProvider,SessionProvider)
 //
 // Generated by this command:
 //
-//	mockgen -destination=internal/app/auth/mock/auth.go github.com/ozontech/seq-ui/internal/app/auth OIDCProvider,JWTProvider
+//	mockgen -destination=internal/app/auth/mock/auth.go github.com/ozontech/seq-ui/internal/app/auth OIDCProvider,JWTProvider,SessionProvider
 //
 
 // Package mock_auth is a generated GoMock package.
@@ -95,6 +95,35 @@ func (mr *MockJWTProviderMockRecorder) IssueToken(name, exp any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockJWTProvider)(nil).IssueToken), name, exp)
 }
 
+// IssueTokenWithClaims mocks base method.
+func (m *MockJWTProvider) IssueTokenWithClaims(claims auth.JWTClaims) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTokenWithClaims", claims)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueTokenWithClaims indicates an expected call of IssueTokenWithClaims.
+func (mr *MockJWTProviderMockRecorder) IssueTokenWithClaims(claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTokenWithClaims", reflect.TypeOf((*MockJWTProvider)(nil).IssueTokenWithClaims), claims)
+}
+
+// JWKS mocks base method.
+func (m *MockJWTProvider) JWKS() []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockJWTProviderMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockJWTProvider)(nil).JWKS))
+}
+
 // Verify mocks base method.
 func (m *MockJWTProvider) Verify(token string) (*auth.JWTClaims, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +138,42 @@ func (mr *MockJWTProviderMockRecorder) Verify(token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockJWTProvider)(nil).Verify), token)
 }
+
+// MockSessionProvider is a mock of SessionProvider interface.
+type MockSessionProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionProviderMockRecorder is the mock recorder for MockSessionProvider.
+type MockSessionProviderMockRecorder struct {
+	mock *MockSessionProvider
+}
+
+// NewMockSessionProvider creates a new mock instance.
+func NewMockSessionProvider(ctrl *gomock.Controller) *MockSessionProvider {
+	mock := &MockSessionProvider{ctrl: ctrl}
+	mock.recorder = &MockSessionProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionProvider) EXPECT() *MockSessionProviderMockRecorder {
+	return m.recorder
+}
+
+// VerifySession mocks base method.
+func (m *MockSessionProvider) VerifySession(ctx context.Context, sessionID string) (auth.OIDCToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySession", ctx, sessionID)
+	ret0, _ := ret[0].(auth.OIDCToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySession indicates an expected call of VerifySession.
+func (mr *MockSessionProviderMockRecorder) VerifySession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySession", reflect.TypeOf((*MockSessionProvider)(nil).VerifySession), ctx, sessionID)
+}
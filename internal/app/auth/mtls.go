@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+// MTLSProvider authenticates the clients by the client certs verified during TLS handshake.
+type MTLSProvider interface {
+	// Verify returns the user name from the client cert.
+	Verify(cert *x509.Certificate) (string, error)
+}
+
+type mtlsProvider struct {
+	userFrom string
+}
+
+// NewMTLSProvider returns the provider taking the user name from the cert field,
+// `cn` for the subject common name or `san` for the first subject alternative name.
+func NewMTLSProvider(userFrom string) MTLSProvider {
+	return &mtlsProvider{userFrom: userFrom}
+}
+
+func (p *mtlsProvider) Verify(cert *x509.Certificate) (string, error) {
+	var userName string
+	if p.userFrom == config.TLSUserFromSAN {
+		userName = certSAN(cert)
+	} else {
+		userName = cert.Subject.CommonName
+	}
+
+	if userName == "" {
+		return "", fmt.Errorf("client cert has no %s", p.userFrom)
+	}
+	return userName, nil
+}
+
+// certSAN returns the first of DNS, URI or email subject alternative names.
+func certSAN(cert *x509.Certificate) string {
+	switch {
+	case len(cert.DNSNames) > 0:
+		return cert.DNSNames[0]
+	case len(cert.URIs) > 0:
+		return cert.URIs[0].String()
+	case len(cert.EmailAddresses) > 0:
+		return cert.EmailAddresses[0]
+	}
+	return ""
+}
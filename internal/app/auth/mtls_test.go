@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+func TestMTLSProvider(t *testing.T) {
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "log-shipper"},
+		DNSNames: []string{"log-shipper.svc.local"},
+	}
+
+	userName, err := NewMTLSProvider(config.TLSUserFromCN).Verify(cert)
+	require.NoError(t, err)
+	require.Equal(t, "log-shipper", userName)
+
+	userName, err = NewMTLSProvider(config.TLSUserFromSAN).Verify(cert)
+	require.NoError(t, err)
+	require.Equal(t, "log-shipper.svc.local", userName)
+
+	spiffeID := &url.URL{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/logs/sa/shipper"}
+	userName, err = NewMTLSProvider(config.TLSUserFromSAN).Verify(&x509.Certificate{URIs: []*url.URL{spiffeID}})
+	require.NoError(t, err)
+	require.Equal(t, "spiffe://cluster.local/ns/logs/sa/shipper", userName)
+
+	_, err = NewMTLSProvider(config.TLSUserFromSAN).Verify(&x509.Certificate{Subject: pkix.Name{CommonName: "log-shipper"}})
+	require.Error(t, err)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"crypto/sha512"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
@@ -22,11 +24,14 @@ import (
 
 const (
 	oidcClientTimeout  = time.Second * 5
-	oidcCacheKeyPrefix = "auth_oidc"
+	oidcCacheKeyPrefix = "auth_oidc_v2"
+
+	userNameClaim = "preferred_username"
 )
 
 type OIDCToken struct {
-	UserName string
+	UserName string   `json:"user_name"`
+	Groups   []string `json:"groups,omitempty"`
 }
 
 type OIDCProvider interface {
@@ -40,6 +45,7 @@ type oidcProvider struct {
 	cacheSecretKey string
 
 	allowedClients []string
+	groupsClaims   []string
 }
 
 func NewOIDCProvider(ctx context.Context, cfg *config.OIDC, cacheCfg config.Cache) (OIDCProvider, error) {
@@ -102,13 +108,10 @@ func NewOIDCProvider(ctx context.Context, cfg *config.OIDC, cacheCfg config.Cach
 		cache:          oidcCache,
 		cacheSecretKey: cfg.CacheSecretKey,
 		allowedClients: cfg.AllowedClients,
+		groupsClaims:   cfg.GroupsClaims,
 	}, nil
 }
 
-type userClaims struct {
-	Username string `json:"preferred_username"`
-}
-
 func (p *oidcProvider) Verify(ctx context.Context, token string) (OIDCToken, error) {
 	var (
 		err      error
@@ -118,8 +121,11 @@ func (p *oidcProvider) Verify(ctx context.Context, token string) (OIDCToken, err
 
 	if p.cacheSecretKey != "" {
 		cacheKey = fmt.Sprintf("%s_%s", oidcCacheKeyPrefix, hashToken(token, p.cacheSecretKey))
-		if userName, err := p.cache.Get(ctx, cacheKey); err == nil {
-			return OIDCToken{UserName: userName}, nil
+		if cached, err := p.cache.Get(ctx, cacheKey); err == nil {
+			var oidcToken OIDCToken
+			if err = json.Unmarshal([]byte(cached), &oidcToken); err == nil {
+				return oidcToken, nil
+			}
 		}
 	}
 
@@ -144,21 +150,66 @@ func (p *oidcProvider) Verify(ctx context.Context, token string) (OIDCToken, err
 		return OIDCToken{}, err
 	}
 
-	oidcClaims := userClaims{}
-	if err = idToken.Claims(&oidcClaims); err != nil {
+	oidcToken, err := newOIDCToken(idToken, p.groupsClaims)
+	if err != nil {
+		return OIDCToken{}, err
+	}
+
+	if p.cacheSecretKey != "" {
+		if cached, err := json.Marshal(oidcToken); err == nil {
+			_ = p.cache.SetWithTTL(ctx, cacheKey, string(cached), time.Until(idToken.Expiry))
+		}
+	}
+
+	return oidcToken, nil
+}
+
+// newOIDCToken returns the user from the verified ID token.
+func newOIDCToken(idToken *oidc.IDToken, groupsClaims []string) (OIDCToken, error) {
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
 		return OIDCToken{}, fmt.Errorf("failed to get user claims: %w", err)
 	}
-	if oidcClaims.Username == "" {
+	userName, _ := claims[userNameClaim].(string)
+	if userName == "" {
 		return OIDCToken{}, errors.New("invalid claims")
 	}
 
-	if p.cacheSecretKey != "" {
-		_ = p.cache.SetWithTTL(ctx, cacheKey, oidcClaims.Username, time.Until(idToken.Expiry))
+	return OIDCToken{
+		UserName: userName,
+		Groups:   extractGroups(claims, groupsClaims),
+	}, nil
+}
+
+// extractGroups collects groups from the claims. Nested claims are addressed with dots,
+// e.g. `realm_access.roles`. Claim value can be either string or list of strings.
+func extractGroups(claims map[string]any, groupsClaims []string) []string {
+	var groups []string
+	for _, path := range groupsClaims {
+		var value any = claims
+		for _, key := range strings.Split(path, ".") {
+			m, ok := value.(map[string]any)
+			if !ok {
+				value = nil
+				break
+			}
+			value = m[key]
+		}
+
+		switch v := value.(type) {
+		case string:
+			groups = append(groups, v)
+		case []any:
+			for _, g := range v {
+				if s, ok := g.(string); ok {
+					groups = append(groups, s)
+				}
+			}
+		}
 	}
 
-	return OIDCToken{
-		UserName: oidcClaims.Username,
-	}, err
+	slices.Sort(groups)
+	return slices.Compact(groups)
 }
 
 func (p *oidcProvider) checkClients(clients []string) error {
@@ -186,11 +237,19 @@ type httpContextCfg struct {
 }
 
 func newHTTPContext(ctx context.Context, conf httpContextCfg) (context.Context, error) {
+	hc, err := newHTTPClient(conf)
+	if err != nil {
+		return nil, err
+	}
+	return oidc.ClientContext(ctx, hc), nil
+}
+
+func newHTTPClient(conf httpContextCfg) (*http.Client, error) {
 	hc := &http.Client{
 		Timeout: oidcClientTimeout,
 	}
 	if conf.isZero() {
-		return oidc.ClientContext(ctx, hc), nil
+		return hc, nil
 	}
 	b := tls.NewConfigBuilder()
 	if conf.sslSkipVerify {
@@ -210,7 +269,7 @@ func newHTTPContext(ctx context.Context, conf httpContextCfg) (context.Context,
 	hc.Transport = &http.Transport{
 		TLSClientConfig: b.Build(),
 	}
-	return oidc.ClientContext(ctx, hc), nil
+	return hc, nil
 }
 
 func (c httpContextCfg) isZero() bool {
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractGroups(t *testing.T) {
+	claims := map[string]any{
+		"groups": []any{"devs", "sre", 1},
+		"role":   "admin",
+		"realm_access": map[string]any{
+			"roles": []any{"sre", "exporters"},
+		},
+	}
+
+	tCases := []struct {
+		name         string
+		groupsClaims []string
+		want         []string
+	}{
+		{
+			name:         "list",
+			groupsClaims: []string{"groups"},
+			want:         []string{"devs", "sre"},
+		},
+		{
+			name:         "string",
+			groupsClaims: []string{"role"},
+			want:         []string{"admin"},
+		},
+		{
+			name:         "nested_merged",
+			groupsClaims: []string{"groups", "realm_access.roles"},
+			want:         []string{"devs", "exporters", "sre"},
+		},
+		{
+			name:         "missing",
+			groupsClaims: []string{"realm_access.groups", "role.name"},
+			want:         nil,
+		},
+	}
+
+	for _, tCase := range tCases {
+		tCase := tCase
+		t.Run(tCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tCase.want, extractGroups(claims, tCase.groupsClaims))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/pkg/redisclient"
+)
+
+const (
+	sessionKeyPrefix    = "auth_session_"
+	loginStateKeyPrefix = "auth_login_state_"
+)
+
+var errSessionNotFound = errors.New("session not found")
+
+// session is the browser session of the user logged in via OIDC.
+type session struct {
+	UserName string   `json:"user_name"`
+	Groups   []string `json:"groups,omitempty"`
+	// IDToken is used as a hint on logout.
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// Expiry is the expiry of the tokens, they are renewed after it.
+	Expiry time.Time `json:"expiry"`
+}
+
+// loginState is the state of the login started in the browser and not completed yet.
+type loginState struct {
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+	Redirect string `json:"redirect"`
+}
+
+// sessionStore stores the sessions and the login states.
+type sessionStore interface {
+	// setSession stores the new session with ttl or updates the existing one keeping its ttl if ttl is zero.
+	setSession(ctx context.Context, id string, s session, ttl time.Duration) error
+	getSession(ctx context.Context, id string) (session, error)
+	deleteSession(ctx context.Context, id string) error
+	setLoginState(ctx context.Context, state string, s loginState, ttl time.Duration) error
+	// popLoginState returns and deletes the login state, so it can be used only once.
+	popLoginState(ctx context.Context, state string) (loginState, error)
+}
+
+type redisSessionStore struct {
+	client *redis.Client
+}
+
+func newRedisSessionStore(ctx context.Context, cfg *config.Redis) (*redisSessionStore, error) {
+	client, err := redisclient.New(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create redis client: %w", err)
+	}
+	return &redisSessionStore{client: client}, nil
+}
+
+func (s *redisSessionStore) setSession(ctx context.Context, id string, sess session, ttl time.Duration) error {
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+	if ttl == 0 {
+		ttl = redis.KeepTTL
+	}
+	return s.client.Set(ctx, sessionKey(id), data, ttl).Err()
+}
+
+func (s *redisSessionStore) getSession(ctx context.Context, id string) (session, error) {
+	data, err := s.client.Get(ctx, sessionKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return session{}, errSessionNotFound
+	} else if err != nil {
+		return session{}, fmt.Errorf("get session: %w", err)
+	}
+
+	var sess session
+	if err = json.Unmarshal(data, &sess); err != nil {
+		return session{}, fmt.Errorf("unmarshal session: %w", err)
+	}
+	return sess, nil
+}
+
+func (s *redisSessionStore) deleteSession(ctx context.Context, id string) error {
+	return s.client.Del(ctx, sessionKey(id)).Err()
+}
+
+func (s *redisSessionStore) setLoginState(ctx context.Context, state string, ls loginState, ttl time.Duration) error {
+	data, err := json.Marshal(ls)
+	if err != nil {
+		return fmt.Errorf("marshal login state: %w", err)
+	}
+	return s.client.Set(ctx, loginStateKeyPrefix+state, data, ttl).Err()
+}
+
+func (s *redisSessionStore) popLoginState(ctx context.Context, state string) (loginState, error) {
+	data, err := s.client.GetDel(ctx, loginStateKeyPrefix+state).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return loginState{}, errors.New("login state not found")
+	} else if err != nil {
+		return loginState{}, fmt.Errorf("get login state: %w", err)
+	}
+
+	var ls loginState
+	if err = json.Unmarshal(data, &ls); err != nil {
+		return loginState{}, fmt.Errorf("unmarshal login state: %w", err)
+	}
+	return ls, nil
+}
+
+// sessionKey returns the key of the session, the session ID itself is not stored.
+func sessionKey(id string) string {
+	hash := sha256.Sum256([]byte(id))
+	return sessionKeyPrefix + hex.EncodeToString(hash[:])
+}
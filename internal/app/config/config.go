@@ -3,7 +3,10 @@ package config
 import (
 	"bytes"
 	"fmt"
+	"net/url"
 	"os"
+	"path"
+	"slices"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -27,6 +30,24 @@ const (
 	FieldFilterModePrefix   = "prefix"
 	FieldFilterModeSuffix   = "suffix"
 
+	ErrorGroupsStorageClickHouse = "clickhouse"
+	ErrorGroupsStoragePostgres   = "postgres"
+
+	AuditSinkPostgres = "postgres"
+	AuditSinkFile     = "file"
+	AuditSinkHTTP     = "http"
+
+	AuthProviderMTLS   = "mtls"
+	AuthProviderAPIKey = "api_key"
+	AuthProviderJWT    = "jwt"
+	AuthProviderOIDC   = "oidc"
+
+	TLSClientAuthRequire       = "require"
+	TLSClientAuthVerifyIfGiven = "verify_if_given"
+
+	TLSUserFromCN  = "cn"
+	TLSUserFromSAN = "san"
+
 	minGRPCKeepaliveTime    = 10 * time.Second
 	minGRPCKeepaliveTimeout = 1 * time.Second
 
@@ -50,6 +71,46 @@ const (
 
 	defaultClickHouseDialTimeout = 5 * time.Second
 	defaultClickHouseReadTimeout = 30 * time.Second
+
+	defaultErrorGroupsDigestCheckInterval   = 5 * time.Minute
+	defaultErrorGroupsDigestGroupsLimit     = 10
+	defaultErrorGroupsDigestWebhookTimeout  = 10 * time.Second
+	defaultErrorGroupsDigestFileStorePrefix = "error_groups_digests"
+
+	defaultDashboardsRenderCacheTTL            = 30 * time.Second
+	defaultDashboardsRenderMaxPanels           = 50
+	defaultDashboardsRenderMaxParallelRequests = 8
+
+	defaultQueryHistoryMaxEntriesPerUser = 1000
+	defaultQueryHistoryRetention         = 30 * 24 * time.Hour
+	defaultQueryHistoryQueueSize         = 10000
+	defaultQueryHistoryBatchSize         = 100
+	defaultQueryHistoryFlushInterval     = time.Second
+
+	defaultAPITokensDefaultTTL         = 90 * 24 * time.Hour
+	defaultAPITokensMaxTTL             = 365 * 24 * time.Hour
+	defaultAPITokensRevocationCacheTTL = time.Minute
+
+	defaultOIDCGroupsClaim = "groups"
+
+	defaultOIDCLoginSessionTTL = 24 * time.Hour
+	defaultOIDCLoginCookieName = "seq_ui_session"
+
+	defaultQueryPolicyReloadInterval = 30 * time.Second
+
+	defaultConcurrencyQueueTimeout = 10 * time.Second
+
+	defaultQueryCostWindow               = time.Minute
+	defaultQueryCostWeightBase           = 1
+	defaultQueryCostWeightPerHour        = 1
+	defaultQueryCostWeightPerAggregation = 0.5
+	defaultQueryCostWeightPerDocument    = 0.01
+	defaultQueryCostWeightWildcard       = 2
+
+	defaultAuditQueueSize     = 10000
+	defaultAuditBatchSize     = 100
+	defaultAuditFlushInterval = time.Second
+	defaultAuditHTTPTimeout   = 5 * time.Second
 )
 
 type Config struct {
@@ -77,6 +138,88 @@ type OIDC struct {
 	SSLSkipVerify  bool     `yaml:"ssl_skip_verify"`
 	AllowedClients []string `yaml:"allowed_clients"`
 	CacheSecretKey string   `yaml:"cache_secret_key"`
+	GroupsClaims   []string `yaml:"groups_claims"`
+	// Login enables the browser login flow with the session cookies.
+	Login *OIDCLogin `yaml:"login"`
+}
+
+// OIDCLogin is the config of the browser login flow, authorization code with PKCE.
+type OIDCLogin struct {
+	// IssuerURL is the OIDC provider, the first of auth urls is used if empty.
+	IssuerURL    string `yaml:"issuer_url"`
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	// RedirectURL is the external URL of `/auth/callback` registered in the OIDC provider.
+	RedirectURL string   `yaml:"redirect_url"`
+	Scopes      []string `yaml:"scopes"`
+	// PostLogoutRedirectURL is passed to the OIDC provider on logout, if it supports RP-initiated logout.
+	PostLogoutRedirectURL string `yaml:"post_logout_redirect_url"`
+	// SessionTTL is the max lifetime of the session, the tokens are renewed with refresh token within it.
+	SessionTTL   time.Duration `yaml:"session_ttl"`
+	CookieName   string        `yaml:"cookie_name"`
+	CookieDomain string        `yaml:"cookie_domain"`
+	// InsecureCookie disables Secure attribute of the cookies, e.g. for local development over HTTP.
+	InsecureCookie bool  `yaml:"insecure_cookie"`
+	Redis          Redis `yaml:"redis"`
+}
+
+// ServerTLS enables TLS of the gRPC and HTTP servers.
+type ServerTLS struct {
+	// Cert and Key are PEM encoded or paths to the PEM files.
+	Cert string `yaml:"cert"`
+	Key  string `yaml:"key"`
+	// ClientCA enables mTLS, the client certs are verified by it.
+	ClientCA string `yaml:"client_ca"`
+	// ClientAuth is the client certs policy, `require` or `verify_if_given`.
+	ClientAuth string `yaml:"client_auth"`
+	// UserFrom is the client cert field the user name is taken from, `cn` or `san`.
+	UserFrom string `yaml:"user_from"`
+}
+
+// JWT is the config of the service tokens signed with asymmetric keys.
+type JWT struct {
+	// Keys are the signing keys, the first one signs the new tokens and all of them verify the tokens,
+	// so the keys can be rotated without invalidating the issued tokens.
+	Keys []JWTKey `yaml:"keys"`
+	// TrustedIssuers are the other issuers, their tokens are verified by the keys from JWKS.
+	TrustedIssuers []JWTTrustedIssuer `yaml:"trusted_issuers"`
+}
+
+// JWTKey is RSA (RS256) or ECDSA P-256 (ES256) key. The key without private key only verifies the tokens.
+type JWTKey struct {
+	// KID is set to `kid` header of the tokens.
+	KID string `yaml:"kid"`
+	// PrivateKey and PublicKey are PEM encoded or paths to the PEM files.
+	PrivateKey string `yaml:"private_key"`
+	PublicKey  string `yaml:"public_key"`
+}
+
+type JWTTrustedIssuer struct {
+	// Issuer is compared with `iss` claim of the tokens.
+	Issuer  string `yaml:"issuer"`
+	JWKSURL string `yaml:"jwks_url"`
+	// Audience is compared with `aud` claim of the tokens if set.
+	Audience string `yaml:"audience"`
+}
+
+// APIKey is the static API key of the service.
+type APIKey struct {
+	Name string `yaml:"name"`
+	// Hash is hex encoded SHA-256 of the key, so the keys aren't stored in plain text.
+	Hash string `yaml:"hash"`
+}
+
+// RBAC is the role-based access control config.
+type RBAC struct {
+	Roles        map[string]RBACRole `yaml:"roles"`
+	DefaultRoles []string            `yaml:"default_roles"`
+}
+
+// RBACRole grants its permissions to the listed users and members of the listed groups.
+type RBACRole struct {
+	Permissions []string `yaml:"permissions"`
+	Groups      []string `yaml:"groups"`
+	Users       []string `yaml:"users"`
 }
 
 type DB struct {
@@ -106,10 +249,11 @@ type CH struct {
 
 type (
 	RateLimiter struct {
-		RatePerSec   int  `yaml:"rate_per_sec"`
-		MaxBurst     int  `yaml:"max_burst"`
-		StoreMaxKeys int  `yaml:"store_max_keys"`
-		PerHandler   bool `yaml:"per_handler"`
+		RatePerSec   int    `yaml:"rate_per_sec"`
+		MaxBurst     int    `yaml:"max_burst"`
+		StoreMaxKeys int    `yaml:"store_max_keys"`
+		PerHandler   bool   `yaml:"per_handler"`
+		Redis        *Redis `yaml:"redis"`
 	}
 
 	UserToRateLimiter map[string]RateLimiter
@@ -122,6 +266,22 @@ type (
 	ApiToRateLimiters map[string]ApiRateLimiters
 )
 
+// ConcurrencyLimits limits the number of in-flight requests of every user by route.
+type ConcurrencyLimits struct {
+	// QueueTimeout is the time the request waits for a free slot before the rejection.
+	QueueTimeout time.Duration `yaml:"queue_timeout"`
+	// Routes contains the limits by HTTP route `METHOD /pattern` or gRPC method `Service/Method`.
+	Routes map[string]ConcurrencyLimit `yaml:"routes"`
+}
+
+type ConcurrencyLimit struct {
+	MaxInFlight int `yaml:"max_in_flight"`
+	// SpecialUsers overrides the limit of the users and tokens by name.
+	SpecialUsers map[string]int `yaml:"spec_users"`
+	// QueueTimeout overrides the default queue timeout of the route.
+	QueueTimeout time.Duration `yaml:"queue_timeout"`
+}
+
 type InmemoryCache struct {
 	NumCounters int64 `yaml:"num_counters"`
 	MaxCost     int64 `yaml:"max_cost"`
@@ -179,20 +339,26 @@ type MassExport struct {
 }
 
 type Server struct {
-	DebugAddr             string            `yaml:"debug_addr"`
-	HTTPAddr              string            `yaml:"http_addr"`
-	GRPCAddr              string            `yaml:"grpc_addr"`
-	CORS                  *CORS             `yaml:"cors"`
-	OIDC                  *OIDC             `yaml:"oidc"`
-	GRPCConnectionTimeout time.Duration     `yaml:"grpc_connection_timeout"`
-	HTTPReadHeaderTimeout time.Duration     `yaml:"http_read_header_timeout"`
-	HTTPReadTimeout       time.Duration     `yaml:"http_read_timeout"`
-	HTTPWriteTimeout      time.Duration     `yaml:"http_write_timeout"`
-	DB                    *DB               `yaml:"db"`
-	CH                    *CH               `yaml:"clickhouse"`
-	RateLimiters          ApiToRateLimiters `yaml:"rate_limiters"`
-	Cache                 Cache             `yaml:"cache"`
-	JWTSecretKey          string            `yaml:"jwt_secret_key"`
+	DebugAddr             string             `yaml:"debug_addr"`
+	HTTPAddr              string             `yaml:"http_addr"`
+	GRPCAddr              string             `yaml:"grpc_addr"`
+	CORS                  *CORS              `yaml:"cors"`
+	TLS                   *ServerTLS         `yaml:"tls"`
+	OIDC                  *OIDC              `yaml:"oidc"`
+	APIKeys               []APIKey           `yaml:"api_keys"`
+	AuthChain             []string           `yaml:"auth_chain"`
+	RBAC                  *RBAC              `yaml:"rbac"`
+	GRPCConnectionTimeout time.Duration      `yaml:"grpc_connection_timeout"`
+	HTTPReadHeaderTimeout time.Duration      `yaml:"http_read_header_timeout"`
+	HTTPReadTimeout       time.Duration      `yaml:"http_read_timeout"`
+	HTTPWriteTimeout      time.Duration      `yaml:"http_write_timeout"`
+	DB                    *DB                `yaml:"db"`
+	CH                    *CH                `yaml:"clickhouse"`
+	RateLimiters          ApiToRateLimiters  `yaml:"rate_limiters"`
+	ConcurrencyLimits     *ConcurrencyLimits `yaml:"concurrency_limits"`
+	Cache                 Cache              `yaml:"cache"`
+	JWTSecretKey          string             `yaml:"jwt_secret_key"`
+	JWT                   *JWT               `yaml:"jwt"`
 }
 
 type GRPCKeepaliveParams struct {
@@ -239,6 +405,13 @@ type Handlers struct {
 	ErrorGroups ErrorGroups `yaml:"error_groups"`
 	MassExport  *MassExport `yaml:"mass_export"`
 	AsyncSearch AsyncSearch `yaml:"async_search"`
+	Dashboards  Dashboards  `yaml:"dashboards"`
+	// QueryHistory of the users' search requests. Disabled if not set.
+	QueryHistory *QueryHistory `yaml:"query_history"`
+	// APITokens issued by users via API. Disabled if not set.
+	APITokens *APITokens `yaml:"api_tokens"`
+	// Audit of the users' actions. Disabled if not set.
+	Audit *Audit `yaml:"audit"`
 }
 
 type Field struct {
@@ -250,11 +423,52 @@ type SeqAPI struct {
 	*SeqAPIOptions `yaml:",inline"`
 	Envs           map[string]SeqAPIEnv `yaml:"envs"`
 	DefaultEnv     string               `yaml:"default_env"`
+	// QueryPolicy adds mandatory filters to the queries of the users. If not set, queries are not restricted.
+	QueryPolicy *SeqAPIQueryPolicy `yaml:"query_policy"`
+	// QueryCost limits the total cost of the queries of the users per window. If not set, the cost is not limited.
+	QueryCost *SeqAPIQueryCost `yaml:"query_cost"`
+}
+
+type SeqAPIQueryPolicy struct {
+	// File is the path to YAML file with the policies.
+	File string `yaml:"file"`
+	// ReloadInterval is the interval of checking the file for changes.
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+type SeqAPIQueryCost struct {
+	// Budget is the total cost of the queries allowed to the user per window.
+	Budget int `yaml:"budget"`
+	// SpecialUsers overrides the budget of the users and tokens by name.
+	SpecialUsers map[string]int   `yaml:"spec_users"`
+	Window       time.Duration    `yaml:"window"`
+	Weights      QueryCostWeights `yaml:"weights"`
+	StoreMaxKeys int              `yaml:"store_max_keys"`
+	// Redis shares the budgets between replicas, if set.
+	Redis *Redis `yaml:"redis"`
+}
+
+// QueryCostWeights are the weights of the query properties in the query cost.
+type QueryCostWeights struct {
+	Base           float64 `yaml:"base"`
+	PerHour        float64 `yaml:"per_hour"`
+	PerAggregation float64 `yaml:"per_aggregation"`
+	PerDocument    float64 `yaml:"per_document"`
+	Wildcard       float64 `yaml:"wildcard"`
 }
 
 type SeqAPIEnv struct {
 	SeqDB   string         `yaml:"seq_db_id"`
 	Options *SeqAPIOptions `yaml:"options"`
+	// Access restricts the env to the listed users, groups and tokens. If not set, the env is available to all users.
+	Access *SeqAPIEnvAccess `yaml:"access"`
+}
+
+type SeqAPIEnvAccess struct {
+	Users  []string `yaml:"users"`
+	Groups []string `yaml:"groups"`
+	// Tokens are the glob patterns of the service tokens names, e.g. `ci-*`.
+	Tokens []string `yaml:"tokens"`
 }
 
 type SeqAPIOptions struct {
@@ -311,8 +525,26 @@ type LogTagsMapping struct {
 }
 
 type ErrorGroups struct {
-	LogTagsMapping LogTagsMapping    `yaml:"log_tags_mapping"`
-	QueryFilter    map[string]string `yaml:"query_filter"`
+	// Storage is the storage of error groups data. If empty, clickhouse is used
+	// if configured, otherwise error groups are disabled.
+	Storage        string             `yaml:"storage"`
+	LogTagsMapping LogTagsMapping     `yaml:"log_tags_mapping"`
+	QueryFilter    map[string]string  `yaml:"query_filter"`
+	Digest         *ErrorGroupsDigest `yaml:"digest"`
+	// AdminUsers can merge and unmerge error groups if RBAC is disabled.
+	AdminUsers []string `yaml:"admin_users"`
+}
+
+type ErrorGroupsDigest struct {
+	// CheckInterval is how often subscriptions are checked for due digests.
+	CheckInterval time.Duration `yaml:"check_interval"`
+	// GroupsLimit is max number of groups in each section of the digest.
+	GroupsLimit    uint32        `yaml:"groups_limit"`
+	WebhookTimeout time.Duration `yaml:"webhook_timeout"`
+	// WebhookAllowlist is the list of url prefixes allowed for webhook delivery, e.g. `https://hooks.example.com/`.
+	// Webhook delivery is not allowed if empty.
+	WebhookAllowlist []string `yaml:"webhook_allowlist"`
+	FileStorePrefix  string   `yaml:"file_store_prefix"`
 }
 
 type AsyncSearch struct {
@@ -320,6 +552,64 @@ type AsyncSearch struct {
 	ListQueryLengthLimit int      `yaml:"list_query_length_limit"`
 }
 
+type Dashboards struct {
+	Render DashboardsRender `yaml:"render"`
+}
+
+type DashboardsRender struct {
+	// CacheTTL of the panel query results. Relative time ranges are aligned to it,
+	// so the dashboard opened by many users is queried once per TTL.
+	CacheTTL            time.Duration `yaml:"cache_ttl"`
+	MaxPanels           int           `yaml:"max_panels"`
+	MaxParallelRequests int           `yaml:"max_parallel_requests"`
+}
+
+type QueryHistory struct {
+	// MaxEntriesPerUser is max number of entries kept for each user, the oldest ones are removed.
+	MaxEntriesPerUser int           `yaml:"max_entries_per_user"`
+	Retention         time.Duration `yaml:"retention"`
+	// QueueSize is max number of entries waiting to be written, the new ones are dropped when it's full.
+	QueueSize     int           `yaml:"queue_size"`
+	BatchSize     int           `yaml:"batch_size"`
+	FlushInterval time.Duration `yaml:"flush_interval"`
+}
+
+type APITokens struct {
+	// AdminUsers can issue, list and revoke tokens of any user.
+	AdminUsers []string      `yaml:"admin_users"`
+	DefaultTTL time.Duration `yaml:"default_ttl"`
+	MaxTTL     time.Duration `yaml:"max_ttl"`
+	// RevocationCacheTTL is how long the token revocation status is cached.
+	RevocationCacheTTL time.Duration `yaml:"revocation_cache_ttl"`
+}
+
+type Audit struct {
+	// Sink is the storage of the audit records: postgres, file or http.
+	Sink string         `yaml:"sink"`
+	File *AuditFileSink `yaml:"file"`
+	HTTP *AuditHTTPSink `yaml:"http"`
+	// Retention of the records in postgres sink, the records are kept forever if zero.
+	Retention time.Duration `yaml:"retention"`
+	// AdminUsers can read the audit log.
+	AdminUsers []string `yaml:"admin_users"`
+	// QueueSize is max number of records waiting to be written, the new ones are dropped when it's full.
+	QueueSize     int           `yaml:"queue_size"`
+	BatchSize     int           `yaml:"batch_size"`
+	FlushInterval time.Duration `yaml:"flush_interval"`
+}
+
+type AuditFileSink struct {
+	// Path to the JSONL file, the records are appended to it.
+	Path string `yaml:"path"`
+}
+
+type AuditHTTPSink struct {
+	// URL the records are sent to as JSONL by POST request, e.g. file.d http input.
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+	Timeout time.Duration     `yaml:"timeout"`
+}
+
 // FromFile parse config from config path.
 func FromFile(cfgPath string) (Config, error) {
 	cfgBytes, err := os.ReadFile(cfgPath) //nolint:gosec
@@ -348,6 +638,185 @@ func FromFile(cfgPath string) (Config, error) {
 		cfg.Handlers.AsyncSearch.ListQueryLengthLimit = defaultAsyncSearchListQueryLengthLimit
 	}
 
+	switch storage := cfg.Handlers.ErrorGroups.Storage; storage {
+	case "":
+		// postgres storage must be chosen explicitly, since db is set in most installations
+		if cfg.Server.CH != nil {
+			cfg.Handlers.ErrorGroups.Storage = ErrorGroupsStorageClickHouse
+		}
+	case ErrorGroupsStorageClickHouse, ErrorGroupsStoragePostgres:
+	default:
+		return Config{}, fmt.Errorf(
+			"invalid value for handlers.error_groups.storage: %q. Allowed values are empty string, %q or %q",
+			storage, ErrorGroupsStorageClickHouse, ErrorGroupsStoragePostgres,
+		)
+	}
+
+	if digest := cfg.Handlers.ErrorGroups.Digest; digest != nil {
+		if digest.CheckInterval <= 0 {
+			digest.CheckInterval = defaultErrorGroupsDigestCheckInterval
+		}
+		if digest.GroupsLimit == 0 {
+			digest.GroupsLimit = defaultErrorGroupsDigestGroupsLimit
+		}
+		if digest.WebhookTimeout <= 0 {
+			digest.WebhookTimeout = defaultErrorGroupsDigestWebhookTimeout
+		}
+		if digest.FileStorePrefix == "" {
+			digest.FileStorePrefix = defaultErrorGroupsDigestFileStorePrefix
+		}
+		for _, prefix := range digest.WebhookAllowlist {
+			u, err := url.Parse(prefix)
+			if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+				return Config{}, fmt.Errorf("invalid url prefix in handlers.error_groups.digest.webhook_allowlist: %q", prefix)
+			}
+		}
+	}
+
+	render := &cfg.Handlers.Dashboards.Render
+	if render.CacheTTL <= 0 {
+		render.CacheTTL = defaultDashboardsRenderCacheTTL
+	}
+	if render.MaxPanels <= 0 {
+		render.MaxPanels = defaultDashboardsRenderMaxPanels
+	}
+	if render.MaxParallelRequests <= 0 {
+		render.MaxParallelRequests = defaultDashboardsRenderMaxParallelRequests
+	}
+
+	if history := cfg.Handlers.QueryHistory; history != nil {
+		if history.MaxEntriesPerUser <= 0 {
+			history.MaxEntriesPerUser = defaultQueryHistoryMaxEntriesPerUser
+		}
+		if history.Retention <= 0 {
+			history.Retention = defaultQueryHistoryRetention
+		}
+		if history.QueueSize <= 0 {
+			history.QueueSize = defaultQueryHistoryQueueSize
+		}
+		if history.BatchSize <= 0 {
+			history.BatchSize = defaultQueryHistoryBatchSize
+		}
+		if history.FlushInterval <= 0 {
+			history.FlushInterval = defaultQueryHistoryFlushInterval
+		}
+	}
+
+	if tokens := cfg.Handlers.APITokens; tokens != nil {
+		if tokens.MaxTTL <= 0 {
+			tokens.MaxTTL = defaultAPITokensMaxTTL
+		}
+		if tokens.DefaultTTL <= 0 {
+			tokens.DefaultTTL = min(defaultAPITokensDefaultTTL, tokens.MaxTTL)
+		}
+		if tokens.DefaultTTL > tokens.MaxTTL {
+			return Config{}, fmt.Errorf("handlers.api_tokens.default_ttl must not exceed max_ttl")
+		}
+		if cfg.Server.JWTSecretKey == "" && (cfg.Server.JWT == nil || len(cfg.Server.JWT.Keys) == 0) {
+			return Config{}, fmt.Errorf("handlers.api_tokens requires server.jwt_secret_key or server.jwt.keys")
+		}
+		if tokens.RevocationCacheTTL <= 0 {
+			tokens.RevocationCacheTTL = defaultAPITokensRevocationCacheTTL
+		}
+	}
+
+	if audit := cfg.Handlers.Audit; audit != nil {
+		switch audit.Sink {
+		case AuditSinkPostgres:
+			if cfg.Server.DB == nil {
+				return Config{}, fmt.Errorf("handlers.audit postgres sink requires server.db")
+			}
+		case AuditSinkFile:
+			if audit.File == nil || audit.File.Path == "" {
+				return Config{}, fmt.Errorf("handlers.audit.file.path must be specified for file sink")
+			}
+		case AuditSinkHTTP:
+			if audit.HTTP == nil || audit.HTTP.URL == "" {
+				return Config{}, fmt.Errorf("handlers.audit.http.url must be specified for http sink")
+			}
+			if audit.HTTP.Timeout <= 0 {
+				audit.HTTP.Timeout = defaultAuditHTTPTimeout
+			}
+		default:
+			return Config{}, fmt.Errorf(
+				"invalid value for handlers.audit.sink: %q. Allowed values are %q, %q or %q",
+				audit.Sink, AuditSinkPostgres, AuditSinkFile, AuditSinkHTTP,
+			)
+		}
+		if audit.QueueSize <= 0 {
+			audit.QueueSize = defaultAuditQueueSize
+		}
+		if audit.BatchSize <= 0 {
+			audit.BatchSize = defaultAuditBatchSize
+		}
+		if audit.FlushInterval <= 0 {
+			audit.FlushInterval = defaultAuditFlushInterval
+		}
+	}
+
+	if err := setServerTLSDefaults(cfg.Server.TLS); err != nil {
+		return Config{}, err
+	}
+
+	if err := validateJWT(cfg.Server.JWT); err != nil {
+		return Config{}, err
+	}
+
+	for i, provider := range cfg.Server.AuthChain {
+		switch provider {
+		case AuthProviderMTLS, AuthProviderAPIKey, AuthProviderJWT, AuthProviderOIDC:
+		default:
+			return Config{}, fmt.Errorf(
+				"invalid value for server.auth_chain: %q. Allowed values are %q, %q, %q or %q",
+				provider, AuthProviderMTLS, AuthProviderAPIKey, AuthProviderJWT, AuthProviderOIDC,
+			)
+		}
+		if slices.Contains(cfg.Server.AuthChain[:i], provider) {
+			return Config{}, fmt.Errorf("duplicate value in server.auth_chain: %q", provider)
+		}
+	}
+
+	for _, key := range cfg.Server.APIKeys {
+		if key.Name == "" {
+			return Config{}, fmt.Errorf("server.api_keys.name must be specified")
+		}
+		if key.Hash == "" {
+			return Config{}, fmt.Errorf("server.api_keys.hash of key %q must be specified", key.Name)
+		}
+	}
+
+	if cfg.Server.OIDC != nil && len(cfg.Server.OIDC.GroupsClaims) == 0 {
+		cfg.Server.OIDC.GroupsClaims = []string{defaultOIDCGroupsClaim}
+	}
+
+	if cfg.Server.OIDC != nil && cfg.Server.OIDC.Login != nil {
+		login := cfg.Server.OIDC.Login
+		if login.IssuerURL == "" {
+			if len(cfg.Server.OIDC.AuthURLs) == 0 {
+				return Config{}, fmt.Errorf("server.oidc.login.issuer_url or server.oidc.auth_urls must be specified")
+			}
+			login.IssuerURL = cfg.Server.OIDC.AuthURLs[0]
+		}
+		if login.ClientID == "" {
+			return Config{}, fmt.Errorf("server.oidc.login.client_id must be specified")
+		}
+		if login.RedirectURL == "" {
+			return Config{}, fmt.Errorf("server.oidc.login.redirect_url must be specified")
+		}
+		if login.Redis.Addr == "" {
+			return Config{}, fmt.Errorf("server.oidc.login.redis.addr must be specified")
+		}
+		if len(login.Scopes) == 0 {
+			login.Scopes = []string{"openid", "profile", "offline_access"}
+		}
+		if login.SessionTTL <= 0 {
+			login.SessionTTL = defaultOIDCLoginSessionTTL
+		}
+		if login.CookieName == "" {
+			login.CookieName = defaultOIDCLoginCookieName
+		}
+	}
+
 	if cfg.Server.DB != nil && cfg.Server.DB.UsePreparedStatements == nil {
 		cfg.Server.DB.UsePreparedStatements = new(bool)
 		*cfg.Server.DB.UsePreparedStatements = true
@@ -370,6 +839,10 @@ func FromFile(cfgPath string) (Config, error) {
 		}
 	}
 
+	if cl := cfg.Server.ConcurrencyLimits; cl != nil && cl.QueueTimeout <= 0 {
+		cl.QueueTimeout = defaultConcurrencyQueueTimeout
+	}
+
 	if cfg.Server.Cache.Inmemory.NumCounters <= 0 {
 		cfg.Server.Cache.Inmemory.NumCounters = defaultInmemCacheNumCounters
 	}
@@ -406,6 +879,38 @@ func FromFile(cfgPath string) (Config, error) {
 		clientIDs[client.ID] = struct{}{}
 	}
 
+	if qp := cfg.Handlers.SeqAPI.QueryPolicy; qp != nil {
+		if qp.File == "" {
+			return Config{}, fmt.Errorf("seq_api.query_policy.file must be specified")
+		}
+		if qp.ReloadInterval <= 0 {
+			qp.ReloadInterval = defaultQueryPolicyReloadInterval
+		}
+	}
+
+	if qc := cfg.Handlers.SeqAPI.QueryCost; qc != nil {
+		if qc.Budget <= 0 {
+			return Config{}, fmt.Errorf("seq_api.query_cost.budget must be greater than zero")
+		}
+		for user, budget := range qc.SpecialUsers {
+			if budget <= 0 {
+				return Config{}, fmt.Errorf("seq_api.query_cost.spec_users.%s must be greater than zero", user)
+			}
+		}
+		if qc.Window <= 0 {
+			qc.Window = defaultQueryCostWindow
+		}
+		if qc.Weights == (QueryCostWeights{}) {
+			qc.Weights = QueryCostWeights{
+				Base:           defaultQueryCostWeightBase,
+				PerHour:        defaultQueryCostWeightPerHour,
+				PerAggregation: defaultQueryCostWeightPerAggregation,
+				PerDocument:    defaultQueryCostWeightPerDocument,
+				Wildcard:       defaultQueryCostWeightWildcard,
+			}
+		}
+	}
+
 	if len(cfg.Handlers.SeqAPI.Envs) > 0 {
 		if cfg.Handlers.SeqAPI.DefaultEnv == "" {
 			return Config{}, fmt.Errorf("default_env must be specified when using envs")
@@ -420,6 +925,14 @@ func FromFile(cfgPath string) (Config, error) {
 				return Config{}, fmt.Errorf("client '%s' for env '%s' not found", envConfig.SeqDB, envName)
 			}
 
+			if envConfig.Access != nil {
+				for _, pattern := range envConfig.Access.Tokens {
+					if _, err := path.Match(pattern, ""); err != nil {
+						return Config{}, fmt.Errorf("invalid token pattern %q for env '%s': %w", pattern, envName, err)
+					}
+				}
+			}
+
 			if envConfig.Options == nil {
 				envConfig.Options = cfg.Handlers.SeqAPI.SeqAPIOptions
 			} else {
@@ -463,6 +976,66 @@ func setSeqAPIOptionsDefaults(options *SeqAPIOptions) {
 	}
 }
 
+func setServerTLSDefaults(t *ServerTLS) error {
+	if t == nil {
+		return nil
+	}
+	if t.Cert == "" || t.Key == "" {
+		return fmt.Errorf("server.tls.cert and server.tls.key must be specified")
+	}
+
+	switch t.ClientAuth {
+	case "":
+		t.ClientAuth = TLSClientAuthRequire
+	case TLSClientAuthRequire, TLSClientAuthVerifyIfGiven:
+	default:
+		return fmt.Errorf(
+			"invalid value for server.tls.client_auth: %q. Allowed values are %q or %q",
+			t.ClientAuth, TLSClientAuthRequire, TLSClientAuthVerifyIfGiven,
+		)
+	}
+
+	switch t.UserFrom {
+	case "":
+		t.UserFrom = TLSUserFromCN
+	case TLSUserFromCN, TLSUserFromSAN:
+	default:
+		return fmt.Errorf(
+			"invalid value for server.tls.user_from: %q. Allowed values are %q or %q",
+			t.UserFrom, TLSUserFromCN, TLSUserFromSAN,
+		)
+	}
+	return nil
+}
+
+func validateJWT(j *JWT) error {
+	if j == nil {
+		return nil
+	}
+
+	for i, key := range j.Keys {
+		if key.KID == "" {
+			return fmt.Errorf("server.jwt.keys.kid must be specified")
+		}
+		if slices.ContainsFunc(j.Keys[:i], func(k JWTKey) bool { return k.KID == key.KID }) {
+			return fmt.Errorf("duplicate server.jwt.keys.kid: %q", key.KID)
+		}
+		if key.PrivateKey == "" && key.PublicKey == "" {
+			return fmt.Errorf("server.jwt.keys.private_key or public_key of key %q must be specified", key.KID)
+		}
+	}
+	if len(j.Keys) > 0 && j.Keys[0].PrivateKey == "" {
+		return fmt.Errorf("server.jwt.keys.private_key of the first key %q must be specified, it signs the tokens", j.Keys[0].KID)
+	}
+
+	for _, iss := range j.TrustedIssuers {
+		if iss.Issuer == "" || iss.JWKSURL == "" {
+			return fmt.Errorf("server.jwt.trusted_issuers.issuer and jwks_url must be specified")
+		}
+	}
+	return nil
+}
+
 func parse(cfg []byte) (Config, error) {
 	result := Config{}
 
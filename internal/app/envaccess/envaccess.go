@@ -0,0 +1,70 @@
+// Package envaccess checks access of the users to the seq-api envs.
+package envaccess
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+// serviceTokenPrefix is the prefix of the user names authenticated by the service tokens.
+const serviceTokenPrefix = "api@"
+
+// Allowed checks that the user from context may query the env with the access policy.
+// Env without policy is available to all users, env with policy is not available to anonymous users.
+func Allowed(ctx context.Context, access *config.SeqAPIEnvAccess) bool {
+	if access == nil {
+		return true
+	}
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return false
+	}
+
+	if slices.Contains(access.Users, userName) {
+		return true
+	}
+
+	groups := types.GetUserGroups(ctx)
+	if slices.ContainsFunc(access.Groups, func(g string) bool {
+		return slices.Contains(groups, g)
+	}) {
+		return true
+	}
+
+	tokenName, ok := strings.CutPrefix(userName, serviceTokenPrefix)
+	if !ok {
+		return false
+	}
+	for _, pattern := range access.Tokens {
+		if matched, _ := path.Match(pattern, tokenName); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check returns permission denied error if the user from context may not query the env.
+// Empty name means the default env. Unknown envs are not checked and must be handled by the caller.
+func Check(ctx context.Context, cfg config.SeqAPI, name string) error {
+	if len(cfg.Envs) == 0 {
+		return nil
+	}
+	if name == "" {
+		name = cfg.DefaultEnv
+	}
+
+	env, ok := cfg.Envs[name]
+	if !ok || Allowed(ctx, env.Access) {
+		return nil
+	}
+
+	return types.NewErrPermissionDenied(fmt.Sprintf("access env '%s'", name))
+}
@@ -0,0 +1,101 @@
+package envaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestAllowed(t *testing.T) {
+	access := &config.SeqAPIEnvAccess{
+		Users:  []string{"alice"},
+		Groups: []string{"sre"},
+		Tokens: []string{"ci-*"},
+	}
+
+	withUser := func(userName string, groups ...string) context.Context {
+		ctx := context.WithValue(context.Background(), types.UserKey{}, userName)
+		if len(groups) > 0 {
+			ctx = context.WithValue(ctx, types.UserGroupsKey{}, groups)
+		}
+		return ctx
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		access *config.SeqAPIEnvAccess
+		want   bool
+	}{
+		{
+			name: "no_policy",
+			ctx:  context.Background(),
+			want: true,
+		},
+		{
+			name:   "anonymous",
+			ctx:    context.Background(),
+			access: access,
+			want:   false,
+		},
+		{
+			name:   "user",
+			ctx:    withUser("alice"),
+			access: access,
+			want:   true,
+		},
+		{
+			name:   "group",
+			ctx:    withUser("bob", "devs", "sre"),
+			access: access,
+			want:   true,
+		},
+		{
+			name:   "token_pattern",
+			ctx:    withUser("api@ci-deploy"),
+			access: access,
+			want:   true,
+		},
+		{
+			name:   "token_pattern_not_service_token",
+			ctx:    withUser("ci-deploy"),
+			access: access,
+			want:   false,
+		},
+		{
+			name:   "denied",
+			ctx:    withUser("api@reports", "devs"),
+			access: access,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Allowed(tt.ctx, tt.access))
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	cfg := config.SeqAPI{
+		Envs: map[string]config.SeqAPIEnv{
+			"prod": {Access: &config.SeqAPIEnvAccess{Users: []string{"alice"}}},
+			"dev":  {},
+		},
+		DefaultEnv: "prod",
+	}
+	ctx := context.WithValue(context.Background(), types.UserKey{}, "bob")
+
+	require.NoError(t, Check(ctx, cfg, "dev"))
+	require.NoError(t, Check(ctx, cfg, "unknown"))
+	require.ErrorIs(t, Check(ctx, cfg, "prod"), types.ErrPermissionDenied)
+	require.ErrorIs(t, Check(ctx, cfg, ""), types.ErrPermissionDenied)
+	require.NoError(t, Check(ctx, config.SeqAPI{}, "prod"))
+}
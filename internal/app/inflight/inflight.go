@@ -0,0 +1,229 @@
+package inflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/metric"
+)
+
+// ErrLimitExceeded is returned when the request didn't get a free slot during the queue timeout.
+var ErrLimitExceeded = errors.New("too many concurrent requests")
+
+// Limiters contains the concurrency limiters by route.
+type Limiters struct {
+	routes map[string]*Limiter
+}
+
+// New creates the limiters by config. Route validation is up to the caller,
+// since the routes of HTTP and gRPC servers are different.
+func New(cfg config.ConcurrencyLimits) (*Limiters, error) {
+	routes := make(map[string]*Limiter, len(cfg.Routes))
+	for route, limit := range cfg.Routes {
+		if limit.MaxInFlight <= 0 {
+			return nil, fmt.Errorf("invalid concurrency limit of route %q: max_in_flight must be greater than zero", route)
+		}
+		for user, maxInFlight := range limit.SpecialUsers {
+			if maxInFlight <= 0 {
+				return nil, fmt.Errorf("invalid concurrency limit of route %q: limit of user %q must be greater than zero", route, user)
+			}
+		}
+
+		queueTimeout := limit.QueueTimeout
+		if queueTimeout <= 0 {
+			queueTimeout = cfg.QueueTimeout
+		}
+		routes[route] = newLimiter(route, limit.MaxInFlight, limit.SpecialUsers, queueTimeout)
+	}
+	return &Limiters{routes: routes}, nil
+}
+
+// Routes returns the limited routes.
+func (l *Limiters) Routes() []string {
+	routes := make([]string, 0, len(l.routes))
+	for route := range l.routes {
+		routes = append(routes, route)
+	}
+	slices.Sort(routes)
+	return routes
+}
+
+// Route returns the limiter of the route or nil if the route isn't limited.
+func (l *Limiters) Route(route string) *Limiter {
+	if l == nil {
+		return nil
+	}
+	return l.routes[route]
+}
+
+// Stats returns the current in-flight and queued requests by route, sorted by route.
+func (l *Limiters) Stats() []RouteStats {
+	stats := make([]RouteStats, 0, len(l.routes))
+	for _, route := range l.Routes() {
+		stats = append(stats, l.routes[route].Stats())
+	}
+	return stats
+}
+
+// Limiter limits the number of in-flight requests of every user.
+// The requests over the limit wait for a free slot in FIFO order.
+type Limiter struct {
+	route        string
+	maxInFlight  int
+	specUsers    map[string]int
+	queueTimeout time.Duration
+
+	mu    sync.Mutex
+	users map[string]*userSlots
+}
+
+// userSlots is the semaphore of the user, it's deleted when there are no requests of the user.
+type userSlots struct {
+	slots   chan struct{}
+	refs    int
+	waiting atomic.Int32
+}
+
+func newLimiter(route string, maxInFlight int, specUsers map[string]int, queueTimeout time.Duration) *Limiter {
+	return &Limiter{
+		route:        route,
+		maxInFlight:  maxInFlight,
+		specUsers:    specUsers,
+		queueTimeout: queueTimeout,
+		users:        map[string]*userSlots{},
+	}
+}
+
+func (l *Limiter) limit(user string) int {
+	if limit, ok := l.specUsers[user]; ok {
+		return limit
+	}
+	return l.maxInFlight
+}
+
+// Acquire takes the slot of the user. If there is no free slot, waits for it during the queue timeout.
+// Returns ErrLimitExceeded on timeout or the context error if it's done while waiting.
+// The returned release must be called when the request is completed.
+func (l *Limiter) Acquire(ctx context.Context, user string) (func(), error) {
+	l.mu.Lock()
+	us, ok := l.users[user]
+	if !ok {
+		us = &userSlots{slots: make(chan struct{}, l.limit(user))}
+		l.users[user] = us
+	}
+	// keep the user slots, so they aren't deleted while waiting
+	us.refs++
+	l.mu.Unlock()
+
+	err := l.wait(ctx, us)
+
+	l.mu.Lock()
+	us.refs--
+	if err != nil {
+		l.cleanup(user, us)
+	}
+	l.mu.Unlock()
+
+	if err != nil {
+		if errors.Is(err, ErrLimitExceeded) {
+			metric.ServerConcurrencyRejected.WithLabelValues(l.route).Inc()
+		}
+		return nil, err
+	}
+
+	metric.ServerConcurrencyInFlight.WithLabelValues(l.route).Inc()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			metric.ServerConcurrencyInFlight.WithLabelValues(l.route).Dec()
+			l.mu.Lock()
+			<-us.slots
+			l.cleanup(user, us)
+			l.mu.Unlock()
+		})
+	}, nil
+}
+
+func (l *Limiter) wait(ctx context.Context, us *userSlots) error {
+	select {
+	case us.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	us.waiting.Add(1)
+	defer us.waiting.Add(-1)
+	metric.ServerConcurrencyQueued.WithLabelValues(l.route).Inc()
+	defer metric.ServerConcurrencyQueued.WithLabelValues(l.route).Dec()
+
+	timer := time.NewTimer(l.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case us.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return fmt.Errorf("%w: %d requests of the user are in flight, waited for %s",
+			ErrLimitExceeded, cap(us.slots), l.queueTimeout)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cleanup deletes the user slots if the user has no requests, must be called under lock.
+func (l *Limiter) cleanup(user string, us *userSlots) {
+	if us.refs == 0 && len(us.slots) == 0 {
+		delete(l.users, user)
+	}
+}
+
+// RouteStats contains the current in-flight and queued requests of the route.
+type RouteStats struct {
+	Route       string      `json:"route"`
+	MaxInFlight int         `json:"max_in_flight"`
+	InFlight    int         `json:"in_flight"`
+	Queued      int         `json:"queued"`
+	Users       []UserStats `json:"users"`
+}
+
+// UserStats contains the current in-flight and queued requests of the user.
+type UserStats struct {
+	User        string `json:"user"`
+	MaxInFlight int    `json:"max_in_flight"`
+	InFlight    int    `json:"in_flight"`
+	Queued      int    `json:"queued"`
+}
+
+// Stats returns the current in-flight and queued requests of the users, sorted by user.
+func (l *Limiter) Stats() RouteStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rs := RouteStats{
+		Route:       l.route,
+		MaxInFlight: l.maxInFlight,
+		Users:       make([]UserStats, 0, len(l.users)),
+	}
+	for user, us := range l.users {
+		stats := UserStats{
+			User:        user,
+			MaxInFlight: cap(us.slots),
+			InFlight:    len(us.slots),
+			Queued:      int(us.waiting.Load()),
+		}
+		rs.Users = append(rs.Users, stats)
+		rs.InFlight += stats.InFlight
+		rs.Queued += stats.Queued
+	}
+	slices.SortFunc(rs.Users, func(a, b UserStats) int {
+		return strings.Compare(a.User, b.User)
+	})
+	return rs
+}
@@ -0,0 +1,118 @@
+package inflight
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+func newTestLimiters(t *testing.T, queueTimeout time.Duration) *Limiters {
+	t.Helper()
+
+	l, err := New(config.ConcurrencyLimits{
+		QueueTimeout: queueTimeout,
+		Routes: map[string]config.ConcurrencyLimit{
+			"POST /seqapi/v1/search": {
+				MaxInFlight:  1,
+				SpecialUsers: map[string]int{"robot": 2},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return l
+}
+
+func TestNewInvalid(t *testing.T) {
+	_, err := New(config.ConcurrencyLimits{
+		Routes: map[string]config.ConcurrencyLimit{
+			"SeqAPIService/Search": {},
+		},
+	})
+	require.Error(t, err)
+
+	_, err = New(config.ConcurrencyLimits{
+		Routes: map[string]config.ConcurrencyLimit{
+			"SeqAPIService/Search": {MaxInFlight: 1, SpecialUsers: map[string]int{"robot": 0}},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestAcquire(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLimiters(t, 50*time.Millisecond).Route("POST /seqapi/v1/search")
+
+	release, err := l.Acquire(ctx, "alice")
+	require.NoError(t, err)
+
+	// the limit is personal
+	releaseBob, err := l.Acquire(ctx, "bob")
+	require.NoError(t, err)
+
+	_, err = l.Acquire(ctx, "alice")
+	require.ErrorIs(t, err, ErrLimitExceeded)
+
+	// the queued request gets the slot when it's released
+	done := make(chan error)
+	go func() {
+		release, err := l.Acquire(ctx, "bob")
+		if err == nil {
+			release()
+		}
+		done <- err
+	}()
+	require.Eventually(t, func() bool {
+		return l.Stats().Queued == 1
+	}, time.Second, time.Millisecond)
+	releaseBob()
+	require.NoError(t, <-done)
+
+	// canceled request leaves the queue
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = l.Acquire(cancelCtx, "alice")
+	require.ErrorIs(t, err, context.Canceled)
+
+	// release is idempotent
+	release()
+	release()
+
+	// special users have own limits
+	for range 2 {
+		_, err = l.Acquire(ctx, "robot")
+		require.NoError(t, err)
+	}
+	_, err = l.Acquire(ctx, "robot")
+	require.ErrorIs(t, err, ErrLimitExceeded)
+}
+
+func TestStats(t *testing.T) {
+	ctx := context.Background()
+	limiters := newTestLimiters(t, time.Second)
+	l := limiters.Route("POST /seqapi/v1/search")
+
+	require.Nil(t, limiters.Route("GET /seqapi/v1/fields"))
+
+	release, err := l.Acquire(ctx, "robot")
+	require.NoError(t, err)
+	_, err = l.Acquire(ctx, "alice")
+	require.NoError(t, err)
+
+	require.Equal(t, []RouteStats{{
+		Route:       "POST /seqapi/v1/search",
+		MaxInFlight: 1,
+		InFlight:    2,
+		Users: []UserStats{
+			{User: "alice", MaxInFlight: 1, InFlight: 1},
+			{User: "robot", MaxInFlight: 2, InFlight: 1},
+		},
+	}}, limiters.Stats())
+
+	// users without requests are deleted
+	release()
+	require.Len(t, l.Stats().Users, 1)
+}
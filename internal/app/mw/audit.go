@@ -0,0 +1,180 @@
+package mw
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+// AuditRecorder records the audited actions of the users.
+type AuditRecorder interface {
+	Record(context.Context, types.AuditRecord)
+}
+
+// grpcAuditActions contains audited gRPC methods.
+var grpcAuditActions = map[string]types.AuditAction{
+	"/seqapi.v1.SeqAPIService/Search":                    types.AuditActionSearch,
+	"/seqapi.v1.SeqAPIService/GetHistogram":              types.AuditActionHistogram,
+	"/seqapi.v1.SeqAPIService/GetAggregation":            types.AuditActionAggregation,
+	"/seqapi.v1.SeqAPIService/GetEvent":                  types.AuditActionEventView,
+	"/seqapi.v1.SeqAPIService/StartAsyncSearch":          types.AuditActionAsyncSearch,
+	"/massexport.v1.MassExportService/Start":             types.AuditActionMassExport,
+	"/dashboards.v1.DashboardsService/Create":            types.AuditActionDashboardCreate,
+	"/dashboards.v1.DashboardsService/Update":            types.AuditActionDashboardUpdate,
+	"/dashboards.v1.DashboardsService/Restore":           types.AuditActionDashboardUpdate,
+	"/dashboards.v1.DashboardsService/UpdateSharing":     types.AuditActionDashboardUpdate,
+	"/dashboards.v1.DashboardsService/TransferOwnership": types.AuditActionDashboardUpdate,
+	"/dashboards.v1.DashboardsService/Delete":            types.AuditActionDashboardDelete,
+	"/dashboards.v1.DashboardsService/Import":            types.AuditActionDashboardImport,
+	"/tokens.v1.TokensService/CreateToken":               types.AuditActionTokenCreate,
+	"/tokens.v1.TokensService/RevokeToken":               types.AuditActionTokenRevoke,
+}
+
+// httpAuditActions contains audited HTTP routes, by method and route pattern.
+var httpAuditActions = map[string]types.AuditAction{
+	"POST /seqapi/v1/search":                                types.AuditActionSearch,
+	"POST /seqapi/v1/histogram":                             types.AuditActionHistogram,
+	"POST /seqapi/v1/aggregation":                           types.AuditActionAggregation,
+	"POST /seqapi/v1/aggregation_ts":                        types.AuditActionAggregation,
+	"POST /seqapi/v1/export":                                types.AuditActionExport,
+	"GET /seqapi/v1/events/{id}":                            types.AuditActionEventView,
+	"POST /seqapi/v1/async_search/start":                    types.AuditActionAsyncSearch,
+	"POST /massexport/v1/start":                             types.AuditActionMassExport,
+	"POST /dashboards/v1":                                   types.AuditActionDashboardCreate,
+	"PATCH /dashboards/v1/{uuid}":                           types.AuditActionDashboardUpdate,
+	"POST /dashboards/v1/{uuid}/versions/{version}/restore": types.AuditActionDashboardUpdate,
+	"PUT /dashboards/v1/{uuid}/sharing":                     types.AuditActionDashboardUpdate,
+	"POST /dashboards/v1/{uuid}/transfer":                   types.AuditActionDashboardUpdate,
+	"DELETE /dashboards/v1/{uuid}":                          types.AuditActionDashboardDelete,
+	"POST /dashboards/v1/import":                            types.AuditActionDashboardImport,
+	"POST /tokens/v1":                                       types.AuditActionTokenCreate,
+	"DELETE /tokens/v1/{id}":                                types.AuditActionTokenRevoke,
+}
+
+// GRPCAuditInterceptor records the audited requests of the authenticated users.
+func GRPCAuditInterceptor(recorder AuditRecorder) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo,
+		h grpc.UnaryHandler,
+	) (any, error) {
+		action, ok := grpcAuditActions[info.FullMethod]
+		if !ok {
+			return h(ctx, req)
+		}
+
+		resp, err := h(ctx, req)
+
+		r := types.AuditRecord{
+			Action: action,
+			Method: info.FullMethod,
+			Status: status.Code(err).String(),
+		}
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if env := md.Get("env"); len(env) > 0 {
+				r.Env = env[0]
+			}
+		}
+		if v, ok := req.(interface{ GetQuery() string }); ok {
+			r.Query = v.GetQuery()
+		}
+		if v, ok := req.(interface {
+			GetFrom() *timestamppb.Timestamp
+			GetTo() *timestamppb.Timestamp
+		}); ok {
+			r.From = timestampPtr(v.GetFrom())
+			r.To = timestampPtr(v.GetTo())
+		}
+		if v, ok := req.(interface{ GetId() string }); ok {
+			r.Target = v.GetId()
+		} else if v, ok := req.(interface{ GetUuid() string }); ok {
+			r.Target = v.GetUuid()
+		} else if v, ok := req.(interface{ GetName() string }); ok {
+			r.Target = v.GetName()
+		}
+		recorder.Record(ctx, r)
+
+		return resp, err
+	}
+}
+
+// auditHTTPRequest contains the audited fields of HTTP request body.
+type auditHTTPRequest struct {
+	Query string     `json:"query"`
+	From  *time.Time `json:"from"`
+	To    *time.Time `json:"to"`
+	Name  string     `json:"name"`
+}
+
+// HTTPAuditInterceptor records the audited requests of the authenticated users.
+func HTTPAuditInterceptor(recorder AuditRecorder) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			route := r.Method + " " + getRoutePattern(r.Context())
+			action, ok := httpAuditActions[route]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var body auditHTTPRequest
+			if r.Body != nil {
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewBuffer(data))
+				// body is parsed only to audit the known fields, invalid body is rejected by handler
+				_ = json.Unmarshal(data, &body)
+			}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			code := ww.Status()
+			if code == 0 {
+				code = http.StatusOK
+			}
+
+			rec := types.AuditRecord{
+				Action: action,
+				Method: route,
+				Env:    r.URL.Query().Get("env"),
+				Query:  body.Query,
+				From:   body.From,
+				To:     body.To,
+				Target: body.Name,
+				Status: http.StatusText(code),
+			}
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				if id := rctx.URLParam("id"); id != "" {
+					rec.Target = id
+				} else if uuid := rctx.URLParam("uuid"); uuid != "" {
+					rec.Target = uuid
+				}
+			}
+			recorder.Record(r.Context(), rec)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+func timestampPtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
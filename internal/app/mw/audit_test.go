@@ -0,0 +1,192 @@
+package mw
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+	"github.com/ozontech/seq-ui/pkg/tokens/v1"
+)
+
+type auditRecorderStub struct {
+	records []types.AuditRecord
+}
+
+func (s *auditRecorderStub) Record(_ context.Context, r types.AuditRecord) {
+	s.records = append(s.records, r)
+}
+
+func TestGRPCAuditInterceptor(t *testing.T) {
+	from := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		method  string
+		req     any
+		md      metadata.MD
+		handErr error
+
+		want *types.AuditRecord
+	}{
+		{
+			name:   "search",
+			method: "/seqapi.v1.SeqAPIService/Search",
+			req: &seqapi.SearchRequest{
+				Query: "service:api",
+				From:  timestamppb.New(from),
+				To:    timestamppb.New(to),
+			},
+			md: metadata.Pairs("env", "prod"),
+			want: &types.AuditRecord{
+				Action: types.AuditActionSearch,
+				Method: "/seqapi.v1.SeqAPIService/Search",
+				Env:    "prod",
+				Query:  "service:api",
+				From:   &from,
+				To:     &to,
+				Status: codes.OK.String(),
+			},
+		},
+		{
+			name:    "token_revoke_err",
+			method:  "/tokens.v1.TokensService/RevokeToken",
+			req:     &tokens.RevokeTokenRequest{Id: "token-id"},
+			handErr: status.Error(codes.NotFound, "not found"),
+			want: &types.AuditRecord{
+				Action: types.AuditActionTokenRevoke,
+				Method: "/tokens.v1.TokensService/RevokeToken",
+				Target: "token-id",
+				Status: codes.NotFound.String(),
+			},
+		},
+		{
+			name:   "not_audited",
+			method: "/seqapi.v1.SeqAPIService/GetFields",
+			req:    &seqapi.GetFieldsRequest{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := &auditRecorderStub{}
+			interceptor := GRPCAuditInterceptor(recorder)
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(context.Context, any) (any, error) {
+					return nil, tt.handErr
+				},
+			)
+			require.Equal(t, tt.handErr, err)
+
+			if tt.want == nil {
+				require.Empty(t, recorder.records)
+				return
+			}
+			require.Len(t, recorder.records, 1)
+			require.Equal(t, *tt.want, recorder.records[0])
+		})
+	}
+}
+
+func TestHTTPAuditInterceptor(t *testing.T) {
+	from := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		body     string
+		respCode int
+
+		want *types.AuditRecord
+	}{
+		{
+			name:   "search",
+			method: http.MethodPost,
+			url:    "/seqapi/v1/search?env=prod",
+			body:   `{"query":"service:api","from":"2024-01-01T10:00:00Z","to":"2024-01-01T11:00:00Z","limit":10}`,
+			want: &types.AuditRecord{
+				Action: types.AuditActionSearch,
+				Method: "POST /seqapi/v1/search",
+				Env:    "prod",
+				Query:  "service:api",
+				From:   &from,
+				To:     &to,
+				Status: http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:     "dashboard_delete_err",
+			method:   http.MethodDelete,
+			url:      "/dashboards/v1/some-uuid",
+			respCode: http.StatusForbidden,
+			want: &types.AuditRecord{
+				Action: types.AuditActionDashboardDelete,
+				Method: "DELETE /dashboards/v1/{uuid}",
+				Target: "some-uuid",
+				Status: http.StatusText(http.StatusForbidden),
+			},
+		},
+		{
+			name:   "not_audited",
+			method: http.MethodGet,
+			url:    "/seqapi/v1/fields",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := &auditRecorderStub{}
+
+			var gotBody string
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				gotBody = string(data)
+				if tt.respCode != 0 {
+					w.WriteHeader(tt.respCode)
+				}
+			}
+
+			mux := chi.NewMux()
+			mux.Use(HTTPNotFoundInterceptor(), HTTPAuditInterceptor(recorder))
+			mux.Post("/seqapi/v1/search", handler)
+			mux.Get("/seqapi/v1/fields", handler)
+			mux.Delete("/dashboards/v1/{uuid}", handler)
+
+			req := httptest.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			mux.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Equal(t, tt.body, gotBody)
+
+			if tt.want == nil {
+				require.Empty(t, recorder.records)
+				return
+			}
+			require.Len(t, recorder.records, 1)
+			require.Equal(t, *tt.want, recorder.records[0])
+		})
+	}
+}
@@ -2,18 +2,33 @@ package mw
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/ozontech/seq-ui/internal/app/auth"
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/logger"
 )
 
-var errAuthProviderNotInit = errors.New("auth provider was not initialized")
+var (
+	errAuthProviderNotInit = errors.New("auth provider was not initialized")
+	errTokenScope          = errors.New("token scopes don't allow access to the api")
+	errNoPermission        = errors.New("user has no permission")
+	errNoCredentials       = errors.New("no credentials provided")
+)
 
-const authHeaderBearerKey = "Bearer"
+const (
+	authHeaderBearerKey = "Bearer"
+	// apiKeyHeader is the header of the static API keys, it's also used as gRPC metadata key.
+	apiKeyHeader = "X-API-Key"
+)
 
 func getTokenFromAuthHeader(authHeader string) (string, error) {
 	tokenOffset := len(authHeaderBearerKey) + 1
@@ -23,67 +38,322 @@ func getTokenFromAuthHeader(authHeader string) (string, error) {
 	return authHeader[tokenOffset:], nil
 }
 
+// TokenRevocationChecker checks whether the personal API token was revoked.
+type TokenRevocationChecker interface {
+	IsRevoked(ctx context.Context, id string) (bool, error)
+}
+
 type AuthProviders struct {
-	JwtProvider  auth.JWTProvider
-	OidcProvider auth.OIDCProvider
+	JwtProvider    auth.JWTProvider
+	OidcProvider   auth.OIDCProvider
+	MTLSProvider   auth.MTLSProvider
+	APIKeyProvider auth.APIKeyProvider
+	// Chain is the order the providers are tried in, all the initialized providers are tried in the default order if empty.
+	Chain []string
+	// TokenChecker is used for the personal API tokens, they are rejected if not set.
+	TokenChecker TokenRevocationChecker
+	// RBAC is used for the permission checks, they are skipped if not set.
+	RBAC *rbac.RBAC
+	// SessionProvider is used for the browser sessions from the SessionCookie, they are rejected if not set.
+	SessionProvider auth.SessionProvider
+	SessionCookie   string
+}
+
+var defaultAuthChain = []string{
+	config.AuthProviderMTLS,
+	config.AuthProviderAPIKey,
+	config.AuthProviderJWT,
+	config.AuthProviderOIDC,
+}
+
+// authResult contains authenticated user.
+type authResult struct {
+	userName string
+	groups   []string
+}
+
+// authCredentials contains the credentials of the request, any of them can be empty.
+type authCredentials struct {
+	authHeader string
+	apiKey     string
+	sessionID  string
+	// clientCert is the client cert verified during TLS handshake.
+	clientCert *x509.Certificate
+}
+
+func (c authCredentials) empty() bool {
+	return c.authHeader == "" && c.apiKey == "" && c.sessionID == "" && c.clientCert == nil
 }
 
 func NewAuthProviders(
 	ctx context.Context,
-	jwtSecretKey string, oidcCfg *config.OIDC,
-	cacheCfg config.Cache,
+	cfg *config.Server,
+	tokenChecker TokenRevocationChecker,
 ) (AuthProviders, error) {
-	authPrvds := AuthProviders{}
+	authPrvds := AuthProviders{
+		Chain:        cfg.AuthChain,
+		TokenChecker: tokenChecker,
+	}
+
+	enabled := func(provider string) bool {
+		return len(cfg.AuthChain) == 0 || slices.Contains(cfg.AuthChain, provider)
+	}
 
-	if jwtSecretKey == "" && oidcCfg == nil {
-		return authPrvds, nil
+	if cfg.TLS != nil && cfg.TLS.ClientCA != "" && enabled(config.AuthProviderMTLS) {
+		logger.Info("initializing mtls provider")
+		authPrvds.MTLSProvider = auth.NewMTLSProvider(cfg.TLS.UserFrom)
 	}
 
-	if jwtSecretKey != "" {
+	if len(cfg.APIKeys) > 0 && enabled(config.AuthProviderAPIKey) {
+		logger.Info("initializing api key provider")
+		var err error
+		authPrvds.APIKeyProvider, err = auth.NewAPIKeyProvider(cfg.APIKeys)
+		if err != nil {
+			return authPrvds, fmt.Errorf("failed to init api key provider: %w", err)
+		}
+	}
+
+	if (cfg.JWTSecretKey != "" || cfg.JWT != nil) && enabled(config.AuthProviderJWT) {
 		logger.Info("initializing jwt provider")
-		authPrvds.JwtProvider = auth.NewJWTProvider(jwtSecretKey)
+		var err error
+		authPrvds.JwtProvider, err = auth.NewJWTProviderWithKeys(ctx, cfg.JWTSecretKey, cfg.JWT)
+		if err != nil {
+			return authPrvds, fmt.Errorf("failed to init jwt provider: %w", err)
+		}
 	}
 
-	if oidcCfg != nil {
+	if cfg.OIDC != nil && enabled(config.AuthProviderOIDC) {
 		logger.Info("initializing oidc provider")
 		var err error
-		authPrvds.OidcProvider, err = auth.NewOIDCProvider(ctx, oidcCfg, cacheCfg)
+		authPrvds.OidcProvider, err = auth.NewOIDCProvider(ctx, cfg.OIDC, cfg.Cache)
 		if err != nil {
 			return authPrvds, fmt.Errorf("failed to init oidc provider: %w", err)
 		}
 	}
+
+	for _, provider := range cfg.AuthChain {
+		if !authPrvds.initialized(provider) {
+			return authPrvds, fmt.Errorf("auth provider %q from auth chain is not configured", provider)
+		}
+	}
 	return authPrvds, nil
 }
 
-func (p *AuthProviders) auth(ctx context.Context, authHeader string) (string, error) {
-	token, err := getTokenFromAuthHeader(authHeader)
+// Enabled reports whether any of the providers is initialized, the requests aren't authenticated otherwise.
+func (p *AuthProviders) Enabled() bool {
+	return slices.ContainsFunc(defaultAuthChain, p.initialized)
+}
+
+func (p *AuthProviders) initialized(provider string) bool {
+	switch provider {
+	case config.AuthProviderMTLS:
+		return p.MTLSProvider != nil
+	case config.AuthProviderAPIKey:
+		return p.APIKeyProvider != nil
+	case config.AuthProviderJWT:
+		return p.JwtProvider != nil
+	case config.AuthProviderOIDC:
+		return p.OidcProvider != nil
+	}
+	return false
+}
+
+func (p *AuthProviders) chain() []string {
+	if len(p.Chain) > 0 {
+		return p.Chain
+	}
+	return defaultAuthChain
+}
+
+// auth tries the providers of the chain in order and returns the user authenticated by the first one
+// accepting the credentials. The session from the session cookie is verified only if there are
+// no other credentials. The api is used to check scopes of the personal API tokens.
+func (p *AuthProviders) auth(ctx context.Context, creds authCredentials, api string) (authResult, error) {
+	var (
+		firstErr    error
+		initialized bool
+	)
+	for _, provider := range p.chain() {
+		if !p.initialized(provider) {
+			continue
+		}
+		initialized = true
+
+		res, recognized, err := p.authBy(ctx, provider, creds, api)
+		// the provider recognized the credentials, so others can't accept them
+		if err == nil || recognized {
+			return res, err
+		}
+		if firstErr == nil && !errors.Is(err, errNoCredentials) {
+			firstErr = err
+		}
+	}
+
+	switch {
+	case firstErr != nil:
+		return authResult{}, firstErr
+	case creds.sessionID != "":
+		return p.authSession(ctx, creds.sessionID)
+	case !initialized:
+		return authResult{}, errAuthProviderNotInit
+	}
+	return authResult{}, errNoCredentials
+}
+
+// authBy verifies the credentials by the provider. The recognized is set if the credentials
+// were issued by the provider, e.g. the token is valid, but its scopes don't allow access to the api.
+func (p *AuthProviders) authBy(
+	ctx context.Context, provider string, creds authCredentials, api string,
+) (_ authResult, recognized bool, _ error) {
+	switch provider {
+	case config.AuthProviderMTLS:
+		if creds.clientCert == nil {
+			return authResult{}, false, errNoCredentials
+		}
+		userName, err := p.MTLSProvider.Verify(creds.clientCert)
+		if err != nil {
+			return authResult{}, false, fmt.Errorf("failed to verify client cert: %w", err)
+		}
+		// client certs are issued to services, so they can't impersonate users
+		return authResult{userName: formatServiceName(userName)}, true, nil
+	case config.AuthProviderAPIKey:
+		if creds.apiKey == "" {
+			return authResult{}, false, errNoCredentials
+		}
+		name, err := p.APIKeyProvider.Verify(creds.apiKey)
+		if err != nil {
+			return authResult{}, false, fmt.Errorf("failed to verify api key: %w", err)
+		}
+		return authResult{userName: formatServiceName(name)}, true, nil
+	case config.AuthProviderJWT:
+		token, err := getTokenFromCreds(creds)
+		if err != nil {
+			return authResult{}, false, err
+		}
+		jwtClaims, err := p.JwtProvider.Verify(token)
+		if err != nil {
+			return authResult{}, false, fmt.Errorf("failed to verify token: %w", err)
+		}
+		// only the personal API tokens have ID, the tokens of other issuers can't be personal
+		if jwtClaims.Id != "" && !jwtClaims.External {
+			userName, err := p.authAPIToken(ctx, jwtClaims, api)
+			return authResult{userName: userName}, true, err
+		}
+		return authResult{userName: formatServiceName(jwtClaims.Name)}, true, nil
+	case config.AuthProviderOIDC:
+		token, err := getTokenFromCreds(creds)
+		if err != nil {
+			return authResult{}, false, err
+		}
+		oidcToken, err := p.OidcProvider.Verify(ctx, token)
+		if err != nil {
+			return authResult{}, false, fmt.Errorf("failed to verify token: %w", err)
+		}
+		return authResult{
+			userName: oidcToken.UserName,
+			groups:   oidcToken.Groups,
+		}, true, nil
+	}
+	return authResult{}, false, fmt.Errorf("unknown auth provider %q", provider)
+}
+
+func getTokenFromCreds(creds authCredentials) (string, error) {
+	if creds.authHeader == "" {
+		return "", errNoCredentials
+	}
+	token, err := getTokenFromAuthHeader(creds.authHeader)
 	if err != nil {
 		return "", fmt.Errorf("failed to get token from auth header: %w", err)
 	}
-	if p.JwtProvider != nil {
-		// first check if token was issued by the app's JWT provider
-		jwtClaims, err := p.JwtProvider.Verify(token)
-		if err == nil {
-			return formatJWTServiceName(jwtClaims.Name), nil
-		} else if p.OidcProvider == nil {
-			return "", fmt.Errorf("failed to verify token: %w", err)
+	return token, nil
+}
+
+// clientCertFromTLS returns the client cert verified during TLS handshake, if any.
+func clientCertFromTLS(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+// authSession verifies the browser session.
+func (p *AuthProviders) authSession(ctx context.Context, sessionID string) (authResult, error) {
+	if p.SessionProvider == nil {
+		return authResult{}, errors.New("sessions are disabled")
+	}
+
+	oidcToken, err := p.SessionProvider.VerifySession(ctx, sessionID)
+	if err != nil {
+		return authResult{}, fmt.Errorf("failed to verify session: %w", err)
+	}
+
+	return authResult{
+		userName: oidcToken.UserName,
+		groups:   oidcToken.Groups,
+	}, nil
+}
+
+// sessionFromCookies returns the session ID from the cookie header values,
+// it's empty if the sessions are disabled or there is no session cookie.
+func (p *AuthProviders) sessionFromCookies(cookies []string) string {
+	if p.SessionProvider == nil || len(cookies) == 0 {
+		return ""
+	}
+
+	parsed, err := http.ParseCookie(strings.Join(cookies, "; "))
+	if err != nil {
+		return ""
+	}
+	for _, c := range parsed {
+		if c.Name == p.SessionCookie {
+			return c.Value
 		}
 	}
+	return ""
+}
+
+// authorize puts the authenticated user into context and checks the required permission.
+// The permission is checked only if RBAC is enabled and the permission is not empty.
+func (p *AuthProviders) authorize(ctx context.Context, res authResult, perm string) (context.Context, error) {
+	ctx = context.WithValue(ctx, types.UserKey{}, res.userName)
+	if len(res.groups) > 0 {
+		ctx = context.WithValue(ctx, types.UserGroupsKey{}, res.groups)
+	}
 
-	if p.OidcProvider == nil {
-		return "", errAuthProviderNotInit
+	if p.RBAC == nil {
+		return ctx, nil
 	}
 
-	// if JWT provider verification failed, check if the token was issued by OIDC
-	oidcToken, err := p.OidcProvider.Verify(ctx, token)
+	access := p.RBAC.Resolve(res.userName, res.groups)
+	ctx = rbac.WithAccess(ctx, access)
+
+	if perm != "" && !access.Has(perm) {
+		return ctx, fmt.Errorf("%w: %q required", errNoPermission, perm)
+	}
+	return ctx, nil
+}
+
+// authAPIToken checks the personal API token, which authenticates as its owner.
+func (p *AuthProviders) authAPIToken(ctx context.Context, claims *auth.JWTClaims, api string) (string, error) {
+	if p.TokenChecker == nil {
+		return "", errors.New("api tokens are disabled")
+	}
+	if !slices.Contains(claims.Scopes, api) {
+		return "", errTokenScope
+	}
+
+	revoked, err := p.TokenChecker.IsRevoked(ctx, claims.Id)
 	if err != nil {
-		return "", fmt.Errorf("failed to verify token: %w", err)
+		return "", fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	if revoked {
+		return "", errors.New("token was revoked")
 	}
 
-	return oidcToken.UserName, nil
+	return claims.Name, nil
 }
 
-func formatJWTServiceName(name string) string {
-	// all api tokens names are prefixed with `api@`, so they have separate requests limits
+func formatServiceName(name string) string {
+	// all service tokens, api keys and client certs names are prefixed with `api@`, so they have separate requests limits
 	return fmt.Sprintf("api@%s", name)
 }
@@ -2,27 +2,45 @@ package mw
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/ozontech/seq-ui/internal/app/auth"
 	mock_auth "github.com/ozontech/seq-ui/internal/app/auth/mock"
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
 )
 
 func TestAuthProvidersAuth(t *testing.T) {
 	token := "test"
 	authHeader := fmt.Sprintf("%s %s", authHeaderBearerKey, token)
 	userName := "unnamed"
+	groups := []string{"devs"}
 	oidcToken := auth.OIDCToken{
 		UserName: userName,
+		Groups:   groups,
 	}
 	jwtClaims := &auth.JWTClaims{
 		Name: userName,
 	}
+	apiTokenClaims := &auth.JWTClaims{
+		Name:   userName,
+		Scopes: []string{"seqapi"},
+		StandardClaims: jwt.StandardClaims{
+			Id: "token-id",
+		},
+	}
 	err := errors.New("some error")
 
 	type (
@@ -38,9 +56,21 @@ func TestAuthProvidersAuth(t *testing.T) {
 			err       error
 		}
 
+		tokenCheckerMockArgs struct {
+			revoked bool
+			err     error
+		}
+
+		sessionMockArgs struct {
+			oidcToken auth.OIDCToken
+			err       error
+		}
+
 		mockArgs struct {
-			jwt  *jwtMockArgs
-			oidc *oidcMockArgs
+			jwt          *jwtMockArgs
+			oidc         *oidcMockArgs
+			tokenChecker *tokenCheckerMockArgs
+			session      *sessionMockArgs
 		}
 	)
 
@@ -48,9 +78,11 @@ func TestAuthProvidersAuth(t *testing.T) {
 		name string
 
 		authHeader string
+		sessionID  string
+		api        string
 		mockArgs   mockArgs
 
-		want    string
+		want    authResult
 		wantErr bool
 	}{
 		{
@@ -67,7 +99,7 @@ func TestAuthProvidersAuth(t *testing.T) {
 					jwtClaims: jwtClaims,
 				},
 			},
-			want: formatJWTServiceName(userName),
+			want: authResult{userName: formatServiceName(userName)},
 		},
 		{
 			name:       "err_jwt_only",
@@ -89,7 +121,7 @@ func TestAuthProvidersAuth(t *testing.T) {
 					oidcToken: oidcToken,
 				},
 			},
-			want: userName,
+			want: authResult{userName: userName, groups: groups},
 		},
 		{
 			name:       "err_oidc_only",
@@ -120,7 +152,120 @@ func TestAuthProvidersAuth(t *testing.T) {
 					oidcToken: oidcToken,
 				},
 			},
-			want: userName,
+			want: authResult{userName: userName, groups: groups},
+		},
+		{
+			name:       "ok_api_token",
+			authHeader: authHeader,
+			api:        "seqapi",
+			mockArgs: mockArgs{
+				jwt: &jwtMockArgs{
+					token:     token,
+					jwtClaims: apiTokenClaims,
+				},
+				tokenChecker: &tokenCheckerMockArgs{},
+			},
+			want: authResult{userName: userName},
+		},
+		{
+			name:       "err_api_token_revoked",
+			authHeader: authHeader,
+			api:        "seqapi",
+			mockArgs: mockArgs{
+				jwt: &jwtMockArgs{
+					token:     token,
+					jwtClaims: apiTokenClaims,
+				},
+				tokenChecker: &tokenCheckerMockArgs{revoked: true},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "err_api_token_checker",
+			authHeader: authHeader,
+			api:        "seqapi",
+			mockArgs: mockArgs{
+				jwt: &jwtMockArgs{
+					token:     token,
+					jwtClaims: apiTokenClaims,
+				},
+				tokenChecker: &tokenCheckerMockArgs{err: err},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "err_api_token_scope",
+			authHeader: authHeader,
+			api:        "dashboards",
+			mockArgs: mockArgs{
+				jwt: &jwtMockArgs{
+					token:     token,
+					jwtClaims: apiTokenClaims,
+				},
+				tokenChecker: &tokenCheckerMockArgs{},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "err_api_token_disabled",
+			authHeader: authHeader,
+			api:        "seqapi",
+			mockArgs: mockArgs{
+				jwt: &jwtMockArgs{
+					token:     token,
+					jwtClaims: apiTokenClaims,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "ok_external_token_with_id",
+			authHeader: authHeader,
+			api:        "seqapi",
+			mockArgs: mockArgs{
+				jwt: &jwtMockArgs{
+					token: token,
+					jwtClaims: &auth.JWTClaims{
+						Name:           userName,
+						External:       true,
+						StandardClaims: apiTokenClaims.StandardClaims,
+					},
+				},
+			},
+			want: authResult{userName: formatServiceName(userName)},
+		},
+		{
+			name:      "ok_session",
+			sessionID: "session-id",
+			mockArgs: mockArgs{
+				session: &sessionMockArgs{oidcToken: oidcToken},
+			},
+			want: authResult{userName: userName, groups: groups},
+		},
+		{
+			name:      "err_session",
+			sessionID: "session-id",
+			mockArgs: mockArgs{
+				session: &sessionMockArgs{err: err},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "err_sessions_disabled",
+			sessionID: "session-id",
+			wantErr:   true,
+		},
+		{
+			name:       "ok_token_over_session",
+			authHeader: authHeader,
+			sessionID:  "session-id",
+			mockArgs: mockArgs{
+				jwt: &jwtMockArgs{
+					token:     token,
+					jwtClaims: jwtClaims,
+				},
+			},
+			want: authResult{userName: formatServiceName(userName)},
 		},
 	}
 
@@ -146,14 +291,250 @@ func TestAuthProvidersAuth(t *testing.T) {
 
 				authPrv.OidcProvider = oidcProvider
 			}
+			if args := tCase.mockArgs.tokenChecker; args != nil {
+				authPrv.TokenChecker = tokenCheckerFunc(func(_ context.Context, id string) (bool, error) {
+					require.Equal(t, apiTokenClaims.Id, id)
+					return args.revoked, args.err
+				})
+			}
+
+			if args := tCase.mockArgs.session; args != nil {
+				sessionProvider := mock_auth.NewMockSessionProvider(ctrl)
+				sessionProvider.EXPECT().VerifySession(gomock.Any(), tCase.sessionID).
+					Return(args.oidcToken, args.err)
+
+				authPrv.SessionProvider = sessionProvider
+			}
 
-			got, err := authPrv.auth(context.Background(), authHeader)
+			got, err := authPrv.auth(context.Background(), authCredentials{authHeader: tCase.authHeader, sessionID: tCase.sessionID}, tCase.api)
 			require.Equal(t, tCase.wantErr, err != nil)
 			if tCase.wantErr {
 				return
 			}
 
-			require.Equal(t, got, tCase.want)
+			require.Equal(t, tCase.want, got)
+		})
+	}
+}
+
+func TestAuthProvidersAuthChain(t *testing.T) {
+	const (
+		apiKey   = "secret-key"
+		token    = "test"
+		certUser = "log-shipper"
+	)
+	authHeader := fmt.Sprintf("%s %s", authHeaderBearerKey, token)
+	apiKeyHash := sha256.Sum256([]byte(apiKey))
+	apiKeyProvider, err := auth.NewAPIKeyProvider([]config.APIKey{
+		{Name: "robot", Hash: hex.EncodeToString(apiKeyHash[:])},
+	})
+	require.NoError(t, err)
+	clientCert := &x509.Certificate{Subject: pkix.Name{CommonName: certUser}}
+
+	tCases := []struct {
+		name  string
+		chain []string
+		creds authCredentials
+		// jwtUser is returned by JWT provider, it fails if empty
+		jwtUser string
+
+		want    authResult
+		wantErr bool
+	}{
+		{
+			name:  "ok_api_key",
+			creds: authCredentials{apiKey: apiKey},
+			want:  authResult{userName: formatServiceName("robot")},
+		},
+		{
+			name:    "err_api_key",
+			creds:   authCredentials{apiKey: "invalid"},
+			wantErr: true,
+		},
+		{
+			name:    "ok_jwt_after_invalid_api_key",
+			creds:   authCredentials{apiKey: "invalid", authHeader: authHeader},
+			jwtUser: "service",
+			want:    authResult{userName: formatServiceName("service")},
+		},
+		{
+			name:  "ok_mtls",
+			creds: authCredentials{clientCert: clientCert},
+			want:  authResult{userName: formatServiceName(certUser)},
+		},
+		{
+			name:    "err_mtls_no_cn",
+			creds:   authCredentials{clientCert: &x509.Certificate{}},
+			wantErr: true,
+		},
+		{
+			name:    "ok_mtls_first_by_default",
+			creds:   authCredentials{clientCert: clientCert, authHeader: authHeader},
+			jwtUser: "service",
+			want:    authResult{userName: formatServiceName(certUser)},
+		},
+		{
+			name:    "ok_custom_chain",
+			chain:   []string{config.AuthProviderJWT, config.AuthProviderMTLS},
+			creds:   authCredentials{clientCert: clientCert, authHeader: authHeader},
+			jwtUser: "service",
+			want:    authResult{userName: formatServiceName("service")},
+		},
+		{
+			name:    "err_no_credentials",
+			wantErr: true,
+		},
+	}
+
+	for _, tCase := range tCases {
+		tCase := tCase
+		t.Run(tCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			jwtProvider := mock_auth.NewMockJWTProvider(gomock.NewController(t))
+			if tCase.jwtUser != "" {
+				jwtProvider.EXPECT().Verify(token).Return(&auth.JWTClaims{Name: tCase.jwtUser}, nil).AnyTimes()
+			}
+
+			authPrv := &AuthProviders{
+				JwtProvider:    jwtProvider,
+				MTLSProvider:   auth.NewMTLSProvider(config.TLSUserFromCN),
+				APIKeyProvider: apiKeyProvider,
+				Chain:          tCase.chain,
+			}
+
+			got, err := authPrv.auth(context.Background(), tCase.creds, "")
+			require.Equal(t, tCase.wantErr, err != nil)
+			if tCase.wantErr {
+				return
+			}
+
+			require.Equal(t, tCase.want, got)
+		})
+	}
+}
+
+func TestNewAuthProvidersChain(t *testing.T) {
+	ctx := context.Background()
+
+	// the providers out of the chain are not initialized
+	prvds, err := NewAuthProviders(ctx, &config.Server{
+		JWTSecretKey: "secret",
+		APIKeys:      []config.APIKey{{Name: "robot", Hash: strings.Repeat("0", 64)}},
+		AuthChain:    []string{config.AuthProviderAPIKey},
+	}, nil)
+	require.NoError(t, err)
+	require.True(t, prvds.Enabled())
+	require.NotNil(t, prvds.APIKeyProvider)
+	require.Nil(t, prvds.JwtProvider)
+
+	// the providers of the chain must be configured
+	_, err = NewAuthProviders(ctx, &config.Server{
+		JWTSecretKey: "secret",
+		AuthChain:    []string{config.AuthProviderMTLS, config.AuthProviderJWT},
+	}, nil)
+	require.Error(t, err)
+
+	_, err = NewAuthProviders(ctx, &config.Server{
+		APIKeys: []config.APIKey{{Name: "robot", Hash: "invalid"}},
+	}, nil)
+	require.Error(t, err)
+
+	prvds, err = NewAuthProviders(ctx, &config.Server{}, nil)
+	require.NoError(t, err)
+	require.False(t, prvds.Enabled())
+}
+
+func TestAuthProvidersSessionFromCookies(t *testing.T) {
+	authPrv := &AuthProviders{
+		SessionProvider: mock_auth.NewMockSessionProvider(gomock.NewController(t)),
+		SessionCookie:   "seq_ui_session",
+	}
+
+	require.Equal(t, "", authPrv.sessionFromCookies(nil))
+	require.Equal(t, "", authPrv.sessionFromCookies([]string{"other=value"}))
+	require.Equal(t, "id", authPrv.sessionFromCookies([]string{"other=value; seq_ui_session=id"}))
+	require.Equal(t, "id", authPrv.sessionFromCookies([]string{"other=value", "seq_ui_session=id"}))
+
+	// sessions are disabled
+	require.Equal(t, "", (&AuthProviders{SessionCookie: "seq_ui_session"}).sessionFromCookies([]string{"seq_ui_session=id"}))
+}
+
+type tokenCheckerFunc func(ctx context.Context, id string) (bool, error)
+
+func (f tokenCheckerFunc) IsRevoked(ctx context.Context, id string) (bool, error) {
+	return f(ctx, id)
+}
+
+func TestAuthProvidersAuthorize(t *testing.T) {
+	r, err := rbac.New(&config.RBAC{
+		Roles: map[string]config.RBACRole{
+			"exporter": {
+				Permissions: []string{rbac.PermMassExportStart},
+				Groups:      []string{"exporters"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tCases := []struct {
+		name string
+
+		rbac *rbac.RBAC
+		res  authResult
+		perm string
+
+		wantAccess bool
+		wantErr    bool
+	}{
+		{
+			name: "ok_rbac_disabled",
+			res:  authResult{userName: "user"},
+			perm: rbac.PermMassExportStart,
+		},
+		{
+			name:       "ok_no_permission_required",
+			rbac:       r,
+			res:        authResult{userName: "user"},
+			wantAccess: true,
+		},
+		{
+			name:       "ok_permission_by_group",
+			rbac:       r,
+			res:        authResult{userName: "user", groups: []string{"exporters"}},
+			perm:       rbac.PermMassExportStart,
+			wantAccess: true,
+		},
+		{
+			name:    "err_no_permission",
+			rbac:    r,
+			res:     authResult{userName: "user", groups: []string{"devs"}},
+			perm:    rbac.PermMassExportStart,
+			wantErr: true,
+		},
+	}
+
+	for _, tCase := range tCases {
+		tCase := tCase
+		t.Run(tCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			authPrv := &AuthProviders{RBAC: tCase.rbac}
+
+			ctx, err := authPrv.authorize(context.Background(), tCase.res, tCase.perm)
+			require.Equal(t, tCase.wantErr, err != nil)
+			if tCase.wantErr {
+				require.ErrorIs(t, err, errNoPermission)
+				return
+			}
+
+			userName, err := types.GetUserKey(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tCase.res.userName, userName)
+			require.Equal(t, tCase.res.groups, types.GetUserGroups(ctx))
+
+			_, ok := rbac.GetAccess(ctx)
+			require.Equal(t, tCase.wantAccess, ok)
 		})
 	}
 }
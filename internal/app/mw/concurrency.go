@@ -0,0 +1,117 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/inflight"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/swagger"
+)
+
+// ValidateConcurrencyRoutes checks that the limited routes exist.
+// HTTP routes are `METHOD /pattern`, gRPC methods are `Service/Method`.
+func ValidateConcurrencyRoutes(limiters *inflight.Limiters, grpcServer *grpc.Server) error {
+	grpcMethods := map[string]struct{}{}
+	for fullSvc, info := range grpcServer.GetServiceInfo() {
+		svc := fullSvc[strings.LastIndex(fullSvc, ".")+1:]
+		for _, m := range info.Methods {
+			grpcMethods[svc+"/"+m.Name] = struct{}{}
+		}
+	}
+
+	spec := swagger.GetSpecEx()
+	for _, route := range limiters.Routes() {
+		if method, pattern, ok := strings.Cut(route, " "); ok {
+			if path, ok := spec.FindPath(pattern); !ok || !path.HasOperation(method) {
+				return fmt.Errorf("invalid concurrency limit route %q: unknown HTTP route", route)
+			}
+			continue
+		}
+		if _, ok := grpcMethods[route]; !ok {
+			return fmt.Errorf("invalid concurrency limit route %q: unknown gRPC method", route)
+		}
+	}
+	return nil
+}
+
+// acquireConcurrencySlot takes the in-flight slot of the user from context.
+// Returns nil release if the route isn't limited.
+func acquireConcurrencySlot(ctx context.Context, limiters *inflight.Limiters, route string) (func(), error) {
+	limiter := limiters.Route(route)
+	if limiter == nil {
+		return nil, nil
+	}
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		userName = RateLimiterDefaultUser
+	}
+
+	release, err := limiter.Acquire(ctx, userName)
+	if errors.Is(err, inflight.ErrLimitExceeded) {
+		logger.Warn("request was rejected by concurrency limit",
+			zap.String("route", route), zap.String("user", userName),
+		)
+	}
+	return release, err
+}
+
+func HTTPConcurrencyLimitInterceptor(limiters *inflight.Limiters) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			release, err := acquireConcurrencySlot(ctx, limiters, r.Method+" "+getRoutePattern(ctx))
+			if errors.Is(err, inflight.ErrLimitExceeded) {
+				http.Error(w, err.Error(), http.StatusTooManyRequests)
+				return
+			} else if err != nil {
+				// the client has gone while waiting
+				http.Error(w, err.Error(), 499)
+				return
+			}
+			if release != nil {
+				defer release()
+			}
+
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+func GRPCConcurrencyLimitInterceptor(limiters *inflight.Limiters) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo,
+		h grpc.UnaryHandler,
+	) (any, error) {
+		svc, method, err := parseGRPCFullMethod(info.FullMethod)
+		if err != nil {
+			msg := "failed to parse gRPC FullMethod"
+			logger.Error(msg, zap.Error(err))
+			return nil, status.Error(codes.Internal, msg)
+		}
+
+		release, err := acquireConcurrencySlot(ctx, limiters, svc+"/"+method)
+		if errors.Is(err, inflight.ErrLimitExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		} else if err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		if release != nil {
+			defer release()
+		}
+
+		return h(ctx, req)
+	}
+}
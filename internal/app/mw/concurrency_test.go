@@ -0,0 +1,88 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/inflight"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func newTestConcurrencyLimiters(t *testing.T) *inflight.Limiters {
+	t.Helper()
+
+	l, err := inflight.New(config.ConcurrencyLimits{
+		QueueTimeout: 10 * time.Millisecond,
+		Routes: map[string]config.ConcurrencyLimit{
+			"POST /seqapi/v1/search": {MaxInFlight: 1},
+			"SeqAPIService/Search":   {MaxInFlight: 1},
+		},
+	})
+	require.NoError(t, err)
+	return l
+}
+
+func TestHTTPConcurrencyLimitInterceptor(t *testing.T) {
+	limiters := newTestConcurrencyLimiters(t)
+
+	inHandler := make(chan struct{})
+	unblock := make(chan struct{})
+	mux := chi.NewMux()
+	mux.Use(HTTPNotFoundInterceptor(), HTTPConcurrencyLimitInterceptor(limiters))
+	mux.Post("/seqapi/v1/search", func(_ http.ResponseWriter, _ *http.Request) {
+		inHandler <- struct{}{}
+		<-unblock
+	})
+	mux.Get("/seqapi/v1/fields", func(_ http.ResponseWriter, _ *http.Request) {})
+
+	serve := func(method, url string) int {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(method, url, http.NoBody))
+		return rec.Code
+	}
+
+	done := make(chan int)
+	go func() {
+		done <- serve(http.MethodPost, "/seqapi/v1/search")
+	}()
+	<-inHandler
+
+	require.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "/seqapi/v1/search"))
+	// not limited route
+	require.Equal(t, http.StatusOK, serve(http.MethodGet, "/seqapi/v1/fields"))
+
+	close(unblock)
+	require.Equal(t, http.StatusOK, <-done)
+}
+
+func TestGRPCConcurrencyLimitInterceptor(t *testing.T) {
+	limiters := newTestConcurrencyLimiters(t)
+	interceptor := GRPCConcurrencyLimitInterceptor(limiters)
+	info := &grpc.UnaryServerInfo{FullMethod: "/seqapi.v1.SeqAPIService/Search"}
+	ctx := context.WithValue(context.Background(), types.UserKey{}, "alice")
+
+	_, err := interceptor(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		// the slot is taken by this request
+		_, err := interceptor(ctx, req, info, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// other users aren't limited
+		otherCtx := context.WithValue(ctx, types.UserKey{}, "bob")
+		return interceptor(otherCtx, req, info, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+	})
+	require.NoError(t, err)
+}
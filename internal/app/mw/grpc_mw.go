@@ -2,6 +2,7 @@ package mw
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,11 +10,14 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/ozontech/seq-ui/internal/app/rbac"
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/metric"
@@ -135,6 +139,13 @@ var noAuthGRPCMethods = map[string]map[string]struct{}{
 
 var errUnauth = status.Error(codes.Unauthenticated, types.ErrUnauthenticated.Error())
 
+func firstMDValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
 func GRPCAuthInterceptor(providers *AuthProviders) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context, req any, info *grpc.UnaryServerInfo,
@@ -145,25 +156,49 @@ func GRPCAuthInterceptor(providers *AuthProviders) grpc.UnaryServerInterceptor {
 			logger.Error("failed to parse gRPC FullMethod", zap.Error(err))
 			return nil, errUnauth
 		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		creds := authCredentials{
+			authHeader: firstMDValue(md, "authorization"),
+			apiKey:     firstMDValue(md, apiKeyHeader),
+			// the session cookie is forwarded by the gRPC-Web proxies
+			sessionID: providers.sessionFromCookies(md.Get("cookie")),
+		}
+		if providers.MTLSProvider != nil {
+			if p, ok := peer.FromContext(ctx); ok {
+				if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+					creds.clientCert = clientCertFromTLS(&tlsInfo.State)
+				}
+			}
+		}
+		api := parseGRPCFullMethodAPI(info.FullMethod)
+
 		if _, noAuth := noAuthGRPCMethods[svc][method]; noAuth {
+			// the user is optional, but it's used if provided, e.g. to filter envs by access
+			if !creds.empty() {
+				if res, err := providers.auth(ctx, creds, api); err == nil {
+					ctx, _ = providers.authorize(ctx, res, "")
+				}
+			}
 			return h(ctx, req)
 		}
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			logger.Error("no metadata provided")
-			return nil, errUnauth
-		}
-		authHeaderSlice, ok := md["authorization"]
-		if !ok || len(authHeaderSlice) == 0 {
+		if creds.empty() {
 			logger.Error("no authorization metadata provided")
 			return nil, errUnauth
 		}
-		userName, err := providers.auth(ctx, authHeaderSlice[0])
-		if err != nil {
+		res, err := providers.auth(ctx, creds, api)
+		if errors.Is(err, errTokenScope) {
+			logger.Error("token auth failed", zap.Error(err))
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		} else if err != nil {
 			logger.Error("token auth failed", zap.Error(err))
 			return nil, errUnauth
 		}
-		ctx = context.WithValue(ctx, types.UserKey{}, userName)
+
+		ctx, err = providers.authorize(ctx, res, rbac.GRPCPermission(svc, method))
+		if err != nil {
+			logger.Error("authorization failed", zap.String("user", res.userName), zap.Error(err))
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 
 		return h(ctx, req)
 	}
@@ -3,6 +3,7 @@ package mw
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/metric"
@@ -157,19 +159,47 @@ func HTTPAuthInterceptor(providers *AuthProviders) func(next http.Handler) http.
 				noAuth = !path.HasSecurity(r.Method)
 			}
 
+			// api is used only for scopes of the personal API tokens, so it can be empty
+			uriApi, _, _, _ := parseURI(r.RequestURI)
+			creds := authCredentials{
+				authHeader: r.Header.Get("Authorization"),
+				apiKey:     r.Header.Get(apiKeyHeader),
+				sessionID:  providers.sessionFromCookies(r.Header.Values("Cookie")),
+			}
+			if providers.MTLSProvider != nil {
+				creds.clientCert = clientCertFromTLS(r.TLS)
+			}
+
 			if noAuth {
+				// the user is optional, but it's used if provided, e.g. to filter envs by access
+				if !creds.empty() {
+					if res, err := providers.auth(ctx, creds, uriApi); err == nil {
+						ctx, _ = providers.authorize(ctx, res, "")
+						r = r.WithContext(ctx)
+					}
+				}
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			authHeader := r.Header.Get("Authorization")
-			username, err := providers.auth(ctx, authHeader)
-			if err != nil {
+			res, err := providers.auth(ctx, creds, uriApi)
+			if errors.Is(err, errTokenScope) {
+				logger.Error("token auth failed", zap.Error(err))
+				http.Error(w, "Permission denied", http.StatusForbidden)
+				return
+			} else if err != nil {
 				logger.Error("token auth failed", zap.Error(err))
 				http.Error(w, "Unauthenticated", http.StatusUnauthorized)
 				return
 			}
-			r = r.WithContext(context.WithValue(ctx, types.UserKey{}, username))
+
+			ctx, err = providers.authorize(ctx, res, rbac.HTTPPermission(r.Method, getRoutePattern(ctx)))
+			if err != nil {
+				logger.Error("authorization failed", zap.String("user", res.userName), zap.Error(err))
+				http.Error(w, "Permission denied", http.StatusForbidden)
+				return
+			}
+			r = r.WithContext(ctx)
 
 			next.ServeHTTP(w, r)
 		}
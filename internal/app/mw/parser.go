@@ -48,3 +48,22 @@ func parseGRPCFullMethod(fullMethod string) (string, string, error) {
 
 	return sliceStrings[0][lastDotIndex+1:], sliceStrings[1], nil
 }
+
+// parseGRPCFullMethodAPI parses FullMethod into api name, which is the first part of the package.
+//
+// Example 1: /api.v1.Service/Method -> api
+//
+// Example 2: /Service/Method -> ""
+func parseGRPCFullMethodAPI(fullMethod string) string {
+	if fullMethod == "" || fullMethod[0] != '/' {
+		return ""
+	}
+
+	svc, _, _ := strings.Cut(fullMethod[1:], "/")
+	api, _, found := strings.Cut(svc, ".")
+	if !found {
+		return ""
+	}
+
+	return api
+}
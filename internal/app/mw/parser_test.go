@@ -118,3 +118,20 @@ func TestParseGRPCFullMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGRPCFullMethodAPI(t *testing.T) {
+	tCases := []struct {
+		fullMethod string
+		want       string
+	}{
+		{fullMethod: "/seqapi.v1.SeqAPIService/Search", want: "seqapi"},
+		{fullMethod: "/tokens.v1.TokensService/CreateToken", want: "tokens"},
+		{fullMethod: "/Service/Method", want: ""},
+		{fullMethod: "seqapi.v1.SeqAPIService/Search", want: ""},
+		{fullMethod: "", want: ""},
+	}
+
+	for _, tCase := range tCases {
+		assert.Equal(t, tCase.want, parseGRPCFullMethodAPI(tCase.fullMethod), tCase.fullMethod)
+	}
+}
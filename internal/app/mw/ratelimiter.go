@@ -25,7 +25,7 @@ var grpcServices = map[string]struct{}{
 }
 
 type rateLimiter interface {
-	RateLimit(string) (bool, ratelimiter.RateLimitInfo, error)
+	RateLimit(context.Context, string) (bool, ratelimiter.RateLimitInfo, error)
 }
 
 type RateLimiter struct {
@@ -33,7 +33,7 @@ type RateLimiter struct {
 	perHandler bool
 }
 
-func NewRateLimiter(api string, cfg config.RateLimiter) (RateLimiter, error) {
+func NewRateLimiter(ctx context.Context, api string, cfg config.RateLimiter) (RateLimiter, error) {
 	if _, ok := grpcServices[api]; !ok {
 		spec := swagger.GetSpecEx()
 		if !spec.HasApi(api) {
@@ -41,7 +41,7 @@ func NewRateLimiter(api string, cfg config.RateLimiter) (RateLimiter, error) {
 		}
 	}
 
-	limiter, err := ratelimiter.New(cfg)
+	limiter, err := ratelimiter.New(ctx, api, cfg)
 	if err != nil {
 		return RateLimiter{}, fmt.Errorf("init %q rate limiter: %w", api, err)
 	}
@@ -70,7 +70,7 @@ func handleUserRateLimit(
 		key = fmt.Sprintf("%s_%s", userName, handler)
 	}
 
-	limit, rlc, err := limiter.RateLimit(key)
+	limit, rlc, err := limiter.RateLimit(ctx, key)
 	if limit {
 		metric.ServerRateLimits.Inc()
 	}
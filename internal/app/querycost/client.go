@@ -0,0 +1,63 @@
+package querycost
+
+import (
+	"context"
+	"time"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+type client struct {
+	seqdb.Client
+	limiter *Limiter
+}
+
+// NewClient returns seq-db client charging the cost of the queries from the budgets of the users.
+func NewClient(c seqdb.Client, l *Limiter) seqdb.Client {
+	return &client{
+		Client:  c,
+		limiter: l,
+	}
+}
+
+// Unwrap returns the wrapped client.
+func (c *client) Unwrap() seqdb.Client {
+	return c.Client
+}
+
+func (c *client) GetAggregation(ctx context.Context, req *seqapi.GetAggregationRequest) (*seqapi.GetAggregationResponse, error) {
+	if _, err := c.limiter.Charge(ctx, aggregationQuery(req, time.Now())); err != nil {
+		return nil, err
+	}
+	return c.Client.GetAggregation(ctx, req)
+}
+
+func (c *client) GetHistogram(ctx context.Context, req *seqapi.GetHistogramRequest) (*seqapi.GetHistogramResponse, error) {
+	if _, err := c.limiter.Charge(ctx, histogramQuery(req, time.Now())); err != nil {
+		return nil, err
+	}
+	return c.Client.GetHistogram(ctx, req)
+}
+
+func (c *client) Search(ctx context.Context, req *seqapi.SearchRequest) (*seqapi.SearchResponse, error) {
+	if _, err := c.limiter.Charge(ctx, searchQuery(req, time.Now())); err != nil {
+		return nil, err
+	}
+	return c.Client.Search(ctx, req)
+}
+
+func (c *client) Export(ctx context.Context, req *seqapi.ExportRequest, cw *httputil.ChunkedWriter) error {
+	if _, err := c.limiter.Charge(ctx, exportQuery(req, time.Now())); err != nil {
+		return err
+	}
+	return c.Client.Export(ctx, req, cw)
+}
+
+func (c *client) StartAsyncSearch(ctx context.Context, req *seqapi.StartAsyncSearchRequest) (*seqapi.StartAsyncSearchResponse, error) {
+	if _, err := c.limiter.Charge(ctx, asyncSearchQuery(req, time.Now())); err != nil {
+		return nil, err
+	}
+	return c.Client.StartAsyncSearch(ctx, req)
+}
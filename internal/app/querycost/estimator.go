@@ -0,0 +1,120 @@
+package querycost
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+// Query contains the properties of the seq-db request affecting its cost.
+type Query struct {
+	Query        string
+	From, To     time.Time
+	Aggregations int
+	Documents    int
+}
+
+// Estimator estimates the cost of the queries by the weights.
+type Estimator struct {
+	weights config.QueryCostWeights
+}
+
+func NewEstimator(weights config.QueryCostWeights) Estimator {
+	return Estimator{weights: weights}
+}
+
+// Estimate returns the cost of the query, it's at least 1.
+//
+// The cost grows with the time range width, since seq-db scans more fractions.
+// The aggregations are computed over the whole range, so their cost grows with the width as well.
+// The wildcards make seq-db scan more tokens, so they multiply the cost.
+func (e Estimator) Estimate(q Query) int {
+	hours := max(q.To.Sub(q.From).Hours(), 0)
+
+	cost := e.weights.Base +
+		e.weights.PerHour*hours +
+		e.weights.PerAggregation*float64(q.Aggregations)*hours +
+		e.weights.PerDocument*float64(q.Documents)
+	if e.weights.Wildcard > 0 && strings.Contains(q.Query, "*") {
+		cost *= e.weights.Wildcard
+	}
+
+	return max(int(math.Ceil(cost)), 1)
+}
+
+func searchQuery(req *seqapi.SearchRequest, now time.Time) Query {
+	aggs := len(req.GetAggregations())
+	if req.GetHistogram() != nil {
+		aggs++
+	}
+	return Query{
+		Query:        req.GetQuery(),
+		From:         asTime(req.GetFrom(), now),
+		To:           asTime(req.GetTo(), now),
+		Aggregations: aggs,
+		Documents:    int(req.GetLimit()) + int(req.GetOffset()),
+	}
+}
+
+func aggregationQuery(req *seqapi.GetAggregationRequest, now time.Time) Query {
+	aggs := len(req.GetAggregations())
+	if aggs == 0 && req.GetAggField() != "" {
+		aggs = 1
+	}
+	return Query{
+		Query:        req.GetQuery(),
+		From:         asTime(req.GetFrom(), now),
+		To:           asTime(req.GetTo(), now),
+		Aggregations: aggs,
+	}
+}
+
+func histogramQuery(req *seqapi.GetHistogramRequest, now time.Time) Query {
+	return Query{
+		Query:        req.GetQuery(),
+		From:         asTime(req.GetFrom(), now),
+		To:           asTime(req.GetTo(), now),
+		Aggregations: 1,
+	}
+}
+
+func exportQuery(req *seqapi.ExportRequest, now time.Time) Query {
+	return Query{
+		Query:     req.GetQuery(),
+		From:      asTime(req.GetFrom(), now),
+		To:        asTime(req.GetTo(), now),
+		Documents: int(req.GetLimit()) + int(req.GetOffset()),
+	}
+}
+
+func asyncSearchQuery(req *seqapi.StartAsyncSearchRequest, now time.Time) Query {
+	aggs := len(req.GetAggs())
+	if req.GetHist() != nil {
+		aggs++
+	}
+	var docs int
+	if req.GetWithDocs() {
+		docs = int(req.GetSize())
+	}
+	return Query{
+		Query:        req.GetQuery(),
+		From:         asTime(req.GetFrom(), now),
+		To:           asTime(req.GetTo(), now),
+		Aggregations: aggs,
+		Documents:    docs,
+	}
+}
+
+// asTime returns now for nil timestamp, so the missing bound doesn't widen the range to decades,
+// unlike AsTime returning unix epoch.
+func asTime(ts *timestamppb.Timestamp, now time.Time) time.Time {
+	if ts == nil {
+		return now
+	}
+	return ts.AsTime()
+}
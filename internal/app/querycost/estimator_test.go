@@ -0,0 +1,110 @@
+package querycost
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+func TestEstimate(t *testing.T) {
+	e := NewEstimator(config.QueryCostWeights{
+		Base:           1,
+		PerHour:        1,
+		PerAggregation: 0.5,
+		PerDocument:    0.01,
+		Wildcard:       2,
+	})
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		q    Query
+		want int
+	}{
+		{
+			name: "short_search",
+			q:    Query{Query: "level:error", From: to.Add(-5 * time.Minute), To: to, Documents: 100},
+			want: 3,
+		},
+		{
+			name: "month_aggregation",
+			q:    Query{Query: "level:error", From: to.Add(-30 * 24 * time.Hour), To: to, Aggregations: 2},
+			want: 1441,
+		},
+		{
+			name: "wildcard",
+			q:    Query{Query: "message:*timeout*", From: to.Add(-time.Hour), To: to},
+			want: 4,
+		},
+		{
+			name: "inverted_range",
+			q:    Query{From: to, To: to.Add(-time.Hour)},
+			want: 1,
+		},
+		{
+			name: "min_cost",
+			q:    Query{},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, e.Estimate(tt.q))
+		})
+	}
+
+	require.Equal(t, 1, NewEstimator(config.QueryCostWeights{}).Estimate(Query{Query: "*"}))
+}
+
+func TestSearchQueryMissingBounds(t *testing.T) {
+	now := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		from, to *timestamppb.Timestamp
+		wantFrom time.Time
+		wantTo   time.Time
+	}{
+		{
+			name:     "both",
+			from:     timestamppb.New(now.Add(-time.Hour)),
+			to:       timestamppb.New(now.Add(-time.Minute)),
+			wantFrom: now.Add(-time.Hour),
+			wantTo:   now.Add(-time.Minute),
+		},
+		{
+			name:     "no_to",
+			from:     timestamppb.New(now.Add(-time.Hour)),
+			wantFrom: now.Add(-time.Hour),
+			wantTo:   now,
+		},
+		{
+			name:     "no_from",
+			to:       timestamppb.New(now.Add(-time.Hour)),
+			wantFrom: now,
+			wantTo:   now.Add(-time.Hour),
+		},
+		{
+			name:     "no_bounds",
+			wantFrom: now,
+			wantTo:   now,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := searchQuery(&seqapi.SearchRequest{From: tt.from, To: tt.to}, now)
+			require.True(t, tt.wantFrom.Equal(q.From), "from: %v", q.From)
+			require.True(t, tt.wantTo.Equal(q.To), "to: %v", q.To)
+		})
+	}
+
+	// the cost of the range with missing bound must not span decades
+	e := NewEstimator(config.QueryCostWeights{Base: 1, PerHour: 1})
+	q := searchQuery(&seqapi.SearchRequest{From: timestamppb.New(now.Add(-time.Hour))}, now)
+	require.Equal(t, 2, e.Estimate(q))
+}
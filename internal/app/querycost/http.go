@@ -0,0 +1,55 @@
+package querycost
+
+import (
+	"net/http"
+)
+
+// HTTPHeadersInterceptor writes the budget of the user to the response headers
+// if the seq-db client charged the query cost during the request.
+func HTTPHeadersInterceptor(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		ctx, h := withBudgetHolder(r.Context())
+		next.ServeHTTP(&headersWriter{ResponseWriter: w, holder: h}, r.WithContext(ctx))
+	}
+	return http.HandlerFunc(fn)
+}
+
+// headersWriter writes the budget headers right before the response headers are sent.
+type headersWriter struct {
+	http.ResponseWriter
+	holder      *budgetHolder
+	wroteHeader bool
+}
+
+func (w *headersWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if b, ok := w.holder.get(); ok {
+			for k, v := range budgetHeaders(b) {
+				w.Header().Set(k, v)
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *headersWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush is required by the export, which streams the response.
+func (w *headersWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *headersWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,225 @@
+package querycost
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/ratelimiter"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/metric"
+)
+
+const (
+	// defaultUser is the key of unauthenticated users, they share the default budget.
+	defaultUser = "_"
+
+	rateLimiterName = "query_cost"
+
+	headerCost      = "X-Query-Cost"
+	headerLimit     = "X-Query-Budget-Limit"
+	headerRemaining = "X-Query-Budget-Remaining"
+	headerReset     = "X-Query-Budget-Reset"
+	headerRetry     = "Retry-After"
+)
+
+// Budget is the state of the user budget after the query.
+type Budget struct {
+	Cost       int
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// BudgetExceededError is returned when the query cost exceeds the remaining budget of the user.
+type BudgetExceededError struct {
+	User   string
+	Budget Budget
+}
+
+func (e *BudgetExceededError) Error() string {
+	if e.Budget.Cost > e.Budget.Limit {
+		return fmt.Sprintf("query cost budget exceeded: query cost %d is greater than budget %d, "+
+			"try decreasing date range or make search query more precise", e.Budget.Cost, e.Budget.Limit)
+	}
+	return fmt.Sprintf("query cost budget exceeded: query cost %d, remaining budget %d, retry after %s",
+		e.Budget.Cost, e.Budget.Remaining, e.Budget.RetryAfter.Round(time.Second))
+}
+
+// GRPCStatus returns ResourceExhausted status with the quota failure and retry info details.
+func (e *BudgetExceededError) GRPCStatus() *status.Status {
+	st := status.New(codes.ResourceExhausted, e.Error())
+	details := []protoadapt.MessageV1{
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     "user:" + e.User,
+				Description: fmt.Sprintf("query cost %d, remaining budget %d of %d", e.Budget.Cost, e.Budget.Remaining, e.Budget.Limit),
+			}},
+		},
+	}
+	if e.Budget.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.Budget.RetryAfter)})
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// Limiter limits the total cost of the user queries per window.
+type Limiter struct {
+	estimator Estimator
+	budget    *ratelimiter.RateLimiter
+	specUsers map[string]*ratelimiter.RateLimiter
+}
+
+func New(ctx context.Context, cfg config.SeqAPIQueryCost) (*Limiter, error) {
+	newBudget := func(budget int) (*ratelimiter.RateLimiter, error) {
+		// the whole budget can be spent at once, then it's restored gradually during the window
+		return ratelimiter.NewWithQuota(ctx, rateLimiterName, ratelimiter.Quota{
+			Count:        budget,
+			Period:       cfg.Window,
+			MaxBurst:     budget - 1,
+			StoreMaxKeys: cfg.StoreMaxKeys,
+			Redis:        cfg.Redis,
+		})
+	}
+
+	budget, err := newBudget(cfg.Budget)
+	if err != nil {
+		return nil, fmt.Errorf("init default budget: %w", err)
+	}
+
+	specUsers := make(map[string]*ratelimiter.RateLimiter, len(cfg.SpecialUsers))
+	for user, b := range cfg.SpecialUsers {
+		if specUsers[user], err = newBudget(b); err != nil {
+			return nil, fmt.Errorf("init user %q budget: %w", user, err)
+		}
+	}
+
+	return &Limiter{
+		estimator: NewEstimator(cfg.Weights),
+		budget:    budget,
+		specUsers: specUsers,
+	}, nil
+}
+
+// Charge estimates the query cost and spends it from the budget of the user from context.
+// Returns BudgetExceededError if the budget is insufficient, the budget isn't spent in that case.
+func (l *Limiter) Charge(ctx context.Context, q Query) (Budget, error) {
+	user, err := types.GetUserKey(ctx)
+	if err != nil {
+		user = defaultUser
+	}
+
+	limiter, ok := l.specUsers[user]
+	if !ok {
+		limiter = l.budget
+	}
+
+	cost := l.estimator.Estimate(q)
+	limited, info, err := limiter.RateLimitN(ctx, user, cost)
+	if err != nil {
+		return Budget{}, fmt.Errorf("charge query cost: %w", err)
+	}
+
+	b := Budget{
+		Cost:       cost,
+		Limit:      info.Limit,
+		Remaining:  info.Remaining,
+		ResetAfter: info.ResetAfter,
+		RetryAfter: info.RetryAfter,
+	}
+	if cost > info.Limit {
+		// the query can't fit into the budget at all, so there is no sense to retry
+		b.RetryAfter = 0
+	}
+	reportBudget(ctx, b)
+
+	if limited {
+		metric.QueryCostBudgetExceeded.Inc()
+		logger.Warn("query cost budget exceeded",
+			zap.String("user", user), zap.Int("cost", cost), zap.Int("remaining", b.Remaining),
+		)
+		return b, &BudgetExceededError{User: user, Budget: b}
+	}
+	metric.QueryCostCharged.Add(float64(cost))
+	return b, nil
+}
+
+type budgetKey struct{}
+
+// budgetHolder keeps the budget of the request, the seq-db client can be called several times per request.
+type budgetHolder struct {
+	mu     sync.Mutex
+	budget *Budget
+}
+
+func withBudgetHolder(ctx context.Context) (context.Context, *budgetHolder) {
+	h := &budgetHolder{}
+	return context.WithValue(ctx, budgetKey{}, h), h
+}
+
+func (h *budgetHolder) set(b Budget) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.budget != nil {
+		b.Cost += h.budget.Cost
+	}
+	h.budget = &b
+}
+
+func (h *budgetHolder) get() (Budget, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.budget == nil {
+		return Budget{}, false
+	}
+	return *h.budget, true
+}
+
+// reportBudget passes the budget to the HTTP headers interceptor or to gRPC response headers.
+func reportBudget(ctx context.Context, b Budget) {
+	if h, ok := ctx.Value(budgetKey{}).(*budgetHolder); ok {
+		h.set(b)
+		return
+	}
+
+	md := metadata.MD{}
+	for k, v := range budgetHeaders(b) {
+		md.Set(k, v)
+	}
+	// fails outside of gRPC server handlers, headers aren't needed there
+	_ = grpc.SetHeader(ctx, md)
+}
+
+func budgetHeaders(b Budget) map[string]string {
+	headers := map[string]string{
+		headerCost:      strconv.Itoa(b.Cost),
+		headerLimit:     strconv.Itoa(b.Limit),
+		headerRemaining: strconv.Itoa(max(b.Remaining, 0)),
+		headerReset:     strconv.Itoa(ceilSeconds(b.ResetAfter)),
+	}
+	if b.RetryAfter > 0 {
+		headers[headerRetry] = strconv.Itoa(ceilSeconds(b.RetryAfter))
+	}
+	return headers
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int((max(d, 0) + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,149 @@
+package querycost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	mock_seqdb "github.com/ozontech/seq-ui/internal/pkg/client/seqdb/mock"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+func userContext(user string) context.Context {
+	return context.WithValue(context.Background(), types.UserKey{}, user)
+}
+
+func newTestLimiter(t *testing.T) *Limiter {
+	t.Helper()
+
+	l, err := New(context.Background(), config.SeqAPIQueryCost{
+		Budget:       10,
+		SpecialUsers: map[string]int{"robot": 100},
+		Window:       time.Hour,
+		Weights:      config.QueryCostWeights{Base: 1, PerHour: 1},
+	})
+	require.NoError(t, err)
+	return l
+}
+
+func hoursQuery(hours int) Query {
+	to := time.Now()
+	return Query{From: to.Add(-time.Duration(hours) * time.Hour), To: to}
+}
+
+func TestCharge(t *testing.T) {
+	l := newTestLimiter(t)
+	ctx := userContext("alice")
+
+	b, err := l.Charge(ctx, hoursQuery(5))
+	require.NoError(t, err)
+	require.Equal(t, 6, b.Cost)
+	require.Equal(t, 10, b.Limit)
+	require.Equal(t, 4, b.Remaining)
+
+	b, err = l.Charge(ctx, hoursQuery(5))
+	var budgetErr *BudgetExceededError
+	require.ErrorAs(t, err, &budgetErr)
+	require.Equal(t, "alice", budgetErr.User)
+	require.Equal(t, b, budgetErr.Budget)
+	require.Positive(t, b.RetryAfter)
+
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 2)
+	require.Equal(t, "user:alice", st.Details()[0].(*errdetails.QuotaFailure).GetViolations()[0].GetSubject())
+
+	// the rejected query isn't charged
+	b, err = l.Charge(ctx, hoursQuery(3))
+	require.NoError(t, err)
+	require.Equal(t, 0, b.Remaining)
+
+	// budgets are personal
+	_, err = l.Charge(userContext("bob"), hoursQuery(9))
+	require.NoError(t, err)
+
+	b, err = l.Charge(userContext("robot"), hoursQuery(50))
+	require.NoError(t, err)
+	require.Equal(t, 100, b.Limit)
+
+	// the query can't fit into the budget at all
+	b, err = l.Charge(userContext("carol"), hoursQuery(20))
+	require.ErrorAs(t, err, &budgetErr)
+	require.Zero(t, b.RetryAfter)
+	require.Len(t, status.Convert(err).Details(), 1)
+}
+
+func TestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mock := mock_seqdb.NewMockClient(ctrl)
+	c := NewClient(mock, newTestLimiter(t))
+
+	to := time.Now()
+	req := &seqapi.SearchRequest{
+		From: timestamppb.New(to.Add(-8 * time.Hour)),
+		To:   timestamppb.New(to),
+	}
+
+	mock.EXPECT().Search(gomock.Any(), req).Return(&seqapi.SearchResponse{}, nil)
+	_, err := c.Search(userContext("alice"), req)
+	require.NoError(t, err)
+
+	// seq-db isn't requested if the budget is exceeded
+	_, err = c.Search(userContext("alice"), req)
+	require.True(t, errors.As(err, new(*BudgetExceededError)))
+
+	// requests without the query aren't charged
+	mock.EXPECT().GetFields(gomock.Any(), gomock.Any()).Return(&seqapi.GetFieldsResponse{}, nil)
+	_, err = c.GetFields(userContext("alice"), &seqapi.GetFieldsRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, mock, c.(*client).Unwrap())
+}
+
+func TestHTTPHeadersInterceptor(t *testing.T) {
+	l := newTestLimiter(t)
+
+	handler := HTTPHeadersInterceptor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), types.UserKey{}, "alice")
+		if _, err := l.Charge(ctx, hoursQuery(3)); err != nil {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+
+	serve := func() *http.Response {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/seqapi/v1/search", http.NoBody))
+		return rec.Result()
+	}
+
+	resp := serve()
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "4", resp.Header.Get("X-Query-Cost"))
+	require.Equal(t, "10", resp.Header.Get("X-Query-Budget-Limit"))
+	require.Equal(t, "6", resp.Header.Get("X-Query-Budget-Remaining"))
+	require.NotEmpty(t, resp.Header.Get("X-Query-Budget-Reset"))
+	require.Empty(t, resp.Header.Get("Retry-After"))
+
+	_ = serve().Body.Close()
+
+	resp = serve()
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "2", resp.Header.Get("X-Query-Budget-Remaining"))
+	require.NotEmpty(t, resp.Header.Get("Retry-After"))
+}
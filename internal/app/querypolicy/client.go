@@ -0,0 +1,124 @@
+package querypolicy
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ozontech/seq-ui/internal/api/httputil"
+	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+type client struct {
+	seqdb.Client
+	engine *Engine
+}
+
+// NewClient returns seq-db client restricting the requests of the users by the engine policies.
+func NewClient(c seqdb.Client, e *Engine) seqdb.Client {
+	return &client{
+		Client: c,
+		engine: e,
+	}
+}
+
+// ClientRestriction returns the restriction of the user from context applied by the client.
+// Clients wrapping the restricting client are unwrapped by their `Unwrap() seqdb.Client` method.
+// Returns nil if the client does not restrict the requests.
+func ClientRestriction(ctx context.Context, c seqdb.Client) *Restriction {
+	for c != nil {
+		if pc, ok := c.(*client); ok {
+			return pc.engine.Resolve(ctx)
+		}
+		w, ok := c.(interface{ Unwrap() seqdb.Client })
+		if !ok {
+			return nil
+		}
+		c = w.Unwrap()
+	}
+	return nil
+}
+
+// CheckEvent returns permission denied error if the event is not available to the user from context.
+func CheckEvent(ctx context.Context, c seqdb.Client, event *seqapi.Event) error {
+	if event == nil || ClientRestriction(ctx, c).MatchEvent(event.Data) {
+		return nil
+	}
+	return errEventDenied
+}
+
+var errEventDenied = status.Error(codes.PermissionDenied, "event is not available by query policy")
+
+func (c *client) GetAggregation(ctx context.Context, req *seqapi.GetAggregationRequest) (*seqapi.GetAggregationResponse, error) {
+	if r := c.engine.Resolve(ctx); r != nil {
+		req = proto.Clone(req).(*seqapi.GetAggregationRequest)
+		query, err := r.Apply(req.Query)
+		if err != nil {
+			return nil, err
+		}
+		req.Query = query
+	}
+	return c.Client.GetAggregation(ctx, req)
+}
+
+func (c *client) GetEvent(ctx context.Context, req *seqapi.GetEventRequest) (*seqapi.GetEventResponse, error) {
+	resp, err := c.Client.GetEvent(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckEvent(ctx, c, resp.GetEvent()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *client) GetHistogram(ctx context.Context, req *seqapi.GetHistogramRequest) (*seqapi.GetHistogramResponse, error) {
+	if r := c.engine.Resolve(ctx); r != nil {
+		req = proto.Clone(req).(*seqapi.GetHistogramRequest)
+		query, err := r.Apply(req.Query)
+		if err != nil {
+			return nil, err
+		}
+		req.Query = query
+	}
+	return c.Client.GetHistogram(ctx, req)
+}
+
+func (c *client) Search(ctx context.Context, req *seqapi.SearchRequest) (*seqapi.SearchResponse, error) {
+	if r := c.engine.Resolve(ctx); r != nil {
+		req = proto.Clone(req).(*seqapi.SearchRequest)
+		query, err := r.Apply(req.Query)
+		if err != nil {
+			return nil, err
+		}
+		req.Query = query
+	}
+	return c.Client.Search(ctx, req)
+}
+
+func (c *client) Export(ctx context.Context, req *seqapi.ExportRequest, cw *httputil.ChunkedWriter) error {
+	if r := c.engine.Resolve(ctx); r != nil {
+		req = proto.Clone(req).(*seqapi.ExportRequest)
+		query, err := r.Apply(req.Query)
+		if err != nil {
+			return err
+		}
+		req.Query = query
+	}
+	return c.Client.Export(ctx, req, cw)
+}
+
+func (c *client) StartAsyncSearch(ctx context.Context, req *seqapi.StartAsyncSearchRequest) (*seqapi.StartAsyncSearchResponse, error) {
+	if r := c.engine.Resolve(ctx); r != nil {
+		req = proto.Clone(req).(*seqapi.StartAsyncSearchRequest)
+		query, err := r.Apply(req.Query)
+		if err != nil {
+			return nil, err
+		}
+		req.Query = query
+	}
+	return c.Client.StartAsyncSearch(ctx, req)
+}
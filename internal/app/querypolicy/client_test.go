@@ -0,0 +1,97 @@
+package querypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
+	mock_seqdb "github.com/ozontech/seq-ui/internal/pkg/client/seqdb/mock"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+func newTestClient(t *testing.T) (*mock_seqdb.MockClient, *client) {
+	t.Helper()
+
+	e, err := NewStatic(File{
+		Policies: []Policy{
+			{
+				Name:            "payments",
+				SeqAPIEnvAccess: config.SeqAPIEnvAccess{Users: []string{"alice"}},
+				Filter:          Filter{"service": {"payments"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	mock := mock_seqdb.NewMockClient(ctrl)
+	return mock, NewClient(mock, e).(*client)
+}
+
+func TestClientSearch(t *testing.T) {
+	mock, c := newTestClient(t)
+
+	req := &seqapi.SearchRequest{Query: "level:error", Limit: 10}
+
+	mock.EXPECT().Search(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, r *seqapi.SearchRequest) (*seqapi.SearchResponse, error) {
+			require.Equal(t, "(level:error) AND (service:payments)", r.Query)
+			require.Equal(t, int32(10), r.Limit)
+			return &seqapi.SearchResponse{}, nil
+		})
+	_, err := c.Search(userContext("alice"), req)
+	require.NoError(t, err)
+	require.Equal(t, "level:error", req.Query)
+
+	// unrestricted user
+	mock.EXPECT().Search(gomock.Any(), req).Return(&seqapi.SearchResponse{}, nil)
+	_, err = c.Search(userContext("bob"), req)
+	require.NoError(t, err)
+
+	// query escaping the filter is not sent
+	_, err = c.Search(userContext("alice"), &seqapi.SearchRequest{Query: "service:b) OR (service:*"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+type wrappingClient struct {
+	seqdb.Client
+}
+
+func (c *wrappingClient) Unwrap() seqdb.Client {
+	return c.Client
+}
+
+func TestClientRestriction(t *testing.T) {
+	mock, c := newTestClient(t)
+
+	require.Equal(t, "service:payments", ClientRestriction(userContext("alice"), c).Query())
+	require.Nil(t, ClientRestriction(userContext("bob"), c))
+
+	// restriction is found under the wrapping clients
+	wrapped := &wrappingClient{Client: &wrappingClient{Client: c}}
+	require.Equal(t, "service:payments", ClientRestriction(userContext("alice"), wrapped).Query())
+
+	require.Nil(t, ClientRestriction(userContext("alice"), mock))
+	require.Nil(t, ClientRestriction(userContext("alice"), &wrappingClient{Client: mock}))
+}
+
+func TestClientGetEvent(t *testing.T) {
+	mock, c := newTestClient(t)
+
+	req := &seqapi.GetEventRequest{Id: "1"}
+	mock.EXPECT().GetEvent(gomock.Any(), req).Return(&seqapi.GetEventResponse{
+		Event: &seqapi.Event{Id: "1", Data: map[string]string{"service": "orders"}},
+	}, nil).Times(2)
+
+	_, err := c.GetEvent(userContext("alice"), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := c.GetEvent(userContext("bob"), req)
+	require.NoError(t, err)
+	require.Equal(t, "1", resp.Event.Id)
+}
@@ -0,0 +1,262 @@
+// Package querypolicy restricts the data available to the users by adding mandatory filters to their queries.
+package querypolicy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/envaccess"
+	"github.com/ozontech/seq-ui/logger"
+)
+
+// Filter is the mandatory filter of the policy, it contains allowed values by field.
+// Event matches the filter if the values of all fields are allowed.
+type Filter map[string][]string
+
+// File is the content of the policies file.
+type File struct {
+	// Default is the filter applied to the users matching no policy. If not set, such users are not restricted.
+	Default Filter `yaml:"default"`
+	// Policies are the filters applied to the listed users, groups and tokens.
+	Policies []Policy `yaml:"policies"`
+}
+
+// Policy applies the filter to the users, groups and tokens. If the filter is not set, they are not restricted.
+type Policy struct {
+	Name                   string `yaml:"name"`
+	config.SeqAPIEnvAccess `yaml:",inline"`
+	Filter                 Filter `yaml:"filter"`
+}
+
+type policy struct {
+	access config.SeqAPIEnvAccess
+	filter *filter
+}
+
+type policySet struct {
+	def      *filter
+	policies []policy
+}
+
+// Engine resolves the restrictions of the users by the policies loaded from file.
+// The file is reloaded on change, so the policies can be updated without restart.
+type Engine struct {
+	file    string
+	current atomic.Pointer[policySet]
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+// New returns engine with the policies loaded from file and starts reloading them on change.
+func New(ctx context.Context, cfg config.SeqAPIQueryPolicy) (*Engine, error) {
+	e := &Engine{file: cfg.File}
+	if err := e.reload(); err != nil {
+		return nil, err
+	}
+
+	go e.watch(ctx, cfg.ReloadInterval)
+
+	return e, nil
+}
+
+// NewStatic returns engine with the fixed policies.
+func NewStatic(f File) (*Engine, error) {
+	set, err := parse(f)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Engine{}
+	e.current.Store(set)
+	return e, nil
+}
+
+// Resolve returns the restriction of the user from context. Returns nil if the user is not restricted.
+func (e *Engine) Resolve(ctx context.Context) *Restriction {
+	if e == nil {
+		return nil
+	}
+	set := e.current.Load()
+
+	var filters []*filter
+	for _, p := range set.policies {
+		if !envaccess.Allowed(ctx, &p.access) {
+			continue
+		}
+		if p.filter == nil {
+			return nil
+		}
+		filters = append(filters, p.filter)
+	}
+
+	if len(filters) == 0 {
+		if set.def == nil {
+			return nil
+		}
+		filters = append(filters, set.def)
+	}
+
+	return newRestriction(filters)
+}
+
+// ApplyQuery returns the query restricted for the user from context.
+func (e *Engine) ApplyQuery(ctx context.Context, query string) (string, error) {
+	return e.Resolve(ctx).Apply(query)
+}
+
+func (e *Engine) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.reload(); err != nil {
+				logger.Error("failed to reload query policies, previous policies are kept",
+					zap.String("file", e.file), zap.Error(err))
+			}
+		}
+	}
+}
+
+// reload loads the policies from file if it was changed since the last load.
+func (e *Engine) reload() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	info, err := os.Stat(e.file)
+	if err != nil {
+		return fmt.Errorf("stat query policies file: %w", err)
+	}
+	if e.current.Load() != nil && info.ModTime().Equal(e.modTime) && info.Size() == e.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(e.file)
+	if err != nil {
+		return fmt.Errorf("read query policies file: %w", err)
+	}
+
+	var f File
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse query policies file: %w", err)
+	}
+
+	set, err := parse(f)
+	if err != nil {
+		return err
+	}
+
+	e.current.Store(set)
+	e.modTime = info.ModTime()
+	e.size = info.Size()
+
+	logger.Info("query policies loaded", zap.String("file", e.file), zap.Int("policies", len(set.policies)))
+	return nil
+}
+
+func parse(f File) (*policySet, error) {
+	set := &policySet{
+		policies: make([]policy, 0, len(f.Policies)),
+	}
+
+	if f.Default != nil {
+		def, err := newFilter(f.Default)
+		if err != nil {
+			return nil, fmt.Errorf("default filter: %w", err)
+		}
+		set.def = def
+	}
+
+	names := make(map[string]struct{}, len(f.Policies))
+	for _, p := range f.Policies {
+		if p.Name == "" {
+			return nil, errors.New("empty policy name")
+		}
+		if _, ok := names[p.Name]; ok {
+			return nil, fmt.Errorf("duplicate policy %q", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		if len(p.Users) == 0 && len(p.Groups) == 0 && len(p.Tokens) == 0 {
+			return nil, fmt.Errorf("policy %q: no users, groups or tokens", p.Name)
+		}
+		for _, pattern := range p.Tokens {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("policy %q: invalid token pattern %q: %w", p.Name, pattern, err)
+			}
+		}
+
+		var pf *filter
+		if p.Filter != nil {
+			var err error
+			if pf, err = newFilter(p.Filter); err != nil {
+				return nil, fmt.Errorf("policy %q: %w", p.Name, err)
+			}
+		}
+
+		set.policies = append(set.policies, policy{
+			access: p.SeqAPIEnvAccess,
+			filter: pf,
+		})
+	}
+
+	return set, nil
+}
+
+type condition struct {
+	field  string
+	values []string
+}
+
+// filter is the parsed Filter with the conditions sorted by field.
+type filter struct {
+	conds []condition
+	query string
+}
+
+func newFilter(f Filter) (*filter, error) {
+	if len(f) == 0 {
+		return nil, errors.New("empty filter")
+	}
+
+	res := &filter{conds: make([]condition, 0, len(f))}
+	for field, values := range f {
+		if field == "" {
+			return nil, errors.New("empty filter field")
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("no values of filter field %q", field)
+		}
+		if slices.Contains(values, "") {
+			return nil, fmt.Errorf("empty value of filter field %q", field)
+		}
+		res.conds = append(res.conds, condition{field: field, values: values})
+	}
+	slices.SortFunc(res.conds, func(a, b condition) int {
+		return strings.Compare(a.field, b.field)
+	})
+	res.query = res.buildQuery()
+
+	return res, nil
+}
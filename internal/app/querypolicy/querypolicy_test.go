@@ -0,0 +1,221 @@
+package querypolicy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func userContext(user string, groups ...string) context.Context {
+	ctx := context.WithValue(context.Background(), types.UserKey{}, user)
+	return context.WithValue(ctx, types.UserGroupsKey{}, groups)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    File
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			file: File{
+				Default: Filter{"service": {"public"}},
+				Policies: []Policy{
+					{
+						Name:            "payments",
+						SeqAPIEnvAccess: config.SeqAPIEnvAccess{Groups: []string{"payments"}, Tokens: []string{"ci-*"}},
+						Filter:          Filter{"service": {"payments-*"}},
+					},
+					{
+						Name:            "sre",
+						SeqAPIEnvAccess: config.SeqAPIEnvAccess{Groups: []string{"sre"}},
+					},
+				},
+			},
+		},
+		{
+			name: "err_empty_name",
+			file: File{Policies: []Policy{
+				{SeqAPIEnvAccess: config.SeqAPIEnvAccess{Users: []string{"alice"}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "err_duplicate_name",
+			file: File{Policies: []Policy{
+				{Name: "p", SeqAPIEnvAccess: config.SeqAPIEnvAccess{Users: []string{"alice"}}},
+				{Name: "p", SeqAPIEnvAccess: config.SeqAPIEnvAccess{Users: []string{"bob"}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "err_no_subjects",
+			file: File{Policies: []Policy{
+				{Name: "p", Filter: Filter{"service": {"a"}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "err_bad_token_pattern",
+			file: File{Policies: []Policy{
+				{Name: "p", SeqAPIEnvAccess: config.SeqAPIEnvAccess{Tokens: []string{"["}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "err_empty_filter",
+			file: File{Policies: []Policy{
+				{Name: "p", SeqAPIEnvAccess: config.SeqAPIEnvAccess{Users: []string{"alice"}}, Filter: Filter{}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "err_no_values",
+			file:    File{Default: Filter{"service": {}}},
+			wantErr: true,
+		},
+		{
+			name:    "err_empty_value",
+			file:    File{Default: Filter{"service": {""}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parse(tt.file)
+			require.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestEngineResolve(t *testing.T) {
+	e, err := NewStatic(File{
+		Default: Filter{"service": {"public"}},
+		Policies: []Policy{
+			{
+				Name:            "payments",
+				SeqAPIEnvAccess: config.SeqAPIEnvAccess{Groups: []string{"payments"}, Tokens: []string{"payments-*"}},
+				Filter:          Filter{"service": {"payments-api", "payments-worker"}},
+			},
+			{
+				Name:            "orders",
+				SeqAPIEnvAccess: config.SeqAPIEnvAccess{Users: []string{"bob"}},
+				Filter:          Filter{"service": {"orders"}},
+			},
+			{
+				Name:            "sre",
+				SeqAPIEnvAccess: config.SeqAPIEnvAccess{Groups: []string{"sre"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+			want: "service:public",
+		},
+		{
+			name: "no_policy",
+			ctx:  userContext("alice"),
+			want: "service:public",
+		},
+		{
+			name: "group",
+			ctx:  userContext("alice", "payments"),
+			want: "service:(payments-api OR payments-worker)",
+		},
+		{
+			name: "token",
+			ctx:  userContext("api@payments-ci"),
+			want: "service:(payments-api OR payments-worker)",
+		},
+		{
+			name: "multiple_policies",
+			ctx:  userContext("bob", "payments"),
+			want: "(service:(payments-api OR payments-worker)) OR (service:orders)",
+		},
+		{
+			name: "unrestricted_policy",
+			ctx:  userContext("bob", "payments", "sre"),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, e.Resolve(tt.ctx).Query())
+		})
+	}
+
+	var nilEngine *Engine
+	require.Nil(t, nilEngine.Resolve(context.Background()))
+	query, err := nilEngine.ApplyQuery(context.Background(), "level:error")
+	require.NoError(t, err)
+	require.Equal(t, "level:error", query)
+}
+
+func TestEngineResolveNoDefault(t *testing.T) {
+	e, err := NewStatic(File{
+		Policies: []Policy{
+			{
+				Name:            "payments",
+				SeqAPIEnvAccess: config.SeqAPIEnvAccess{Groups: []string{"payments"}},
+				Filter:          Filter{"service": {"payments"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Nil(t, e.Resolve(userContext("alice")))
+	require.NotNil(t, e.Resolve(userContext("alice", "payments")))
+}
+
+func TestEngineReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.yaml")
+	writeFile := func(data string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(file, []byte(data), 0o600))
+		require.NoError(t, os.Chtimes(file, modTime, modTime))
+	}
+
+	start := time.Now()
+	writeFile("default:\n  service: [a]\n", start)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e, err := New(ctx, config.SeqAPIQueryPolicy{File: file, ReloadInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, "service:a", e.Resolve(context.Background()).Query())
+
+	// invalid file keeps the previous policies
+	writeFile("default:\n  service: []\n", start.Add(time.Second))
+	require.Error(t, e.reload())
+	require.Equal(t, "service:a", e.Resolve(context.Background()).Query())
+
+	writeFile("default:\n  service: [b]\n", start.Add(2*time.Second))
+	require.Eventually(t, func() bool {
+		return e.Resolve(context.Background()).Query() == "service:b"
+	}, time.Second, 10*time.Millisecond)
+
+	_, err = New(ctx, config.SeqAPIQueryPolicy{File: filepath.Join(t.TempDir(), "missing.yaml"), ReloadInterval: time.Second})
+	require.Error(t, err)
+}
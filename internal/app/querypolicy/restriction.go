@@ -0,0 +1,233 @@
+package querypolicy
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errUnbalancedQuery = status.Error(codes.InvalidArgument, "query has unbalanced parentheses or quotes")
+
+// Restriction is the set of filters available to the user, the data must match any of them.
+// Nil restriction allows all data.
+type Restriction struct {
+	filters []*filter
+	query   string
+}
+
+func newRestriction(filters []*filter) *Restriction {
+	queries := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if !slices.Contains(queries, f.query) {
+			queries = append(queries, f.query)
+		}
+	}
+
+	query := queries[0]
+	if len(queries) > 1 {
+		query = "(" + strings.Join(queries, ") OR (") + ")"
+	}
+
+	return &Restriction{
+		filters: filters,
+		query:   query,
+	}
+}
+
+// Query returns the mandatory filter query. Returns empty string for nil restriction.
+func (r *Restriction) Query() string {
+	if r == nil {
+		return ""
+	}
+	return r.query
+}
+
+// Apply returns the query with the mandatory filter added.
+// Pipes of the query are kept after the filter.
+// Queries with unbalanced parentheses or quotes are rejected, since they could escape the filter.
+func (r *Restriction) Apply(query string) (string, error) {
+	if r == nil {
+		return query, nil
+	}
+	if !balanced(query) {
+		return "", errUnbalancedQuery
+	}
+
+	search, pipes := splitPipes(query)
+	search = strings.TrimSpace(search)
+	if search == "" || search == "*" {
+		search = r.query
+	} else {
+		search = "(" + search + ") AND (" + r.query + ")"
+	}
+
+	if pipes == "" {
+		return search, nil
+	}
+	return search + " " + pipes, nil
+}
+
+// MatchEvent checks that the event data matches the restriction.
+func (r *Restriction) MatchEvent(data map[string]string) bool {
+	if r == nil {
+		return true
+	}
+
+	for _, f := range r.filters {
+		if f.match(data) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filter) match(data map[string]string) bool {
+	for _, c := range f.conds {
+		v, ok := data[c.field]
+		if !ok {
+			return false
+		}
+		if unquoted, err := strconv.Unquote(v); err == nil {
+			v = unquoted
+		}
+
+		matched := false
+		for _, pattern := range c.values {
+			if matchWildcard(pattern, v) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// buildQuery returns the filter as query, e.g. `env:prod AND service:(a OR b)`.
+func (f *filter) buildQuery() string {
+	var sb strings.Builder
+	for i, c := range f.conds {
+		if i > 0 {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString(c.field)
+		sb.WriteByte(':')
+
+		if len(c.values) == 1 {
+			sb.WriteString(quoteValue(c.values[0]))
+			continue
+		}
+
+		sb.WriteByte('(')
+		for j, v := range c.values {
+			if j > 0 {
+				sb.WriteString(" OR ")
+			}
+			sb.WriteString(quoteValue(v))
+		}
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+// quoteValue quotes the value if it contains special symbols. Values with `*` wildcard are kept as is.
+func quoteValue(v string) string {
+	for _, c := range v {
+		if !isPlainValueRune(c) {
+			return strconv.Quote(v)
+		}
+	}
+	return v
+}
+
+func isPlainValueRune(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '-' || c == '.' || c == '*' || c == '/' || c == '@'
+}
+
+// matchWildcard matches the value with the pattern, where `*` matches any sequence of symbols.
+func matchWildcard(pattern, v string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == v
+	}
+
+	if !strings.HasPrefix(v, parts[0]) {
+		return false
+	}
+	v = v[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, p := range parts[1 : len(parts)-1] {
+		i := strings.Index(v, p)
+		if i < 0 {
+			return false
+		}
+		v = v[i+len(p):]
+	}
+	return len(v) >= len(last) && strings.HasSuffix(v, last)
+}
+
+// splitPipes splits the query into the search part and the pipes, e.g. `| fields message`.
+// Pipe symbols inside the quotes and the parentheses are not considered.
+func splitPipes(query string) (string, string) {
+	depth := 0
+	var quote rune
+	escaped := false
+	for i, c := range query {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth <= 0:
+			return query[:i], query[i:]
+		}
+	}
+	return query, ""
+}
+
+// balanced checks that the parentheses outside the quotes are balanced
+// and that the query has no unterminated quote or trailing escape.
+func balanced(query string) bool {
+	depth := 0
+	var quote rune
+	escaped := false
+	for _, c := range query {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0 && quote == 0 && !escaped
+}
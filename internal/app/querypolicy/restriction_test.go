@@ -0,0 +1,193 @@
+package querypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testRestriction(t *testing.T, filters ...Filter) *Restriction {
+	t.Helper()
+
+	parsed := make([]*filter, 0, len(filters))
+	for _, f := range filters {
+		pf, err := newFilter(f)
+		require.NoError(t, err)
+		parsed = append(parsed, pf)
+	}
+	return newRestriction(parsed)
+}
+
+func TestRestrictionApply(t *testing.T) {
+	single := testRestriction(t, Filter{"service": {"a", "b"}})
+	multi := testRestriction(t,
+		Filter{"service": {"a"}, "env": {"prod"}},
+		Filter{"service": {"my service"}},
+	)
+
+	tests := []struct {
+		name    string
+		r       *Restriction
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "no_restriction",
+			query: "level:error",
+			want:  "level:error",
+		},
+		{
+			name:  "empty_query",
+			r:     single,
+			query: "",
+			want:  "service:(a OR b)",
+		},
+		{
+			name:  "wildcard_query",
+			r:     single,
+			query: " * ",
+			want:  "service:(a OR b)",
+		},
+		{
+			name:  "query",
+			r:     single,
+			query: "level:error OR level:warn",
+			want:  "(level:error OR level:warn) AND (service:(a OR b))",
+		},
+		{
+			name:  "pipes",
+			r:     single,
+			query: `message:"a|b" | fields message`,
+			want:  `(message:"a|b") AND (service:(a OR b)) | fields message`,
+		},
+		{
+			name:  "multiple_filters",
+			r:     multi,
+			query: "level:error",
+			want:  `(level:error) AND ((env:prod AND service:a) OR (service:"my service"))`,
+		},
+		{
+			name:  "quoted_parentheses",
+			r:     single,
+			query: `message:"a) OR (b"`,
+			want:  `(message:"a) OR (b") AND (service:(a OR b))`,
+		},
+		{
+			name:    "err_escape_filter",
+			r:       single,
+			query:   "service:b) OR (service:*",
+			wantErr: true,
+		},
+		{
+			name:    "err_unclosed_parenthesis",
+			r:       single,
+			query:   "(service:b",
+			wantErr: true,
+		},
+		{
+			name:    "err_unclosed_quote",
+			r:       single,
+			query:   `message:"a`,
+			wantErr: true,
+		},
+		{
+			name:    "err_trailing_escape",
+			r:       single,
+			query:   `message:a\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.r.Apply(tt.query)
+			if tt.wantErr {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRestrictionMatchEvent(t *testing.T) {
+	r := testRestriction(t,
+		Filter{"service": {"payments-*"}, "env": {"prod"}},
+		Filter{"service": {"orders"}},
+	)
+
+	tests := []struct {
+		name string
+		data map[string]string
+		want bool
+	}{
+		{
+			name: "match_wildcard",
+			data: map[string]string{"service": "payments-api", "env": "prod"},
+			want: true,
+		},
+		{
+			name: "match_second_filter",
+			data: map[string]string{"service": "orders"},
+			want: true,
+		},
+		{
+			name: "match_quoted_value",
+			data: map[string]string{"service": `"orders"`},
+			want: true,
+		},
+		{
+			name: "field_mismatch",
+			data: map[string]string{"service": "payments-api", "env": "dev"},
+			want: false,
+		},
+		{
+			name: "field_missing",
+			data: map[string]string{"service": "payments-api"},
+			want: false,
+		},
+		{
+			name: "value_mismatch",
+			data: map[string]string{"service": "billing"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, r.MatchEvent(tt.data))
+		})
+	}
+
+	require.True(t, (*Restriction)(nil).MatchEvent(nil))
+}
+
+func TestMatchWildcard(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{pattern: "abc", value: "abc", want: true},
+		{pattern: "abc", value: "abcd", want: false},
+		{pattern: "a*", value: "abc", want: true},
+		{pattern: "*c", value: "abc", want: true},
+		{pattern: "a*c", value: "ac", want: true},
+		{pattern: "a*b*c", value: "axbyc", want: true},
+		{pattern: "a*b*c", value: "axcyb", want: false},
+		{pattern: "ab*b", value: "ab", want: false},
+		{pattern: "*", value: "", want: true},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, matchWildcard(tt.pattern, tt.value), "%s ~ %s", tt.pattern, tt.value)
+	}
+}
@@ -3,15 +3,28 @@ package ratelimiter
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/throttled/throttled/v2"
+	goredisstore "github.com/throttled/throttled/v2/store/goredisstore.v9"
 	"github.com/throttled/throttled/v2/store/memstore"
+	"go.uber.org/zap"
 
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/pkg/redisclient"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/metric"
 )
 
-const rateLimiterQuantityPerCall = 1
+const (
+	rateLimiterQuantityPerCall = 1
+
+	redisKeyPrefix = "seq_ui_rate_limit_"
+	// fallbackPeriod is the time during which the memory store is used after the redis failure.
+	fallbackPeriod = 10 * time.Second
+)
 
 type RateLimitInfo struct {
 	Limit      int
@@ -20,11 +33,18 @@ type RateLimitInfo struct {
 	RetryAfter time.Duration
 }
 
+// RateLimiter limits the requests by the key with GCRA.
+// If redis is configured, the limits are shared between the replicas,
+// while redis is unavailable the replica limits the requests by itself with the memory store.
 type RateLimiter struct {
-	gcraRL *throttled.GCRARateLimiterCtx
+	gcraRL       *throttled.GCRARateLimiterCtx
+	fallbackRL   *throttled.GCRARateLimiterCtx
+	fallbackTill atomic.Int64
+	nowFn        func() time.Time
 }
 
-func New(cfg config.RateLimiter) (*RateLimiter, error) {
+// New creates rate limiter. Name separates the keys of different rate limiters in redis.
+func New(ctx context.Context, name string, cfg config.RateLimiter) (*RateLimiter, error) {
 	if cfg.RatePerSec <= 0 {
 		return nil, errors.New(
 			"invalid rate limiter config: rate_per_sec must be greater than zero",
@@ -35,30 +55,110 @@ func New(cfg config.RateLimiter) (*RateLimiter, error) {
 			"invalid rate limiter config: max_burst must be non-negative",
 		)
 	}
-	rlStore, err := memstore.NewCtx(cfg.StoreMaxKeys)
+	return NewWithQuota(ctx, name, Quota{
+		Count:        cfg.RatePerSec,
+		Period:       time.Second,
+		MaxBurst:     cfg.MaxBurst,
+		StoreMaxKeys: cfg.StoreMaxKeys,
+		Redis:        cfg.Redis,
+	})
+}
+
+// Quota allows Count units per Period, in addition MaxBurst units can be spent at once.
+type Quota struct {
+	Count        int
+	Period       time.Duration
+	MaxBurst     int
+	StoreMaxKeys int
+	Redis        *config.Redis
+}
+
+// NewWithQuota creates rate limiter with the quota. Name separates the keys of different rate limiters in redis.
+func NewWithQuota(ctx context.Context, name string, q Quota) (*RateLimiter, error) {
+	memStore, err := memstore.NewCtx(q.StoreMaxKeys)
 	if err != nil {
 		return nil, err
 	}
 	rlQuota := throttled.RateQuota{
-		MaxRate:  throttled.PerSec(cfg.RatePerSec),
-		MaxBurst: cfg.MaxBurst,
+		MaxRate:  throttled.PerDuration(q.Count, q.Period),
+		MaxBurst: q.MaxBurst,
+	}
+
+	if q.Redis == nil {
+		return newRateLimiter(memStore, nil, rlQuota)
+	}
+
+	client := redisclient.NewClient(q.Redis)
+	redisStore, err := goredisstore.NewCtx(client, redisKeyPrefix+name+"_")
+	if err != nil {
+		return nil, err
+	}
+	rl, err := newRateLimiter(redisStore, memStore, rlQuota)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = client.Ping(ctx).Err(); err != nil {
+		logger.Warn("redis is unavailable; memory store will be used instead",
+			zap.String("rate_limiter", name), zap.Error(err),
+		)
+		rl.fallback()
 	}
-	gcraRL, err := throttled.NewGCRARateLimiterCtx(rlStore, rlQuota)
+
+	return rl, nil
+}
+
+func newRateLimiter(store, fallbackStore throttled.GCRAStoreCtx, quota throttled.RateQuota) (*RateLimiter, error) {
+	gcraRL, err := throttled.NewGCRARateLimiterCtx(store, quota)
 	if err != nil {
 		return nil, err
 	}
-	return &RateLimiter{
+	rl := &RateLimiter{
 		gcraRL: gcraRL,
-	}, nil
+		nowFn:  time.Now,
+	}
+	if fallbackStore != nil {
+		if rl.fallbackRL, err = throttled.NewGCRARateLimiterCtx(fallbackStore, quota); err != nil {
+			return nil, err
+		}
+	}
+	return rl, nil
+}
+
+func (rl *RateLimiter) RateLimit(ctx context.Context, key string) (bool, RateLimitInfo, error) {
+	return rl.RateLimitN(ctx, key, rateLimiterQuantityPerCall)
 }
 
-func (rl *RateLimiter) RateLimit(key string) (bool, RateLimitInfo, error) {
-	limit, rlc, err := rl.gcraRL.RateLimitCtx(context.Background(), key, rateLimiterQuantityPerCall)
+// RateLimitN spends quantity units of the key quota.
+func (rl *RateLimiter) RateLimitN(ctx context.Context, key string, quantity int) (bool, RateLimitInfo, error) {
+	gcraRL := rl.gcraRL
+	if rl.fallbackRL != nil && rl.nowFn().UnixNano() < rl.fallbackTill.Load() {
+		gcraRL = rl.fallbackRL
+	}
+
+	limit, rlc, err := gcraRL.RateLimitCtx(ctx, key, quantity)
+	if err != nil && gcraRL != rl.fallbackRL && rl.fallbackRL != nil {
+		logger.Warn("failed to rate limit with redis; memory store will be used instead",
+			zap.Duration("fallback_period", fallbackPeriod), zap.Error(err),
+		)
+		rl.fallback()
+		limit, rlc, err = rl.fallbackRL.RateLimitCtx(ctx, key, quantity)
+	}
+	if err != nil {
+		return false, RateLimitInfo{}, fmt.Errorf("rate limit: %w", err)
+	}
+
 	rlInfo := RateLimitInfo{
 		Limit:      rlc.Limit,
 		Remaining:  rlc.Remaining,
 		ResetAfter: rlc.ResetAfter,
 		RetryAfter: rlc.RetryAfter,
 	}
-	return limit, rlInfo, err
+	return limit, rlInfo, nil
+}
+
+// fallback switches rate limiter to the memory store for the fallback period.
+func (rl *RateLimiter) fallback() {
+	metric.ServerRateLimiterFallbacks.Inc()
+	rl.fallbackTill.Store(rl.nowFn().Add(fallbackPeriod).UnixNano())
 }
@@ -0,0 +1,105 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/throttled/throttled/v2"
+	"github.com/throttled/throttled/v2/store/memstore"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+// flakyStore emulates redis, which is unavailable while failing is set.
+type flakyStore struct {
+	throttled.GCRAStoreCtx
+	failing atomic.Bool
+	calls   atomic.Int64
+}
+
+func (s *flakyStore) GetWithTime(ctx context.Context, key string) (int64, time.Time, error) {
+	s.calls.Add(1)
+	if s.failing.Load() {
+		return 0, time.Time{}, errors.New("connection refused")
+	}
+	return s.GCRAStoreCtx.GetWithTime(ctx, key)
+}
+
+func newTestRateLimiter(t *testing.T, store *flakyStore) *RateLimiter {
+	t.Helper()
+
+	memStore, err := memstore.NewCtx(0)
+	require.NoError(t, err)
+
+	rl, err := newRateLimiter(store, memStore, throttled.RateQuota{
+		MaxRate: throttled.PerMin(1),
+	})
+	require.NoError(t, err)
+	return rl
+}
+
+func TestRateLimitFallback(t *testing.T) {
+	ctx := context.Background()
+
+	redisStore, err := memstore.NewCtx(0)
+	require.NoError(t, err)
+	store := &flakyStore{GCRAStoreCtx: redisStore}
+	rl := newTestRateLimiter(t, store)
+
+	now := time.Now()
+	rl.nowFn = func() time.Time { return now }
+
+	limited, _, err := rl.RateLimit(ctx, "user")
+	require.NoError(t, err)
+	require.False(t, limited)
+
+	limited, _, err = rl.RateLimit(ctx, "user")
+	require.NoError(t, err)
+	require.True(t, limited)
+
+	// redis is unavailable, so the memory store limits the requests
+	store.failing.Store(true)
+
+	limited, _, err = rl.RateLimit(ctx, "user")
+	require.NoError(t, err)
+	require.False(t, limited)
+
+	limited, _, err = rl.RateLimit(ctx, "user")
+	require.NoError(t, err)
+	require.True(t, limited)
+
+	// redis isn't requested during the fallback period
+	calls := store.calls.Load()
+	_, _, err = rl.RateLimit(ctx, "other")
+	require.NoError(t, err)
+	require.Equal(t, calls, store.calls.Load())
+
+	// redis is requested again after the fallback period
+	store.failing.Store(false)
+	now = now.Add(fallbackPeriod)
+
+	limited, _, err = rl.RateLimit(ctx, "user")
+	require.NoError(t, err)
+	require.True(t, limited)
+	require.Greater(t, store.calls.Load(), calls)
+}
+
+func TestNewRedisUnavailable(t *testing.T) {
+	rl, err := New(context.Background(), "test", config.RateLimiter{
+		RatePerSec: 1,
+		Redis: &config.Redis{
+			Addr:       "127.0.0.1:1",
+			MaxRetries: -1,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rl.fallbackRL)
+
+	limited, _, err := rl.RateLimit(context.Background(), "user")
+	require.NoError(t, err)
+	require.False(t, limited)
+}
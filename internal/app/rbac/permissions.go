@@ -0,0 +1,125 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// PermAll grants all permissions.
+	PermAll = "*"
+
+	PermAsyncSearchStart = "asyncsearch:start"
+	// PermAsyncSearchAdmin allows to cancel and delete async searches of other users.
+	PermAsyncSearchAdmin = "asyncsearch:admin"
+
+	PermMassExportStart  = "massexport:start"
+	PermMassExportView   = "massexport:view"
+	PermMassExportManage = "massexport:manage"
+
+	PermDashboardsImport = "dashboards:import"
+	// PermDashboardsDeleteAny allows to delete dashboards of other users.
+	PermDashboardsDeleteAny = "dashboards:delete-any"
+
+	PermErrorGroupsMerge = "errorgroups:merge"
+
+	PermSeqAPIExport = "seqapi:export"
+
+	// PermAuditView allows to read the audit log.
+	PermAuditView = "audit:view"
+
+	// PermTokensAdmin allows to issue, list and revoke api tokens of other users.
+	PermTokensAdmin = "tokens:admin"
+)
+
+// Permissions contains all known permissions.
+var Permissions = []string{
+	PermAsyncSearchStart,
+	PermAsyncSearchAdmin,
+	PermMassExportStart,
+	PermMassExportView,
+	PermMassExportManage,
+	PermDashboardsImport,
+	PermDashboardsDeleteAny,
+	PermErrorGroupsMerge,
+	PermSeqAPIExport,
+	PermAuditView,
+	PermTokensAdmin,
+}
+
+// grpcPermissions contains permissions required by gRPC methods, by service and method.
+// Methods without entry require only authentication.
+var grpcPermissions = map[string]map[string]string{
+	"SeqAPIService": {
+		"StartAsyncSearch": PermAsyncSearchStart,
+	},
+	"MassExportService": {
+		"Start":   PermMassExportStart,
+		"Check":   PermMassExportView,
+		"GetAll":  PermMassExportView,
+		"Cancel":  PermMassExportManage,
+		"Restore": PermMassExportManage,
+	},
+	"DashboardsService": {
+		"Import": PermDashboardsImport,
+	},
+	"ErrorGroupsService": {
+		"MergeGroups":   PermErrorGroupsMerge,
+		"UnmergeGroups": PermErrorGroupsMerge,
+	},
+}
+
+// httpPermissions contains permissions required by HTTP routes, by method and route pattern.
+// Routes without entry require only authentication.
+var httpPermissions = map[string]string{
+	"POST /seqapi/v1/export":             PermSeqAPIExport,
+	"POST /seqapi/v1/async_search/start": PermAsyncSearchStart,
+	"POST /massexport/v1/start":          PermMassExportStart,
+	"POST /massexport/v1/check":          PermMassExportView,
+	"GET /massexport/v1/jobs":            PermMassExportView,
+	"POST /massexport/v1/cancel":         PermMassExportManage,
+	"POST /massexport/v1/restore":        PermMassExportManage,
+	"POST /dashboards/v1/import":         PermDashboardsImport,
+	"POST /errorgroups/v1/merge":         PermErrorGroupsMerge,
+	"POST /errorgroups/v1/unmerge":       PermErrorGroupsMerge,
+}
+
+// GRPCPermission returns permission required by gRPC method or empty string if there is no such.
+func GRPCPermission(service, method string) string {
+	return grpcPermissions[service][method]
+}
+
+// HTTPPermission returns permission required by HTTP route or empty string if there is no such.
+func HTTPPermission(method, routePattern string) string {
+	return httpPermissions[method+" "+routePattern]
+}
+
+// validatePermission checks that permission is known.
+// Besides the known permissions, `*` and `<resource>:*` wildcards are valid.
+func validatePermission(perm string) error {
+	if perm == PermAll || slices.Contains(Permissions, perm) {
+		return nil
+	}
+
+	resource, action, ok := strings.Cut(perm, ":")
+	if ok && action == "*" {
+		for _, p := range Permissions {
+			if strings.HasPrefix(p, resource+":") {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("unknown permission %q", perm)
+}
+
+// matchPermission checks that granted permission matches required one.
+func matchPermission(granted, required string) bool {
+	if granted == PermAll || granted == required {
+		return true
+	}
+
+	resource, action, ok := strings.Cut(granted, ":")
+	return ok && action == "*" && strings.HasPrefix(required, resource+":")
+}
@@ -0,0 +1,135 @@
+// Package rbac implements role-based access control.
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+type role struct {
+	permissions []string
+	groups      []string
+	users       []string
+}
+
+// RBAC resolves roles and permissions of the users.
+type RBAC struct {
+	roles        map[string]role
+	defaultRoles []string
+}
+
+// New returns RBAC built from config. Returns nil if config is not set, which means RBAC is disabled.
+func New(cfg *config.RBAC) (*RBAC, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	r := &RBAC{
+		roles:        make(map[string]role, len(cfg.Roles)),
+		defaultRoles: cfg.DefaultRoles,
+	}
+
+	for name, roleCfg := range cfg.Roles {
+		if name == "" {
+			return nil, errors.New("empty role name")
+		}
+		for _, perm := range roleCfg.Permissions {
+			if err := validatePermission(perm); err != nil {
+				return nil, fmt.Errorf("role %q: %w", name, err)
+			}
+		}
+
+		r.roles[name] = role{
+			permissions: roleCfg.Permissions,
+			groups:      roleCfg.Groups,
+			users:       roleCfg.Users,
+		}
+	}
+
+	for _, name := range cfg.DefaultRoles {
+		if _, ok := r.roles[name]; !ok {
+			return nil, fmt.Errorf("unknown default role %q", name)
+		}
+	}
+
+	return r, nil
+}
+
+// Access contains effective roles and permissions of the user.
+type Access struct {
+	Roles       []string
+	Permissions []string
+}
+
+// Has checks that access grants the permission.
+func (a Access) Has(perm string) bool {
+	for _, p := range a.Permissions {
+		if matchPermission(p, perm) {
+			return true
+		}
+	}
+	return false
+}
+
+// Effective returns the known permissions granted by access, with the wildcards expanded.
+func (a Access) Effective() []string {
+	perms := make([]string, 0)
+	for _, p := range Permissions {
+		if a.Has(p) {
+			perms = append(perms, p)
+		}
+	}
+	slices.Sort(perms)
+	return perms
+}
+
+// Resolve returns access of the user with the groups.
+// The user gets the default roles and the roles which list the user or any of the groups.
+func (r *RBAC) Resolve(user string, groups []string) Access {
+	roles := append([]string{}, r.defaultRoles...)
+	for name, role := range r.roles {
+		if slices.Contains(role.users, user) || slices.ContainsFunc(role.groups, func(g string) bool {
+			return slices.Contains(groups, g)
+		}) {
+			roles = append(roles, name)
+		}
+	}
+	slices.Sort(roles)
+	roles = slices.Compact(roles)
+
+	perms := make([]string, 0)
+	for _, name := range roles {
+		perms = append(perms, r.roles[name].permissions...)
+	}
+	slices.Sort(perms)
+	perms = slices.Compact(perms)
+
+	return Access{
+		Roles:       roles,
+		Permissions: perms,
+	}
+}
+
+type accessKey struct{}
+
+// WithAccess returns context with the user access.
+func WithAccess(ctx context.Context, access Access) context.Context {
+	return context.WithValue(ctx, accessKey{}, access)
+}
+
+// GetAccess returns the user access from context. Returns false if RBAC is disabled.
+func GetAccess(ctx context.Context) (Access, bool) {
+	access, ok := ctx.Value(accessKey{}).(Access)
+	return access, ok
+}
+
+// HasPermission checks that the user from context has the permission.
+// Returns false if RBAC is disabled.
+func HasPermission(ctx context.Context, perm string) bool {
+	access, ok := GetAccess(ctx)
+	return ok && access.Has(perm)
+}
@@ -0,0 +1,147 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+)
+
+func TestNew(t *testing.T) {
+	tCases := []struct {
+		name    string
+		cfg     *config.RBAC
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:    "ok_disabled",
+			wantNil: true,
+		},
+		{
+			name: "ok",
+			cfg: &config.RBAC{
+				Roles: map[string]config.RBACRole{
+					"admin":    {Permissions: []string{PermAll}},
+					"exporter": {Permissions: []string{"massexport:*", PermSeqAPIExport}},
+				},
+				DefaultRoles: []string{"exporter"},
+			},
+		},
+		{
+			name: "err_unknown_permission",
+			cfg: &config.RBAC{
+				Roles: map[string]config.RBACRole{
+					"admin": {Permissions: []string{"massexport:delete"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_unknown_resource_wildcard",
+			cfg: &config.RBAC{
+				Roles: map[string]config.RBACRole{
+					"admin": {Permissions: []string{"unknown:*"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_unknown_default_role",
+			cfg: &config.RBAC{
+				DefaultRoles: []string{"viewer"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tCase := range tCases {
+		tCase := tCase
+		t.Run(tCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := New(tCase.cfg)
+			require.Equal(t, tCase.wantErr, err != nil)
+			if tCase.wantErr {
+				return
+			}
+			require.Equal(t, tCase.wantNil, r == nil)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r, err := New(&config.RBAC{
+		Roles: map[string]config.RBACRole{
+			"admin": {
+				Permissions: []string{PermAll},
+				Users:       []string{"root"},
+			},
+			"exporter": {
+				Permissions: []string{"massexport:*"},
+				Groups:      []string{"exporters", "sre"},
+			},
+			"viewer": {
+				Permissions: []string{PermMassExportView, PermSeqAPIExport},
+			},
+		},
+		DefaultRoles: []string{"viewer"},
+	})
+	require.NoError(t, err)
+
+	tCases := []struct {
+		name   string
+		user   string
+		groups []string
+
+		wantRoles []string
+		allowed   []string
+		denied    []string
+	}{
+		{
+			name:      "default",
+			user:      "user",
+			wantRoles: []string{"viewer"},
+			allowed:   []string{PermMassExportView, PermSeqAPIExport},
+			denied:    []string{PermMassExportStart, PermDashboardsDeleteAny},
+		},
+		{
+			name:      "by_group",
+			user:      "user",
+			groups:    []string{"devs", "sre"},
+			wantRoles: []string{"exporter", "viewer"},
+			allowed:   []string{PermMassExportStart, PermMassExportManage},
+			denied:    []string{PermAsyncSearchAdmin},
+		},
+		{
+			name:      "by_user",
+			user:      "root",
+			wantRoles: []string{"admin", "viewer"},
+			allowed:   Permissions,
+		},
+	}
+
+	for _, tCase := range tCases {
+		tCase := tCase
+		t.Run(tCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			access := r.Resolve(tCase.user, tCase.groups)
+			require.Equal(t, tCase.wantRoles, access.Roles)
+
+			ctx := WithAccess(context.Background(), access)
+			for _, perm := range tCase.allowed {
+				require.True(t, HasPermission(ctx, perm), perm)
+			}
+			for _, perm := range tCase.denied {
+				require.False(t, HasPermission(ctx, perm), perm)
+			}
+		})
+	}
+}
+
+func TestHasPermissionDisabled(t *testing.T) {
+	require.False(t, HasPermission(context.Background(), PermMassExportStart))
+}
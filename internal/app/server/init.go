@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -12,10 +13,15 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/ozontech/seq-ui/internal/api"
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/inflight"
 	"github.com/ozontech/seq-ui/internal/app/mw"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	apptls "github.com/ozontech/seq-ui/internal/app/tls"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/tracing"
 )
@@ -30,18 +36,49 @@ var defaultCORSAllowedMethods = []string{"HEAD", "GET", "POST", "PATCH", "DELETE
 func (s *Server) init(ctx context.Context, registrar *api.Registrar) error {
 	var err error
 
-	s.authPrvds, err = mw.NewAuthProviders(ctx, s.config.JWTSecretKey, s.config.OIDC, s.config.Cache)
+	s.authPrvds, err = mw.NewAuthProviders(ctx, s.config, s.tokenChecker)
 	if err != nil {
 		return err
 	}
 
-	err = s.prepareRateLimiters()
+	err = s.prepareTLS()
 	if err != nil {
 		return err
 	}
 
+	if s.sessionPrvd != nil {
+		s.authPrvds.SessionProvider = s.sessionPrvd
+		s.authPrvds.SessionCookie = s.config.OIDC.Login.CookieName
+	}
+
+	s.authPrvds.RBAC, err = rbac.New(s.config.RBAC)
+	if err != nil {
+		return fmt.Errorf("init rbac: %w", err)
+	}
+	if s.authPrvds.RBAC != nil && !s.authPrvds.Enabled() {
+		return errors.New("rbac requires jwt_secret_key, jwt, oidc, api_keys or tls.client_ca to be set")
+	}
+
+	err = s.prepareRateLimiters(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.config.ConcurrencyLimits != nil {
+		s.concurrencyLimiters, err = inflight.New(*s.config.ConcurrencyLimits)
+		if err != nil {
+			return fmt.Errorf("init concurrency limiters: %w", err)
+		}
+	}
+
 	s.prepareGRPCServer(registrar)
 
+	if s.concurrencyLimiters != nil {
+		if err = mw.ValidateConcurrencyRoutes(s.concurrencyLimiters, s.grpcServer); err != nil {
+			return err
+		}
+	}
+
 	s.prepareHTTPServer(ctx, registrar)
 
 	err = s.prepareDebugServer(ctx)
@@ -73,8 +110,30 @@ func (s *Server) setupCORS(mux *chi.Mux) {
 	}))
 }
 
+// prepareTLS prepares TLS config of the gRPC and HTTP servers based on server config.
+// The client certs are verified if the client CA is set, so they can be used for authentication.
+func (s *Server) prepareTLS() error {
+	if s.config.TLS == nil {
+		return nil
+	}
+
+	b := apptls.NewConfigBuilder()
+	if err := b.AppendX509KeyPair(s.config.TLS.Cert, s.config.TLS.Key); err != nil {
+		return fmt.Errorf("init server tls: %w", err)
+	}
+	if s.config.TLS.ClientCA != "" {
+		optional := s.config.TLS.ClientAuth == config.TLSClientAuthVerifyIfGiven
+		if err := b.SetClientCA(s.config.TLS.ClientCA, optional); err != nil {
+			return fmt.Errorf("init server tls client ca: %w", err)
+		}
+	}
+	s.tlsConfig = b.Build()
+
+	return nil
+}
+
 // prepareRateLimiters prepares requests rate limiters based on server config.
-func (s *Server) prepareRateLimiters() error {
+func (s *Server) prepareRateLimiters(ctx context.Context) error {
 	if len(s.config.RateLimiters) == 0 {
 		return nil
 	}
@@ -85,7 +144,7 @@ func (s *Server) prepareRateLimiters() error {
 		s.rateLimiters[apiName] = make(map[string]mw.RateLimiter)
 
 		logger.Info("init default rate limiter", zap.String("api", apiName))
-		defaultLimiter, err := mw.NewRateLimiter(apiName, rateLimiters.Default)
+		defaultLimiter, err := mw.NewRateLimiter(ctx, apiName, rateLimiters.Default)
 		if err != nil {
 			return fmt.Errorf("init default rate limiter: %w", err)
 		}
@@ -98,7 +157,7 @@ func (s *Server) prepareRateLimiters() error {
 				zap.String("api", apiName),
 				zap.String("user", user),
 			)
-			limiter, err := mw.NewRateLimiter(apiName, rateLimiter)
+			limiter, err := mw.NewRateLimiter(ctx, apiName, rateLimiter)
 			if err != nil {
 				return fmt.Errorf("init user %q rate limiter: %w", user, err)
 			}
@@ -116,7 +175,7 @@ func (s *Server) prepareGRPCServer(registrar *api.Registrar) {
 		mw.GRPCRecoverInterceptor(),
 		mw.GRPCProcessHeadersInterceptor(),
 	}
-	if s.authPrvds.JwtProvider != nil || s.authPrvds.OidcProvider != nil {
+	if s.authPrvds.Enabled() {
 		interceptors = append(interceptors, mw.GRPCAuthInterceptor(&s.authPrvds))
 	}
 	interceptors = append(interceptors,
@@ -127,11 +186,20 @@ func (s *Server) prepareGRPCServer(registrar *api.Registrar) {
 	if len(s.rateLimiters) > 0 {
 		interceptors = append(interceptors, mw.GRPCRateLimitInterceptor(s.rateLimiters))
 	}
+	if s.concurrencyLimiters != nil {
+		interceptors = append(interceptors, mw.GRPCConcurrencyLimitInterceptor(s.concurrencyLimiters))
+	}
+	if s.auditRec != nil {
+		interceptors = append(interceptors, mw.GRPCAuditInterceptor(s.auditRec))
+	}
 
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(grpc_mw.ChainUnaryServer(interceptors...)),
 		grpc.ConnectionTimeout(s.config.GRPCConnectionTimeout),
 	}
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
 	s.grpcServer = grpc.NewServer(opts...)
 
 	registrar.RegisterGRPCHandlers(s.grpcServer)
@@ -151,7 +219,7 @@ func (s *Server) prepareHTTPServer(ctx context.Context, registrar *api.Registrar
 		mw.HTTPRecoverInterceptor(),
 		mw.HTTPProcessHeadersInterceptor(),
 	}
-	if s.authPrvds.JwtProvider != nil || s.authPrvds.OidcProvider != nil {
+	if s.authPrvds.Enabled() {
 		interceptors = append(interceptors, mw.HTTPAuthInterceptor(&s.authPrvds))
 	}
 	interceptors = append(interceptors,
@@ -162,11 +230,18 @@ func (s *Server) prepareHTTPServer(ctx context.Context, registrar *api.Registrar
 	if len(s.rateLimiters) > 0 {
 		interceptors = append(interceptors, mw.HTTPRateLimitInterceptor(s.rateLimiters))
 	}
+	if s.concurrencyLimiters != nil {
+		interceptors = append(interceptors, mw.HTTPConcurrencyLimitInterceptor(s.concurrencyLimiters))
+	}
+	if s.auditRec != nil {
+		interceptors = append(interceptors, mw.HTTPAuditInterceptor(s.auditRec))
+	}
 	mux.Use(interceptors...)
 
 	registrar.RegisterHTTPHandlers(mux)
 
 	s.httpServer = s.makeHTTPServer(ctx, mux)
+	s.httpServer.TLSConfig = s.tlsConfig
 }
 
 // prepareHTTPMux prepares debug HTTP server mux with applied CORS policies and added interceptors.
@@ -187,6 +262,9 @@ func (s *Server) prepareDebugServer(ctx context.Context) error {
 	}
 	serveSwaggerUI(mux, port)
 	servePprof(mux)
+	if s.concurrencyLimiters != nil {
+		serveConcurrencyStats(mux, s.concurrencyLimiters)
+	}
 	s.debugServer = s.makeHTTPServer(ctx, mux)
 	return nil
 }
@@ -33,6 +33,10 @@ func (s *Server) Run(ctx context.Context) error {
 			return err
 		}
 
+		if s.tlsConfig != nil {
+			// the cert and key are already in the TLS config
+			return s.httpServer.ServeTLS(l, "", "")
+		}
 		return s.httpServer.Serve(l)
 	})
 
@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
 	"google.golang.org/grpc"
 
 	"github.com/ozontech/seq-ui/internal/api"
+	"github.com/ozontech/seq-ui/internal/app/auth"
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/inflight"
 	"github.com/ozontech/seq-ui/internal/app/mw"
 )
 
@@ -18,14 +21,36 @@ type Server struct {
 	debugServer *http.Server
 	grpcServer  *grpc.Server
 	httpServer  *http.Server
+	// tlsConfig is the TLS config of the gRPC and HTTP servers, they accept plain connections if it's nil.
+	tlsConfig *tls.Config
 
 	authPrvds    mw.AuthProviders
+	tokenChecker mw.TokenRevocationChecker
+	auditRec     mw.AuditRecorder
+	sessionPrvd  auth.SessionProvider
 	rateLimiters map[string]map[string]mw.RateLimiter // rate limiter by api and user
+
+	concurrencyLimiters *inflight.Limiters
 }
 
 // New returns a new Server.
-func New(ctx context.Context, cfg *config.Server, registrar *api.Registrar) (*Server, error) {
-	s := &Server{config: cfg}
+// The token checker is optional, without it the personal API tokens are rejected.
+// The audit recorder is optional, without it the user actions are not audited.
+// The session provider is optional, without it the browser sessions are rejected.
+func New(
+	ctx context.Context,
+	cfg *config.Server,
+	registrar *api.Registrar,
+	tokenChecker mw.TokenRevocationChecker,
+	auditRec mw.AuditRecorder,
+	sessionPrvd auth.SessionProvider,
+) (*Server, error) {
+	s := &Server{
+		config:       cfg,
+		tokenChecker: tokenChecker,
+		auditRec:     auditRec,
+		sessionPrvd:  sessionPrvd,
+	}
 
 	if err := s.init(ctx, registrar); err != nil {
 		return nil, fmt.Errorf("init server: %w", err)
@@ -11,6 +11,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 
+	"github.com/ozontech/seq-ui/internal/app/inflight"
 	"github.com/ozontech/seq-ui/logger"
 	"github.com/ozontech/seq-ui/swagger"
 )
@@ -76,3 +77,13 @@ func servePprof(mux *chi.Mux) {
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 }
+
+// serveConcurrencyStats serves the current in-flight and queued requests of the users by route.
+func serveConcurrencyStats(mux *chi.Mux, limiters *inflight.Limiters) {
+	mux.Get("/admin/concurrency", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"routes": limiters.Stats(),
+		})
+	})
+}
@@ -81,8 +81,39 @@ func (b ConfigBuilder) AppendX509KeyPair(caCert, certKey string) error {
 // If caCert is a path to a PEM encoded file, it reads and appends the content to the tls config.
 // If RootCAs is nil, TLS uses the host's root CA set.
 func (b ConfigBuilder) AppendCARoot(caCert string) error {
+	pool, err := b.certPool(caCert)
+	if err != nil {
+		return err
+	}
+
+	b.cfg.RootCAs = pool
+
+	return nil
+}
+
+// SetClientCA sets the CA certificates verifying the client certificates on the server side.
+// If caCert is a path to a PEM encoded file, it reads the content first.
+// The client certificates are required unless optional is set,
+// but they are verified by the CA in both cases.
+func (b ConfigBuilder) SetClientCA(caCert string, optional bool) error {
+	pool, err := b.certPool(caCert)
+	if err != nil {
+		return err
+	}
+
+	b.cfg.ClientCAs = pool
+	b.cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	if optional {
+		b.cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return nil
+}
+
+// certPool reads and parses all the certificates of caCert.
+func (b ConfigBuilder) certPool(caCert string) (*x509.CertPool, error) {
 	if caCert == "" {
-		return ErrEmptyCert
+		return nil, ErrEmptyCert
 	}
 
 	var (
@@ -93,11 +124,11 @@ func (b ConfigBuilder) AppendCARoot(caCert string) error {
 	if !isPEM(certContent) {
 		certContent, err = b.readFile(caCert)
 		if err != nil {
-			return fmt.Errorf("can't read CA cert file=%q: %w", caCert, err)
+			return nil, fmt.Errorf("can't read CA cert file=%q: %w", caCert, err)
 		}
 	}
 
-	b.cfg.RootCAs = x509.NewCertPool()
+	pool := x509.NewCertPool()
 
 	// certContent can contain many certificates, we have to parse them all
 	for len(certContent) > 0 {
@@ -112,13 +143,13 @@ func (b ConfigBuilder) AppendCARoot(caCert string) error {
 
 		cert, err := x509.ParseCertificate(block.Bytes)
 		if err != nil {
-			return fmt.Errorf("can't parse CA cert: %w", err)
+			return nil, fmt.Errorf("can't parse CA cert: %w", err)
 		}
 
-		b.cfg.RootCAs.AddCert(cert)
+		pool.AddCert(cert)
 	}
 
-	return nil
+	return pool, nil
 }
 
 // Build returns built tls config.
@@ -1,7 +1,16 @@
 package tls
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -143,3 +152,30 @@ Pafatg==
 	require.NotNil(t, tlsConfig)
 	require.NotNil(t, tlsConfig.RootCAs)
 }
+
+func TestTLSConfigBuilder_SetClientCA(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	b := NewConfigBuilder()
+	require.NoError(t, b.SetClientCA(caCert, false))
+	require.NotNil(t, b.Build().ClientCAs)
+	require.Equal(t, tls.RequireAndVerifyClientCert, b.Build().ClientAuth)
+
+	b = NewConfigBuilder()
+	require.NoError(t, b.SetClientCA(caCert, true))
+	require.Equal(t, tls.VerifyClientCertIfGiven, b.Build().ClientAuth)
+
+	require.ErrorIs(t, NewConfigBuilder().SetClientCA("", false), ErrEmptyCert)
+}
@@ -7,15 +7,18 @@ type SaveAsyncSearchRequest struct {
 	OwnerID   int64
 	ExpiresAt time.Time
 	Meta      string
+	// Restriction is the query policy filter the search was started with, empty if not restricted.
+	Restriction string
 }
 
 type AsyncSearchInfo struct {
-	SearchID  string
-	OwnerID   int64
-	OwnerName string
-	Meta      string
-	CreatedAt time.Time
-	ExpiresAt time.Time
+	SearchID    string
+	OwnerID     int64
+	OwnerName   string
+	Meta        string
+	Restriction string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
 }
 
 type GetAsyncSearchesListRequest struct {
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/pkg/audit/v1"
+)
+
+type AuditAction string
+
+const (
+	AuditActionSearch          AuditAction = "search"
+	AuditActionHistogram       AuditAction = "histogram"
+	AuditActionAggregation     AuditAction = "aggregation"
+	AuditActionExport          AuditAction = "export"
+	AuditActionEventView       AuditAction = "event_view"
+	AuditActionAsyncSearch     AuditAction = "async_search"
+	AuditActionMassExport      AuditAction = "mass_export"
+	AuditActionDashboardCreate AuditAction = "dashboard_create"
+	AuditActionDashboardUpdate AuditAction = "dashboard_update"
+	AuditActionDashboardDelete AuditAction = "dashboard_delete"
+	AuditActionDashboardImport AuditAction = "dashboard_import"
+	AuditActionTokenCreate     AuditAction = "token_create"
+	AuditActionTokenRevoke     AuditAction = "token_revoke"
+)
+
+// AuditActions contains all audited actions.
+var AuditActions = []AuditAction{
+	AuditActionSearch,
+	AuditActionHistogram,
+	AuditActionAggregation,
+	AuditActionExport,
+	AuditActionEventView,
+	AuditActionAsyncSearch,
+	AuditActionMassExport,
+	AuditActionDashboardCreate,
+	AuditActionDashboardUpdate,
+	AuditActionDashboardDelete,
+	AuditActionDashboardImport,
+	AuditActionTokenCreate,
+	AuditActionTokenRevoke,
+}
+
+type AuditRecord struct {
+	ID       int64       `json:"id,omitempty"`
+	UserName string      `json:"user_name"`
+	Action   AuditAction `json:"action"`
+	// Method is gRPC method or HTTP route of the request.
+	Method string     `json:"method"`
+	Env    string     `json:"env,omitempty"`
+	Query  string     `json:"query,omitempty"`
+	From   *time.Time `json:"from,omitempty"`
+	To     *time.Time `json:"to,omitempty"`
+	// Target is the object of the action, e.g. ID of the event or UUID of the dashboard.
+	Target    string    `json:"target,omitempty"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (r AuditRecord) ToProto() *audit.AuditRecord {
+	record := &audit.AuditRecord{
+		Id:        r.ID,
+		UserName:  r.UserName,
+		Action:    string(r.Action),
+		Method:    r.Method,
+		Env:       r.Env,
+		Query:     r.Query,
+		Target:    r.Target,
+		Status:    r.Status,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+	if r.From != nil {
+		record.From = timestamppb.New(*r.From)
+	}
+	if r.To != nil {
+		record.To = timestamppb.New(*r.To)
+	}
+	return record
+}
+
+type AuditRecords []AuditRecord
+
+func (rs AuditRecords) ToProto() []*audit.AuditRecord {
+	res := make([]*audit.AuditRecord, 0, len(rs))
+	for _, r := range rs {
+		res = append(res, r.ToProto())
+	}
+	return res
+}
+
+// GetAuditLogRequest filters the audit log, empty fields are not used in filter.
+type GetAuditLogRequest struct {
+	UserName *string
+	Actions  []AuditAction
+	From     *time.Time
+	To       *time.Time
+	Limit    int32
+	Offset   int32
+}
@@ -30,6 +30,14 @@ func GetUserKey(ctx context.Context) (string, error) {
 	return userStr, nil
 }
 
+type UserGroupsKey struct{}
+
+// GetUserGroups returns groups of the user from context.
+func GetUserGroups(ctx context.Context) []string {
+	groups, _ := ctx.Value(UserGroupsKey{}).([]string)
+	return groups
+}
+
 type UseSeqQL struct{}
 
 // GetUseSeqQL returns header `use-seq-ql` from context.
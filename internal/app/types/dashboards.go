@@ -1,5 +1,13 @@
 package types
 
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/pkg/dashboards/v1"
+)
+
 type DashboardInfo struct {
 	UUID string
 	Name string
@@ -18,6 +26,9 @@ type Dashboard struct {
 	Name      string
 	Meta      string
 	OwnerName string
+	FolderID  *int64
+	Tags      []string
+	UpdatedAt time.Time
 }
 
 // temporarily to maintain compatibility
@@ -26,6 +37,7 @@ type GetDashboardsRequest struct {
 }
 
 type GetAllDashboardsRequest struct {
+	User   DashboardUser
 	Limit  int
 	Offset int
 }
@@ -37,9 +49,13 @@ type GetUserDashboardsRequest struct {
 }
 
 type CreateDashboardRequest struct {
+	// UUID of the new dashboard, generated if empty.
+	UUID      string
 	ProfileID int64
 	Name      string
 	Meta      string
+	FolderID  *int64
+	Tags      []string
 }
 
 type UpdateDashboardRequest struct {
@@ -47,10 +63,13 @@ type UpdateDashboardRequest struct {
 	ProfileID int64
 	Name      *string
 	Meta      *string
+	// FolderID moves the dashboard to the folder, zero moves it to the root.
+	FolderID *int64
+	Tags     *[]string
 }
 
 func (ur UpdateDashboardRequest) IsEmpty() bool {
-	return ur.Name == nil && ur.Meta == nil
+	return ur.Name == nil && ur.Meta == nil && ur.FolderID == nil && ur.Tags == nil
 }
 
 type DeleteDashboardRequest struct {
@@ -60,11 +79,592 @@ type DeleteDashboardRequest struct {
 
 type SearchDashboardsFilter struct {
 	OwnerName *string
+	// Tags filters dashboards having all of the tags.
+	Tags     []string
+	FolderID *int64
+	Starred  bool
+}
+
+type DashboardsSort string
+
+const (
+	DashboardsSortName    DashboardsSort = "name"
+	DashboardsSortUpdated DashboardsSort = "updated"
+	DashboardsSortViewed  DashboardsSort = "viewed"
+)
+
+func (s DashboardsSort) IsValid() bool {
+	return s == DashboardsSortName || s == DashboardsSortUpdated || s == DashboardsSortViewed
+}
+
+func (s DashboardsSort) ToProto() dashboards.Sort {
+	switch s {
+	case DashboardsSortUpdated:
+		return dashboards.Sort_SORT_UPDATED
+	case DashboardsSortViewed:
+		return dashboards.Sort_SORT_VIEWED
+	default:
+		return dashboards.Sort_SORT_NAME
+	}
+}
+
+func DashboardsSortFromProto(s dashboards.Sort) DashboardsSort {
+	switch s {
+	case dashboards.Sort_SORT_UPDATED:
+		return DashboardsSortUpdated
+	case dashboards.Sort_SORT_VIEWED:
+		return DashboardsSortViewed
+	default:
+		return DashboardsSortName
+	}
 }
 
+// SearchDashboardsRequest searches dashboards by name, tags and queries in meta.
+// Dashboard must match all words of the query.
 type SearchDashboardsRequest struct {
+	User   DashboardUser
 	Query  string
 	Limit  int
 	Offset int
 	Filter *SearchDashboardsFilter
+	Sort   DashboardsSort
+}
+
+type DashboardSearchResult struct {
+	DashboardInfoWithOwner
+	FolderID  *int64
+	Tags      []string
+	Starred   bool
+	UpdatedAt time.Time
+	ViewedAt  *time.Time
+}
+
+type DashboardSearchResults []DashboardSearchResult
+
+type DashboardVersionInfo struct {
+	Version    int64
+	Name       string
+	AuthorName string
+	CreatedAt  time.Time
+}
+
+type DashboardVersionInfos []DashboardVersionInfo
+
+type DashboardVersion struct {
+	DashboardVersionInfo
+	Meta string
+}
+
+type ListDashboardVersionsRequest struct {
+	UUID   string
+	Limit  int
+	Offset int
+}
+
+type GetDashboardVersionRequest struct {
+	UUID    string
+	Version int64
+}
+
+type RestoreDashboardVersionRequest struct {
+	UUID      string
+	Version   int64
+	ProfileID int64
+}
+
+type DiffDashboardVersionsRequest struct {
+	UUID        string
+	FromVersion int64
+	ToVersion   int64
+}
+
+type DashboardDiffOp int
+
+const (
+	DashboardDiffOpChanged DashboardDiffOp = iota
+	DashboardDiffOpAdded
+	DashboardDiffOpRemoved
+)
+
+// DashboardDiffChange is a single difference between two versions of the dashboard.
+// Path is a JSON pointer (RFC 6901) to the changed value in the dashboard meta,
+// values are JSON-encoded and empty if absent.
+type DashboardDiffChange struct {
+	Path     string
+	Op       DashboardDiffOp
+	OldValue string
+	NewValue string
+}
+
+type DashboardVersionsDiff struct {
+	From    DashboardVersionInfo
+	To      DashboardVersionInfo
+	Changes []DashboardDiffChange
+}
+
+type DashboardVisibility string
+
+const (
+	// DashboardVisibilityPublic dashboard can be viewed by everyone.
+	DashboardVisibilityPublic DashboardVisibility = "public"
+	// DashboardVisibilityTeam dashboard can be viewed by members of the team group and collaborators.
+	DashboardVisibilityTeam DashboardVisibility = "team"
+	// DashboardVisibilityPrivate dashboard can be viewed only by the owner and collaborators.
+	DashboardVisibilityPrivate DashboardVisibility = "private"
+)
+
+func (v DashboardVisibility) IsValid() bool {
+	return v == DashboardVisibilityPublic || v == DashboardVisibilityTeam || v == DashboardVisibilityPrivate
+}
+
+func (v DashboardVisibility) ToProto() dashboards.Visibility {
+	switch v {
+	case DashboardVisibilityTeam:
+		return dashboards.Visibility_VISIBILITY_TEAM
+	case DashboardVisibilityPrivate:
+		return dashboards.Visibility_VISIBILITY_PRIVATE
+	default:
+		return dashboards.Visibility_VISIBILITY_PUBLIC
+	}
+}
+
+func DashboardVisibilityFromProto(v dashboards.Visibility) DashboardVisibility {
+	switch v {
+	case dashboards.Visibility_VISIBILITY_TEAM:
+		return DashboardVisibilityTeam
+	case dashboards.Visibility_VISIBILITY_PRIVATE:
+		return DashboardVisibilityPrivate
+	default:
+		return DashboardVisibilityPublic
+	}
+}
+
+type DashboardRole string
+
+const (
+	DashboardRoleViewer DashboardRole = "viewer"
+	DashboardRoleEditor DashboardRole = "editor"
+)
+
+func (r DashboardRole) IsValid() bool {
+	return r == DashboardRoleViewer || r == DashboardRoleEditor
+}
+
+func (r DashboardRole) ToProto() dashboards.Role {
+	if r == DashboardRoleEditor {
+		return dashboards.Role_ROLE_EDITOR
+	}
+	return dashboards.Role_ROLE_VIEWER
+}
+
+func DashboardRoleFromProto(r dashboards.Role) DashboardRole {
+	if r == dashboards.Role_ROLE_EDITOR {
+		return DashboardRoleEditor
+	}
+	return DashboardRoleViewer
+}
+
+type DashboardCollaboratorKind string
+
+const (
+	DashboardCollaboratorUser  DashboardCollaboratorKind = "user"
+	DashboardCollaboratorGroup DashboardCollaboratorKind = "group"
+)
+
+func (k DashboardCollaboratorKind) IsValid() bool {
+	return k == DashboardCollaboratorUser || k == DashboardCollaboratorGroup
+}
+
+func (k DashboardCollaboratorKind) ToProto() dashboards.Collaborator_Kind {
+	if k == DashboardCollaboratorGroup {
+		return dashboards.Collaborator_KIND_GROUP
+	}
+	return dashboards.Collaborator_KIND_USER
+}
+
+func DashboardCollaboratorKindFromProto(k dashboards.Collaborator_Kind) DashboardCollaboratorKind {
+	if k == dashboards.Collaborator_KIND_GROUP {
+		return DashboardCollaboratorGroup
+	}
+	return DashboardCollaboratorUser
+}
+
+// DashboardCollaborator grants role on the dashboard to the user or to all members of the group.
+type DashboardCollaborator struct {
+	Kind DashboardCollaboratorKind
+	Name string
+	Role DashboardRole
+}
+
+func (c DashboardCollaborator) ToProto() *dashboards.Collaborator {
+	return &dashboards.Collaborator{
+		Kind: c.Kind.ToProto(),
+		Name: c.Name,
+		Role: c.Role.ToProto(),
+	}
+}
+
+func DashboardCollaboratorFromProto(c *dashboards.Collaborator) DashboardCollaborator {
+	return DashboardCollaborator{
+		Kind: DashboardCollaboratorKindFromProto(c.GetKind()),
+		Name: c.GetName(),
+		Role: DashboardRoleFromProto(c.GetRole()),
+	}
+}
+
+type DashboardCollaborators []DashboardCollaborator
+
+// DashboardUser is the user accessing dashboards.
+type DashboardUser struct {
+	ProfileID int64
+	Name      string
+	Groups    []string
+}
+
+type DashboardACL struct {
+	OwnerID       int64
+	OwnerName     string
+	Visibility    DashboardVisibility
+	Team          string
+	Collaborators DashboardCollaborators
+}
+
+type UpdateDashboardSharingRequest struct {
+	UUID          string
+	Visibility    DashboardVisibility
+	Team          string
+	Collaborators DashboardCollaborators
+}
+
+type TransferDashboardOwnershipRequest struct {
+	UUID          string
+	NewOwnerName  string
+	PrevOwnerName string
+}
+
+type DashboardFolder struct {
+	ID        int64
+	ParentID  *int64
+	Name      string
+	OwnerID   int64
+	OwnerName string
+}
+
+type DashboardFolders []DashboardFolder
+
+type CreateDashboardFolderRequest struct {
+	ProfileID int64
+	Name      string
+	ParentID  *int64
+}
+
+type UpdateDashboardFolderRequest struct {
+	ID        int64
+	ProfileID int64
+	Name      *string
+	// ParentID moves the folder to the parent one, zero moves it to the root.
+	ParentID *int64
+}
+
+func (ur UpdateDashboardFolderRequest) IsEmpty() bool {
+	return ur.Name == nil && ur.ParentID == nil
+}
+
+type DeleteDashboardFolderRequest struct {
+	ID        int64
+	ProfileID int64
+}
+
+type StarDashboardRequest struct {
+	UUID      string
+	ProfileID int64
+}
+
+type MarkDashboardViewedRequest struct {
+	UUID      string
+	ProfileID int64
+}
+
+// DashboardsBundleVersion is the current version of the dashboards bundle format.
+const DashboardsBundleVersion = 1
+
+// DashboardsBundle is a portable set of dashboards used to move them between installations.
+type DashboardsBundle struct {
+	Version    int                    `json:"version"`
+	Dashboards []DashboardsBundleItem `json:"dashboards"`
+}
+
+type DashboardsBundleItem struct {
+	UUID string   `json:"uuid"`
+	Name string   `json:"name"`
+	Meta string   `json:"meta"`
+	Tags []string `json:"tags"`
+}
+
+type DashboardImportConflict string
+
+const (
+	DashboardImportConflictSkip      DashboardImportConflict = "skip"
+	DashboardImportConflictOverwrite DashboardImportConflict = "overwrite"
+	DashboardImportConflictNewUUID   DashboardImportConflict = "new_uuid"
+)
+
+func (c DashboardImportConflict) IsValid() bool {
+	return c == DashboardImportConflictSkip ||
+		c == DashboardImportConflictOverwrite ||
+		c == DashboardImportConflictNewUUID
+}
+
+func DashboardImportConflictFromProto(c dashboards.ImportConflict) DashboardImportConflict {
+	switch c {
+	case dashboards.ImportConflict_IMPORT_CONFLICT_OVERWRITE:
+		return DashboardImportConflictOverwrite
+	case dashboards.ImportConflict_IMPORT_CONFLICT_NEW_UUID:
+		return DashboardImportConflictNewUUID
+	default:
+		return DashboardImportConflictSkip
+	}
+}
+
+type ImportDashboardsRequest struct {
+	ProfileID int64
+	Bundle    DashboardsBundle
+	Conflict  DashboardImportConflict
+}
+
+type DashboardImportStatus string
+
+const (
+	DashboardImportStatusCreated     DashboardImportStatus = "created"
+	DashboardImportStatusOverwritten DashboardImportStatus = "overwritten"
+	DashboardImportStatusSkipped     DashboardImportStatus = "skipped"
+)
+
+func (s DashboardImportStatus) ToProto() dashboards.ImportResponse_Status {
+	switch s {
+	case DashboardImportStatusOverwritten:
+		return dashboards.ImportResponse_STATUS_OVERWRITTEN
+	case DashboardImportStatusSkipped:
+		return dashboards.ImportResponse_STATUS_SKIPPED
+	default:
+		return dashboards.ImportResponse_STATUS_CREATED
+	}
+}
+
+type DashboardImportResult struct {
+	SourceUUID string
+	UUID       string
+	Status     DashboardImportStatus
+}
+
+type DashboardImportResults []DashboardImportResult
+
+type DashboardPanelType string
+
+const (
+	DashboardPanelTypeHistogram     DashboardPanelType = "histogram"
+	DashboardPanelTypeAggregation   DashboardPanelType = "aggregation"
+	DashboardPanelTypeAggregationTs DashboardPanelType = "aggregation_ts"
+)
+
+func (t DashboardPanelType) IsValid() bool {
+	return t == DashboardPanelTypeHistogram ||
+		t == DashboardPanelTypeAggregation ||
+		t == DashboardPanelTypeAggregationTs
+}
+
+// DashboardMeta is the part of the dashboard meta interpreted by the server.
+// Other fields of the meta, panels and variables are interpreted by the frontend only.
+type DashboardMeta struct {
+	Panels    []DashboardPanel    `json:"panels"`
+	Variables []DashboardVariable `json:"variables"`
+}
+
+// DashboardPanel is a panel of the dashboard, its query and env may contain variables like "$service".
+type DashboardPanel struct {
+	ID    string             `json:"id"`
+	Title string             `json:"title,omitempty"`
+	Type  DashboardPanelType `json:"type"`
+	Query string             `json:"query"`
+	// Env is the seq-db environment, empty means the default one.
+	Env       string             `json:"env,omitempty"`
+	TimeRange DashboardTimeRange `json:"time_range"`
+	// Interval of the histogram buckets.
+	Interval     string                      `json:"interval,omitempty"`
+	Aggregations []DashboardPanelAggregation `json:"aggregations,omitempty"`
+}
+
+// DashboardTimeRange is either relative to the render time or absolute.
+type DashboardTimeRange struct {
+	// Last is the duration before the render time, e.g. "1h".
+	Last string     `json:"last,omitempty"`
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+type DashboardPanelAggregation struct {
+	Field     string    `json:"field"`
+	GroupBy   string    `json:"group_by,omitempty"`
+	Func      string    `json:"func,omitempty"`
+	Quantiles []float64 `json:"quantiles,omitempty"`
+	// Interval of the buckets, required for aggregation_ts panels.
+	Interval         string `json:"interval,omitempty"`
+	TargetBucketRate string `json:"target_bucket_rate,omitempty"`
+}
+
+type DashboardVariableType string
+
+const (
+	// DashboardVariableTypeCustom has options listed in the definition.
+	DashboardVariableTypeCustom DashboardVariableType = "custom"
+	// DashboardVariableTypeAggregation has options from values of the field found by the query.
+	DashboardVariableTypeAggregation DashboardVariableType = "aggregation"
+	// DashboardVariableTypeErrorGroupsServices has options from services of the error groups.
+	DashboardVariableTypeErrorGroupsServices DashboardVariableType = "error_groups_services"
+	// DashboardVariableTypeEnv has options from the configured seq-db envs.
+	DashboardVariableTypeEnv DashboardVariableType = "env"
+)
+
+func (t DashboardVariableType) IsValid() bool {
+	return t == DashboardVariableTypeCustom ||
+		t == DashboardVariableTypeAggregation ||
+		t == DashboardVariableTypeErrorGroupsServices ||
+		t == DashboardVariableTypeEnv
+}
+
+// DashboardVariable is a variable substituted into queries and envs of the panels.
+// Query and env of the variable may refer to other variables.
+type DashboardVariable struct {
+	Name string                `json:"name"`
+	Type DashboardVariableType `json:"type"`
+	// Query filters events for aggregation variable or services by prefix for error_groups_services one.
+	Query     string              `json:"query,omitempty"`
+	Field     string              `json:"field,omitempty"`
+	Env       string              `json:"env,omitempty"`
+	TimeRange *DashboardTimeRange `json:"time_range,omitempty"`
+	Options   []string            `json:"options,omitempty"`
+	Default   string              `json:"default,omitempty"`
+}
+
+type RenderDashboardRequest struct {
+	UUID string
+	// From and To override time range of all panels.
+	From *time.Time
+	To   *time.Time
+	// Variables are values of the dashboard variables, default values are used for missing ones.
+	Variables map[string]string
+}
+
+type ResolveDashboardVariablesRequest struct {
+	UUID      string
+	Variables map[string]string
+}
+
+type DashboardVariableOptions struct {
+	Name string
+	// Value is the value used for the variable, either requested or default.
+	Value   string
+	Options []string
+	Error   string
+}
+
+func (o DashboardVariableOptions) ToProto() *dashboards.VariableOptions {
+	return &dashboards.VariableOptions{
+		Name:    o.Name,
+		Value:   o.Value,
+		Options: o.Options,
+		Error:   o.Error,
+	}
+}
+
+type DashboardVariablesOptions []DashboardVariableOptions
+
+func (o DashboardVariablesOptions) ToProto() []*dashboards.VariableOptions {
+	res := make([]*dashboards.VariableOptions, len(o))
+	for i, v := range o {
+		res[i] = v.ToProto()
+	}
+	return res
+}
+
+type DashboardHistogramBucket struct {
+	Key      uint64
+	DocCount uint64
+}
+
+type DashboardAggregationBucket struct {
+	Key       string
+	Value     *float64
+	NotExists int64
+	Quantiles []float64
+	Ts        *time.Time
+}
+
+type DashboardAggregation struct {
+	Buckets          []DashboardAggregationBucket
+	NotExists        int64
+	TargetBucketRate string
+}
+
+type DashboardPanelData struct {
+	ID           string
+	From         time.Time
+	To           time.Time
+	Histogram    []DashboardHistogramBucket
+	Aggregations []DashboardAggregation
+	Error        string
+}
+
+func (d DashboardPanelData) ToProto() *dashboards.PanelData {
+	res := &dashboards.PanelData{
+		Id:    d.ID,
+		From:  timestamppb.New(d.From),
+		To:    timestamppb.New(d.To),
+		Error: d.Error,
+	}
+
+	if len(d.Histogram) > 0 {
+		res.Histogram = make([]*dashboards.PanelData_HistogramBucket, len(d.Histogram))
+		for i, b := range d.Histogram {
+			res.Histogram[i] = &dashboards.PanelData_HistogramBucket{
+				Key:      b.Key,
+				DocCount: b.DocCount,
+			}
+		}
+	}
+
+	if len(d.Aggregations) > 0 {
+		res.Aggregations = make([]*dashboards.PanelData_Aggregation, len(d.Aggregations))
+		for i, a := range d.Aggregations {
+			buckets := make([]*dashboards.PanelData_AggregationBucket, len(a.Buckets))
+			for j, b := range a.Buckets {
+				buckets[j] = &dashboards.PanelData_AggregationBucket{
+					Key:       b.Key,
+					Value:     b.Value,
+					NotExists: b.NotExists,
+					Quantiles: b.Quantiles,
+				}
+				if b.Ts != nil {
+					buckets[j].Ts = timestamppb.New(*b.Ts)
+				}
+			}
+			res.Aggregations[i] = &dashboards.PanelData_Aggregation{
+				Buckets:          buckets,
+				NotExists:        a.NotExists,
+				TargetBucketRate: a.TargetBucketRate,
+			}
+		}
+	}
+
+	return res
+}
+
+type DashboardPanelsData []DashboardPanelData
+
+func (d DashboardPanelsData) ToProto() []*dashboards.PanelData {
+	res := make([]*dashboards.PanelData, len(d))
+	for i, p := range d {
+		res[i] = p.ToProto()
+	}
+	return res
 }
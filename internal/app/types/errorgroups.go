@@ -60,6 +60,7 @@ type ErrorGroup struct {
 }
 
 type GetTopErrorGroupsRequest struct {
+	Service   *string
 	Env       *string
 	Source    *string
 	TimeRange *TimeRange
@@ -75,6 +76,23 @@ type TopErrorGroup struct {
 	Count   uint64
 }
 
+// Intervals of error hist buckets available from rollups.
+const (
+	HistIntervalMinute    = time.Minute
+	HistInterval10Minutes = 10 * time.Minute
+	HistIntervalHour      = time.Hour
+	HistIntervalDay       = 24 * time.Hour
+	HistIntervalWeek      = 7 * HistIntervalDay
+)
+
+type ErrorHistGroupBy int
+
+const (
+	HistGroupByNone ErrorHistGroupBy = iota
+	HistGroupByRelease
+	HistGroupByEnv
+)
+
 type GetErrorHistRequest struct {
 	GroupHash *uint64
 	Service   *string
@@ -82,6 +100,9 @@ type GetErrorHistRequest struct {
 	Source    *string
 	Release   *string
 	TimeRange *TimeRange
+	// Interval of buckets. If zero, interval is chosen by time range.
+	Interval time.Duration
+	GroupBy  ErrorHistGroupBy
 }
 
 type ErrorHistBucket struct {
@@ -89,11 +110,34 @@ type ErrorHistBucket struct {
 	Count uint64
 }
 
+// ErrorHistSeries is the hist of the single release or env.
+type ErrorHistSeries struct {
+	Key     string
+	Buckets []ErrorHistBucket
+}
+
 type ErrorHist struct {
-	Buckets  []ErrorHistBucket
+	Buckets []ErrorHistBucket
+	// Series is filled instead of buckets if hist is grouped.
+	Series   []ErrorHistSeries
 	Interval uint64
 }
 
+// AddSeriesBucket adds bucket to the series with the key.
+// Series are ordered by the first appearance of their keys.
+func (h *ErrorHist) AddSeriesBucket(key string, bucket ErrorHistBucket) {
+	for i := range h.Series {
+		if h.Series[i].Key == key {
+			h.Series[i].Buckets = append(h.Series[i].Buckets, bucket)
+			return
+		}
+	}
+	h.Series = append(h.Series, ErrorHistSeries{
+		Key:     key,
+		Buckets: []ErrorHistBucket{bucket},
+	})
+}
+
 type GetErrorGroupDetailsRequest struct {
 	GroupHash uint64
 	Env       *string
@@ -176,3 +220,122 @@ type DiffGroup struct {
 	Source       string
 	ReleaseInfos map[string]DiffReleaseInfo
 }
+
+type ErrorGroupMerge struct {
+	Hash       uint64
+	TargetHash uint64
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+type MergeErrorGroupsRequest struct {
+	TargetHash uint64
+	Hashes     []uint64
+	User       string
+}
+
+type UnmergeErrorGroupsRequest struct {
+	Hashes []uint64
+	User   string
+}
+
+type GetMergedErrorGroupsRequest struct {
+	TargetHash *uint64
+}
+
+type GetSimilarErrorGroupsRequest struct {
+	Service       string
+	GroupHash     uint64
+	Env           *string
+	Limit         uint32
+	MinSimilarity *float64
+}
+
+type SimilarErrorGroup struct {
+	Hash       uint64
+	Source     string
+	Message    string
+	Count      uint64
+	Similarity float64
+}
+
+type ReleaseExposureKind int
+
+const (
+	ExposureTime ReleaseExposureKind = iota
+	ExposureRequests
+)
+
+type ReleaseCompareStatus int
+
+const (
+	CompareStatusUnchanged ReleaseCompareStatus = iota
+	CompareStatusNew
+	CompareStatusRegressed
+	CompareStatusFixed
+)
+
+type CompareReleasesRequest struct {
+	Service           string
+	BaselineRelease   string
+	CandidateRelease  string
+	Env               *string
+	Source            *string
+	Exposure          ReleaseExposureKind
+	SignificanceLevel *float64
+	Statuses          []ReleaseCompareStatus
+	Limit             uint32
+	Offset            uint32
+
+	// BaselineRequests and CandidateRequests are the numbers of requests served
+	// by the releases, required for ExposureRequests.
+	BaselineRequests  *uint64
+	CandidateRequests *uint64
+}
+
+type ReleaseGroupCount struct {
+	Hash        uint64
+	Source      string
+	Message     string
+	Release     string
+	SeenTotal   uint64
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+type ReleaseExposure struct {
+	Release     string
+	SeenTotal   uint64
+	Exposure    float64
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+type CompareGroup struct {
+	Hash               uint64
+	Source             string
+	Message            string
+	BaselineSeenTotal  uint64
+	CandidateSeenTotal uint64
+	BaselineRate       float64
+	CandidateRate      float64
+	RateRatio          float64
+	PValue             float64
+	Significant        bool
+	Status             ReleaseCompareStatus
+}
+
+type CompareReleasesSummary struct {
+	New       uint64
+	Regressed uint64
+	Fixed     uint64
+	Unchanged uint64
+}
+
+type CompareReleasesResult struct {
+	Baseline  ReleaseExposure
+	Candidate ReleaseExposure
+	Summary   CompareReleasesSummary
+	Total     uint64
+	Groups    []CompareGroup
+}
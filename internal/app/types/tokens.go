@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/pkg/tokens/v1"
+)
+
+// APITokenScopes are the APIs the personal API tokens can grant access to.
+var APITokenScopes = []string{"seqapi", "userprofile", "dashboards", "massexport", "errorgroups"}
+
+type APIToken struct {
+	ID        string
+	UserName  string
+	Name      string
+	Scopes    []string
+	CreatedBy string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
+
+func (t APIToken) ToProto() *tokens.Token {
+	token := &tokens.Token{
+		Id:        t.ID,
+		UserName:  t.UserName,
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		CreatedBy: t.CreatedBy,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		ExpiresAt: timestamppb.New(t.ExpiresAt),
+	}
+	if t.RevokedAt != nil {
+		token.RevokedAt = timestamppb.New(*t.RevokedAt)
+	}
+	return token
+}
+
+type APITokens []APIToken
+
+func (ts APITokens) ToProto() []*tokens.Token {
+	res := make([]*tokens.Token, 0, len(ts))
+	for _, t := range ts {
+		res = append(res, t.ToProto())
+	}
+	return res
+}
+
+type CreateAPITokenRequest struct {
+	// UserName is the owner of the token, the current user if empty.
+	UserName string
+	Name     string
+	Scopes   []string
+	// TTL is the token lifetime, the default one if zero.
+	TTL time.Duration
+}
+
+type GetAPITokensRequest struct {
+	// UserName is the owner of the tokens, the current user if empty.
+	UserName string
+}
+
+type RevokeAPITokenRequest struct {
+	ID string
+}
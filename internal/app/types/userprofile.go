@@ -1,6 +1,10 @@
 package types
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/ozontech/seq-ui/pkg/userprofile/v1"
 )
 
@@ -10,18 +14,18 @@ type LogColumns struct {
 
 // User Profile
 type UserProfile struct {
-	ID                int64      `json:"_id"`
-	UserName          string     `json:"user_name"`
-	Timezone          string     `json:"timezone"`
-	OnboardingVersion string     `json:"onboarding_version"`
-	LogColumns        LogColumns `json:"log_columns"`
+	ID                int64           `json:"_id"`
+	UserName          string          `json:"user_name"`
+	OnboardingVersion string          `json:"onboarding_version"`
+	Preferences       UserPreferences `json:"preferences"`
 }
 
 func (up UserProfile) ToProto() *userprofile.GetUserProfileResponse {
 	return &userprofile.GetUserProfileResponse{
-		Timezone:          up.Timezone,
+		Timezone:          up.Preferences.Timezone,
 		OnboardingVersion: up.OnboardingVersion,
-		LogColumns:        &userprofile.LogColumns{LogColumns: up.LogColumns.LogColumns},
+		LogColumns:        &userprofile.LogColumns{LogColumns: up.Preferences.LogColumns},
+		Preferences:       up.Preferences.ToProto(),
 	}
 }
 
@@ -29,6 +33,7 @@ type GetOrCreateUserProfileRequest struct {
 	UserName string `json:"user_name"`
 }
 
+// UpdateUserProfileRequest updates the profile, Timezone and LogColumns are stored in the preferences.
 type UpdateUserProfileRequest struct {
 	UserName          string      `json:"user_name"`
 	Timezone          *string     `json:"timezone"`
@@ -40,18 +45,168 @@ func (ur UpdateUserProfileRequest) IsEmpty() bool {
 	return ur.Timezone == nil && ur.OnboardingVersion == nil && ur.LogColumns == nil
 }
 
+// User Preferences
+
+// UserPreferencesVersion is the current version of the preferences schema.
+// It's stored in the document to migrate the stored preferences when the schema changes.
+const UserPreferencesVersion = 1
+
+type Theme string
+
+const (
+	ThemeUnspecified Theme = ""
+	ThemeLight       Theme = "light"
+	ThemeDark        Theme = "dark"
+	ThemeSystem      Theme = "system"
+)
+
+func (t Theme) IsValid() bool {
+	return t == ThemeUnspecified || t == ThemeLight || t == ThemeDark || t == ThemeSystem
+}
+
+func (t Theme) ToProto() userprofile.Theme {
+	switch t {
+	case ThemeLight:
+		return userprofile.Theme_THEME_LIGHT
+	case ThemeDark:
+		return userprofile.Theme_THEME_DARK
+	case ThemeSystem:
+		return userprofile.Theme_THEME_SYSTEM
+	default:
+		return userprofile.Theme_THEME_UNSPECIFIED
+	}
+}
+
+func ThemeFromProto(t userprofile.Theme) Theme {
+	switch t {
+	case userprofile.Theme_THEME_LIGHT:
+		return ThemeLight
+	case userprofile.Theme_THEME_DARK:
+		return ThemeDark
+	case userprofile.Theme_THEME_SYSTEM:
+		return ThemeSystem
+	default:
+		return ThemeUnspecified
+	}
+}
+
+// UserPreferences is the document stored in the user profile.
+// JSON names of the fields are the paths used in the update mask.
+type UserPreferences struct {
+	Version    int    `json:"version"`
+	Timezone   string `json:"timezone"`
+	DefaultEnv string `json:"default_env"`
+	// DefaultTimeRange is relative time range in seconds.
+	DefaultTimeRange uint64   `json:"default_time_range"`
+	PageSize         int32    `json:"page_size"`
+	DateFormat       string   `json:"date_format"`
+	Theme            Theme    `json:"theme"`
+	LogColumns       []string `json:"log_columns"`
+	// PinnedFields overrides pinned fields from the config if not empty.
+	PinnedFields []string                  `json:"pinned_fields"`
+	Envs         map[string]EnvPreferences `json:"envs,omitempty"`
+}
+
+// EnvPreferences overrides env-scoped preferences in the env.
+type EnvPreferences struct {
+	LogColumns   []string `json:"log_columns"`
+	PinnedFields []string `json:"pinned_fields"`
+}
+
+// ForEnv returns preferences with env-scoped fields resolved for the env.
+func (p UserPreferences) ForEnv(env string) UserPreferences {
+	envPrefs, ok := p.Envs[env]
+	if !ok {
+		return p
+	}
+	if len(envPrefs.LogColumns) > 0 {
+		p.LogColumns = envPrefs.LogColumns
+	}
+	if len(envPrefs.PinnedFields) > 0 {
+		p.PinnedFields = envPrefs.PinnedFields
+	}
+	return p
+}
+
+func (p UserPreferences) ToProto() *userprofile.Preferences {
+	prefs := &userprofile.Preferences{
+		Version:          int32(p.Version),
+		Timezone:         p.Timezone,
+		DefaultEnv:       p.DefaultEnv,
+		DefaultTimeRange: p.DefaultTimeRange,
+		PageSize:         p.PageSize,
+		DateFormat:       p.DateFormat,
+		Theme:            p.Theme.ToProto(),
+		LogColumns:       p.LogColumns,
+		PinnedFields:     p.PinnedFields,
+	}
+	if len(p.Envs) > 0 {
+		prefs.Envs = make(map[string]*userprofile.EnvPreferences, len(p.Envs))
+		for env, envPrefs := range p.Envs {
+			prefs.Envs[env] = &userprofile.EnvPreferences{
+				LogColumns:   envPrefs.LogColumns,
+				PinnedFields: envPrefs.PinnedFields,
+			}
+		}
+	}
+	return prefs
+}
+
+func UserPreferencesFromProto(p *userprofile.Preferences) UserPreferences {
+	prefs := UserPreferences{
+		Timezone:         p.GetTimezone(),
+		DefaultEnv:       p.GetDefaultEnv(),
+		DefaultTimeRange: p.GetDefaultTimeRange(),
+		PageSize:         p.GetPageSize(),
+		DateFormat:       p.GetDateFormat(),
+		Theme:            ThemeFromProto(p.GetTheme()),
+		LogColumns:       p.GetLogColumns(),
+		PinnedFields:     p.GetPinnedFields(),
+	}
+	if len(p.GetEnvs()) > 0 {
+		prefs.Envs = make(map[string]EnvPreferences, len(p.GetEnvs()))
+		for env, envPrefs := range p.GetEnvs() {
+			prefs.Envs[env] = EnvPreferences{
+				LogColumns:   envPrefs.GetLogColumns(),
+				PinnedFields: envPrefs.GetPinnedFields(),
+			}
+		}
+	}
+	return prefs
+}
+
+// UpdateUserPreferencesRequest updates the preferences fields listed in Paths.
+// If Env is set, the env-scoped fields of the env are updated.
+type UpdateUserPreferencesRequest struct {
+	UserName    string          `json:"user_name"`
+	Env         string          `json:"env"`
+	Preferences UserPreferences `json:"preferences"`
+	Paths       []string        `json:"paths"`
+}
+
 // Favorite Queries
 type FavoriteQuery struct {
-	ID           int64  `json:"id"`
-	Query        string `json:"query"`
-	Name         string `json:"name"`
-	RelativeFrom uint64 `json:"relative_from"`
+	ID           int64    `json:"id"`
+	Query        string   `json:"query"`
+	Name         string   `json:"name"`
+	RelativeFrom uint64   `json:"relative_from"`
+	FolderID     *int64   `json:"folder_id"`
+	Env          string   `json:"env"`
+	LogColumns   []string `json:"log_columns"`
+	Team         string   `json:"team"`
+	OwnerName    string   `json:"owner_name"`
+	Subscribed   bool     `json:"subscribed"`
 }
 
 func (fq FavoriteQuery) ToProto() *userprofile.GetFavoriteQueriesResponse_Query {
 	q := &userprofile.GetFavoriteQueriesResponse_Query{
-		Id:    fq.ID,
-		Query: fq.Query,
+		Id:         fq.ID,
+		Query:      fq.Query,
+		FolderId:   fq.FolderID,
+		Subscribed: fq.Subscribed,
+	}
+	if len(fq.LogColumns) > 0 {
+		q.LogColumns = &userprofile.LogColumns{LogColumns: fq.LogColumns}
 	}
 	if fq.Name != "" {
 		q.Name = new(string)
@@ -61,6 +216,18 @@ func (fq FavoriteQuery) ToProto() *userprofile.GetFavoriteQueriesResponse_Query
 		q.RelativeFrom = new(uint64)
 		*q.RelativeFrom = fq.RelativeFrom
 	}
+	if fq.Env != "" {
+		q.Env = new(string)
+		*q.Env = fq.Env
+	}
+	if fq.Team != "" {
+		q.Team = new(string)
+		*q.Team = fq.Team
+	}
+	if fq.OwnerName != "" {
+		q.OwnerName = new(string)
+		*q.OwnerName = fq.OwnerName
+	}
 	return q
 }
 
@@ -77,17 +244,376 @@ func (fqs FavoriteQueries) ToProto() []*userprofile.GetFavoriteQueriesResponse_Q
 }
 
 type GetFavoriteQueriesRequest struct {
-	ProfileID int64 `json:"profile_id"`
+	ProfileID int64    `json:"profile_id"`
+	Groups    []string `json:"groups"`
 }
 
 type GetOrCreateFavoriteQueryRequest struct {
-	ProfileID    int64  `json:"profile_id"`
-	Query        string `json:"query"`
-	Name         string `json:"name"`
-	RelativeFrom uint64 `json:"relative_from"`
+	ProfileID    int64    `json:"profile_id"`
+	Query        string   `json:"query"`
+	Name         string   `json:"name"`
+	RelativeFrom uint64   `json:"relative_from"`
+	Env          string   `json:"env"`
+	LogColumns   []string `json:"log_columns"`
+	FolderID     *int64   `json:"folder_id"`
+}
+
+type UpdateFavoriteQueryRequest struct {
+	ID           int64     `json:"id"`
+	ProfileID    int64     `json:"profile_id"`
+	Query        *string   `json:"query"`
+	Name         *string   `json:"name"`
+	RelativeFrom *uint64   `json:"relative_from"`
+	Env          *string   `json:"env"`
+	LogColumns   *[]string `json:"log_columns"`
+	// FolderID equal to 0 moves the query to the root.
+	FolderID *int64 `json:"folder_id"`
+}
+
+func (r UpdateFavoriteQueryRequest) IsEmpty() bool {
+	return r.Query == nil && r.Name == nil && r.RelativeFrom == nil &&
+		r.Env == nil && r.LogColumns == nil && r.FolderID == nil
 }
 
 type DeleteFavoriteQueryRequest struct {
 	ID        int64 `json:"id"`
 	ProfileID int64 `json:"profile_id"`
 }
+
+// ReorderFavoriteQueriesRequest sets positions of the queries in order of IDs.
+type ReorderFavoriteQueriesRequest struct {
+	ProfileID int64   `json:"profile_id"`
+	IDs       []int64 `json:"ids"`
+}
+
+type FavoriteQueryFolder struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (f FavoriteQueryFolder) ToProto() *userprofile.FavoriteQueryFolder {
+	return &userprofile.FavoriteQueryFolder{
+		Id:   f.ID,
+		Name: f.Name,
+	}
+}
+
+type FavoriteQueryFolders []FavoriteQueryFolder
+
+func (fs FavoriteQueryFolders) ToProto() []*userprofile.FavoriteQueryFolder {
+	folders := make([]*userprofile.FavoriteQueryFolder, len(fs))
+	for i, f := range fs {
+		folders[i] = f.ToProto()
+	}
+	return folders
+}
+
+type GetFavoriteQueryFoldersRequest struct {
+	ProfileID int64 `json:"profile_id"`
+}
+
+type CreateFavoriteQueryFolderRequest struct {
+	ProfileID int64  `json:"profile_id"`
+	Name      string `json:"name"`
+}
+
+type UpdateFavoriteQueryFolderRequest struct {
+	ID        int64  `json:"id"`
+	ProfileID int64  `json:"profile_id"`
+	Name      string `json:"name"`
+}
+
+type DeleteFavoriteQueryFolderRequest struct {
+	ID        int64 `json:"id"`
+	ProfileID int64 `json:"profile_id"`
+}
+
+// PublishFavoriteQueryRequest publishes the query to the team library, empty team unpublishes it.
+type PublishFavoriteQueryRequest struct {
+	ID        int64  `json:"id"`
+	ProfileID int64  `json:"profile_id"`
+	Team      string `json:"team"`
+}
+
+type GetFavoriteQueriesLibraryRequest struct {
+	ProfileID int64    `json:"profile_id"`
+	Team      *string  `json:"team"`
+	Groups    []string `json:"groups"`
+}
+
+type SubscribeFavoriteQueryRequest struct {
+	ID        int64    `json:"id"`
+	ProfileID int64    `json:"profile_id"`
+	Groups    []string `json:"groups"`
+}
+
+type UnsubscribeFavoriteQueryRequest struct {
+	ID        int64 `json:"id"`
+	ProfileID int64 `json:"profile_id"`
+}
+
+// Error Groups Subscriptions
+type DigestPeriod string
+
+const (
+	DigestPeriodDaily  DigestPeriod = "daily"
+	DigestPeriodWeekly DigestPeriod = "weekly"
+)
+
+func (p DigestPeriod) IsValid() bool {
+	return p == DigestPeriodDaily || p == DigestPeriodWeekly
+}
+
+// Duration returns time range covered by single digest.
+func (p DigestPeriod) Duration() time.Duration {
+	if p == DigestPeriodWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+func (p DigestPeriod) ToProto() userprofile.DigestPeriod {
+	if p == DigestPeriodWeekly {
+		return userprofile.DigestPeriod_DIGEST_PERIOD_WEEKLY
+	}
+	return userprofile.DigestPeriod_DIGEST_PERIOD_DAILY
+}
+
+func DigestPeriodFromProto(p userprofile.DigestPeriod) DigestPeriod {
+	if p == userprofile.DigestPeriod_DIGEST_PERIOD_WEEKLY {
+		return DigestPeriodWeekly
+	}
+	return DigestPeriodDaily
+}
+
+type DigestFormat string
+
+const (
+	DigestFormatMarkdown DigestFormat = "markdown"
+	DigestFormatHTML     DigestFormat = "html"
+)
+
+func (f DigestFormat) IsValid() bool {
+	return f == DigestFormatMarkdown || f == DigestFormatHTML
+}
+
+func (f DigestFormat) ToProto() userprofile.DigestFormat {
+	if f == DigestFormatHTML {
+		return userprofile.DigestFormat_DIGEST_FORMAT_HTML
+	}
+	return userprofile.DigestFormat_DIGEST_FORMAT_MARKDOWN
+}
+
+func DigestFormatFromProto(f userprofile.DigestFormat) DigestFormat {
+	if f == userprofile.DigestFormat_DIGEST_FORMAT_HTML {
+		return DigestFormatHTML
+	}
+	return DigestFormatMarkdown
+}
+
+type DigestDelivery string
+
+const (
+	DigestDeliveryWebhook   DigestDelivery = "webhook"
+	DigestDeliveryFileStore DigestDelivery = "file_store"
+)
+
+func (d DigestDelivery) IsValid() bool {
+	return d == DigestDeliveryWebhook || d == DigestDeliveryFileStore
+}
+
+func (d DigestDelivery) ToProto() userprofile.DigestDelivery {
+	if d == DigestDeliveryFileStore {
+		return userprofile.DigestDelivery_DIGEST_DELIVERY_FILE_STORE
+	}
+	return userprofile.DigestDelivery_DIGEST_DELIVERY_WEBHOOK
+}
+
+func DigestDeliveryFromProto(d userprofile.DigestDelivery) DigestDelivery {
+	if d == userprofile.DigestDelivery_DIGEST_DELIVERY_FILE_STORE {
+		return DigestDeliveryFileStore
+	}
+	return DigestDeliveryWebhook
+}
+
+type ErrorGroupsSubscription struct {
+	ID         int64          `json:"id"`
+	ProfileID  int64          `json:"profile_id"`
+	Service    string         `json:"service"`
+	Env        string         `json:"env"`
+	Period     DigestPeriod   `json:"period"`
+	Format     DigestFormat   `json:"format"`
+	Delivery   DigestDelivery `json:"delivery"`
+	WebhookURL string         `json:"webhook_url"`
+	LastSentAt *time.Time     `json:"last_sent_at"`
+}
+
+// IsDue reports whether the next digest of the subscription must be sent.
+func (s ErrorGroupsSubscription) IsDue(now time.Time) bool {
+	return s.LastSentAt == nil || !now.Before(s.LastSentAt.Add(s.Period.Duration()))
+}
+
+func (s ErrorGroupsSubscription) ToProto() *userprofile.ErrorGroupsSubscription {
+	sub := &userprofile.ErrorGroupsSubscription{
+		Id:       s.ID,
+		Service:  s.Service,
+		Period:   s.Period.ToProto(),
+		Format:   s.Format.ToProto(),
+		Delivery: s.Delivery.ToProto(),
+	}
+	if s.Env != "" {
+		sub.Env = new(string)
+		*sub.Env = s.Env
+	}
+	if s.WebhookURL != "" {
+		sub.WebhookUrl = new(string)
+		*sub.WebhookUrl = s.WebhookURL
+	}
+	if s.LastSentAt != nil {
+		sub.LastSentAt = timestamppb.New(*s.LastSentAt)
+	}
+	return sub
+}
+
+type ErrorGroupsSubscriptions []ErrorGroupsSubscription
+
+func (ss ErrorGroupsSubscriptions) ToProto() []*userprofile.ErrorGroupsSubscription {
+	subs := make([]*userprofile.ErrorGroupsSubscription, len(ss))
+
+	for i, s := range ss {
+		subs[i] = s.ToProto()
+	}
+
+	return subs
+}
+
+type GetErrorGroupsSubscriptionsRequest struct {
+	ProfileID int64 `json:"profile_id"`
+}
+
+type CreateErrorGroupsSubscriptionRequest struct {
+	ProfileID  int64          `json:"profile_id"`
+	Service    string         `json:"service"`
+	Env        string         `json:"env"`
+	Period     DigestPeriod   `json:"period"`
+	Format     DigestFormat   `json:"format"`
+	Delivery   DigestDelivery `json:"delivery"`
+	WebhookURL string         `json:"webhook_url"`
+}
+
+type DeleteErrorGroupsSubscriptionRequest struct {
+	ID        int64 `json:"id"`
+	ProfileID int64 `json:"profile_id"`
+}
+
+// MarkErrorGroupsSubscriptionSentRequest moves last sent time of the subscription
+// from PrevSentAt to SentAt. It is used as optimistic lock to deliver digest only once
+// when there are several instances of the app.
+type MarkErrorGroupsSubscriptionSentRequest struct {
+	ID         int64      `json:"id"`
+	PrevSentAt *time.Time `json:"prev_sent_at"`
+	SentAt     time.Time  `json:"sent_at"`
+}
+
+// Query History
+type QueryHistoryKind string
+
+const (
+	QueryHistoryKindSearch      QueryHistoryKind = "search"
+	QueryHistoryKindHistogram   QueryHistoryKind = "histogram"
+	QueryHistoryKindAggregation QueryHistoryKind = "aggregation"
+)
+
+func (k QueryHistoryKind) ToProto() userprofile.QueryHistoryKind {
+	switch k {
+	case QueryHistoryKindHistogram:
+		return userprofile.QueryHistoryKind_QUERY_HISTORY_KIND_HISTOGRAM
+	case QueryHistoryKindAggregation:
+		return userprofile.QueryHistoryKind_QUERY_HISTORY_KIND_AGGREGATION
+	default:
+		return userprofile.QueryHistoryKind_QUERY_HISTORY_KIND_SEARCH
+	}
+}
+
+type QueryHistoryEntry struct {
+	ID        int64            `json:"id"`
+	ProfileID int64            `json:"profile_id"`
+	Kind      QueryHistoryKind `json:"kind"`
+	Query     string           `json:"query"`
+	From      time.Time        `json:"from"`
+	To        time.Time        `json:"to"`
+	Env       string           `json:"env"`
+	Duration  time.Duration    `json:"duration"`
+	// Total is number of found events for search and histogram,
+	// number of buckets for aggregation.
+	Total     int64     `json:"total"`
+	ErrorCode string    `json:"error_code"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (e QueryHistoryEntry) ToProto() *userprofile.GetQueryHistoryResponse_Entry {
+	entry := &userprofile.GetQueryHistoryResponse_Entry{
+		Id:         e.ID,
+		Kind:       e.Kind.ToProto(),
+		Query:      e.Query,
+		From:       timestamppb.New(e.From),
+		To:         timestamppb.New(e.To),
+		DurationMs: e.Duration.Milliseconds(),
+		Total:      e.Total,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+	if e.Env != "" {
+		entry.Env = new(string)
+		*entry.Env = e.Env
+	}
+	if e.ErrorCode != "" {
+		entry.ErrorCode = new(string)
+		*entry.ErrorCode = e.ErrorCode
+	}
+	return entry
+}
+
+type QueryHistoryEntries []QueryHistoryEntry
+
+func (es QueryHistoryEntries) ToProto() []*userprofile.GetQueryHistoryResponse_Entry {
+	entries := make([]*userprofile.GetQueryHistoryResponse_Entry, len(es))
+
+	for i, e := range es {
+		entries[i] = e.ToProto()
+	}
+
+	return entries
+}
+
+type GetQueryHistoryRequest struct {
+	ProfileID int64   `json:"profile_id"`
+	Query     *string `json:"query"`
+	Limit     int32   `json:"limit"`
+	Offset    int32   `json:"offset"`
+}
+
+// AddQueryHistoryRequest adds entries to the history keeping at most MaxEntriesPerUser
+// of the newest entries for each of the profiles.
+type AddQueryHistoryRequest struct {
+	Entries           QueryHistoryEntries `json:"entries"`
+	MaxEntriesPerUser int                 `json:"max_entries_per_user"`
+}
+
+// WhoAmI describes the authenticated user and its access.
+type WhoAmI struct {
+	UserName    string
+	Groups      []string
+	RBACEnabled bool
+	Roles       []string
+	Permissions []string
+}
+
+func (w WhoAmI) ToProto() *userprofile.WhoAmIResponse {
+	return &userprofile.WhoAmIResponse{
+		UserName:    w.UserName,
+		Groups:      w.Groups,
+		RbacEnabled: w.RBACEnabled,
+		Roles:       w.Roles,
+		Permissions: w.Permissions,
+	}
+}
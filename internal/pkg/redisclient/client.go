@@ -10,7 +10,18 @@ import (
 )
 
 func New(ctx context.Context, cfg *config.Redis) (*redis.Client, error) {
-	client := redis.NewClient(&redis.Options{
+	client := NewClient(cfg)
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("ping redis: %w", err)
+	}
+
+	return client, nil
+}
+
+// NewClient creates client without checking the connection.
+func NewClient(cfg *config.Redis) *redis.Client {
+	return redis.NewClient(&redis.Options{
 		Addr:     cfg.Addr,
 		Username: cfg.Username,
 		Password: cfg.Password,
@@ -22,10 +33,4 @@ func New(ctx context.Context, cfg *config.Redis) (*redis.Client, error) {
 		MinRetryBackoff: cfg.MinRetryBackoff,
 		MaxRetryBackoff: cfg.MaxRetryBackoff,
 	})
-
-	if err := client.Ping(ctx).Err(); err != nil {
-		return nil, fmt.Errorf("ping redis: %w", err)
-	}
-
-	return client, nil
 }
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	sq "github.com/n-r-w/squirrel"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	sqlb "github.com/ozontech/seq-ui/internal/pkg/repository/sql_builder"
+)
+
+var apiTokensColumns = []string{
+	"id", "user_name", "name", "scopes", "created_by", "created_at", "expires_at", "revoked_at",
+}
+
+type apiTokensRepository struct {
+	*pool
+}
+
+func newAPITokensRepository(pool *pool) *apiTokensRepository {
+	return &apiTokensRepository{pool}
+}
+
+func (r *apiTokensRepository) Create(ctx context.Context, t types.APIToken) error {
+	query, args := sqlb.Insert("api_tokens").
+		Columns("id", "user_name", "name", "scopes", "created_by", "created_at", "expires_at").
+		Values(t.ID, t.UserName, t.Name, t.Scopes, t.CreatedBy, t.CreatedAt, t.ExpiresAt).
+		MustSql()
+
+	metricLabels := []string{"api_tokens", "INSERT"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to create api token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *apiTokensRepository) Get(ctx context.Context, id string) (types.APIToken, error) {
+	query, args := sqlb.Select(apiTokensColumns...).
+		From("api_tokens").
+		Where(sq.Eq{"id": id}).
+		MustSql()
+
+	metricLabels := []string{"api_tokens", "SELECT"}
+	t, err := scanAPIToken(r.queryRow(ctx, metricLabels, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = types.NewErrNotFound("api token")
+	} else if err != nil {
+		err = fmt.Errorf("failed to get api token: %w", err)
+	}
+
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return types.APIToken{}, err
+	}
+
+	return t, nil
+}
+
+func (r *apiTokensRepository) GetByUser(ctx context.Context, userName string) (types.APITokens, error) {
+	query, args := sqlb.Select(apiTokensColumns...).
+		From("api_tokens").
+		Where(sq.Eq{"user_name": userName}).
+		OrderBy("created_at DESC", "id").
+		MustSql()
+
+	metricLabels := []string{"api_tokens", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get api tokens: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := types.APITokens{}
+	for rows.Next() {
+		t, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		tokens = append(tokens, t)
+	}
+
+	return tokens, nil
+}
+
+// Revoke marks the token as revoked, already revoked token is kept as is.
+func (r *apiTokensRepository) Revoke(ctx context.Context, id string) error {
+	query, args := "UPDATE api_tokens SET revoked_at = COALESCE(revoked_at, now()) WHERE id = $1",
+		[]any{id}
+
+	metricLabels := []string{"api_tokens", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to revoke api token: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("api token")
+	}
+
+	return nil
+}
+
+func scanAPIToken(row pgx.Row) (types.APIToken, error) {
+	var t types.APIToken
+	err := row.Scan(
+		&t.ID,
+		&t.UserName,
+		&t.Name,
+		&t.Scopes,
+		&t.CreatedBy,
+		&t.CreatedAt,
+		&t.ExpiresAt,
+		&t.RevokedAt,
+	)
+	return t, err
+}
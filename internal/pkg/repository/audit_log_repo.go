@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/n-r-w/squirrel"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	sqlb "github.com/ozontech/seq-ui/internal/pkg/repository/sql_builder"
+)
+
+type auditLogRepository struct {
+	*pool
+}
+
+func newAuditLogRepository(pool *pool) *auditLogRepository {
+	return &auditLogRepository{pool}
+}
+
+func (r *auditLogRepository) Get(ctx context.Context, req types.GetAuditLogRequest) (types.AuditRecords, error) {
+	query, args := auditLogSelectQuery(req)
+
+	metricLabels := []string{"audit_log", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get audit log: %w", err)
+	}
+	defer rows.Close()
+
+	records := types.AuditRecords{}
+	for rows.Next() {
+		var rec types.AuditRecord
+		if err = rows.Scan(
+			&rec.ID,
+			&rec.UserName,
+			&rec.Action,
+			&rec.Method,
+			&rec.Env,
+			&rec.Query,
+			&rec.From,
+			&rec.To,
+			&rec.Target,
+			&rec.Status,
+			&rec.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+func auditLogSelectQuery(req types.GetAuditLogRequest) (string, []any) {
+	qb := sqlb.Select(
+		"id", "user_name", "action", "method", "env", "query",
+		`"from"`, `"to"`, "target", "status", "created_at",
+	).
+		From("audit_log").
+		OrderBy("created_at DESC", "id DESC")
+
+	if req.UserName != nil {
+		qb = qb.Where(sq.Eq{"user_name": *req.UserName})
+	}
+	if len(req.Actions) > 0 {
+		qb = qb.Where(sq.Eq{"action": req.Actions})
+	}
+	if req.From != nil {
+		qb = qb.Where(sq.GtOrEq{"created_at": *req.From})
+	}
+	if req.To != nil {
+		qb = qb.Where(sq.Lt{"created_at": *req.To})
+	}
+	if req.Limit > 0 {
+		qb = qb.Limit(uint64(req.Limit))
+	}
+	if req.Offset > 0 {
+		qb = qb.Offset(uint64(req.Offset))
+	}
+
+	return qb.MustSql()
+}
+
+func (r *auditLogRepository) Add(ctx context.Context, records types.AuditRecords) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	qb := sqlb.Insert("audit_log").
+		Columns("user_name", "action", "method", "env", "query", `"from"`, `"to"`, "target", "status", "created_at")
+	for _, rec := range records {
+		qb = qb.Values(
+			rec.UserName, rec.Action, rec.Method, rec.Env, rec.Query,
+			rec.From, rec.To, rec.Target, rec.Status, rec.CreatedAt,
+		)
+	}
+
+	query, args := qb.MustSql()
+
+	metricLabels := []string{"audit_log", "INSERT"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to add audit log: %w", err)
+	}
+
+	return nil
+}
+
+func (r *auditLogRepository) DeleteExpired(ctx context.Context, before time.Time) error {
+	query, args := "DELETE FROM audit_log WHERE created_at < $1",
+		[]any{before}
+
+	metricLabels := []string{"audit_log", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to delete expired audit log: %w", err)
+	}
+
+	return nil
+}
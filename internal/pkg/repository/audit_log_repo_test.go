@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestAuditLogSelectQuery(t *testing.T) {
+	user := "alice"
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	query, args := auditLogSelectQuery(types.GetAuditLogRequest{
+		UserName: &user,
+		Actions:  []types.AuditAction{types.AuditActionSearch, types.AuditActionExport},
+		From:     &from,
+		To:       &to,
+		Limit:    10,
+		Offset:   20,
+	})
+
+	require.Equal(t,
+		`SELECT id, user_name, action, method, env, query, "from", "to", target, status, created_at`+
+			" FROM audit_log WHERE user_name = $1 AND action IN ($2,$3) AND created_at >= $4 AND created_at < $5"+
+			" ORDER BY created_at DESC, id DESC LIMIT 10 OFFSET 20",
+		query,
+	)
+	require.Equal(t, []any{"alice", types.AuditActionSearch, types.AuditActionExport, from, to}, args)
+
+	query, args = auditLogSelectQuery(types.GetAuditLogRequest{})
+	require.Equal(t,
+		`SELECT id, user_name, action, method, env, query, "from", "to", target, status, created_at`+
+			" FROM audit_log ORDER BY created_at DESC, id DESC",
+		query,
+	)
+	require.Empty(t, args)
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/gofrs/uuid"
@@ -23,15 +25,14 @@ func newDashboardsRepository(pool *pool) *dashboardsRepository {
 }
 
 func (r *dashboardsRepository) GetAll(ctx context.Context, req types.GetAllDashboardsRequest) (types.DashboardInfosWithOwner, error) {
-	query, args := `
-		SELECT d.uuid, d.name, p.user_name
-		FROM dashboards AS d
-		JOIN user_profiles AS p	ON p.id = d.owner_id
-		ORDER BY d.name ASC
-		LIMIT $1
-		OFFSET $2
-		`,
-		[]any{req.Limit, req.Offset}
+	query, args := sqlb.Select("d.uuid", "d.name", "p.user_name").
+		From("dashboards AS d").
+		Join("user_profiles AS p ON p.id = d.owner_id").
+		Where(dashboardVisibleCond(req.User)).
+		OrderBy("d.name ASC").
+		Limit(uint64(req.Limit)).
+		Offset(uint64(req.Offset)).
+		MustSql()
 
 	metricLabels := []string{"dashboards", "SELECT"}
 	rows, err := r.query(ctx, metricLabels, query, args...)
@@ -90,7 +91,7 @@ func (r *dashboardsRepository) GetByUUID(ctx context.Context, id string) (types.
 	dashboard := types.Dashboard{}
 
 	query, args := `
-		SELECT d.name, d.meta, p.user_name
+		SELECT d.name, d.meta, p.user_name, d.folder_id, d.tags, d.updated_at
 		FROM dashboards AS d
 		JOIN user_profiles AS p	ON p.id = d.owner_id
 		WHERE d.uuid = $1
@@ -103,6 +104,9 @@ func (r *dashboardsRepository) GetByUUID(ctx context.Context, id string) (types.
 		&dashboard.Name,
 		&dashboard.Meta,
 		&dashboard.OwnerName,
+		&dashboard.FolderID,
+		&dashboard.Tags,
+		&dashboard.UpdatedAt,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
 		err = types.NewErrNotFound("dashboard")
@@ -114,19 +118,30 @@ func (r *dashboardsRepository) GetByUUID(ctx context.Context, id string) (types.
 		incErrorMetric(err, metricLabels)
 		return dashboard, err
 	}
+	dashboard.Tags = nonNilTags(dashboard.Tags)
 
 	return dashboard, nil
 }
 
 func (r *dashboardsRepository) Create(ctx context.Context, req types.CreateDashboardRequest) (string, error) {
-	uuidv7, err := uuid.NewV7()
-	if err != nil {
-		return "", fmt.Errorf("failed to create uuid v7: %w", err)
+	uuidStr := req.UUID
+	if uuidStr == "" {
+		uuidv7, err := uuid.NewV7()
+		if err != nil {
+			return "", fmt.Errorf("failed to create uuid v7: %w", err)
+		}
+		uuidStr = uuidv7.String()
 	}
-	uuidStr := uuidv7.String()
 
-	query, args := "INSERT INTO dashboards (uuid,owner_id,name,meta) VALUES ($1,$2,$3,$4)",
-		[]any{uuidStr, req.ProfileID, req.Name, req.Meta}
+	query, args := `
+		WITH d AS (
+			INSERT INTO dashboards (uuid,owner_id,name,meta,folder_id,tags,queries) VALUES ($1,$2,$3,$4,$5,$6,$7)
+			RETURNING uuid, version, owner_id, name, meta
+		)
+		INSERT INTO dashboard_versions (dashboard_uuid,version,name,meta,author_id)
+		SELECT uuid, version, name, meta, owner_id FROM d
+		`,
+		[]any{uuidStr, req.ProfileID, req.Name, req.Meta, req.FolderID, nonNilTags(req.Tags), metaQueries(req.Meta)}
 
 	metricLabels := []string{"dashboards", "INSERT"}
 	tag, err := r.exec(ctx, metricLabels, query, args...)
@@ -139,29 +154,28 @@ func (r *dashboardsRepository) Create(ctx context.Context, req types.CreateDashb
 }
 
 func (r *dashboardsRepository) Update(ctx context.Context, req types.UpdateDashboardRequest) error {
-	query, args := "SELECT owner_id FROM dashboards WHERE uuid = $1 LIMIT 1 FOR UPDATE",
-		[]any{req.UUID}
-
-	metricLabelsSelect := []string{"dashboards", "SELECT"}
-	var ownerID int64
-	err := r.queryRow(ctx, metricLabelsSelect, query, args...).Scan(&ownerID)
-	switch {
-	case errors.Is(err, pgx.ErrNoRows):
-		err = types.NewErrNotFound("dashboard")
-	case err != nil:
-		err = fmt.Errorf("failed to get dashboard for update: %w", err)
-	case ownerID != req.ProfileID:
-		err = types.NewErrPermissionDenied("update dashboard")
-	default:
-		err = nil
-	}
+	query, args := dashboardsUpdateQuery(req)
 
+	metricLabels := []string{"dashboards", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
 	if err != nil {
-		incErrorMetric(err, metricLabelsSelect)
-		return err
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to update dashboard: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("dashboard")
 	}
 
+	return nil
+}
+
+// dashboardsUpdateQuery updates the dashboard and appends its new state to the versions.
+// The version number comes from the counter of the updated row, which is locked by the update,
+// so concurrent updates get the different versions.
+func dashboardsUpdateQuery(req types.UpdateDashboardRequest) (string, []any) {
 	qb := sqlb.Update("dashboards").
+		Set("updated_at", sq.Expr("now()")).
+		Set("version", sq.Expr("version + 1")).
 		Where(sq.Eq{
 			"uuid": req.UUID,
 		})
@@ -169,92 +183,524 @@ func (r *dashboardsRepository) Update(ctx context.Context, req types.UpdateDashb
 		qb = qb.Set("name", *req.Name)
 	}
 	if req.Meta != nil {
-		qb = qb.Set("meta", *req.Meta)
+		qb = qb.
+			Set("meta", *req.Meta).
+			Set("queries", metaQueries(*req.Meta))
+	}
+	if req.FolderID != nil {
+		var folderID *int64
+		if *req.FolderID != 0 {
+			folderID = req.FolderID
+		}
+		qb = qb.Set("folder_id", folderID)
+	}
+	if req.Tags != nil {
+		qb = qb.Set("tags", nonNilTags(*req.Tags))
 	}
 
-	query, args = qb.MustSql()
+	query, args := qb.Suffix("RETURNING uuid, version, name, meta").MustSql()
 
-	metricLabelsUpdate := []string{"dashboards", "UPDATE"}
-	if _, err = r.exec(ctx, metricLabelsUpdate, query, args...); err != nil {
-		incErrorMetric(err, metricLabelsUpdate)
-		return fmt.Errorf("failed to update dashboard: %w", err)
-	}
+	query = fmt.Sprintf(`
+		WITH d AS (%s)
+		INSERT INTO dashboard_versions (dashboard_uuid,version,name,meta,author_id)
+		SELECT d.uuid, d.version, d.name, d.meta, $%d
+		FROM d
+		`, query, len(args)+1)
+	args = append(args, req.ProfileID)
 
-	return nil
+	return query, args
 }
 
 func (r *dashboardsRepository) Delete(ctx context.Context, req types.DeleteDashboardRequest) error {
-	query, args := "SELECT owner_id FROM dashboards WHERE uuid = $1 LIMIT 1 FOR UPDATE",
+	query, args := "DELETE FROM dashboards WHERE uuid = $1",
 		[]any{req.UUID}
 
-	metricLabelsSelect := []string{"dashboards", "SELECT"}
-	var ownerID int64
-	err := r.queryRow(ctx, metricLabelsSelect, query, args...).Scan(&ownerID)
-	switch {
-	case errors.Is(err, pgx.ErrNoRows):
-		err = nil
-	case err != nil:
-		err = fmt.Errorf("failed to get dashboard for delete: %w", err)
-	case ownerID != req.ProfileID:
-		err = types.NewErrPermissionDenied("delete dashboard")
-	default:
-		err = nil
+	metricLabels := []string{"dashboards", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to delete dashboard: %w", err)
 	}
 
+	return nil
+}
+
+func (r *dashboardsRepository) Search(ctx context.Context, req types.SearchDashboardsRequest) (types.DashboardSearchResults, error) {
+	query, args := dashboardsSearchQuery(req)
+
+	metricLabels := []string{"dashboards", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
 	if err != nil {
-		incErrorMetric(err, metricLabelsSelect)
-		return err
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get dashboards: %w", err)
 	}
+	defer rows.Close()
 
-	query, args = "DELETE FROM dashboards WHERE uuid = $1",
-		[]any{req.UUID}
+	results := types.DashboardSearchResults{}
+	for rows.Next() {
+		var d types.DashboardSearchResult
+		if err = rows.Scan(
+			&d.UUID,
+			&d.Name,
+			&d.OwnerName,
+			&d.FolderID,
+			&d.Tags,
+			&d.UpdatedAt,
+			&d.Starred,
+			&d.ViewedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
 
-	metricLabelsDelete := []string{"dashboards", "DELETE"}
-	if _, err = r.exec(ctx, metricLabelsDelete, query, args...); err != nil {
-		incErrorMetric(err, metricLabelsDelete)
-		return fmt.Errorf("failed to delete dashboard: %w", err)
+		results = append(results, d)
 	}
 
-	return nil
+	return results, nil
 }
 
-func (r *dashboardsRepository) Search(ctx context.Context, req types.SearchDashboardsRequest) (types.DashboardInfosWithOwner, error) {
-	qb := sqlb.Select("d.uuid", "d.name", "p.user_name").
+func dashboardsSearchQuery(req types.SearchDashboardsRequest) (string, []any) {
+	qb := sqlb.Select(
+		"d.uuid", "d.name", "p.user_name", "d.folder_id", "d.tags", "d.updated_at",
+		"s.profile_id IS NOT NULL", "v.viewed_at",
+	).
 		From("dashboards AS d").
 		Join("user_profiles AS p ON p.id = d.owner_id").
-		Where(sq.Like{
-			"LOWER(d.name)": fmt.Sprint("%", strings.ToLower(req.Query), "%"),
-		}).
-		OrderBy("d.name ASC").
+		LeftJoin("dashboard_stars AS s ON s.dashboard_uuid = d.uuid AND s.profile_id = ?", req.User.ProfileID).
+		LeftJoin("dashboard_views AS v ON v.dashboard_uuid = d.uuid AND v.profile_id = ?", req.User.ProfileID).
+		Where(dashboardVisibleCond(req.User)).
 		Limit(uint64(req.Limit)).
 		Offset(uint64(req.Offset))
-	if req.Filter != nil {
-		if req.Filter.OwnerName != nil {
+
+	// each word must be found in the name, tags or queries of the dashboard
+	for _, word := range strings.Fields(strings.ToLower(req.Query)) {
+		pattern := fmt.Sprint("%", word, "%")
+		qb = qb.Where(sq.Or{
+			sq.Like{"LOWER(d.name)": pattern},
+			sq.Expr("EXISTS (SELECT 1 FROM unnest(d.tags) AS t WHERE LOWER(t) LIKE ?)", pattern),
+			sq.Like{"LOWER(d.queries)": pattern},
+		})
+	}
+
+	if f := req.Filter; f != nil {
+		if f.OwnerName != nil {
 			qb = qb.Where(sq.Eq{
-				"p.user_name": *req.Filter.OwnerName,
+				"p.user_name": *f.OwnerName,
 			})
 		}
+		if len(f.Tags) > 0 {
+			qb = qb.Where(sq.Expr("d.tags @> ?", f.Tags))
+		}
+		if f.FolderID != nil {
+			if *f.FolderID == 0 {
+				qb = qb.Where(sq.Eq{"d.folder_id": nil})
+			} else {
+				qb = qb.Where(sq.Eq{"d.folder_id": *f.FolderID})
+			}
+		}
+		if f.Starred {
+			qb = qb.Where("s.profile_id IS NOT NULL")
+		}
 	}
 
-	query, args := qb.MustSql()
+	switch req.Sort {
+	case types.DashboardsSortUpdated:
+		qb = qb.OrderBy("d.updated_at DESC", "d.name ASC")
+	case types.DashboardsSortViewed:
+		qb = qb.OrderBy("v.viewed_at DESC NULLS LAST", "d.name ASC")
+	default:
+		qb = qb.OrderBy("d.name ASC")
+	}
+
+	return qb.MustSql()
+}
+
+func (r *dashboardsRepository) ListVersions(ctx context.Context, req types.ListDashboardVersionsRequest) (types.DashboardVersionInfos, error) {
+	query, args := `
+		SELECT v.version, v.name, p.user_name, v.created_at
+		FROM dashboard_versions AS v
+		JOIN user_profiles AS p ON p.id = v.author_id
+		WHERE v.dashboard_uuid = $1
+		ORDER BY v.version DESC
+		LIMIT $2
+		OFFSET $3
+		`,
+		[]any{req.UUID, req.Limit, req.Offset}
+
+	metricLabels := []string{"dashboard_versions", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get dashboard versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := types.DashboardVersionInfos{}
+	for rows.Next() {
+		var v types.DashboardVersionInfo
+		if err = rows.Scan(&v.Version, &v.Name, &v.AuthorName, &v.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
+
+func (r *dashboardsRepository) GetVersion(ctx context.Context, req types.GetDashboardVersionRequest) (types.DashboardVersion, error) {
+	version := types.DashboardVersion{}
+
+	query, args := `
+		SELECT v.version, v.name, v.meta, p.user_name, v.created_at
+		FROM dashboard_versions AS v
+		JOIN user_profiles AS p ON p.id = v.author_id
+		WHERE v.dashboard_uuid = $1 AND v.version = $2
+		LIMIT 1
+		`,
+		[]any{req.UUID, req.Version}
+
+	metricLabels := []string{"dashboard_versions", "SELECT"}
+	err := r.queryRow(ctx, metricLabels, query, args...).Scan(
+		&version.Version,
+		&version.Name,
+		&version.Meta,
+		&version.AuthorName,
+		&version.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = types.NewErrNotFound("dashboard version")
+	} else if err != nil {
+		err = fmt.Errorf("failed to get dashboard version: %w", err)
+	}
+
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return version, err
+	}
+
+	return version, nil
+}
+
+func (r *dashboardsRepository) GetACL(ctx context.Context, id string) (types.DashboardACL, error) {
+	acl := types.DashboardACL{}
+
+	query, args := `
+		SELECT d.owner_id, p.user_name, d.visibility, d.team
+		FROM dashboards AS d
+		JOIN user_profiles AS p ON p.id = d.owner_id
+		WHERE d.uuid = $1
+		LIMIT 1
+		`,
+		[]any{id}
 
 	metricLabels := []string{"dashboards", "SELECT"}
+	err := r.queryRow(ctx, metricLabels, query, args...).Scan(
+		&acl.OwnerID,
+		&acl.OwnerName,
+		&acl.Visibility,
+		&acl.Team,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = types.NewErrNotFound("dashboard")
+	} else if err != nil {
+		err = fmt.Errorf("failed to get dashboard acl: %w", err)
+	}
+
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return acl, err
+	}
+
+	query, args = `
+		SELECT kind, name, role
+		FROM dashboard_collaborators
+		WHERE dashboard_uuid = $1
+		ORDER BY kind, name
+		`,
+		[]any{id}
+
+	metricLabels = []string{"dashboard_collaborators", "SELECT"}
 	rows, err := r.query(ctx, metricLabels, query, args...)
 	if err != nil {
 		incErrorMetric(err, metricLabels)
-		return nil, fmt.Errorf("failed to get dashboards: %w", err)
+		return acl, fmt.Errorf("failed to get dashboard collaborators: %w", err)
 	}
 	defer rows.Close()
 
-	dashboardInfos := types.DashboardInfosWithOwner{}
+	acl.Collaborators = types.DashboardCollaborators{}
 	for rows.Next() {
-		var dashboardInfo types.DashboardInfoWithOwner
-		if err = rows.Scan(&dashboardInfo.UUID, &dashboardInfo.Name, &dashboardInfo.OwnerName); err != nil {
+		var c types.DashboardCollaborator
+		if err = rows.Scan(&c.Kind, &c.Name, &c.Role); err != nil {
+			return acl, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		acl.Collaborators = append(acl.Collaborators, c)
+	}
+
+	return acl, nil
+}
+
+// UpdateSharing sets visibility of the dashboard and replaces its collaborators.
+func (r *dashboardsRepository) UpdateSharing(ctx context.Context, req types.UpdateDashboardSharingRequest) error {
+	var (
+		kinds = make([]string, 0, len(req.Collaborators))
+		names = make([]string, 0, len(req.Collaborators))
+		roles = make([]string, 0, len(req.Collaborators))
+	)
+	for _, c := range req.Collaborators {
+		kinds = append(kinds, string(c.Kind))
+		names = append(names, c.Name)
+		roles = append(roles, string(c.Role))
+	}
+
+	query, args := `
+		WITH d AS (
+			UPDATE dashboards SET visibility = $2, team = $3
+			WHERE uuid = $1
+			RETURNING uuid
+		), c AS (
+			SELECT * FROM unnest($4::text[], $5::text[], $6::text[]) AS c(kind, name, role)
+		), deleted AS (
+			DELETE FROM dashboard_collaborators
+			WHERE dashboard_uuid IN (SELECT uuid FROM d)
+			AND (kind, name) NOT IN (SELECT kind, name FROM c)
+		)
+		INSERT INTO dashboard_collaborators (dashboard_uuid,kind,name,role)
+		SELECT d.uuid, c.kind, c.name, c.role FROM d, c
+		ON CONFLICT (dashboard_uuid, kind, name) DO UPDATE SET
+			role = EXCLUDED.role
+		`,
+		[]any{req.UUID, req.Visibility, req.Team, kinds, names, roles}
+
+	metricLabels := []string{"dashboard_collaborators", "UPDATE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to update dashboard sharing: %w", err)
+	}
+
+	return nil
+}
+
+// TransferOwnership changes owner of the dashboard, previous owner becomes its editor.
+func (r *dashboardsRepository) TransferOwnership(ctx context.Context, req types.TransferDashboardOwnershipRequest) error {
+	query, args := `
+		WITH d AS (
+			UPDATE dashboards SET owner_id = p.id
+			FROM user_profiles AS p
+			WHERE dashboards.uuid = $1 AND p.user_name = $2
+			RETURNING dashboards.uuid
+		)
+		INSERT INTO dashboard_collaborators (dashboard_uuid,kind,name,role)
+		SELECT d.uuid, $3, $4, $5 FROM d
+		ON CONFLICT (dashboard_uuid, kind, name) DO UPDATE SET
+			role = EXCLUDED.role
+		`,
+		[]any{
+			req.UUID, req.NewOwnerName,
+			types.DashboardCollaboratorUser, req.PrevOwnerName, types.DashboardRoleEditor,
+		}
+
+	metricLabels := []string{"dashboards", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to transfer dashboard ownership: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("user profile")
+	}
+
+	return nil
+}
+
+// dashboardVisibleCond returns condition matching dashboards the user can view.
+func dashboardVisibleCond(u types.DashboardUser) sq.Sqlizer {
+	groups := u.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	return sq.Or{
+		sq.Eq{"d.visibility": types.DashboardVisibilityPublic},
+		sq.Eq{"d.owner_id": u.ProfileID},
+		sq.Expr("(d.visibility = ? AND d.team = ANY(?))", types.DashboardVisibilityTeam, groups),
+		sq.Expr(`EXISTS (
+			SELECT 1 FROM dashboard_collaborators AS c
+			WHERE c.dashboard_uuid = d.uuid
+			AND ((c.kind = ? AND c.name = ?) OR (c.kind = ? AND c.name = ANY(?)))
+		)`, types.DashboardCollaboratorUser, u.Name, types.DashboardCollaboratorGroup, groups),
+	}
+}
+
+func (r *dashboardsRepository) Star(ctx context.Context, req types.StarDashboardRequest) error {
+	query, args := `
+		INSERT INTO dashboard_stars (profile_id,dashboard_uuid) VALUES ($1,$2)
+		ON CONFLICT DO NOTHING
+		`,
+		[]any{req.ProfileID, req.UUID}
+
+	metricLabels := []string{"dashboard_stars", "INSERT"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to star dashboard: %w", err)
+	}
+
+	return nil
+}
+
+func (r *dashboardsRepository) Unstar(ctx context.Context, req types.StarDashboardRequest) error {
+	query, args := "DELETE FROM dashboard_stars WHERE profile_id = $1 AND dashboard_uuid = $2",
+		[]any{req.ProfileID, req.UUID}
+
+	metricLabels := []string{"dashboard_stars", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to unstar dashboard: %w", err)
+	}
+
+	return nil
+}
+
+func (r *dashboardsRepository) MarkViewed(ctx context.Context, req types.MarkDashboardViewedRequest) error {
+	query, args := `
+		INSERT INTO dashboard_views (profile_id,dashboard_uuid) VALUES ($1,$2)
+		ON CONFLICT (profile_id, dashboard_uuid) DO UPDATE SET
+			viewed_at = now()
+		`,
+		[]any{req.ProfileID, req.UUID}
+
+	metricLabels := []string{"dashboard_views", "INSERT"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to mark dashboard viewed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *dashboardsRepository) GetFolders(ctx context.Context) (types.DashboardFolders, error) {
+	query := `
+		SELECT f.id, f.parent_id, f.name, f.owner_id, p.user_name
+		FROM dashboard_folders AS f
+		JOIN user_profiles AS p ON p.id = f.owner_id
+		ORDER BY f.name ASC, f.id ASC
+		`
+
+	metricLabels := []string{"dashboard_folders", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get dashboard folders: %w", err)
+	}
+	defer rows.Close()
+
+	folders := types.DashboardFolders{}
+	for rows.Next() {
+		var f types.DashboardFolder
+		if err = rows.Scan(&f.ID, &f.ParentID, &f.Name, &f.OwnerID, &f.OwnerName); err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
-		dashboardInfos = append(dashboardInfos, dashboardInfo)
+		folders = append(folders, f)
 	}
 
-	return dashboardInfos, nil
+	return folders, nil
+}
+
+func (r *dashboardsRepository) CreateFolder(ctx context.Context, req types.CreateDashboardFolderRequest) (int64, error) {
+	query, args := "INSERT INTO dashboard_folders (parent_id,owner_id,name) VALUES ($1,$2,$3) RETURNING id",
+		[]any{req.ParentID, req.ProfileID, req.Name}
+
+	metricLabels := []string{"dashboard_folders", "INSERT"}
+	var id int64
+	if err := r.queryRow(ctx, metricLabels, query, args...).Scan(&id); err != nil {
+		incErrorMetric(err, metricLabels)
+		return 0, fmt.Errorf("failed to create dashboard folder: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *dashboardsRepository) UpdateFolder(ctx context.Context, req types.UpdateDashboardFolderRequest) error {
+	qb := sqlb.Update("dashboard_folders").
+		Where(sq.Eq{
+			"id": req.ID,
+		})
+	if req.Name != nil {
+		qb = qb.Set("name", *req.Name)
+	}
+	if req.ParentID != nil {
+		var parentID *int64
+		if *req.ParentID != 0 {
+			parentID = req.ParentID
+		}
+		qb = qb.Set("parent_id", parentID)
+	}
+
+	query, args := qb.MustSql()
+
+	metricLabels := []string{"dashboard_folders", "UPDATE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to update dashboard folder: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteFolder deletes folder with all nested folders, their dashboards are moved to the root.
+func (r *dashboardsRepository) DeleteFolder(ctx context.Context, req types.DeleteDashboardFolderRequest) error {
+	query, args := "DELETE FROM dashboard_folders WHERE id = $1",
+		[]any{req.ID}
+
+	metricLabels := []string{"dashboard_folders", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to delete dashboard folder: %w", err)
+	}
+
+	return nil
+}
+
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// metaQueries extracts search queries from the dashboard meta.
+// Query is any string value with the 'query' key on any level of meta.
+func metaQueries(meta string) string {
+	var v any
+	if err := json.Unmarshal([]byte(meta), &v); err != nil {
+		return ""
+	}
+
+	var queries []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch val := v.(type) {
+		case map[string]any:
+			keys := make([]string, 0, len(val))
+			for k := range val {
+				keys = append(keys, k)
+			}
+			slices.Sort(keys)
+
+			for _, k := range keys {
+				if q, ok := val[k].(string); ok && k == "query" {
+					queries = append(queries, q)
+					continue
+				}
+				walk(val[k])
+			}
+		case []any:
+			for _, item := range val {
+				walk(item)
+			}
+		}
+	}
+	walk(v)
+
+	return strings.Join(queries, "\n")
 }
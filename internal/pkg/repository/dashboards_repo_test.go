@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	sqlb "github.com/ozontech/seq-ui/internal/pkg/repository/sql_builder"
+)
+
+func TestDashboardVisibleCond(t *testing.T) {
+	query, args := sqlb.Select("d.uuid").
+		From("dashboards AS d").
+		Where(dashboardVisibleCond(types.DashboardUser{
+			ProfileID: 1,
+			Name:      "user1",
+		})).
+		MustSql()
+
+	require.Equal(t,
+		"SELECT d.uuid FROM dashboards AS d WHERE (d.visibility = $1 OR d.owner_id = $2"+
+			" OR (d.visibility = $3 AND d.team = ANY($4)) OR EXISTS (\n"+
+			"\t\t\tSELECT 1 FROM dashboard_collaborators AS c\n"+
+			"\t\t\tWHERE c.dashboard_uuid = d.uuid\n"+
+			"\t\t\tAND ((c.kind = $5 AND c.name = $6) OR (c.kind = $7 AND c.name = ANY($8)))\n"+
+			"\t\t))",
+		query,
+	)
+	require.Equal(t, []any{
+		types.DashboardVisibilityPublic,
+		int64(1),
+		types.DashboardVisibilityTeam,
+		[]string{},
+		types.DashboardCollaboratorUser,
+		"user1",
+		types.DashboardCollaboratorGroup,
+		[]string{},
+	}, args)
+}
+
+func TestDashboardsSearchQuery(t *testing.T) {
+	folderID := int64(0)
+	query, args := dashboardsSearchQuery(types.SearchDashboardsRequest{
+		Query:  "Errors API",
+		Limit:  10,
+		Offset: 20,
+		Filter: &types.SearchDashboardsFilter{
+			Tags:     []string{"prod"},
+			FolderID: &folderID,
+			Starred:  true,
+		},
+		Sort: types.DashboardsSortViewed,
+		User: types.DashboardUser{ProfileID: 1},
+	})
+
+	require.Contains(t, query, "LEFT JOIN dashboard_stars AS s ON s.dashboard_uuid = d.uuid AND s.profile_id = $1")
+	require.Contains(t, query, "(LOWER(d.name) LIKE $11 OR EXISTS (SELECT 1 FROM unnest(d.tags) AS t WHERE LOWER(t) LIKE $12) OR LOWER(d.queries) LIKE $13)")
+	require.Contains(t, query, "AND d.tags @> $17 AND d.folder_id IS NULL AND s.profile_id IS NOT NULL")
+	require.Contains(t, query, "ORDER BY v.viewed_at DESC NULLS LAST, d.name ASC LIMIT 10 OFFSET 20")
+	require.Equal(t, []any{"%errors%", "%errors%", "%errors%", "%api%", "%api%", "%api%", []string{"prod"}}, args[10:])
+}
+
+func TestDashboardsUpdateQuery(t *testing.T) {
+	name := "new name"
+	query, args := dashboardsUpdateQuery(types.UpdateDashboardRequest{
+		UUID:      "064dc707-02b8-7000-8201-02a7f396738a",
+		ProfileID: 1,
+		Name:      &name,
+	})
+
+	require.Contains(t, query, "UPDATE dashboards SET updated_at = now(), version = version + 1, name = $1 WHERE uuid = $2 RETURNING uuid, version, name, meta")
+	require.Contains(t, query, "SELECT d.uuid, d.version, d.name, d.meta, $3")
+	require.NotContains(t, query, "MAX(")
+	require.Equal(t, []any{name, "064dc707-02b8-7000-8201-02a7f396738a", int64(1)}, args)
+}
+
+func TestMetaQueries(t *testing.T) {
+	tests := []struct {
+		name string
+		meta string
+		want string
+	}{
+		{
+			name: "nested",
+			meta: `{"query":"service:api","panels":[{"title":"errors","query":"level:error"},{"query":"level:warn","b":{"query":"x"}}]}`,
+			want: "level:error\nx\nlevel:warn\nservice:api",
+		},
+		{
+			name: "non_string_query",
+			meta: `{"query":{"text":"ignored"},"panels":[]}`,
+			want: "",
+		},
+		{
+			name: "invalid_json",
+			meta: "my_meta",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, metaQueries(tt.meta))
+		})
+	}
+}
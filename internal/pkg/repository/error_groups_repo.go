@@ -0,0 +1,1383 @@
+// nolint:goconst
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sq "github.com/n-r-w/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/errorgroups"
+	"github.com/ozontech/seq-ui/logger"
+)
+
+const (
+	errorGroupsTable       = "error_groups"
+	errorGroupsMergesTable = "error_groups_merges"
+	aggEvents1minTable     = "agg_events_1min"
+	aggEvents10minTable    = "agg_events_10min"
+	aggEvents1hTable       = "agg_events_1h"
+	aggEvents1dTable       = "agg_events_1d"
+	servicesTable          = "services"
+
+	day = 24 * time.Hour
+
+	// TTLs are the same as in clickhouse tables.
+	errorGroupsTTL    = 90 * day
+	aggEvents1minTTL  = 7 * day
+	aggEvents10minTTL = 90 * day
+	aggEvents1hTTL    = 365 * day
+	aggEvents1dTTL    = 2 * 365 * day
+	servicesTTL       = 90 * day
+
+	deleteExpiredErrorGroupsInterval = time.Hour
+)
+
+// errorGroupsTablesColumns contains columns of tables with group hash except the hash itself.
+var errorGroupsTablesColumns = map[string][]string{
+	errorGroupsTable: {
+		"service", "env", "source", "cluster", "release",
+		"message", "seen_total", "first_seen_at", "last_seen_at", "log_tags",
+	},
+	aggEvents1minTable:  {"start_date", "service", "env", "source", "cluster", "release", "counts"},
+	aggEvents10minTable: {"start_date", "service", "env", "source", "cluster", "release", "counts"},
+	aggEvents1hTable:    {"start_date", "service", "env", "source", "cluster", "release", "counts"},
+	aggEvents1dTable:    {"start_date", "service", "env", "source", "cluster", "release", "counts"},
+}
+
+// errorGroupsRepository is the postgres implementation of error groups storage
+// for installations without clickhouse. Events are rolled up into
+// aggregated tables by trigger, see migration/13_create_tables_error_groups.sql.
+//
+// Group hashes are UInt64 in clickhouse, so they are stored as BIGINT with the same bits.
+type errorGroupsRepository struct {
+	*pool
+
+	queryFilter map[string]string
+
+	nowFn func() time.Time // for testing
+}
+
+// NewErrorGroups creates postgres error groups repository and
+// starts periodic deletion of the expired data.
+func NewErrorGroups(
+	ctx context.Context,
+	p *pgxpool.Pool,
+	requestTimeout time.Duration,
+	queryFilter map[string]string,
+) errorgroups.Repository {
+	r := newErrorGroupsRepository(newPool(p, requestTimeout), queryFilter, time.Now)
+	go r.deleteExpiredLoop(ctx)
+	return r
+}
+
+func newErrorGroupsRepository(
+	pool *pool,
+	queryFilter map[string]string,
+	nowFn func() time.Time,
+) *errorGroupsRepository {
+	return &errorGroupsRepository{
+		pool:        pool,
+		queryFilter: queryFilter,
+		nowFn:       nowFn,
+	}
+}
+
+func (r *errorGroupsRepository) GetErrorGroups(
+	ctx context.Context,
+	req types.GetErrorGroupsRequest,
+) ([]types.ErrorGroup, error) {
+	where := r.where(req.Env, req.Source, req.Release)
+	where["service"] = req.Service
+
+	if !req.TimeRange.IsEmpty() {
+		var (
+			hashes []int64 // ordered
+			infos  errorGroupInfos
+			counts errorGroupCounts
+
+			err error
+		)
+
+		if req.Order == types.OrderFrequent {
+			counts, err = r.getErrorCounts(ctx, getErrorGroupCountsParams{
+				tr:      req.TimeRange,
+				where:   where,
+				orderBy: "count DESC",
+				limit:   uint64(req.Limit),
+				offset:  uint64(req.Offset),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if len(counts) == 0 {
+				return nil, nil
+			}
+
+			hashes = counts.hashes()
+
+			where["_group_hash"] = hashes
+			infos, err = r.getErrorInfos(ctx, getErrorGroupInfosParams{
+				columns: []string{
+					"_group_hash",
+					"source",
+					"min(message) AS message",
+					"min(first_seen_at) AS first_seen_at",
+					"max(last_seen_at) AS last_seen_at",
+				},
+				where: where,
+			})
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			subQuery := r.getHashSubQuery(getErrorGroupsHashSubQueryParams{
+				where:   where,
+				tr:      req.TimeRange,
+				orderBy: errorGroupsOrderBy(req.Order, true),
+				limit:   uint64(req.Limit),
+				offset:  uint64(req.Offset),
+			})
+
+			infos, err = r.getErrorInfos(ctx, getErrorGroupInfosParams{
+				columns: []string{
+					"_group_hash",
+					"source",
+					"min(message) AS message",
+					"min(first_seen_at) AS first_seen_at",
+					"max(last_seen_at) AS last_seen_at",
+				},
+				where:    where,
+				subQuery: &subQuery,
+				orderBy:  errorGroupsOrderBy(req.Order, false),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if len(infos) == 0 {
+				return nil, nil
+			}
+
+			hashes = infos.hashes()
+
+			where["_group_hash"] = hashes
+			counts, err = r.getErrorCounts(ctx, getErrorGroupCountsParams{
+				tr:    req.TimeRange,
+				where: where,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		infoByHash := infos.mapByHash()
+		countByHash := counts.mapByHash()
+
+		var groups []types.ErrorGroup
+		for _, hash := range hashes {
+			info := infoByHash[hash]
+			groups = append(groups, types.ErrorGroup{
+				Hash:        uint64(hash),
+				Source:      info.Source,
+				Message:     info.Message,
+				Count:       countByHash[hash],
+				FirstSeenAt: info.FirstSeenAt,
+				LastSeenAt:  info.LastSeenAt,
+			})
+		}
+
+		return groups, nil
+	}
+
+	subQ := r.getHashSubQuery(getErrorGroupsHashSubQueryParams{
+		where:   where,
+		orderBy: errorGroupsOrderBy(req.Order, true),
+		limit:   uint64(req.Limit),
+		offset:  uint64(req.Offset),
+	})
+
+	infos, err := r.getErrorInfos(ctx, getErrorGroupInfosParams{
+		columns: []string{
+			"_group_hash",
+			"source",
+			"min(message) AS message",
+			"sum(seen_total)::bigint AS seen_total",
+			"min(first_seen_at) AS first_seen_at",
+			"max(last_seen_at) AS last_seen_at",
+		},
+		where:    where,
+		subQuery: &subQ,
+		orderBy:  errorGroupsOrderBy(req.Order, false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return infos.toErrorGroups(), nil
+}
+
+func (r *errorGroupsRepository) GetErrorGroupsTotal(
+	ctx context.Context,
+	req types.GetErrorGroupsRequest,
+) (uint64, error) {
+	where := r.where(req.Env, req.Source, req.Release)
+	where["service"] = req.Service
+
+	return r.getTotal(ctx, where, req.TimeRange)
+}
+
+func (r *errorGroupsRepository) GetNewErrorGroups(
+	ctx context.Context,
+	req types.GetErrorGroupsRequest,
+) ([]types.ErrorGroup, error) {
+	where := r.where(req.Env, req.Source, nil)
+	where["service"] = req.Service
+
+	subQ := r.getHashSubQuery(getErrorGroupsHashSubQueryParams{
+		where:   where,
+		orderBy: errorGroupsOrderBy(req.Order, true),
+		limit:   uint64(req.Limit),
+		offset:  uint64(req.Offset),
+	})
+	subQ = r.newGroupsCond(subQ, req)
+
+	infos, err := r.getErrorInfos(ctx, getErrorGroupInfosParams{
+		columns: []string{
+			"_group_hash",
+			"source",
+			"min(message) AS message",
+			"sum(seen_total)::bigint AS seen_total",
+			"min(first_seen_at) AS first_seen_at",
+			"max(last_seen_at) AS last_seen_at",
+		},
+		where:    where,
+		subQuery: &subQ,
+		orderBy:  errorGroupsOrderBy(req.Order, false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return infos.toErrorGroups(), nil
+}
+
+func (r *errorGroupsRepository) GetNewErrorGroupsTotal(
+	ctx context.Context,
+	req types.GetErrorGroupsRequest,
+) (uint64, error) {
+	where := r.where(req.Env, req.Source, nil)
+	where["service"] = req.Service
+
+	subQ := sq.
+		Select("_group_hash").
+		From(r.table(errorGroupsTable)).
+		Where(where).
+		GroupBy("_group_hash")
+	subQ = r.newGroupsCond(subQ, req)
+
+	query, args := sq.
+		Select("count(*)").
+		FromSelect(subQ, "subQ").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	metricLabels := []string{errorGroupsTable, "SELECT"}
+	var total uint64
+	if err := r.queryRow(ctx, metricLabels, query, args...).Scan(&total); err != nil {
+		incErrorMetric(err, metricLabels)
+		return 0, fmt.Errorf("failed to get error groups count: %w", err)
+	}
+
+	return total, nil
+}
+
+// newGroupsCond adds condition of new groups to the hash subquery:
+// groups seen only in the requested release or first seen in the time range.
+func (r *errorGroupsRepository) newGroupsCond(
+	subQ sq.SelectBuilder,
+	req types.GetErrorGroupsRequest,
+) sq.SelectBuilder {
+	if req.Release != nil && *req.Release != "" { // new by releases, ignore time range
+		return subQ.Having(sq.Eq{
+			"min(release)":            *req.Release,
+			"count(DISTINCT release)": 1,
+		})
+	}
+	if !req.TimeRange.IsEmpty() { // new by time range
+		return subQ.Having(r.timeRangeCond("min(first_seen_at)", req.TimeRange))
+	}
+	return subQ
+}
+
+func (r *errorGroupsRepository) GetTopErrorGroups(
+	ctx context.Context,
+	req types.GetTopErrorGroupsRequest,
+) ([]types.TopErrorGroup, error) {
+	where := r.where(req.Env, req.Source, nil)
+	if req.Service != nil && *req.Service != "" {
+		where["service"] = *req.Service
+	}
+
+	if !req.TimeRange.IsEmpty() {
+		counts, err := r.getErrorCounts(ctx, getErrorGroupCountsParams{
+			tr:      req.TimeRange,
+			where:   where,
+			orderBy: "count DESC",
+			limit:   uint64(req.Limit),
+			offset:  uint64(req.Offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(counts) == 0 {
+			return nil, nil
+		}
+
+		where["_group_hash"] = counts.hashes()
+		infos, err := r.getErrorInfos(ctx, getErrorGroupInfosParams{
+			columns: []string{
+				"_group_hash",
+				"source",
+				"min(message) AS message",
+			},
+			where: where,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		infoByHash := infos.mapByHash()
+
+		var groups []types.TopErrorGroup
+		for _, count := range counts {
+			info := infoByHash[count.Hash]
+			groups = append(groups, types.TopErrorGroup{
+				Hash:    uint64(count.Hash),
+				Source:  info.Source,
+				Message: info.Message,
+				Count:   count.Count,
+			})
+		}
+
+		return groups, nil
+	}
+
+	subQ := r.getHashSubQuery(getErrorGroupsHashSubQueryParams{
+		where:   where,
+		orderBy: errorGroupsOrderBy(types.OrderFrequent, true),
+		limit:   uint64(req.Limit),
+		offset:  uint64(req.Offset),
+	})
+
+	infos, err := r.getErrorInfos(ctx, getErrorGroupInfosParams{
+		columns: []string{
+			"_group_hash",
+			"source",
+			"min(message) AS message",
+			"sum(seen_total)::bigint AS seen_total",
+		},
+		where:    where,
+		subQuery: &subQ,
+		orderBy:  errorGroupsOrderBy(types.OrderFrequent, false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []types.TopErrorGroup
+	for _, info := range infos {
+		groups = append(groups, types.TopErrorGroup{
+			Hash:    uint64(info.Hash),
+			Source:  info.Source,
+			Message: info.Message,
+			Count:   info.SeenTotal,
+		})
+	}
+
+	return groups, nil
+}
+
+func (r *errorGroupsRepository) GetTopErrorGroupsTotal(
+	ctx context.Context,
+	req types.GetTopErrorGroupsRequest,
+) (uint64, error) {
+	where := r.where(req.Env, req.Source, nil)
+	if req.Service != nil && *req.Service != "" {
+		where["service"] = *req.Service
+	}
+	return r.getTotal(ctx, where, req.TimeRange)
+}
+
+func (r *errorGroupsRepository) GetErrorHist(
+	ctx context.Context,
+	req types.GetErrorHistRequest,
+) (types.ErrorHist, error) {
+	histData := r.getHistData(req.TimeRange)
+	if req.Interval != 0 {
+		if data, ok := getErrorGroupsHistDataByInterval(req.Interval); ok {
+			histData = data
+		}
+	}
+
+	where := r.where(req.Env, req.Source, req.Release)
+	if req.Service != nil && *req.Service != "" {
+		where["service"] = *req.Service
+	}
+
+	q := sq.
+		Select(
+			histData.column+" AS bucket",
+			"sum(counts)::bigint AS counts",
+		).
+		From(r.table(histData.table)).
+		Where(where).
+		GroupBy("bucket").
+		OrderBy("bucket")
+
+	groupColumn := errorGroupsHistGroupColumn(req.GroupBy)
+	if groupColumn != "" {
+		q = q.Column(groupColumn).GroupBy(groupColumn)
+	}
+
+	if req.GroupHash != nil && *req.GroupHash != 0 {
+		q = q.Where(groupHashCond(*req.GroupHash))
+	}
+	if !req.TimeRange.IsEmpty() {
+		q = q.Where(r.timeRangeCond(histData.column, req.TimeRange))
+	}
+
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	metricLabels := []string{histData.table, "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return types.ErrorHist{}, fmt.Errorf("failed to get error hist: %w", err)
+	}
+	defer rows.Close()
+
+	hist := types.ErrorHist{
+		Interval: histData.interval,
+	}
+	for rows.Next() {
+		var (
+			bucket types.ErrorHistBucket
+			key    string
+		)
+		dest := []any{&bucket.Time, &bucket.Count}
+		if groupColumn != "" {
+			dest = append(dest, &key)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return types.ErrorHist{}, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if groupColumn != "" {
+			hist.AddSeriesBucket(key, bucket)
+		} else {
+			hist.Buckets = append(hist.Buckets, bucket)
+		}
+	}
+
+	return hist, nil
+}
+
+func (r *errorGroupsRepository) GetErrorDetails(
+	ctx context.Context,
+	req types.GetErrorGroupDetailsRequest,
+) (types.ErrorGroupDetails, error) {
+	where := r.where(req.Env, req.Source, req.Release)
+	if req.Service != nil && *req.Service != "" {
+		where["service"] = *req.Service
+	}
+
+	query, args := sq.
+		Select(
+			"_group_hash",
+			"source",
+			"min(message) AS message",
+			"sum(seen_total)::bigint AS seen_total",
+			"min(first_seen_at) AS first_seen_at",
+			"max(last_seen_at) AS last_seen_at",
+			"(array_agg(log_tags ORDER BY last_seen_at DESC))[1] AS log_tags",
+		).
+		From(r.table(errorGroupsTable)).
+		Where(groupHashCond(req.GroupHash)).
+		Where(where).
+		GroupBy("_group_hash", "source").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	metricLabels := []string{errorGroupsTable, "SELECT"}
+	row := r.queryRow(ctx, metricLabels, query, args...)
+
+	var (
+		details types.ErrorGroupDetails
+		hash    int64
+	)
+	if err := row.Scan(
+		&hash,
+		&details.Source,
+		&details.Message,
+		&details.SeenTotal,
+		&details.FirstSeenAt,
+		&details.LastSeenAt,
+		&details.LogTags,
+	); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		incErrorMetric(err, metricLabels)
+		return details, fmt.Errorf("failed to get error details: %w", err)
+	}
+	details.Hash = uint64(hash)
+
+	return details, nil
+}
+
+func (r *errorGroupsRepository) GetErrorCounts(
+	ctx context.Context,
+	req types.GetErrorGroupDetailsRequest,
+) (types.ErrorGroupCounts, error) {
+	counts := types.ErrorGroupCounts{
+		ByEnv:     types.ErrorGroupCount{},
+		BySource:  types.ErrorGroupCount{},
+		ByService: types.ErrorGroupCount{},
+		ByRelease: types.ErrorGroupCount{},
+	}
+
+	// releases only with service
+	withRelease := req.Service != nil && *req.Service != ""
+
+	release := req.Release
+	if !withRelease {
+		release = nil
+	}
+	where := r.where(req.Env, req.Source, release)
+	if withRelease {
+		where["service"] = *req.Service
+	}
+
+	columns := []string{"sum(seen_total)::bigint AS count", "env", "source", "service"}
+	groupBy := []string{"env", "source", "service"}
+	if withRelease {
+		columns = append(columns, "release")
+		groupBy = append(groupBy, "release")
+	}
+
+	query, args := sq.
+		Select(columns...).
+		From(r.table(errorGroupsTable)).
+		Where(groupHashCond(req.GroupHash)).
+		Where(where).
+		GroupBy(groupBy...).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	metricLabels := []string{errorGroupsTable, "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return counts, fmt.Errorf("failed to get error counts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			count                         uint64
+			env, source, service, release string
+		)
+		dest := []any{&count, &env, &source, &service}
+		if withRelease {
+			dest = append(dest, &release)
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return counts, fmt.Errorf("failed to scan row: %w", err)
+		}
+		counts.ByEnv[env] += count
+		counts.BySource[source] += count
+		counts.ByService[service] += count
+		if withRelease {
+			counts.ByRelease[release] += count
+		}
+	}
+
+	return counts, nil
+}
+
+func (r *errorGroupsRepository) GetServices(
+	ctx context.Context,
+	req types.GetServicesRequest,
+) ([]string, error) {
+	q := sq.
+		Select("service").Distinct().
+		From(servicesTable).
+		Where(r.where(req.Env, nil, nil)).
+		Where(sq.NotEq{"service": ""}).
+		OrderBy("service")
+
+	if req.Query != "" {
+		q = q.Where("starts_with(service, ?)", req.Query)
+	}
+	if req.Limit > 0 {
+		q = q.Limit(uint64(req.Limit))
+	}
+	if req.Offset > 0 {
+		q = q.Offset(uint64(req.Offset))
+	}
+
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	metricLabels := []string{servicesTable, "SELECT"}
+	services, err := r.getStrings(ctx, metricLabels, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get services: %w", err)
+	}
+
+	return services, nil
+}
+
+func (r *errorGroupsRepository) GetReleases(
+	ctx context.Context,
+	req types.GetReleasesRequest,
+) ([]string, error) {
+	query, args := sq.
+		Select("release").
+		From(servicesTable).
+		Where(r.where(req.Env, nil, nil)).
+		Where(sq.And{
+			sq.Eq{"service": req.Service},
+			sq.NotEq{"release": ""},
+		}).
+		GroupBy("release").
+		OrderBy("max(ttl) DESC").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	metricLabels := []string{servicesTable, "SELECT"}
+	releases, err := r.getStrings(ctx, metricLabels, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+
+	return releases, nil
+}
+
+func (r *errorGroupsRepository) getStrings(
+	ctx context.Context,
+	metricLabels []string,
+	query string,
+	args ...any,
+) ([]string, error) {
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+func (r *errorGroupsRepository) DiffByReleases(
+	ctx context.Context,
+	req types.DiffByReleasesRequest,
+) ([]types.DiffGroup, error) {
+	where := r.where(req.Env, req.Source, nil)
+	where["service"] = req.Service
+	where["release"] = req.Releases
+
+	groupsQ := sq.
+		Select(
+			"_group_hash",
+			"source",
+			"min(message) AS message",
+			"min(first_seen_at) AS first_seen_at",
+			"max(last_seen_at) AS last_seen_at",
+		).
+		From(r.table(errorGroupsTable)).
+		Where(where).
+		GroupBy("_group_hash", "source").
+		Limit(uint64(req.Limit)).
+		Offset(uint64(req.Offset))
+
+	switch req.Order {
+	case types.OrderFrequent:
+		groupsQ = groupsQ.OrderBy("sum(seen_total) DESC")
+	case types.OrderLatest:
+		groupsQ = groupsQ.OrderBy("last_seen_at DESC")
+	case types.OrderOldest:
+		groupsQ = groupsQ.OrderBy("first_seen_at")
+	}
+
+	groupsQuery, args := groupsQ.PlaceholderFormat(sq.Dollar).MustSql()
+	metricLabels := []string{errorGroupsTable, "SELECT"}
+	rows, err := r.query(ctx, metricLabels, groupsQuery, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups: %w", err)
+	}
+
+	var (
+		groups    []types.DiffGroup
+		idxByHash = map[int64]int{}
+		hashes    []int64
+	)
+	for rows.Next() {
+		group := types.DiffGroup{
+			ReleaseInfos: make(map[string]types.DiffReleaseInfo),
+		}
+		for _, r := range req.Releases {
+			group.ReleaseInfos[r] = types.DiffReleaseInfo{}
+		}
+
+		var hash int64
+		if err = rows.Scan(
+			&hash,
+			&group.Source,
+			&group.Message,
+			&group.FirstSeenAt,
+			&group.LastSeenAt,
+		); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		group.Hash = uint64(hash)
+
+		groups = append(groups, group)
+		hashes = append(hashes, hash)
+		idxByHash[hash] = len(groups) - 1
+	}
+	rows.Close()
+
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	where["_group_hash"] = hashes
+	query, args := sq.
+		Select(
+			"_group_hash",
+			"release",
+			"sum(seen_total)::bigint AS seen_total",
+		).
+		From(r.table(errorGroupsTable)).
+		Where(where).
+		GroupBy("_group_hash", "release").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	rows, err = r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups by release: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			hash      int64
+			seenTotal uint64
+			release   string
+		)
+		if err := rows.Scan(
+			&hash,
+			&release,
+			&seenTotal,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		groups[idxByHash[hash]].ReleaseInfos[release] = types.DiffReleaseInfo{
+			SeenTotal: seenTotal,
+		}
+	}
+
+	return groups, nil
+}
+
+func (r *errorGroupsRepository) DiffByReleasesTotal(
+	ctx context.Context,
+	req types.DiffByReleasesRequest,
+) (uint64, error) {
+	where := r.where(req.Env, req.Source, nil)
+	where["service"] = req.Service
+	where["release"] = req.Releases
+
+	return r.getTotal(ctx, where, nil)
+}
+
+func (r *errorGroupsRepository) GetReleasesGroupCounts(
+	ctx context.Context,
+	req types.CompareReleasesRequest,
+) ([]types.ReleaseGroupCount, error) {
+	where := r.where(req.Env, req.Source, nil)
+	where["service"] = req.Service
+	where["release"] = []string{req.BaselineRelease, req.CandidateRelease}
+
+	query, args := sq.
+		Select(
+			"_group_hash",
+			"source",
+			"release",
+			"min(message) AS message",
+			"sum(seen_total)::bigint AS seen_total",
+			"min(first_seen_at) AS first_seen_at",
+			"max(last_seen_at) AS last_seen_at",
+		).
+		From(r.table(errorGroupsTable)).
+		Where(where).
+		GroupBy("_group_hash", "source", "release").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	metricLabels := []string{errorGroupsTable, "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups by releases: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []types.ReleaseGroupCount
+	for rows.Next() {
+		var (
+			c    types.ReleaseGroupCount
+			hash int64
+		)
+		if err := rows.Scan(
+			&hash,
+			&c.Source,
+			&c.Release,
+			&c.Message,
+			&c.SeenTotal,
+			&c.FirstSeenAt,
+			&c.LastSeenAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		c.Hash = uint64(hash)
+		counts = append(counts, c)
+	}
+
+	return counts, nil
+}
+
+func (r *errorGroupsRepository) GetErrorGroupMerges(ctx context.Context) ([]types.ErrorGroupMerge, error) {
+	query := "SELECT _group_hash, target_hash, created_by, created_at FROM error_groups_merges ORDER BY _group_hash"
+
+	metricLabels := []string{errorGroupsMergesTable, "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups merges: %w", err)
+	}
+	defer rows.Close()
+
+	var merges []types.ErrorGroupMerge
+	for rows.Next() {
+		var (
+			m            types.ErrorGroupMerge
+			hash, target int64
+		)
+		if err := rows.Scan(
+			&hash,
+			&target,
+			&m.CreatedBy,
+			&m.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		m.Hash, m.TargetHash = uint64(hash), uint64(target)
+		merges = append(merges, m)
+	}
+
+	return merges, nil
+}
+
+// SaveErrorGroupMerges stores merges. Merge with zero target hash cancels previous merge of the group.
+func (r *errorGroupsRepository) SaveErrorGroupMerges(ctx context.Context, merges []types.ErrorGroupMerge) error {
+	var (
+		unmerged []int64
+		now      = r.nowFn()
+	)
+	q := sq.
+		Insert(errorGroupsMergesTable).
+		Columns("_group_hash", "target_hash", "created_by", "created_at").
+		Suffix("ON CONFLICT (_group_hash) DO UPDATE SET " +
+			"target_hash = EXCLUDED.target_hash, created_by = EXCLUDED.created_by, created_at = EXCLUDED.created_at")
+
+	mergedCount := 0
+	for _, m := range merges {
+		if m.TargetHash == 0 {
+			unmerged = append(unmerged, int64(m.Hash))
+			continue
+		}
+		q = q.Values(int64(m.Hash), int64(m.TargetHash), m.CreatedBy, now)
+		mergedCount++
+	}
+
+	if len(unmerged) > 0 {
+		query := "DELETE FROM error_groups_merges WHERE _group_hash = ANY($1)"
+
+		metricLabels := []string{errorGroupsMergesTable, "DELETE"}
+		if _, err := r.exec(ctx, metricLabels, query, unmerged); err != nil {
+			incErrorMetric(err, metricLabels)
+			return fmt.Errorf("failed to delete error groups merges: %w", err)
+		}
+	}
+
+	if mergedCount > 0 {
+		query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+
+		metricLabels := []string{errorGroupsMergesTable, "INSERT"}
+		if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+			incErrorMetric(err, metricLabels)
+			return fmt.Errorf("failed to save error groups merges: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteExpired deletes data not updated for longer than TTL of the table.
+func (r *errorGroupsRepository) deleteExpired(ctx context.Context) error {
+	now := r.nowFn()
+	expired := []struct {
+		table  string
+		column string
+		ttl    time.Duration
+	}{
+		{table: aggEvents1minTable, column: "start_date", ttl: aggEvents1minTTL},
+		{table: aggEvents10minTable, column: "start_date", ttl: aggEvents10minTTL},
+		{table: aggEvents1hTable, column: "start_date", ttl: aggEvents1hTTL},
+		{table: aggEvents1dTable, column: "start_date", ttl: aggEvents1dTTL},
+		{table: errorGroupsTable, column: "last_seen_at", ttl: errorGroupsTTL},
+		{table: servicesTable, column: "ttl", ttl: servicesTTL},
+	}
+
+	for _, e := range expired {
+		query := fmt.Sprintf("DELETE FROM %s WHERE %s < $1", e.table, e.column)
+
+		metricLabels := []string{e.table, "DELETE"}
+		if _, err := r.exec(ctx, metricLabels, query, now.Add(-e.ttl)); err != nil {
+			incErrorMetric(err, metricLabels)
+			return fmt.Errorf("failed to delete expired rows from %s: %w", e.table, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *errorGroupsRepository) deleteExpiredLoop(ctx context.Context) {
+	ticker := time.NewTicker(deleteExpiredErrorGroupsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.deleteExpired(ctx); err != nil {
+				logger.Error("failed to delete expired error groups", zap.Error(err))
+			}
+		}
+	}
+}
+
+// where returns common conditions of error groups queries.
+func (r *errorGroupsRepository) where(env, source, release *string) sq.Eq {
+	where := sq.Eq{}
+	for col, val := range r.queryFilter {
+		where[col] = val
+	}
+
+	add := func(col string, val *string) {
+		if val != nil && *val != "" {
+			where[col] = *val
+		}
+	}
+	add("env", env)
+	add("source", source)
+	add("release", release)
+
+	return where
+}
+
+// table returns table name to select error groups data from.
+// Table is wrapped into subquery replacing merged hashes with their target hashes.
+func (r *errorGroupsRepository) table(name string) string {
+	return fmt.Sprintf(
+		"(SELECT COALESCE(m.target_hash, t._group_hash) AS _group_hash, t.%s FROM %s t LEFT JOIN %s m ON m._group_hash = t._group_hash) AS %s",
+		strings.Join(errorGroupsTablesColumns[name], ", t."), name, errorGroupsMergesTable, name,
+	)
+}
+
+// groupHashCond returns condition on group hash taking its merge into account.
+func groupHashCond(hash uint64) sq.Sqlizer {
+	return sq.Expr(
+		"_group_hash = COALESCE((SELECT target_hash FROM error_groups_merges WHERE _group_hash = ?), ?)",
+		int64(hash), int64(hash),
+	)
+}
+
+type getErrorGroupsHashSubQueryParams struct {
+	where   sq.Eq
+	tr      *types.TimeRange
+	orderBy string
+	limit   uint64
+	offset  uint64
+}
+
+func (r *errorGroupsRepository) getHashSubQuery(params getErrorGroupsHashSubQueryParams) sq.SelectBuilder {
+	subQ := sq.
+		Select("_group_hash").
+		From(r.table(errorGroupsTable)).
+		Where(params.where).
+		GroupBy("_group_hash").
+		OrderBy(params.orderBy).
+		Limit(params.limit).
+		Offset(params.offset)
+
+	if !params.tr.IsEmpty() {
+		subQ = subQ.Having(r.timeRangeCond("max(last_seen_at)", params.tr))
+	}
+
+	return subQ
+}
+
+func (r *errorGroupsRepository) getTotal(
+	ctx context.Context,
+	where sq.Eq,
+	tr *types.TimeRange,
+) (uint64, error) {
+	q := sq.
+		Select("count(DISTINCT _group_hash)").
+		Where(where)
+
+	table := errorGroupsTable
+	if !tr.IsEmpty() {
+		histData := r.getHistData(tr)
+		q = q.Where(r.timeRangeCond(histData.column, tr))
+
+		table = histData.table
+	}
+
+	query, args := q.From(r.table(table)).PlaceholderFormat(sq.Dollar).MustSql()
+	metricLabels := []string{table, "SELECT"}
+
+	var total uint64
+	if err := r.queryRow(ctx, metricLabels, query, args...).Scan(&total); err != nil {
+		incErrorMetric(err, metricLabels)
+		return 0, fmt.Errorf("failed to get total: %w", err)
+	}
+
+	return total, nil
+}
+
+type errorGroupInfo struct {
+	Hash        int64     `db:"_group_hash"`
+	Source      string    `db:"source"`
+	Message     string    `db:"message"`
+	SeenTotal   uint64    `db:"seen_total"`
+	FirstSeenAt time.Time `db:"first_seen_at"`
+	LastSeenAt  time.Time `db:"last_seen_at"`
+}
+
+type errorGroupInfos []errorGroupInfo
+
+func (i errorGroupInfos) hashes() []int64 {
+	hashes := make([]int64, 0, len(i))
+	for _, v := range i {
+		hashes = append(hashes, v.Hash)
+	}
+	return hashes
+}
+
+func (i errorGroupInfos) mapByHash() map[int64]errorGroupInfo {
+	m := make(map[int64]errorGroupInfo, len(i))
+	for _, v := range i {
+		m[v.Hash] = v
+	}
+	return m
+}
+
+func (i errorGroupInfos) toErrorGroups() []types.ErrorGroup {
+	var groups []types.ErrorGroup
+	for _, info := range i {
+		groups = append(groups, types.ErrorGroup{
+			Hash:        uint64(info.Hash),
+			Source:      info.Source,
+			Message:     info.Message,
+			Count:       info.SeenTotal,
+			FirstSeenAt: info.FirstSeenAt,
+			LastSeenAt:  info.LastSeenAt,
+		})
+	}
+	return groups
+}
+
+type getErrorGroupInfosParams struct {
+	columns  []string
+	where    sq.Eq
+	subQuery *sq.SelectBuilder
+	orderBy  string
+}
+
+func (r *errorGroupsRepository) getErrorInfos(
+	ctx context.Context,
+	params getErrorGroupInfosParams,
+) (errorGroupInfos, error) {
+	q := sq.
+		Select(params.columns...).
+		From(r.table(errorGroupsTable)).
+		Where(params.where).
+		GroupBy("_group_hash", "source")
+
+	if params.subQuery != nil {
+		subQ, subArgs := params.subQuery.MustSql()
+		q = q.Where(fmt.Sprintf("_group_hash IN (%s)", subQ), subArgs...)
+	}
+	if params.orderBy != "" {
+		q = q.OrderBy(params.orderBy)
+	}
+
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	metricLabels := []string{errorGroupsTable, "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups: %w", err)
+	}
+
+	infos, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[errorGroupInfo])
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	return infos, nil
+}
+
+type errorGroupCount struct {
+	Hash  int64
+	Count uint64
+}
+
+type errorGroupCounts []errorGroupCount
+
+func (c errorGroupCounts) hashes() []int64 {
+	hashes := make([]int64, 0, len(c))
+	for _, v := range c {
+		hashes = append(hashes, v.Hash)
+	}
+	return hashes
+}
+
+func (c errorGroupCounts) mapByHash() map[int64]uint64 {
+	m := make(map[int64]uint64, len(c))
+	for _, v := range c {
+		m[v.Hash] = v.Count
+	}
+	return m
+}
+
+type getErrorGroupCountsParams struct {
+	tr      *types.TimeRange
+	where   sq.Eq
+	orderBy string
+	limit   uint64
+	offset  uint64
+}
+
+func (r *errorGroupsRepository) getErrorCounts(
+	ctx context.Context,
+	params getErrorGroupCountsParams,
+) (errorGroupCounts, error) {
+	histData := r.getHistData(params.tr)
+
+	q := sq.
+		Select(
+			"_group_hash",
+			"sum(counts)::bigint AS count",
+		).
+		From(r.table(histData.table)).
+		Where(params.where).
+		Where(r.timeRangeCond(histData.column, params.tr)).
+		GroupBy("_group_hash")
+
+	if params.orderBy != "" {
+		q = q.OrderBy(params.orderBy)
+	}
+	if params.limit > 0 {
+		q = q.Limit(params.limit)
+	}
+	if params.offset > 0 {
+		q = q.Offset(params.offset)
+	}
+
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	metricLabels := []string{histData.table, "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups count: %w", err)
+	}
+	defer rows.Close()
+
+	var counts errorGroupCounts
+	for rows.Next() {
+		var ec errorGroupCount
+		if err = rows.Scan(&ec.Hash, &ec.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		counts = append(counts, ec)
+	}
+
+	return counts, nil
+}
+
+type errorGroupsHistData struct {
+	table    string
+	column   string
+	interval uint64
+}
+
+func (r *errorGroupsRepository) getHistData(tr *types.TimeRange) errorGroupsHistData {
+	const (
+		startDate    = "start_date"
+		startOfHour  = "date_trunc('hour', start_date)"
+		startOfDay   = "date_trunc('day', start_date)"
+		startOfWeek  = "date_trunc('week', start_date)"
+		startOfMonth = "date_trunc('month', start_date)"
+
+		_10min = 10 * time.Minute
+		hour   = time.Hour
+		week   = 7 * day
+		month  = 31 * day
+	)
+
+	data := func(table, column string, interval time.Duration) errorGroupsHistData {
+		return errorGroupsHistData{table: table, column: column, interval: uint64(interval.Seconds())}
+	}
+
+	if tr.IsEmpty() {
+		return data(aggEvents1dTable, startOfMonth, month)
+	}
+
+	// try get ~30 buckets
+	var d time.Duration
+	if tr.IsAbsolute() {
+		d = tr.AbsoluteDuration()
+		if r.nowFn().Sub(tr.From) > aggEvents10minTTL {
+			switch {
+			case d <= month:
+				return data(aggEvents1dTable, startDate, day)
+			case d <= 7*month:
+				return data(aggEvents1dTable, startOfWeek, week)
+			default:
+				return data(aggEvents1dTable, startOfMonth, month)
+			}
+		}
+	} else {
+		d = tr.Duration
+	}
+
+	switch {
+	case d <= 5*time.Hour:
+		return data(aggEvents10minTable, startDate, _10min)
+	case d <= day:
+		return data(aggEvents10minTable, startOfHour, hour)
+	case d <= month:
+		return data(aggEvents10minTable, startOfDay, day)
+	case d <= 7*month:
+		return data(aggEvents1dTable, startOfWeek, week)
+	default:
+		return data(aggEvents1dTable, startOfMonth, month)
+	}
+}
+
+// getErrorGroupsHistDataByInterval returns hist data of the rollup with requested interval.
+func getErrorGroupsHistDataByInterval(interval time.Duration) (errorGroupsHistData, bool) {
+	const (
+		startDate   = "start_date"
+		startOfWeek = "date_trunc('week', start_date)"
+	)
+
+	data := func(table, column string) (errorGroupsHistData, bool) {
+		return errorGroupsHistData{table: table, column: column, interval: uint64(interval.Seconds())}, true
+	}
+
+	switch interval {
+	case types.HistIntervalMinute:
+		return data(aggEvents1minTable, startDate)
+	case types.HistInterval10Minutes:
+		return data(aggEvents10minTable, startDate)
+	case types.HistIntervalHour:
+		return data(aggEvents1hTable, startDate)
+	case types.HistIntervalDay:
+		return data(aggEvents1dTable, startDate)
+	case types.HistIntervalWeek:
+		return data(aggEvents1dTable, startOfWeek)
+	}
+	return errorGroupsHistData{}, false
+}
+
+func errorGroupsHistGroupColumn(groupBy types.ErrorHistGroupBy) string {
+	switch groupBy {
+	case types.HistGroupByRelease:
+		return "release"
+	case types.HistGroupByEnv:
+		return "env"
+	}
+	return ""
+}
+
+func (r *errorGroupsRepository) timeRangeCond(column string, tr *types.TimeRange) any {
+	if tr.IsEmpty() {
+		return nil
+	}
+
+	if tr.IsAbsolute() {
+		return sq.And{
+			sq.GtOrEq{column: tr.From},
+			sq.LtOrEq{column: tr.To},
+		}
+	}
+
+	return sq.GtOrEq{column: r.nowFn().Add(-tr.Duration.Abs())}
+}
+
+func errorGroupsOrderBy(o types.ErrorGroupsOrder, sub bool) string {
+	seenTotal := "seen_total DESC"
+	lastSeenAt := "last_seen_at DESC"
+	firstSeenAt := "first_seen_at"
+	if sub {
+		seenTotal = "sum(seen_total) DESC"
+		lastSeenAt = "max(last_seen_at) DESC"
+		firstSeenAt = "min(first_seen_at)"
+	}
+
+	switch o {
+	case types.OrderFrequent:
+		return seenTotal
+	case types.OrderLatest:
+		return lastSeenAt
+	case types.OrderOldest:
+		return firstSeenAt
+	}
+	return seenTotal
+}
@@ -0,0 +1,499 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sq "github.com/n-r-w/squirrel"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestErrorGroupsTable(t *testing.T) {
+	r := newErrorGroupsRepository(nil, nil, time.Now)
+
+	require.Equal(t,
+		"(SELECT COALESCE(m.target_hash, t._group_hash) AS _group_hash, t.start_date, t.service, t.env, t.source, t.cluster, t.release, t.counts"+
+			" FROM agg_events_10min t LEFT JOIN error_groups_merges m ON m._group_hash = t._group_hash) AS agg_events_10min",
+		r.table(aggEvents10minTable),
+	)
+}
+
+func TestErrorGroupsWhere(t *testing.T) {
+	var (
+		env     = "prod"
+		empty   = ""
+		release = "v1"
+	)
+
+	r := newErrorGroupsRepository(nil, map[string]string{"cluster": "c1"}, time.Now)
+
+	query, args := sq.
+		Select("_group_hash").
+		From(errorGroupsTable).
+		Where(r.where(&env, &empty, &release)).
+		Where(groupHashCond(18446744073709551615)).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	require.Equal(t,
+		"SELECT _group_hash FROM error_groups WHERE cluster = $1 AND env = $2 AND release = $3"+
+			" AND _group_hash = COALESCE((SELECT target_hash FROM error_groups_merges WHERE _group_hash = $4), $5)",
+		query,
+	)
+	require.Equal(t, []any{"c1", env, release, int64(-1), int64(-1)}, args)
+}
+
+func TestErrorGroupsGetHistData(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name string
+
+		tr   *types.TimeRange
+		want errorGroupsHistData
+	}{
+		{
+			name: "nil",
+			want: errorGroupsHistData{
+				table:    aggEvents1dTable,
+				column:   "date_trunc('month', start_date)",
+				interval: uint64((31 * day).Seconds()),
+			},
+		},
+		{
+			name: "relative_hour",
+			tr:   &types.TimeRange{Duration: time.Hour},
+			want: errorGroupsHistData{
+				table:    aggEvents10minTable,
+				column:   "start_date",
+				interval: uint64((10 * time.Minute).Seconds()),
+			},
+		},
+		{
+			name: "relative_day",
+			tr:   &types.TimeRange{Duration: day},
+			want: errorGroupsHistData{
+				table:    aggEvents10minTable,
+				column:   "date_trunc('hour', start_date)",
+				interval: uint64(time.Hour.Seconds()),
+			},
+		},
+		{
+			name: "absolute_expired_10min",
+			tr: &types.TimeRange{
+				From: now.Add(-100 * day),
+				To:   now.Add(-99 * day),
+			},
+			want: errorGroupsHistData{
+				table:    aggEvents1dTable,
+				column:   "start_date",
+				interval: uint64(day.Seconds()),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newErrorGroupsRepository(nil, nil, func() time.Time { return now })
+			require.Equal(t, tt.want, r.getHistData(tt.tr))
+		})
+	}
+}
+
+func TestErrorGroupsGetHistDataByInterval(t *testing.T) {
+	got, ok := getErrorGroupsHistDataByInterval(types.HistIntervalWeek)
+	require.True(t, ok)
+	require.Equal(t, errorGroupsHistData{
+		table:    aggEvents1dTable,
+		column:   "date_trunc('week', start_date)",
+		interval: uint64(types.HistIntervalWeek.Seconds()),
+	}, got)
+
+	_, ok = getErrorGroupsHistDataByInterval(5 * time.Minute)
+	require.False(t, ok)
+}
+
+func newErrorGroupsRepositoryMock(t *testing.T, now time.Time) (*errorGroupsRepository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(mock.Close)
+
+	r := newErrorGroupsRepository(newPool(mock, time.Second), nil, func() time.Time { return now })
+	return r, mock
+}
+
+func TestErrorGroupsGetErrorGroups(t *testing.T) {
+	var (
+		now     = time.Now()
+		service = "svc"
+		hour    = &types.TimeRange{Duration: time.Hour}
+		someErr = errors.New("some err")
+	)
+
+	r, _ := newErrorGroupsRepositoryMock(t, now)
+	var (
+		groupsTable = r.table(errorGroupsTable)
+		aggTable    = r.table(aggEvents10minTable)
+	)
+
+	infoColumns := []string{"_group_hash", "source", "message", "first_seen_at", "last_seen_at"}
+
+	tests := []struct {
+		name string
+
+		req     types.GetErrorGroupsRequest
+		mockFn  func(m pgxmock.PgxPoolIface)
+		want    []types.ErrorGroup
+		wantErr bool
+	}{
+		{
+			name: "ok_all_time",
+			req: types.GetErrorGroupsRequest{
+				Service: service,
+				Limit:   10,
+				Order:   types.OrderFrequent,
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT _group_hash, source, min(message) AS message, sum(seen_total)::bigint AS seen_total,"+
+						" min(first_seen_at) AS first_seen_at, max(last_seen_at) AS last_seen_at"+
+						" FROM "+groupsTable+" WHERE service = $1"+
+						" AND _group_hash IN (SELECT _group_hash FROM "+groupsTable+" WHERE service = $2"+
+						" GROUP BY _group_hash ORDER BY sum(seen_total) DESC LIMIT 10 OFFSET 0)"+
+						" GROUP BY _group_hash, source ORDER BY seen_total DESC",
+				).
+					WithArgs(service, service).
+					WillReturnRows(pgxmock.NewRows([]string{"_group_hash", "source", "message", "seen_total", "first_seen_at", "last_seen_at"}).
+						AddRow(int64(-1), "src", "msg1", uint64(20), now, now).
+						AddRow(int64(2), "src", "msg2", uint64(10), now, now))
+			},
+			want: []types.ErrorGroup{
+				{Hash: 18446744073709551615, Source: "src", Message: "msg1", Count: 20, FirstSeenAt: now, LastSeenAt: now},
+				{Hash: 2, Source: "src", Message: "msg2", Count: 10, FirstSeenAt: now, LastSeenAt: now},
+			},
+		},
+		{
+			name: "ok_frequent_time_range",
+			req: types.GetErrorGroupsRequest{
+				Service:   service,
+				TimeRange: hour,
+				Limit:     10,
+				Order:     types.OrderFrequent,
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT _group_hash, sum(counts)::bigint AS count FROM "+aggTable+
+						" WHERE service = $1 AND start_date >= $2 GROUP BY _group_hash ORDER BY count DESC LIMIT 10",
+				).
+					WithArgs(service, now.Add(-time.Hour)).
+					WillReturnRows(pgxmock.NewRows([]string{"_group_hash", "count"}).
+						AddRow(int64(2), uint64(5)).
+						AddRow(int64(1), uint64(3)))
+				m.ExpectQuery(
+					"SELECT _group_hash, source, min(message) AS message,"+
+						" min(first_seen_at) AS first_seen_at, max(last_seen_at) AS last_seen_at"+
+						" FROM "+groupsTable+" WHERE _group_hash IN ($1,$2) AND service = $3"+
+						" GROUP BY _group_hash, source",
+				).
+					WithArgs(int64(2), int64(1), service).
+					WillReturnRows(pgxmock.NewRows(infoColumns).
+						AddRow(int64(1), "src", "msg1", now, now).
+						AddRow(int64(2), "src", "msg2", now, now))
+			},
+			want: []types.ErrorGroup{
+				{Hash: 2, Source: "src", Message: "msg2", Count: 5, FirstSeenAt: now, LastSeenAt: now},
+				{Hash: 1, Source: "src", Message: "msg1", Count: 3, FirstSeenAt: now, LastSeenAt: now},
+			},
+		},
+		{
+			name: "ok_latest_time_range",
+			req: types.GetErrorGroupsRequest{
+				Service:   service,
+				TimeRange: hour,
+				Limit:     10,
+				Order:     types.OrderLatest,
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT _group_hash, source, min(message) AS message,"+
+						" min(first_seen_at) AS first_seen_at, max(last_seen_at) AS last_seen_at"+
+						" FROM "+groupsTable+" WHERE service = $1"+
+						" AND _group_hash IN (SELECT _group_hash FROM "+groupsTable+" WHERE service = $2"+
+						" GROUP BY _group_hash HAVING max(last_seen_at) >= $3 ORDER BY max(last_seen_at) DESC LIMIT 10 OFFSET 0)"+
+						" GROUP BY _group_hash, source ORDER BY last_seen_at DESC",
+				).
+					WithArgs(service, service, now.Add(-time.Hour)).
+					WillReturnRows(pgxmock.NewRows(infoColumns).
+						AddRow(int64(1), "src", "msg1", now, now))
+				m.ExpectQuery(
+					"SELECT _group_hash, sum(counts)::bigint AS count FROM "+aggTable+
+						" WHERE _group_hash IN ($1) AND service = $2 AND start_date >= $3 GROUP BY _group_hash",
+				).
+					WithArgs(int64(1), service, now.Add(-time.Hour)).
+					WillReturnRows(pgxmock.NewRows([]string{"_group_hash", "count"}).
+						AddRow(int64(1), uint64(7)))
+			},
+			want: []types.ErrorGroup{
+				{Hash: 1, Source: "src", Message: "msg1", Count: 7, FirstSeenAt: now, LastSeenAt: now},
+			},
+		},
+		{
+			name: "ok_no_counts",
+			req: types.GetErrorGroupsRequest{
+				Service:   service,
+				TimeRange: hour,
+				Limit:     10,
+				Order:     types.OrderFrequent,
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT _group_hash, sum(counts)::bigint AS count FROM "+aggTable+
+						" WHERE service = $1 AND start_date >= $2 GROUP BY _group_hash ORDER BY count DESC LIMIT 10",
+				).
+					WithArgs(service, now.Add(-time.Hour)).
+					WillReturnRows(pgxmock.NewRows([]string{"_group_hash", "count"}))
+			},
+		},
+		{
+			name: "err_query",
+			req: types.GetErrorGroupsRequest{
+				Service:   service,
+				TimeRange: hour,
+				Limit:     10,
+				Order:     types.OrderFrequent,
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT _group_hash, sum(counts)::bigint AS count FROM "+aggTable+
+						" WHERE service = $1 AND start_date >= $2 GROUP BY _group_hash ORDER BY count DESC LIMIT 10",
+				).
+					WithArgs(service, now.Add(-time.Hour)).
+					WillReturnError(someErr)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, mock := newErrorGroupsRepositoryMock(t, now)
+			tt.mockFn(mock)
+
+			got, err := r.GetErrorGroups(context.Background(), tt.req)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestErrorGroupsGetErrorHist(t *testing.T) {
+	var (
+		now     = time.Now().Truncate(time.Hour)
+		service = "svc"
+		hash    = uint64(5)
+		someErr = errors.New("some err")
+	)
+
+	r, _ := newErrorGroupsRepositoryMock(t, now)
+	aggTable := r.table(aggEvents10minTable)
+
+	tests := []struct {
+		name string
+
+		req     types.GetErrorHistRequest
+		mockFn  func(m pgxmock.PgxPoolIface)
+		want    types.ErrorHist
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			req: types.GetErrorHistRequest{
+				Service:   &service,
+				GroupHash: &hash,
+				TimeRange: &types.TimeRange{Duration: day},
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT date_trunc('hour', start_date) AS bucket, sum(counts)::bigint AS counts FROM "+aggTable+
+						" WHERE service = $1"+
+						" AND _group_hash = COALESCE((SELECT target_hash FROM error_groups_merges WHERE _group_hash = $2), $3)"+
+						" AND date_trunc('hour', start_date) >= $4 GROUP BY bucket ORDER BY bucket",
+				).
+					WithArgs(service, int64(hash), int64(hash), now.Add(-day)).
+					WillReturnRows(pgxmock.NewRows([]string{"bucket", "counts"}).
+						AddRow(now.Add(-time.Hour), uint64(3)).
+						AddRow(now, uint64(4)))
+			},
+			want: types.ErrorHist{
+				Interval: uint64(time.Hour.Seconds()),
+				Buckets: []types.ErrorHistBucket{
+					{Time: now.Add(-time.Hour), Count: 3},
+					{Time: now, Count: 4},
+				},
+			},
+		},
+		{
+			name: "ok_group_by_release",
+			req: types.GetErrorHistRequest{
+				Service:   &service,
+				TimeRange: &types.TimeRange{Duration: time.Hour},
+				GroupBy:   types.HistGroupByRelease,
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT start_date AS bucket, sum(counts)::bigint AS counts, release FROM "+aggTable+
+						" WHERE service = $1 AND start_date >= $2 GROUP BY bucket, release ORDER BY bucket",
+				).
+					WithArgs(service, now.Add(-time.Hour)).
+					WillReturnRows(pgxmock.NewRows([]string{"bucket", "counts", "release"}).
+						AddRow(now, uint64(1), "v1").
+						AddRow(now, uint64(2), "v2"))
+			},
+			want: func() types.ErrorHist {
+				hist := types.ErrorHist{Interval: uint64((10 * time.Minute).Seconds())}
+				hist.AddSeriesBucket("v1", types.ErrorHistBucket{Time: now, Count: 1})
+				hist.AddSeriesBucket("v2", types.ErrorHistBucket{Time: now, Count: 2})
+				return hist
+			}(),
+		},
+		{
+			name: "err_query",
+			req: types.GetErrorHistRequest{
+				Service:   &service,
+				TimeRange: &types.TimeRange{Duration: time.Hour},
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectQuery(
+					"SELECT start_date AS bucket, sum(counts)::bigint AS counts FROM "+aggTable+
+						" WHERE service = $1 AND start_date >= $2 GROUP BY bucket ORDER BY bucket",
+				).
+					WithArgs(service, now.Add(-time.Hour)).
+					WillReturnError(someErr)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, mock := newErrorGroupsRepositoryMock(t, now)
+			tt.mockFn(mock)
+
+			got, err := r.GetErrorHist(context.Background(), tt.req)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestErrorGroupsSaveErrorGroupMerges(t *testing.T) {
+	var (
+		now     = time.Now()
+		someErr = errors.New("some err")
+	)
+
+	const (
+		deleteQuery = "DELETE FROM error_groups_merges WHERE _group_hash = ANY($1)"
+		insertQuery = "INSERT INTO error_groups_merges (_group_hash,target_hash,created_by,created_at)" +
+			" VALUES ($1,$2,$3,$4),($5,$6,$7,$8) ON CONFLICT (_group_hash) DO UPDATE SET" +
+			" target_hash = EXCLUDED.target_hash, created_by = EXCLUDED.created_by, created_at = EXCLUDED.created_at"
+	)
+
+	tests := []struct {
+		name string
+
+		merges  []types.ErrorGroupMerge
+		mockFn  func(m pgxmock.PgxPoolIface)
+		wantErr bool
+	}{
+		{
+			name: "ok_merge",
+			merges: []types.ErrorGroupMerge{
+				{Hash: 1, TargetHash: 3, CreatedBy: "user"},
+				{Hash: 18446744073709551615, TargetHash: 3, CreatedBy: "user"},
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectExec(insertQuery).
+					WithArgs(int64(1), int64(3), "user", now, int64(-1), int64(3), "user", now).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+			},
+		},
+		{
+			name: "ok_merge_and_unmerge",
+			merges: []types.ErrorGroupMerge{
+				{Hash: 1, TargetHash: 3, CreatedBy: "user"},
+				{Hash: 2, TargetHash: 3, CreatedBy: "user"},
+				{Hash: 4},
+			},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectExec(deleteQuery).
+					WithArgs([]int64{4}).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				m.ExpectExec(insertQuery).
+					WithArgs(int64(1), int64(3), "user", now, int64(2), int64(3), "user", now).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+			},
+		},
+		{
+			name:   "ok_unmerge",
+			merges: []types.ErrorGroupMerge{{Hash: 4}, {Hash: 5}},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectExec(deleteQuery).
+					WithArgs([]int64{4, 5}).
+					WillReturnResult(pgxmock.NewResult("DELETE", 2))
+			},
+		},
+		{
+			name:   "err_delete",
+			merges: []types.ErrorGroupMerge{{Hash: 1, TargetHash: 3, CreatedBy: "user"}, {Hash: 4}},
+			mockFn: func(m pgxmock.PgxPoolIface) {
+				m.ExpectExec(deleteQuery).
+					WithArgs([]int64{4}).
+					WillReturnError(someErr)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, mock := newErrorGroupsRepositoryMock(t, now)
+			tt.mockFn(mock)
+
+			err := r.SaveErrorGroupMerges(context.Background(), tt.merges)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestErrorGroupsGetErrorGroupMerges(t *testing.T) {
+	now := time.Now()
+
+	r, mock := newErrorGroupsRepositoryMock(t, now)
+	mock.ExpectQuery("SELECT _group_hash, target_hash, created_by, created_at FROM error_groups_merges ORDER BY _group_hash").
+		WillReturnRows(pgxmock.NewRows([]string{"_group_hash", "target_hash", "created_by", "created_at"}).
+			AddRow(int64(-1), int64(3), "user", now))
+
+	got, err := r.GetErrorGroupMerges(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []types.ErrorGroupMerge{
+		{Hash: 18446744073709551615, TargetHash: 3, CreatedBy: "user", CreatedAt: now},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+const errorGroupsSubscriptionsColumns = "id, profile_id, service, env, period, format, delivery, webhook_url, last_sent_at"
+
+type errorGroupsSubscriptionsRepository struct {
+	*pool
+}
+
+func newErrorGroupsSubscriptionsRepository(pool *pool) *errorGroupsSubscriptionsRepository {
+	return &errorGroupsSubscriptionsRepository{pool}
+}
+
+func (r *errorGroupsSubscriptionsRepository) GetAll(ctx context.Context) (types.ErrorGroupsSubscriptions, error) {
+	query := "SELECT " + errorGroupsSubscriptionsColumns + " FROM error_groups_subscriptions ORDER BY id"
+
+	return r.getSubscriptions(ctx, query)
+}
+
+func (r *errorGroupsSubscriptionsRepository) GetByProfile(
+	ctx context.Context,
+	req types.GetErrorGroupsSubscriptionsRequest,
+) (types.ErrorGroupsSubscriptions, error) {
+	query, args := "SELECT "+errorGroupsSubscriptionsColumns+" FROM error_groups_subscriptions WHERE profile_id = $1 ORDER BY id",
+		[]any{req.ProfileID}
+
+	return r.getSubscriptions(ctx, query, args...)
+}
+
+func (r *errorGroupsSubscriptionsRepository) getSubscriptions(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (types.ErrorGroupsSubscriptions, error) {
+	metricLabels := []string{"error_groups_subscriptions", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := types.ErrorGroupsSubscriptions{}
+	for rows.Next() {
+		var s types.ErrorGroupsSubscription
+		if err = rows.Scan(
+			&s.ID,
+			&s.ProfileID,
+			&s.Service,
+			&s.Env,
+			&s.Period,
+			&s.Format,
+			&s.Delivery,
+			&s.WebhookURL,
+			&s.LastSentAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		subscriptions = append(subscriptions, s)
+	}
+
+	return subscriptions, nil
+}
+
+// Create creates subscription or updates delivery settings of the existing one
+// with the same service, env and period.
+func (r *errorGroupsSubscriptionsRepository) Create(
+	ctx context.Context,
+	req types.CreateErrorGroupsSubscriptionRequest,
+) (int64, error) {
+	query, args := `
+		INSERT INTO error_groups_subscriptions (profile_id,service,env,period,format,delivery,webhook_url)
+		VALUES ($1,$2,$3,$4,$5,$6,$7)
+		ON CONFLICT (profile_id,service,env,period) DO UPDATE
+		SET format = EXCLUDED.format, delivery = EXCLUDED.delivery, webhook_url = EXCLUDED.webhook_url
+		RETURNING id
+		`,
+		[]any{req.ProfileID, req.Service, req.Env, req.Period, req.Format, req.Delivery, req.WebhookURL}
+
+	var id int64 = -1
+	metricLabels := []string{"error_groups_subscriptions", "INSERT"}
+	if err := r.queryRow(ctx, metricLabels, query, args...).Scan(&id); err != nil {
+		incErrorMetric(err, metricLabels)
+		return id, fmt.Errorf("failed to create error groups subscription: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *errorGroupsSubscriptionsRepository) Delete(
+	ctx context.Context,
+	req types.DeleteErrorGroupsSubscriptionRequest,
+) error {
+	query, args := "DELETE FROM error_groups_subscriptions WHERE id = $1 AND profile_id = $2",
+		[]any{req.ID, req.ProfileID}
+
+	metricLabels := []string{"error_groups_subscriptions", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to delete error groups subscription: %w", err)
+	}
+
+	return nil
+}
+
+// MarkSent updates last sent time of the subscription if it wasn't changed by someone else.
+// Returns false if the subscription was already marked.
+func (r *errorGroupsSubscriptionsRepository) MarkSent(
+	ctx context.Context,
+	req types.MarkErrorGroupsSubscriptionSentRequest,
+) (bool, error) {
+	query, args := "UPDATE error_groups_subscriptions SET last_sent_at = $1 WHERE id = $2 AND last_sent_at IS NOT DISTINCT FROM $3",
+		[]any{req.SentAt, req.ID, req.PrevSentAt}
+
+	metricLabels := []string{"error_groups_subscriptions", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return false, fmt.Errorf("failed to mark error groups subscription as sent: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
+	sq "github.com/n-r-w/squirrel"
 
 	"github.com/ozontech/seq-ui/internal/app/types"
+	sqlb "github.com/ozontech/seq-ui/internal/pkg/repository/sql_builder"
 )
 
 type favoriteQueriesRepository struct {
@@ -18,9 +20,23 @@ func newFavoriteQueriesRepository(pool *pool) *favoriteQueriesRepository {
 	return &favoriteQueriesRepository{pool}
 }
 
+// GetAll returns user's favorite queries in manual order followed by the subscribed ones
+// which are still published to the user's teams.
 func (r *favoriteQueriesRepository) GetAll(ctx context.Context, req types.GetFavoriteQueriesRequest) (types.FavoriteQueries, error) {
-	query, args := "SELECT id, query, name, relative_from FROM favorite_queries WHERE profile_id = $1",
-		[]any{req.ProfileID}
+	query, args := `
+		SELECT id, query, name, relative_from, folder_id, env, log_columns, team, '', false, position
+		FROM favorite_queries
+		WHERE profile_id = $1
+		UNION ALL
+		SELECT q.id, q.query, q.name, q.relative_from, NULL, q.env, q.log_columns, q.team, p.user_name, true,
+			row_number() OVER (ORDER BY s.created_at, q.id)
+		FROM favorite_query_subscriptions AS s
+		JOIN favorite_queries AS q ON q.id = s.query_id
+		JOIN user_profiles AS p ON p.id = q.profile_id
+		WHERE s.profile_id = $1 AND q.team = ANY($2)
+		ORDER BY 10, 11, 1
+		`,
+		[]any{req.ProfileID, nonNilTags(req.Groups)}
 
 	metricLabels := []string{"favorite_queries", "SELECT"}
 	rows, err := r.query(ctx, metricLabels, query, args...)
@@ -30,32 +46,27 @@ func (r *favoriteQueriesRepository) GetAll(ctx context.Context, req types.GetFav
 	}
 	defer rows.Close()
 
-	favoriteQueries := types.FavoriteQueries{}
-	for rows.Next() {
-		fQuery := types.FavoriteQuery{}
-		if err = rows.Scan(&fQuery.ID, &fQuery.Query, &fQuery.Name, &fQuery.RelativeFrom); err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
-		}
-
-		favoriteQueries = append(favoriteQueries, fQuery)
-	}
-
-	return favoriteQueries, nil
+	return scanFavoriteQueries(rows)
 }
 
 func (r *favoriteQueriesRepository) GetOrCreate(ctx context.Context, req types.GetOrCreateFavoriteQueryRequest) (int64, error) {
 	var id int64 = -1
 
-	query, args := "SELECT id FROM favorite_queries WHERE profile_id = $1 AND query = $2 AND name = $3 AND relative_from = $4 LIMIT 1",
-		[]any{req.ProfileID, req.Query, req.Name, req.RelativeFrom}
+	query, args := "SELECT id FROM favorite_queries WHERE profile_id = $1 AND query = $2 AND name = $3 AND relative_from = $4 AND env = $5 LIMIT 1",
+		[]any{req.ProfileID, req.Query, req.Name, req.RelativeFrom, req.Env}
 
 	metricLabelsSelect := []string{"favorite_queries", "SELECT"}
 	err := r.queryRow(ctx, metricLabelsSelect, query, args...).Scan(&id)
 
-	// create favorite query if it doesn't exist
+	// create favorite query if it doesn't exist, new query is placed to the end
 	if errors.Is(err, pgx.ErrNoRows) {
-		query, args = "INSERT INTO favorite_queries (profile_id,query,name,relative_from) VALUES ($1,$2,$3,$4) RETURNING id",
-			[]any{req.ProfileID, req.Query, req.Name, req.RelativeFrom}
+		query, args = `
+			INSERT INTO favorite_queries (profile_id,query,name,relative_from,env,log_columns,folder_id,position)
+			SELECT $1,$2,$3,$4,$5,$6,$7, COALESCE(MAX(position), 0) + 1
+			FROM favorite_queries WHERE profile_id = $1
+			RETURNING id
+			`,
+			[]any{req.ProfileID, req.Query, req.Name, req.RelativeFrom, req.Env, nonNilTags(req.LogColumns), req.FolderID}
 
 		metricLabelsInsert := []string{"favorite_queries", "INSERT"}
 		if err = r.queryRow(ctx, metricLabelsInsert, query, args...).Scan(&id); err != nil {
@@ -71,6 +82,50 @@ func (r *favoriteQueriesRepository) GetOrCreate(ctx context.Context, req types.G
 	return id, nil
 }
 
+func (r *favoriteQueriesRepository) Update(ctx context.Context, req types.UpdateFavoriteQueryRequest) error {
+	qb := sqlb.Update("favorite_queries").
+		Where(sq.Eq{
+			"id":         req.ID,
+			"profile_id": req.ProfileID,
+		})
+	if req.Query != nil {
+		qb = qb.Set("query", *req.Query)
+	}
+	if req.Name != nil {
+		qb = qb.Set("name", *req.Name)
+	}
+	if req.RelativeFrom != nil {
+		qb = qb.Set("relative_from", *req.RelativeFrom)
+	}
+	if req.Env != nil {
+		qb = qb.Set("env", *req.Env)
+	}
+	if req.LogColumns != nil {
+		qb = qb.Set("log_columns", nonNilTags(*req.LogColumns))
+	}
+	if req.FolderID != nil {
+		var folderID *int64
+		if *req.FolderID != 0 {
+			folderID = req.FolderID
+		}
+		qb = qb.Set("folder_id", folderID)
+	}
+
+	query, args := qb.MustSql()
+
+	metricLabels := []string{"favorite_queries", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to update favorite query: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("favorite query")
+	}
+
+	return nil
+}
+
 func (r *favoriteQueriesRepository) Delete(ctx context.Context, req types.DeleteFavoriteQueryRequest) error {
 	query, args := "DELETE FROM favorite_queries WHERE id = $1 AND profile_id = $2",
 		[]any{req.ID, req.ProfileID}
@@ -83,3 +138,200 @@ func (r *favoriteQueriesRepository) Delete(ctx context.Context, req types.Delete
 
 	return nil
 }
+
+// Reorder sets positions of the queries in order of the request, nothing is changed
+// if any of the queries doesn't belong to the user.
+func (r *favoriteQueriesRepository) Reorder(ctx context.Context, req types.ReorderFavoriteQueriesRequest) error {
+	query, args := `
+		UPDATE favorite_queries AS q SET position = o.position
+		FROM unnest($1::bigint[]) WITH ORDINALITY AS o(id, position)
+		WHERE q.id = o.id AND q.profile_id = $2
+		AND (SELECT count(*) FROM favorite_queries WHERE profile_id = $2 AND id = ANY($1)) = cardinality($1)
+		`,
+		[]any{req.IDs, req.ProfileID}
+
+	metricLabels := []string{"favorite_queries", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to reorder favorite queries: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("favorite query")
+	}
+
+	return nil
+}
+
+func (r *favoriteQueriesRepository) GetFolders(ctx context.Context, req types.GetFavoriteQueryFoldersRequest) (types.FavoriteQueryFolders, error) {
+	query, args := "SELECT id, name FROM favorite_query_folders WHERE profile_id = $1 ORDER BY name ASC, id ASC",
+		[]any{req.ProfileID}
+
+	metricLabels := []string{"favorite_query_folders", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get favorite query folders: %w", err)
+	}
+	defer rows.Close()
+
+	folders := types.FavoriteQueryFolders{}
+	for rows.Next() {
+		var f types.FavoriteQueryFolder
+		if err = rows.Scan(&f.ID, &f.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		folders = append(folders, f)
+	}
+
+	return folders, nil
+}
+
+func (r *favoriteQueriesRepository) CreateFolder(ctx context.Context, req types.CreateFavoriteQueryFolderRequest) (int64, error) {
+	query, args := "INSERT INTO favorite_query_folders (profile_id,name) VALUES ($1,$2) RETURNING id",
+		[]any{req.ProfileID, req.Name}
+
+	metricLabels := []string{"favorite_query_folders", "INSERT"}
+	var id int64
+	if err := r.queryRow(ctx, metricLabels, query, args...).Scan(&id); err != nil {
+		incErrorMetric(err, metricLabels)
+		return 0, fmt.Errorf("failed to create favorite query folder: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *favoriteQueriesRepository) UpdateFolder(ctx context.Context, req types.UpdateFavoriteQueryFolderRequest) error {
+	query, args := "UPDATE favorite_query_folders SET name = $1 WHERE id = $2 AND profile_id = $3",
+		[]any{req.Name, req.ID, req.ProfileID}
+
+	metricLabels := []string{"favorite_query_folders", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to update favorite query folder: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("favorite query folder")
+	}
+
+	return nil
+}
+
+// DeleteFolder deletes folder, its queries are moved to the root.
+func (r *favoriteQueriesRepository) DeleteFolder(ctx context.Context, req types.DeleteFavoriteQueryFolderRequest) error {
+	query, args := "DELETE FROM favorite_query_folders WHERE id = $1 AND profile_id = $2",
+		[]any{req.ID, req.ProfileID}
+
+	metricLabels := []string{"favorite_query_folders", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to delete favorite query folder: %w", err)
+	}
+
+	return nil
+}
+
+func (r *favoriteQueriesRepository) Publish(ctx context.Context, req types.PublishFavoriteQueryRequest) error {
+	query, args := "UPDATE favorite_queries SET team = $1 WHERE id = $2 AND profile_id = $3",
+		[]any{req.Team, req.ID, req.ProfileID}
+
+	metricLabels := []string{"favorite_queries", "UPDATE"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to publish favorite query: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("favorite query")
+	}
+
+	return nil
+}
+
+// GetLibrary returns queries published to the user's teams.
+func (r *favoriteQueriesRepository) GetLibrary(ctx context.Context, req types.GetFavoriteQueriesLibraryRequest) (types.FavoriteQueries, error) {
+	qb := sqlb.Select(
+		"q.id", "q.query", "q.name", "q.relative_from", "NULL", "q.env", "q.log_columns", "q.team", "p.user_name",
+		"s.profile_id IS NOT NULL", "0",
+	).
+		From("favorite_queries AS q").
+		Join("user_profiles AS p ON p.id = q.profile_id").
+		LeftJoin("favorite_query_subscriptions AS s ON s.query_id = q.id AND s.profile_id = ?", req.ProfileID).
+		Where(sq.Expr("q.team = ANY(?)", nonNilTags(req.Groups))).
+		OrderBy("q.team ASC", "q.name ASC", "q.id ASC")
+	if req.Team != nil {
+		qb = qb.Where(sq.Eq{"q.team": *req.Team})
+	}
+
+	query, args := qb.MustSql()
+
+	metricLabels := []string{"favorite_queries", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get favorite queries library: %w", err)
+	}
+	defer rows.Close()
+
+	return scanFavoriteQueries(rows)
+}
+
+// Subscribe subscribes the user to the query published to one of the user's teams.
+func (r *favoriteQueriesRepository) Subscribe(ctx context.Context, req types.SubscribeFavoriteQueryRequest) error {
+	query, args := `
+		INSERT INTO favorite_query_subscriptions (profile_id,query_id)
+		SELECT $1, id FROM favorite_queries
+		WHERE id = $2 AND profile_id <> $1 AND team = ANY($3)
+		ON CONFLICT (profile_id, query_id) DO UPDATE SET
+			created_at = favorite_query_subscriptions.created_at
+		`,
+		[]any{req.ProfileID, req.ID, nonNilTags(req.Groups)}
+
+	metricLabels := []string{"favorite_query_subscriptions", "INSERT"}
+	tag, err := r.exec(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to subscribe to favorite query: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return types.NewErrNotFound("favorite query")
+	}
+
+	return nil
+}
+
+func (r *favoriteQueriesRepository) Unsubscribe(ctx context.Context, req types.UnsubscribeFavoriteQueryRequest) error {
+	query, args := "DELETE FROM favorite_query_subscriptions WHERE profile_id = $1 AND query_id = $2",
+		[]any{req.ProfileID, req.ID}
+
+	metricLabels := []string{"favorite_query_subscriptions", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to unsubscribe from favorite query: %w", err)
+	}
+
+	return nil
+}
+
+// scanFavoriteQueries scans rows of queries with trailing sort column.
+func scanFavoriteQueries(rows pgx.Rows) (types.FavoriteQueries, error) {
+	favoriteQueries := types.FavoriteQueries{}
+	for rows.Next() {
+		var (
+			fQuery   types.FavoriteQuery
+			position int64
+		)
+		if err := rows.Scan(
+			&fQuery.ID, &fQuery.Query, &fQuery.Name, &fQuery.RelativeFrom, &fQuery.FolderID,
+			&fQuery.Env, &fQuery.LogColumns, &fQuery.Team, &fQuery.OwnerName, &fQuery.Subscribed, &position,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		favoriteQueries = append(favoriteQueries, fQuery)
+	}
+
+	return favoriteQueries, nil
+}
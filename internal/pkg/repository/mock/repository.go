@@ -12,6 +12,7 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	types "github.com/ozontech/seq-ui/internal/app/types"
 	gomock "go.uber.org/mock/gomock"
@@ -70,6 +71,21 @@ func (mr *MockUserProfilesMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserProfiles)(nil).Update), arg0, arg1)
 }
 
+// UpdatePreferences mocks base method.
+func (m *MockUserProfiles) UpdatePreferences(arg0 context.Context, arg1 types.UpdateUserPreferencesRequest) (types.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", arg0, arg1)
+	ret0, _ := ret[0].(types.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockUserProfilesMockRecorder) UpdatePreferences(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockUserProfiles)(nil).UpdatePreferences), arg0, arg1)
+}
+
 // MockFavoriteQueries is a mock of FavoriteQueries interface.
 type MockFavoriteQueries struct {
 	ctrl     *gomock.Controller
@@ -94,6 +110,21 @@ func (m *MockFavoriteQueries) EXPECT() *MockFavoriteQueriesMockRecorder {
 	return m.recorder
 }
 
+// CreateFolder mocks base method.
+func (m *MockFavoriteQueries) CreateFolder(arg0 context.Context, arg1 types.CreateFavoriteQueryFolderRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockFavoriteQueriesMockRecorder) CreateFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockFavoriteQueries)(nil).CreateFolder), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *MockFavoriteQueries) Delete(arg0 context.Context, arg1 types.DeleteFavoriteQueryRequest) error {
 	m.ctrl.T.Helper()
@@ -108,6 +139,20 @@ func (mr *MockFavoriteQueriesMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFavoriteQueries)(nil).Delete), arg0, arg1)
 }
 
+// DeleteFolder mocks base method.
+func (m *MockFavoriteQueries) DeleteFolder(arg0 context.Context, arg1 types.DeleteFavoriteQueryFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockFavoriteQueriesMockRecorder) DeleteFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockFavoriteQueries)(nil).DeleteFolder), arg0, arg1)
+}
+
 // GetAll mocks base method.
 func (m *MockFavoriteQueries) GetAll(arg0 context.Context, arg1 types.GetFavoriteQueriesRequest) (types.FavoriteQueries, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +168,36 @@ func (mr *MockFavoriteQueriesMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockFavoriteQueries)(nil).GetAll), arg0, arg1)
 }
 
+// GetFolders mocks base method.
+func (m *MockFavoriteQueries) GetFolders(arg0 context.Context, arg1 types.GetFavoriteQueryFoldersRequest) (types.FavoriteQueryFolders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolders", arg0, arg1)
+	ret0, _ := ret[0].(types.FavoriteQueryFolders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolders indicates an expected call of GetFolders.
+func (mr *MockFavoriteQueriesMockRecorder) GetFolders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolders", reflect.TypeOf((*MockFavoriteQueries)(nil).GetFolders), arg0, arg1)
+}
+
+// GetLibrary mocks base method.
+func (m *MockFavoriteQueries) GetLibrary(arg0 context.Context, arg1 types.GetFavoriteQueriesLibraryRequest) (types.FavoriteQueries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLibrary", arg0, arg1)
+	ret0, _ := ret[0].(types.FavoriteQueries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLibrary indicates an expected call of GetLibrary.
+func (mr *MockFavoriteQueriesMockRecorder) GetLibrary(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLibrary", reflect.TypeOf((*MockFavoriteQueries)(nil).GetLibrary), arg0, arg1)
+}
+
 // GetOrCreate mocks base method.
 func (m *MockFavoriteQueries) GetOrCreate(arg0 context.Context, arg1 types.GetOrCreateFavoriteQueryRequest) (int64, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +213,90 @@ func (mr *MockFavoriteQueriesMockRecorder) GetOrCreate(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreate", reflect.TypeOf((*MockFavoriteQueries)(nil).GetOrCreate), arg0, arg1)
 }
 
+// Publish mocks base method.
+func (m *MockFavoriteQueries) Publish(arg0 context.Context, arg1 types.PublishFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockFavoriteQueriesMockRecorder) Publish(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockFavoriteQueries)(nil).Publish), arg0, arg1)
+}
+
+// Reorder mocks base method.
+func (m *MockFavoriteQueries) Reorder(arg0 context.Context, arg1 types.ReorderFavoriteQueriesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockFavoriteQueriesMockRecorder) Reorder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockFavoriteQueries)(nil).Reorder), arg0, arg1)
+}
+
+// Subscribe mocks base method.
+func (m *MockFavoriteQueries) Subscribe(arg0 context.Context, arg1 types.SubscribeFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockFavoriteQueriesMockRecorder) Subscribe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockFavoriteQueries)(nil).Subscribe), arg0, arg1)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockFavoriteQueries) Unsubscribe(arg0 context.Context, arg1 types.UnsubscribeFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockFavoriteQueriesMockRecorder) Unsubscribe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockFavoriteQueries)(nil).Unsubscribe), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockFavoriteQueries) Update(arg0 context.Context, arg1 types.UpdateFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFavoriteQueriesMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFavoriteQueries)(nil).Update), arg0, arg1)
+}
+
+// UpdateFolder mocks base method.
+func (m *MockFavoriteQueries) UpdateFolder(arg0 context.Context, arg1 types.UpdateFavoriteQueryFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFolder indicates an expected call of UpdateFolder.
+func (mr *MockFavoriteQueriesMockRecorder) UpdateFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFolder", reflect.TypeOf((*MockFavoriteQueries)(nil).UpdateFolder), arg0, arg1)
+}
+
 // MockDashboards is a mock of Dashboards interface.
 type MockDashboards struct {
 	ctrl     *gomock.Controller
@@ -177,6 +336,21 @@ func (mr *MockDashboardsMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDashboards)(nil).Create), arg0, arg1)
 }
 
+// CreateFolder mocks base method.
+func (m *MockDashboards) CreateFolder(arg0 context.Context, arg1 types.CreateDashboardFolderRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockDashboardsMockRecorder) CreateFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockDashboards)(nil).CreateFolder), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *MockDashboards) Delete(arg0 context.Context, arg1 types.DeleteDashboardRequest) error {
 	m.ctrl.T.Helper()
@@ -191,6 +365,35 @@ func (mr *MockDashboardsMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDashboards)(nil).Delete), arg0, arg1)
 }
 
+// DeleteFolder mocks base method.
+func (m *MockDashboards) DeleteFolder(arg0 context.Context, arg1 types.DeleteDashboardFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockDashboardsMockRecorder) DeleteFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockDashboards)(nil).DeleteFolder), arg0, arg1)
+}
+
+// GetACL mocks base method.
+func (m *MockDashboards) GetACL(arg0 context.Context, arg1 string) (types.DashboardACL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetACL", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetACL indicates an expected call of GetACL.
+func (mr *MockDashboardsMockRecorder) GetACL(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetACL", reflect.TypeOf((*MockDashboards)(nil).GetACL), arg0, arg1)
+}
+
 // GetAll mocks base method.
 func (m *MockDashboards) GetAll(arg0 context.Context, arg1 types.GetAllDashboardsRequest) (types.DashboardInfosWithOwner, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +424,21 @@ func (mr *MockDashboardsMockRecorder) GetByUUID(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUUID", reflect.TypeOf((*MockDashboards)(nil).GetByUUID), arg0, arg1)
 }
 
+// GetFolders mocks base method.
+func (m *MockDashboards) GetFolders(arg0 context.Context) (types.DashboardFolders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolders", arg0)
+	ret0, _ := ret[0].(types.DashboardFolders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolders indicates an expected call of GetFolders.
+func (mr *MockDashboardsMockRecorder) GetFolders(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolders", reflect.TypeOf((*MockDashboards)(nil).GetFolders), arg0)
+}
+
 // GetMy mocks base method.
 func (m *MockDashboards) GetMy(arg0 context.Context, arg1 types.GetUserDashboardsRequest) (types.DashboardInfos, error) {
 	m.ctrl.T.Helper()
@@ -236,11 +454,55 @@ func (mr *MockDashboardsMockRecorder) GetMy(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMy", reflect.TypeOf((*MockDashboards)(nil).GetMy), arg0, arg1)
 }
 
+// GetVersion mocks base method.
+func (m *MockDashboards) GetVersion(arg0 context.Context, arg1 types.GetDashboardVersionRequest) (types.DashboardVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockDashboardsMockRecorder) GetVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockDashboards)(nil).GetVersion), arg0, arg1)
+}
+
+// ListVersions mocks base method.
+func (m *MockDashboards) ListVersions(arg0 context.Context, arg1 types.ListDashboardVersionsRequest) (types.DashboardVersionInfos, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardVersionInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockDashboardsMockRecorder) ListVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockDashboards)(nil).ListVersions), arg0, arg1)
+}
+
+// MarkViewed mocks base method.
+func (m *MockDashboards) MarkViewed(arg0 context.Context, arg1 types.MarkDashboardViewedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkViewed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkViewed indicates an expected call of MarkViewed.
+func (mr *MockDashboardsMockRecorder) MarkViewed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkViewed", reflect.TypeOf((*MockDashboards)(nil).MarkViewed), arg0, arg1)
+}
+
 // Search mocks base method.
-func (m *MockDashboards) Search(arg0 context.Context, arg1 types.SearchDashboardsRequest) (types.DashboardInfosWithOwner, error) {
+func (m *MockDashboards) Search(arg0 context.Context, arg1 types.SearchDashboardsRequest) (types.DashboardSearchResults, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Search", arg0, arg1)
-	ret0, _ := ret[0].(types.DashboardInfosWithOwner)
+	ret0, _ := ret[0].(types.DashboardSearchResults)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -251,6 +513,48 @@ func (mr *MockDashboardsMockRecorder) Search(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockDashboards)(nil).Search), arg0, arg1)
 }
 
+// Star mocks base method.
+func (m *MockDashboards) Star(arg0 context.Context, arg1 types.StarDashboardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Star", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Star indicates an expected call of Star.
+func (mr *MockDashboardsMockRecorder) Star(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Star", reflect.TypeOf((*MockDashboards)(nil).Star), arg0, arg1)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockDashboards) TransferOwnership(arg0 context.Context, arg1 types.TransferDashboardOwnershipRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockDashboardsMockRecorder) TransferOwnership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockDashboards)(nil).TransferOwnership), arg0, arg1)
+}
+
+// Unstar mocks base method.
+func (m *MockDashboards) Unstar(arg0 context.Context, arg1 types.StarDashboardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unstar", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unstar indicates an expected call of Unstar.
+func (mr *MockDashboardsMockRecorder) Unstar(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unstar", reflect.TypeOf((*MockDashboards)(nil).Unstar), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockDashboards) Update(arg0 context.Context, arg1 types.UpdateDashboardRequest) error {
 	m.ctrl.T.Helper()
@@ -265,6 +569,34 @@ func (mr *MockDashboardsMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDashboards)(nil).Update), arg0, arg1)
 }
 
+// UpdateFolder mocks base method.
+func (m *MockDashboards) UpdateFolder(arg0 context.Context, arg1 types.UpdateDashboardFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFolder indicates an expected call of UpdateFolder.
+func (mr *MockDashboardsMockRecorder) UpdateFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFolder", reflect.TypeOf((*MockDashboards)(nil).UpdateFolder), arg0, arg1)
+}
+
+// UpdateSharing mocks base method.
+func (m *MockDashboards) UpdateSharing(arg0 context.Context, arg1 types.UpdateDashboardSharingRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSharing", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSharing indicates an expected call of UpdateSharing.
+func (mr *MockDashboardsMockRecorder) UpdateSharing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSharing", reflect.TypeOf((*MockDashboards)(nil).UpdateSharing), arg0, arg1)
+}
+
 // MockAsyncSearches is a mock of AsyncSearches interface.
 type MockAsyncSearches struct {
 	ctrl     *gomock.Controller
@@ -360,3 +692,317 @@ func (mr *MockAsyncSearchesMockRecorder) SaveAsyncSearch(arg0, arg1 any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAsyncSearch", reflect.TypeOf((*MockAsyncSearches)(nil).SaveAsyncSearch), arg0, arg1)
 }
+
+// MockErrorGroupsSubscriptions is a mock of ErrorGroupsSubscriptions interface.
+type MockErrorGroupsSubscriptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockErrorGroupsSubscriptionsMockRecorder
+	isgomock struct{}
+}
+
+// MockErrorGroupsSubscriptionsMockRecorder is the mock recorder for MockErrorGroupsSubscriptions.
+type MockErrorGroupsSubscriptionsMockRecorder struct {
+	mock *MockErrorGroupsSubscriptions
+}
+
+// NewMockErrorGroupsSubscriptions creates a new mock instance.
+func NewMockErrorGroupsSubscriptions(ctrl *gomock.Controller) *MockErrorGroupsSubscriptions {
+	mock := &MockErrorGroupsSubscriptions{ctrl: ctrl}
+	mock.recorder = &MockErrorGroupsSubscriptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockErrorGroupsSubscriptions) EXPECT() *MockErrorGroupsSubscriptionsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockErrorGroupsSubscriptions) Create(arg0 context.Context, arg1 types.CreateErrorGroupsSubscriptionRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockErrorGroupsSubscriptionsMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockErrorGroupsSubscriptions)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockErrorGroupsSubscriptions) Delete(arg0 context.Context, arg1 types.DeleteErrorGroupsSubscriptionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockErrorGroupsSubscriptionsMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockErrorGroupsSubscriptions)(nil).Delete), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockErrorGroupsSubscriptions) GetAll(arg0 context.Context) (types.ErrorGroupsSubscriptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].(types.ErrorGroupsSubscriptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockErrorGroupsSubscriptionsMockRecorder) GetAll(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockErrorGroupsSubscriptions)(nil).GetAll), arg0)
+}
+
+// GetByProfile mocks base method.
+func (m *MockErrorGroupsSubscriptions) GetByProfile(arg0 context.Context, arg1 types.GetErrorGroupsSubscriptionsRequest) (types.ErrorGroupsSubscriptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProfile", arg0, arg1)
+	ret0, _ := ret[0].(types.ErrorGroupsSubscriptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProfile indicates an expected call of GetByProfile.
+func (mr *MockErrorGroupsSubscriptionsMockRecorder) GetByProfile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProfile", reflect.TypeOf((*MockErrorGroupsSubscriptions)(nil).GetByProfile), arg0, arg1)
+}
+
+// MarkSent mocks base method.
+func (m *MockErrorGroupsSubscriptions) MarkSent(arg0 context.Context, arg1 types.MarkErrorGroupsSubscriptionSentRequest) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockErrorGroupsSubscriptionsMockRecorder) MarkSent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockErrorGroupsSubscriptions)(nil).MarkSent), arg0, arg1)
+}
+
+// MockQueryHistory is a mock of QueryHistory interface.
+type MockQueryHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueryHistoryMockRecorder
+	isgomock struct{}
+}
+
+// MockQueryHistoryMockRecorder is the mock recorder for MockQueryHistory.
+type MockQueryHistoryMockRecorder struct {
+	mock *MockQueryHistory
+}
+
+// NewMockQueryHistory creates a new mock instance.
+func NewMockQueryHistory(ctrl *gomock.Controller) *MockQueryHistory {
+	mock := &MockQueryHistory{ctrl: ctrl}
+	mock.recorder = &MockQueryHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryHistory) EXPECT() *MockQueryHistoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockQueryHistory) Add(arg0 context.Context, arg1 types.AddQueryHistoryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockQueryHistoryMockRecorder) Add(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockQueryHistory)(nil).Add), arg0, arg1)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockQueryHistory) DeleteExpired(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockQueryHistoryMockRecorder) DeleteExpired(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockQueryHistory)(nil).DeleteExpired), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockQueryHistory) Get(arg0 context.Context, arg1 types.GetQueryHistoryRequest) (types.QueryHistoryEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(types.QueryHistoryEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockQueryHistoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockQueryHistory)(nil).Get), arg0, arg1)
+}
+
+// MockAPITokens is a mock of APITokens interface.
+type MockAPITokens struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPITokensMockRecorder
+	isgomock struct{}
+}
+
+// MockAPITokensMockRecorder is the mock recorder for MockAPITokens.
+type MockAPITokensMockRecorder struct {
+	mock *MockAPITokens
+}
+
+// NewMockAPITokens creates a new mock instance.
+func NewMockAPITokens(ctrl *gomock.Controller) *MockAPITokens {
+	mock := &MockAPITokens{ctrl: ctrl}
+	mock.recorder = &MockAPITokensMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPITokens) EXPECT() *MockAPITokensMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAPITokens) Create(arg0 context.Context, arg1 types.APIToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPITokensMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPITokens)(nil).Create), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockAPITokens) Get(arg0 context.Context, arg1 string) (types.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(types.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAPITokensMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAPITokens)(nil).Get), arg0, arg1)
+}
+
+// GetByUser mocks base method.
+func (m *MockAPITokens) GetByUser(arg0 context.Context, arg1 string) (types.APITokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", arg0, arg1)
+	ret0, _ := ret[0].(types.APITokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockAPITokensMockRecorder) GetByUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockAPITokens)(nil).GetByUser), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockAPITokens) Revoke(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPITokensMockRecorder) Revoke(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPITokens)(nil).Revoke), arg0, arg1)
+}
+
+// MockAuditLog is a mock of AuditLog interface.
+type MockAuditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogMockRecorder is the mock recorder for MockAuditLog.
+type MockAuditLogMockRecorder struct {
+	mock *MockAuditLog
+}
+
+// NewMockAuditLog creates a new mock instance.
+func NewMockAuditLog(ctrl *gomock.Controller) *MockAuditLog {
+	mock := &MockAuditLog{ctrl: ctrl}
+	mock.recorder = &MockAuditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLog) EXPECT() *MockAuditLogMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockAuditLog) Add(arg0 context.Context, arg1 types.AuditRecords) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockAuditLogMockRecorder) Add(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockAuditLog)(nil).Add), arg0, arg1)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockAuditLog) DeleteExpired(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockAuditLogMockRecorder) DeleteExpired(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockAuditLog)(nil).DeleteExpired), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockAuditLog) Get(arg0 context.Context, arg1 types.GetAuditLogRequest) (types.AuditRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(types.AuditRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAuditLogMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAuditLog)(nil).Get), arg0, arg1)
+}
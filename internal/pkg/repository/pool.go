@@ -6,17 +6,23 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/ozontech/seq-ui/metric"
 )
 
+// pgxPool is the part of *pgxpool.Pool used by repositories.
+type pgxPool interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
 type pool struct {
-	pool           *pgxpool.Pool
+	pool           pgxPool
 	requestTimeout time.Duration
 }
 
-func newPool(p *pgxpool.Pool, requestTimeout time.Duration) *pool {
+func newPool(p pgxPool, requestTimeout time.Duration) *pool {
 	return &pool{
 		pool:           p,
 		requestTimeout: requestTimeout,
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/n-r-w/squirrel"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	sqlb "github.com/ozontech/seq-ui/internal/pkg/repository/sql_builder"
+)
+
+type queryHistoryRepository struct {
+	*pool
+}
+
+func newQueryHistoryRepository(pool *pool) *queryHistoryRepository {
+	return &queryHistoryRepository{pool}
+}
+
+func (r *queryHistoryRepository) Get(
+	ctx context.Context,
+	req types.GetQueryHistoryRequest,
+) (types.QueryHistoryEntries, error) {
+	query, args := queryHistorySelectQuery(req)
+
+	metricLabels := []string{"query_history", "SELECT"}
+	rows, err := r.query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get query history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := types.QueryHistoryEntries{}
+	for rows.Next() {
+		var (
+			e          types.QueryHistoryEntry
+			durationMs int64
+		)
+		if err = rows.Scan(
+			&e.ID,
+			&e.ProfileID,
+			&e.Kind,
+			&e.Query,
+			&e.From,
+			&e.To,
+			&e.Env,
+			&durationMs,
+			&e.Total,
+			&e.ErrorCode,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		e.Duration = time.Duration(durationMs) * time.Millisecond
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func queryHistorySelectQuery(req types.GetQueryHistoryRequest) (string, []any) {
+	qb := sqlb.Select(
+		"id", "profile_id", "kind", "query", `"from"`, `"to"`,
+		"env", "duration_ms", "total", "error_code", "created_at",
+	).
+		From("query_history").
+		Where(sq.Eq{"profile_id": req.ProfileID}).
+		OrderBy("created_at DESC", "id DESC")
+
+	// each word must be found in the query
+	if req.Query != nil {
+		for _, word := range strings.Fields(*req.Query) {
+			qb = qb.Where(sq.ILike{"query": fmt.Sprint("%", word, "%")})
+		}
+	}
+	if req.Limit > 0 {
+		qb = qb.Limit(uint64(req.Limit))
+	}
+	if req.Offset > 0 {
+		qb = qb.Offset(uint64(req.Offset))
+	}
+
+	return qb.MustSql()
+}
+
+// Add inserts the entries and removes the oldest entries of their profiles over the limit.
+func (r *queryHistoryRepository) Add(ctx context.Context, req types.AddQueryHistoryRequest) error {
+	if len(req.Entries) == 0 {
+		return nil
+	}
+
+	qb := sqlb.Insert("query_history").
+		Columns("profile_id", "kind", "query", `"from"`, `"to"`, "env", "duration_ms", "total", "error_code", "created_at")
+
+	profileIDs := make([]int64, 0, len(req.Entries))
+	seen := make(map[int64]struct{}, len(req.Entries))
+	for _, e := range req.Entries {
+		qb = qb.Values(
+			e.ProfileID, e.Kind, e.Query, e.From, e.To, e.Env,
+			e.Duration.Milliseconds(), e.Total, e.ErrorCode, e.CreatedAt,
+		)
+		if _, ok := seen[e.ProfileID]; !ok {
+			seen[e.ProfileID] = struct{}{}
+			profileIDs = append(profileIDs, e.ProfileID)
+		}
+	}
+
+	query, args := qb.MustSql()
+
+	metricLabels := []string{"query_history", "INSERT"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to add query history: %w", err)
+	}
+
+	query, args = `
+		DELETE FROM query_history WHERE id IN (
+			SELECT id FROM (
+				SELECT id, row_number() OVER (PARTITION BY profile_id ORDER BY created_at DESC, id DESC) AS n
+				FROM query_history
+				WHERE profile_id = ANY($1)
+			) AS h
+			WHERE h.n > $2
+		)`,
+		[]any{profileIDs, req.MaxEntriesPerUser}
+
+	metricLabels = []string{"query_history", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to trim query history: %w", err)
+	}
+
+	return nil
+}
+
+func (r *queryHistoryRepository) DeleteExpired(ctx context.Context, before time.Time) error {
+	query, args := "DELETE FROM query_history WHERE created_at < $1",
+		[]any{before}
+
+	metricLabels := []string{"query_history", "DELETE"}
+	if _, err := r.exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to delete expired query history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestQueryHistorySelectQuery(t *testing.T) {
+	filter := "Level:Error  service"
+	query, args := queryHistorySelectQuery(types.GetQueryHistoryRequest{
+		ProfileID: 1,
+		Query:     &filter,
+		Limit:     10,
+		Offset:    20,
+	})
+
+	require.Equal(t,
+		`SELECT id, profile_id, kind, query, "from", "to", env, duration_ms, total, error_code, created_at`+
+			" FROM query_history WHERE profile_id = $1 AND query ILIKE $2 AND query ILIKE $3"+
+			" ORDER BY created_at DESC, id DESC LIMIT 10 OFFSET 20",
+		query,
+	)
+	require.Equal(t, []any{int64(1), "%Level:Error%", "%service%"}, args)
+}
@@ -13,12 +13,23 @@ type (
 	UserProfiles interface {
 		GetOrCreate(context.Context, types.GetOrCreateUserProfileRequest) (types.UserProfile, error)
 		Update(context.Context, types.UpdateUserProfileRequest) error
+		UpdatePreferences(context.Context, types.UpdateUserPreferencesRequest) (types.UserPreferences, error)
 	}
 
 	FavoriteQueries interface {
 		GetAll(context.Context, types.GetFavoriteQueriesRequest) (types.FavoriteQueries, error)
 		GetOrCreate(context.Context, types.GetOrCreateFavoriteQueryRequest) (int64, error)
+		Update(context.Context, types.UpdateFavoriteQueryRequest) error
 		Delete(context.Context, types.DeleteFavoriteQueryRequest) error
+		Reorder(context.Context, types.ReorderFavoriteQueriesRequest) error
+		GetFolders(context.Context, types.GetFavoriteQueryFoldersRequest) (types.FavoriteQueryFolders, error)
+		CreateFolder(context.Context, types.CreateFavoriteQueryFolderRequest) (int64, error)
+		UpdateFolder(context.Context, types.UpdateFavoriteQueryFolderRequest) error
+		DeleteFolder(context.Context, types.DeleteFavoriteQueryFolderRequest) error
+		Publish(context.Context, types.PublishFavoriteQueryRequest) error
+		GetLibrary(context.Context, types.GetFavoriteQueriesLibraryRequest) (types.FavoriteQueries, error)
+		Subscribe(context.Context, types.SubscribeFavoriteQueryRequest) error
+		Unsubscribe(context.Context, types.UnsubscribeFavoriteQueryRequest) error
 	}
 
 	Dashboards interface {
@@ -28,7 +39,19 @@ type (
 		Create(context.Context, types.CreateDashboardRequest) (string, error)
 		Update(context.Context, types.UpdateDashboardRequest) error
 		Delete(context.Context, types.DeleteDashboardRequest) error
-		Search(context.Context, types.SearchDashboardsRequest) (types.DashboardInfosWithOwner, error)
+		Search(context.Context, types.SearchDashboardsRequest) (types.DashboardSearchResults, error)
+		ListVersions(context.Context, types.ListDashboardVersionsRequest) (types.DashboardVersionInfos, error)
+		GetVersion(context.Context, types.GetDashboardVersionRequest) (types.DashboardVersion, error)
+		GetACL(context.Context, string) (types.DashboardACL, error)
+		UpdateSharing(context.Context, types.UpdateDashboardSharingRequest) error
+		TransferOwnership(context.Context, types.TransferDashboardOwnershipRequest) error
+		Star(context.Context, types.StarDashboardRequest) error
+		Unstar(context.Context, types.StarDashboardRequest) error
+		MarkViewed(context.Context, types.MarkDashboardViewedRequest) error
+		GetFolders(context.Context) (types.DashboardFolders, error)
+		CreateFolder(context.Context, types.CreateDashboardFolderRequest) (int64, error)
+		UpdateFolder(context.Context, types.UpdateDashboardFolderRequest) error
+		DeleteFolder(context.Context, types.DeleteDashboardFolderRequest) error
 	}
 
 	AsyncSearches interface {
@@ -38,6 +61,33 @@ type (
 		DeleteExpiredAsyncSearches(context.Context) error
 		GetAsyncSearchesList(context.Context, types.GetAsyncSearchesListRequest) ([]types.AsyncSearchInfo, error)
 	}
+
+	ErrorGroupsSubscriptions interface {
+		GetAll(context.Context) (types.ErrorGroupsSubscriptions, error)
+		GetByProfile(context.Context, types.GetErrorGroupsSubscriptionsRequest) (types.ErrorGroupsSubscriptions, error)
+		Create(context.Context, types.CreateErrorGroupsSubscriptionRequest) (int64, error)
+		Delete(context.Context, types.DeleteErrorGroupsSubscriptionRequest) error
+		MarkSent(context.Context, types.MarkErrorGroupsSubscriptionSentRequest) (bool, error)
+	}
+
+	QueryHistory interface {
+		Get(context.Context, types.GetQueryHistoryRequest) (types.QueryHistoryEntries, error)
+		Add(context.Context, types.AddQueryHistoryRequest) error
+		DeleteExpired(context.Context, time.Time) error
+	}
+
+	APITokens interface {
+		Create(context.Context, types.APIToken) error
+		Get(context.Context, string) (types.APIToken, error)
+		GetByUser(context.Context, string) (types.APITokens, error)
+		Revoke(context.Context, string) error
+	}
+
+	AuditLog interface {
+		Get(context.Context, types.GetAuditLogRequest) (types.AuditRecords, error)
+		Add(context.Context, types.AuditRecords) error
+		DeleteExpired(context.Context, time.Time) error
+	}
 )
 
 type Repository struct {
@@ -45,6 +95,10 @@ type Repository struct {
 	FavoriteQueries
 	Dashboards
 	AsyncSearches
+	ErrorGroupsSubscriptions
+	QueryHistory
+	APITokens
+	AuditLog
 }
 
 func New(pool *pgxpool.Pool, requestTimeout time.Duration) *Repository {
@@ -54,5 +108,10 @@ func New(pool *pgxpool.Pool, requestTimeout time.Duration) *Repository {
 		FavoriteQueries: newFavoriteQueriesRepository(p),
 		Dashboards:      newDashboardsRepository(p),
 		AsyncSearches:   newAsyncSearchesRepository(p),
+
+		ErrorGroupsSubscriptions: newErrorGroupsSubscriptionsRepository(p),
+		QueryHistory:             newQueryHistoryRepository(p),
+		APITokens:                newAPITokensRepository(p),
+		AuditLog:                 newAuditLogRepository(p),
 	}
 }
@@ -26,22 +26,24 @@ func (r *userProfilesRepository) GetOrCreate(ctx context.Context, req types.GetO
 		UserName: req.UserName,
 	}
 
-	query, args := "SELECT id, timezone, onboarding_version, log_columns FROM user_profiles WHERE user_name = $1 LIMIT 1",
+	query, args := "SELECT id, onboarding_version, preferences FROM user_profiles WHERE user_name = $1 LIMIT 1",
 		[]any{req.UserName}
 
 	metricLabelsSelect := []string{"user_profiles", "SELECT"}
-	var logColumns string
+	var preferences []byte
 	err := r.queryRow(ctx, metricLabelsSelect, query, args...).Scan(
 		&userProfile.ID,
-		&userProfile.Timezone,
 		&userProfile.OnboardingVersion,
-		&logColumns,
+		&preferences,
 	)
 
 	// create user profile if it doesn't exist
 	if errors.Is(err, pgx.ErrNoRows) {
-		query, args = "INSERT INTO user_profiles (user_name,timezone,onboarding_version,log_columns) VALUES ($1,$2,$3,$4) RETURNING id",
-			[]any{req.UserName, "", "", "[]"}
+		userProfile.Preferences = types.UserPreferences{Version: types.UserPreferencesVersion}
+		preferences, _ = json.Marshal(userProfile.Preferences)
+
+		query, args = "INSERT INTO user_profiles (user_name,onboarding_version,preferences) VALUES ($1,$2,$3) RETURNING id",
+			[]any{req.UserName, "", preferences}
 
 		metricLabelsInsert := []string{"user_profiles", "INSERT"}
 		if err = r.queryRow(ctx, metricLabelsInsert, query, args...).Scan(&userProfile.ID); err != nil {
@@ -55,9 +57,8 @@ func (r *userProfilesRepository) GetOrCreate(ctx context.Context, req types.GetO
 		return userProfile, fmt.Errorf("failed to get user profile: %w", err)
 	}
 
-	err = json.Unmarshal([]byte(logColumns), &userProfile.LogColumns.LogColumns)
-	if err != nil {
-		return userProfile, fmt.Errorf("failed to parse log columns: %w", err)
+	if err = json.Unmarshal(preferences, &userProfile.Preferences); err != nil {
+		return userProfile, fmt.Errorf("failed to parse preferences: %w", err)
 	}
 
 	return userProfile, nil
@@ -68,15 +69,21 @@ func (r *userProfilesRepository) Update(ctx context.Context, req types.UpdateUse
 		Where(sq.Eq{
 			"user_name": req.UserName,
 		})
-	if req.Timezone != nil {
-		qb = qb.Set("timezone", *req.Timezone)
-	}
 	if req.OnboardingVersion != nil {
 		qb = qb.Set("onboarding_version", *req.OnboardingVersion)
 	}
+
+	patch := map[string]any{}
+	if req.Timezone != nil {
+		patch["timezone"] = *req.Timezone
+	}
 	if req.LogColumns != nil {
-		logColumns, _ := json.Marshal(req.LogColumns.LogColumns)
-		qb = qb.Set("log_columns", logColumns)
+		patch["log_columns"] = req.LogColumns.LogColumns
+	}
+	if len(patch) > 0 {
+		patch["version"] = types.UserPreferencesVersion
+		patchRaw, _ := json.Marshal(patch)
+		qb = qb.Set("preferences", sq.Expr("preferences || ?::jsonb", patchRaw))
 	}
 
 	query, args := qb.MustSql()
@@ -96,3 +103,87 @@ func (r *userProfilesRepository) Update(ctx context.Context, req types.UpdateUse
 
 	return nil
 }
+
+// UpdatePreferences merges fields of the request listed in the paths into the stored preferences
+// and returns the updated preferences.
+func (r *userProfilesRepository) UpdatePreferences(
+	ctx context.Context,
+	req types.UpdateUserPreferencesRequest,
+) (types.UserPreferences, error) {
+	var prefs types.UserPreferences
+
+	query, args, err := updatePreferencesQuery(req)
+	if err != nil {
+		return prefs, err
+	}
+
+	metricLabels := []string{"user_profiles", "UPDATE"}
+	var preferences []byte
+	err = r.queryRow(ctx, metricLabels, query, args...).Scan(&preferences)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = types.NewErrNotFound("user")
+	} else if err != nil {
+		err = fmt.Errorf("failed to update user preferences: %w", err)
+	}
+
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return prefs, err
+	}
+
+	if err = json.Unmarshal(preferences, &prefs); err != nil {
+		return prefs, fmt.Errorf("failed to parse preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+func updatePreferencesQuery(req types.UpdateUserPreferencesRequest) (string, []any, error) {
+	version, _ := json.Marshal(map[string]any{"version": types.UserPreferencesVersion})
+
+	if req.Env == "" {
+		patch, err := preferencesPatch(req.Preferences, req.Paths)
+		if err != nil {
+			return "", nil, err
+		}
+		return `UPDATE user_profiles SET preferences = preferences || $1::jsonb || $2::jsonb
+			WHERE user_name = $3 RETURNING preferences`,
+			[]any{version, patch, req.UserName}, nil
+	}
+
+	patch, err := preferencesPatch(req.Preferences.Envs[req.Env], req.Paths)
+	if err != nil {
+		return "", nil, err
+	}
+	return `UPDATE user_profiles SET preferences = jsonb_set(
+				preferences || $1::jsonb,
+				'{envs}',
+				COALESCE(NULLIF(preferences->'envs', 'null'::jsonb), '{}'::jsonb) || jsonb_build_object(
+					$2::text, COALESCE(preferences->'envs'->$2::text, '{}'::jsonb) || $3::jsonb
+				)
+			)
+			WHERE user_name = $4 RETURNING preferences`,
+		[]any{version, req.Env, patch, req.UserName}, nil
+}
+
+// preferencesPatch returns JSON object with the fields of v named by the paths.
+func preferencesPatch(v any, paths []string) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal preferences: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal preferences: %w", err)
+	}
+
+	patch := make(map[string]json.RawMessage, len(paths))
+	for _, p := range paths {
+		f, ok := fields[p]
+		if !ok {
+			return nil, fmt.Errorf("unknown preferences field %q", p)
+		}
+		patch[p] = f
+	}
+	return json.Marshal(patch)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestPreferencesPatch(t *testing.T) {
+	prefs := types.UserPreferences{
+		Timezone:   "UTC",
+		PageSize:   100,
+		Theme:      types.ThemeDark,
+		LogColumns: []string{"message"},
+	}
+
+	patch, err := preferencesPatch(prefs, []string{"page_size", "theme", "pinned_fields"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"page_size":100,"theme":"dark","pinned_fields":null}`, string(patch))
+
+	_, err = preferencesPatch(prefs, []string{"unknown"})
+	require.Error(t, err)
+}
+
+func TestUpdatePreferencesQuery(t *testing.T) {
+	t.Run("global", func(t *testing.T) {
+		query, args, err := updatePreferencesQuery(types.UpdateUserPreferencesRequest{
+			UserName:    "user",
+			Preferences: types.UserPreferences{DefaultEnv: "prod"},
+			Paths:       []string{"default_env"},
+		})
+		require.NoError(t, err)
+		require.Contains(t, query, "preferences = preferences || $1::jsonb || $2::jsonb")
+		require.Len(t, args, 3)
+		require.JSONEq(t, `{"version":1}`, string(args[0].([]byte)))
+		require.JSONEq(t, `{"default_env":"prod"}`, string(args[1].([]byte)))
+		require.Equal(t, "user", args[2])
+	})
+
+	t.Run("env", func(t *testing.T) {
+		query, args, err := updatePreferencesQuery(types.UpdateUserPreferencesRequest{
+			UserName: "user",
+			Env:      "prod",
+			Preferences: types.UserPreferences{
+				Envs: map[string]types.EnvPreferences{
+					"prod": {LogColumns: []string{"message", "level"}},
+				},
+			},
+			Paths: []string{"log_columns"},
+		})
+		require.NoError(t, err)
+		require.Contains(t, query, "'{envs}'")
+		require.Len(t, args, 4)
+		require.JSONEq(t, `{"version":1}`, string(args[0].([]byte)))
+		require.Equal(t, "prod", args[1])
+		require.JSONEq(t, `{"log_columns":["message","level"]}`, string(args[2].([]byte)))
+		require.Equal(t, "user", args[3])
+	})
+}
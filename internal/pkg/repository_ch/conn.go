@@ -38,3 +38,13 @@ func (c *conn) QueryRow(ctx context.Context, metricLabels []string, query string
 
 	return row
 }
+
+func (c *conn) Exec(ctx context.Context, metricLabels []string, query string, args ...any) error {
+	metric.ClickHouseRequestSent.WithLabelValues(metricLabels...).Inc()
+	start := time.Now()
+	err := c.conn.Exec(ctx, query, args...)
+	took := time.Since(start)
+	metric.ClickHouseRequestDuration.WithLabelValues(metricLabels...).Observe(took.Seconds())
+
+	return err
+}
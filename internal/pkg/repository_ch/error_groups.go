@@ -17,6 +17,8 @@ func (r *repository) GetErrorGroups(
 	ctx context.Context,
 	req types.GetErrorGroupsRequest,
 ) ([]types.ErrorGroup, error) {
+	r.syncMerges(ctx)
+
 	where := sq.Eq{
 		"service": req.Service,
 	}
@@ -179,6 +181,8 @@ func (r *repository) GetErrorGroupsTotal(
 	ctx context.Context,
 	req types.GetErrorGroupsRequest,
 ) (uint64, error) {
+	r.syncMerges(ctx)
+
 	where := sq.Eq{
 		"service": req.Service,
 	}
@@ -206,6 +210,8 @@ func (r *repository) GetNewErrorGroups(
 	ctx context.Context,
 	req types.GetErrorGroupsRequest,
 ) ([]types.ErrorGroup, error) {
+	r.syncMerges(ctx)
+
 	where := sq.Eq{
 		"service": req.Service,
 	}
@@ -271,9 +277,11 @@ func (r *repository) GetNewErrorGroupsTotal(
 	ctx context.Context,
 	req types.GetErrorGroupsRequest,
 ) (uint64, error) {
+	r.syncMerges(ctx)
+
 	subQ := sq.
 		Select("_group_hash").
-		From("error_groups").
+		From(r.table("error_groups")).
 		Where(sq.Eq{"service": req.Service}).
 		GroupBy("_group_hash")
 
@@ -318,10 +326,15 @@ func (r *repository) GetTopErrorGroups(
 	ctx context.Context,
 	req types.GetTopErrorGroupsRequest,
 ) ([]types.TopErrorGroup, error) {
+	r.syncMerges(ctx)
+
 	where := sq.Eq{}
 	for col, val := range r.queryFilters() {
 		where[col] = val
 	}
+	if req.Service != nil && *req.Service != "" {
+		where["service"] = *req.Service
+	}
 	if req.Env != nil && *req.Env != "" {
 		where["env"] = *req.Env
 	}
@@ -414,10 +427,15 @@ func (r *repository) GetTopErrorGroupsTotal(
 	ctx context.Context,
 	req types.GetTopErrorGroupsRequest,
 ) (uint64, error) {
+	r.syncMerges(ctx)
+
 	where := sq.Eq{}
 	for col, val := range r.queryFilters() {
 		where[col] = val
 	}
+	if req.Service != nil && *req.Service != "" {
+		where["service"] = *req.Service
+	}
 	if req.Env != nil && *req.Env != "" {
 		where["env"] = *req.Env
 	}
@@ -436,22 +454,34 @@ func (r *repository) GetErrorHist(
 	ctx context.Context,
 	req types.GetErrorHistRequest,
 ) (types.ErrorHist, error) {
+	r.syncMerges(ctx)
+
 	histData := r.getHistData(req.TimeRange)
+	if req.Interval != 0 {
+		if data, ok := getHistDataByInterval(req.Interval); ok {
+			histData = data
+		}
+	}
 
 	q := sq.
 		Select(
 			histData.column,
 			"countMerge(counts) as counts",
 		).
-		From(histData.table).
+		From(r.table(histData.table)).
 		GroupBy(histData.column).
 		OrderBy(histData.column)
 
+	groupColumn := histGroupColumn(req.GroupBy)
+	if groupColumn != "" {
+		q = q.Column(groupColumn).GroupBy(groupColumn)
+	}
+
 	for col, val := range r.queryFilters() {
 		q = q.Where(sq.Eq{col: val})
 	}
 	if req.GroupHash != nil && *req.GroupHash != 0 {
-		q = q.Where(sq.Eq{"_group_hash": *req.GroupHash})
+		q = q.Where(sq.Eq{"_group_hash": r.groupHash(*req.GroupHash)})
 	}
 	if req.Env != nil && *req.Env != "" {
 		q = q.Where(sq.Eq{"env": *req.Env})
@@ -470,35 +500,45 @@ func (r *repository) GetErrorHist(
 	}
 
 	query, args := q.MustSql()
-	metricLabels := []string{"agg_events_10min", "SELECT"}
+	metricLabels := []string{histData.table, "SELECT"}
 	rows, err := r.conn.Query(ctx, metricLabels, query, args...)
 	if err != nil {
 		incErrorMetric(err, metricLabels)
 		return types.ErrorHist{}, fmt.Errorf("failed to get error hist: %w", err)
 	}
 
-	var buckets []types.ErrorHistBucket
+	hist := types.ErrorHist{
+		Interval: histData.interval,
+	}
 	for rows.Next() {
-		var bucket types.ErrorHistBucket
-		if err := rows.Scan(
-			&bucket.Time,
-			&bucket.Count,
-		); err != nil {
+		var (
+			bucket types.ErrorHistBucket
+			key    string
+		)
+		dest := []any{&bucket.Time, &bucket.Count}
+		if groupColumn != "" {
+			dest = append(dest, &key)
+		}
+		if err := rows.Scan(dest...); err != nil {
 			return types.ErrorHist{}, fmt.Errorf("failed to scan row: %w", err)
 		}
-		buckets = append(buckets, bucket)
+
+		if groupColumn != "" {
+			hist.AddSeriesBucket(key, bucket)
+		} else {
+			hist.Buckets = append(hist.Buckets, bucket)
+		}
 	}
 
-	return types.ErrorHist{
-		Buckets:  buckets,
-		Interval: histData.interval,
-	}, nil
+	return hist, nil
 }
 
 func (r *repository) GetErrorDetails(
 	ctx context.Context,
 	req types.GetErrorGroupDetailsRequest,
 ) (types.ErrorGroupDetails, error) {
+	r.syncMerges(ctx)
+
 	q := sq.
 		Select(
 			"_group_hash",
@@ -509,8 +549,8 @@ func (r *repository) GetErrorDetails(
 			"maxMerge(last_seen_at) as last_seen_at",
 			"max(log_tags) as log_tags",
 		).
-		From("error_groups").
-		Where(sq.Eq{"_group_hash": req.GroupHash}).
+		From(r.table("error_groups")).
+		Where(sq.Eq{"_group_hash": r.groupHash(req.GroupHash)}).
 		GroupBy("_group_hash", "source")
 
 	for col, val := range r.queryFilters() {
@@ -562,6 +602,8 @@ func (r *repository) GetErrorCounts(
 	ctx context.Context,
 	req types.GetErrorGroupDetailsRequest,
 ) (types.ErrorGroupCounts, error) {
+	r.syncMerges(ctx)
+
 	counts := types.ErrorGroupCounts{
 		ByEnv:     types.ErrorGroupCount{},
 		BySource:  types.ErrorGroupCount{},
@@ -576,8 +618,8 @@ func (r *repository) GetErrorCounts(
 			"source",
 			"service",
 		).
-		From("error_groups").
-		Where(sq.Eq{"_group_hash": req.GroupHash}).
+		From(r.table("error_groups")).
+		Where(sq.Eq{"_group_hash": r.groupHash(req.GroupHash)}).
 		GroupBy("env", "source", "service")
 
 	for col, val := range r.queryFilters() {
@@ -716,6 +758,8 @@ func (r *repository) DiffByReleases(
 	ctx context.Context,
 	req types.DiffByReleasesRequest,
 ) ([]types.DiffGroup, error) {
+	r.syncMerges(ctx)
+
 	where := sq.Eq{
 		"service": req.Service,
 		"release": req.Releases,
@@ -738,7 +782,7 @@ func (r *repository) DiffByReleases(
 			"minMerge(first_seen_at) as first_seen_at",
 			"maxMerge(last_seen_at) as last_seen_at",
 		).
-		From("error_groups").
+		From(r.table("error_groups")).
 		Where(where).
 		GroupBy("_group_hash", "source").
 		Limit(uint64(req.Limit)).
@@ -800,7 +844,7 @@ func (r *repository) DiffByReleases(
 			"release",
 			"countMerge(seen_total) as seen_total",
 		).
-		From("error_groups").
+		From(r.table("error_groups")).
 		Where(where).
 		GroupBy("_group_hash", "release")
 
@@ -836,6 +880,8 @@ func (r *repository) DiffByReleasesTotal(
 	ctx context.Context,
 	req types.DiffByReleasesRequest,
 ) (uint64, error) {
+	r.syncMerges(ctx)
+
 	where := sq.Eq{
 		"service": req.Service,
 		"release": req.Releases,
@@ -856,6 +902,68 @@ func (r *repository) DiffByReleasesTotal(
 	})
 }
 
+func (r *repository) GetReleasesGroupCounts(
+	ctx context.Context,
+	req types.CompareReleasesRequest,
+) ([]types.ReleaseGroupCount, error) {
+	r.syncMerges(ctx)
+
+	where := sq.Eq{
+		"service": req.Service,
+		"release": []string{req.BaselineRelease, req.CandidateRelease},
+	}
+	for col, val := range r.queryFilters() {
+		where[col] = val
+	}
+	if req.Env != nil && *req.Env != "" {
+		where["env"] = *req.Env
+	}
+	if req.Source != nil && *req.Source != "" {
+		where["source"] = *req.Source
+	}
+
+	q := sq.
+		Select(
+			"_group_hash",
+			"source",
+			"release",
+			"any(message) as message",
+			"countMerge(seen_total) as seen_total",
+			"minMerge(first_seen_at) as first_seen_at",
+			"maxMerge(last_seen_at) as last_seen_at",
+		).
+		From(r.table("error_groups")).
+		Where(where).
+		GroupBy("_group_hash", "source", "release")
+
+	query, args := q.MustSql()
+	metricLabels := []string{"error_groups", "SELECT"}
+	rows, err := r.conn.Query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups by releases: %w", err)
+	}
+
+	var counts []types.ReleaseGroupCount
+	for rows.Next() {
+		var c types.ReleaseGroupCount
+		if err := rows.Scan(
+			&c.Hash,
+			&c.Source,
+			&c.Release,
+			&c.Message,
+			&c.SeenTotal,
+			&c.FirstSeenAt,
+			&c.LastSeenAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		counts = append(counts, c)
+	}
+
+	return counts, nil
+}
+
 type getHashSubQueryParams struct {
 	table   string
 	where   sq.Eq
@@ -868,7 +976,7 @@ type getHashSubQueryParams struct {
 func (r *repository) getHashSubQuery(params getHashSubQueryParams) sq.SelectBuilder {
 	subQ := sq.
 		Select("_group_hash").
-		From(params.table).
+		From(r.table(params.table)).
 		Where(params.where).
 		GroupBy("_group_hash").
 		OrderBy(params.orderBy).
@@ -909,7 +1017,7 @@ func (r *repository) getTotal(
 		table = params.table
 	}
 
-	query, args := q.From(table).MustSql()
+	query, args := q.From(r.table(table)).MustSql()
 	metricLabels := []string{table, "SELECT"}
 	row := r.conn.QueryRow(ctx, metricLabels, query, args...)
 
@@ -965,7 +1073,7 @@ func (r *repository) getErrorInfos(
 ) (errorInfos, error) {
 	q := sq.
 		Select(params.columns...).
-		From("error_groups").
+		From(r.table("error_groups")).
 		Where(params.where).
 		GroupBy("_group_hash", "source")
 
@@ -1039,7 +1147,7 @@ func (r *repository) getErrorCounts(
 			"_group_hash",
 			"countMerge(counts) as count",
 		).
-		From(histData.table).
+		From(r.table(histData.table)).
 		Where(params.where).
 		Where(r.timeRangeCond(histData.column, params.tr)).
 		GroupBy("_group_hash")
@@ -1147,6 +1255,47 @@ func (r *repository) getHistData(tr *types.TimeRange) histData {
 	}
 }
 
+// getHistDataByInterval returns hist data of the rollup with requested interval.
+func getHistDataByInterval(interval time.Duration) (histData, bool) {
+	const (
+		table_1min  = "agg_events_1min"
+		table_10min = "agg_events_10min"
+		table_1h    = "agg_events_1h"
+		table_1d    = "agg_events_1d"
+
+		startDate   = "start_date"
+		startOfWeek = "toStartOfWeek(start_date)"
+	)
+
+	data := func(table, column string) (histData, bool) {
+		return histData{table: table, column: column, interval: uint64(interval.Seconds())}, true
+	}
+
+	switch interval {
+	case types.HistIntervalMinute:
+		return data(table_1min, startDate)
+	case types.HistInterval10Minutes:
+		return data(table_10min, startDate)
+	case types.HistIntervalHour:
+		return data(table_1h, startDate)
+	case types.HistIntervalDay:
+		return data(table_1d, startDate)
+	case types.HistIntervalWeek:
+		return data(table_1d, startOfWeek)
+	}
+	return histData{}, false
+}
+
+func histGroupColumn(groupBy types.ErrorHistGroupBy) string {
+	switch groupBy {
+	case types.HistGroupByRelease:
+		return "release"
+	case types.HistGroupByEnv:
+		return "env"
+	}
+	return ""
+}
+
 func (r *repository) timeRangeCond(column string, tr *types.TimeRange) any {
 	if tr.IsEmpty() {
 		return nil
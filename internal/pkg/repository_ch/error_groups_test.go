@@ -1534,8 +1534,9 @@ func TestGetTopErrorGroups(t *testing.T) {
 
 func TestGetTopErrorGroupsTotal(t *testing.T) {
 	var (
-		env    = "test-env"
-		source = "test-source"
+		service = "test-service"
+		env     = "test-env"
+		source  = "test-source"
 
 		fakeNow  = fakeNow(time.Now())
 		duration = time.Hour * 24
@@ -1624,6 +1625,24 @@ func TestGetTopErrorGroupsTotal(t *testing.T) {
 				args: []any{env, "value1", "value2", source},
 			},
 		},
+		{
+			name: "ok_service",
+
+			req: types.GetTopErrorGroupsRequest{
+				Service: &service,
+				TimeRange: &types.TimeRange{
+					Duration: duration,
+				},
+			},
+
+			mockConn: &mockConnRow{
+				query: "SELECT uniq(_group_hash)" +
+					" FROM agg_events_10min" +
+					" WHERE service = ? AND toStartOfHour(start_date) >= ?",
+
+				args: []any{service, timeDiff},
+			},
+		},
 		{
 			name: "ok_no_rows",
 
@@ -2062,6 +2081,119 @@ func TestDiffByReleasesTotal(t *testing.T) {
 	}
 }
 
+func TestGetReleasesGroupCounts(t *testing.T) {
+	var (
+		service   = "test-svc"
+		baseline  = "test-release1"
+		candidate = "test-release2"
+		env       = "test-env"
+		source    = "test-source"
+
+		someErr = errors.New("some err")
+	)
+
+	tests := []struct {
+		name string
+
+		req       types.CompareReleasesRequest
+		wantCount int
+		wantErr   bool
+
+		queryFilter map[string]string
+
+		mockConn *mockConnRows
+	}{
+		{
+			name: "ok",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+			},
+			wantCount: 3,
+
+			mockConn: &mockConnRows{
+				query: "" +
+					"SELECT _group_hash, source, release, any(message) as message, countMerge(seen_total) as seen_total, minMerge(first_seen_at) as first_seen_at, maxMerge(last_seen_at) as last_seen_at" +
+					" FROM error_groups" +
+					" WHERE release IN (?,?) AND service = ?" +
+					" GROUP BY _group_hash, source, release",
+				args: []any{baseline, candidate, service},
+
+				rows: &mockRowsCount{
+					count: 3,
+				},
+			},
+		},
+		{
+			name: "ok_full_filters",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+				Env:              &env,
+				Source:           &source,
+			},
+			wantCount: 1,
+
+			queryFilter: map[string]string{
+				"filter1": "value1",
+				"filter2": "value2",
+			},
+
+			mockConn: &mockConnRows{
+				query: "" +
+					"SELECT _group_hash, source, release, any(message) as message, countMerge(seen_total) as seen_total, minMerge(first_seen_at) as first_seen_at, maxMerge(last_seen_at) as last_seen_at" +
+					" FROM error_groups" +
+					" WHERE env = ? AND filter1 = ? AND filter2 = ? AND release IN (?,?) AND service = ? AND source = ?" +
+					" GROUP BY _group_hash, source, release",
+				args: []any{env, "value1", "value2", baseline, candidate, service, source},
+
+				rows: &mockRowsCount{
+					count: 1,
+				},
+			},
+		},
+		{
+			name: "err_query",
+
+			req:     types.CompareReleasesRequest{},
+			wantErr: true,
+
+			mockConn: &mockConnRows{
+				err: someErr,
+			},
+		},
+		{
+			name: "err_scan",
+
+			req:     types.CompareReleasesRequest{},
+			wantErr: true,
+
+			mockConn: &mockConnRows{
+				rows: &mockRowsCount{
+					scanErr: someErr,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockedConn := initMockConnRows(t, tt.mockConn)
+			repo := newRepo(mockedConn, true, tt.queryFilter, time.Now)
+
+			got, err := repo.GetReleasesGroupCounts(context.Background(), tt.req)
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.wantCount, len(got))
+		})
+	}
+}
+
 func TestGetErrorHist(t *testing.T) {
 	var (
 		groupHash = uint64(123)
@@ -2082,6 +2214,7 @@ func TestGetErrorHist(t *testing.T) {
 
 		req              types.GetErrorHistRequest
 		wantBucketsCount int
+		wantSeriesCount  int
 		wantErr          bool
 
 		queryFilter map[string]string
@@ -2190,6 +2323,58 @@ func TestGetErrorHist(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ok_interval",
+
+			req: types.GetErrorHistRequest{
+				TimeRange: &types.TimeRange{
+					Duration: duration,
+				},
+				Interval: types.HistIntervalMinute,
+			},
+			wantBucketsCount: 2,
+
+			mockConn: &mockConnRows{
+				query: "" +
+					"SELECT start_date, countMerge(counts) as counts" +
+					" FROM agg_events_1min" +
+					" WHERE start_date >= ?" +
+					" GROUP BY start_date" +
+					" ORDER BY start_date",
+				args: []any{timeDiff},
+
+				rows: &mockRowsCount{
+					count: 2,
+				},
+			},
+		},
+		{
+			name: "ok_group_by_release",
+
+			req: types.GetErrorHistRequest{
+				Service: &service,
+				TimeRange: &types.TimeRange{
+					Duration: 30 * 24 * time.Hour,
+				},
+				Interval: types.HistIntervalWeek,
+				GroupBy:  types.HistGroupByRelease,
+			},
+			wantSeriesCount: 1,
+
+			mockConn: &mockConnRows{
+				query: "" +
+					"SELECT toStartOfWeek(start_date), countMerge(counts) as counts, release" +
+					" FROM agg_events_1d" +
+					" WHERE service = ? AND toStartOfWeek(start_date) >= ?" +
+					" GROUP BY toStartOfWeek(start_date), release" +
+					" ORDER BY toStartOfWeek(start_date)",
+				args: []any{service, fakeNow().Add(-30 * 24 * time.Hour)},
+
+				rows: &mockRowsCount{
+					count: 2,
+				},
+			},
+		},
 		{
 			name: "ok_no_rows",
 
@@ -2237,6 +2422,7 @@ func TestGetErrorHist(t *testing.T) {
 
 			require.Equal(t, tt.wantErr, err != nil)
 			require.Equal(t, tt.wantBucketsCount, len(got.Buckets))
+			require.Equal(t, tt.wantSeriesCount, len(got.Series))
 		})
 	}
 }
@@ -0,0 +1,176 @@
+package repositorych
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sq "github.com/n-r-w/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/logger"
+)
+
+const (
+	mergesTable = "error_groups_merges"
+
+	mergesRefreshInterval = 30 * time.Second
+)
+
+// mergesCache holds mapping of merged group hashes to their target hashes.
+type mergesCache struct {
+	mu        sync.RWMutex
+	targets   map[uint64]uint64
+	updatedAt time.Time
+}
+
+func newMergesCache() *mergesCache {
+	return &mergesCache{
+		targets: map[uint64]uint64{},
+	}
+}
+
+func (c *mergesCache) get() map[uint64]uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.targets
+}
+
+func (c *mergesCache) set(targets map[uint64]uint64, updatedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.targets = targets
+	c.updatedAt = updatedAt
+}
+
+func (c *mergesCache) isStale(now time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return now.Sub(c.updatedAt) > mergesRefreshInterval
+}
+
+func (c *mergesCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.updatedAt = time.Time{}
+}
+
+// syncMerges reloads merges from storage if cached ones are stale.
+// On failure the previously loaded merges are kept.
+func (r *repository) syncMerges(ctx context.Context) {
+	if r.merges == nil || !r.merges.isStale(r.nowFn()) {
+		return
+	}
+
+	merges, err := r.GetErrorGroupMerges(ctx)
+	if err != nil {
+		logger.Error("failed to sync error groups merges", zap.Error(err))
+		return
+	}
+
+	targets := make(map[uint64]uint64, len(merges))
+	for _, m := range merges {
+		targets[m.Hash] = m.TargetHash
+	}
+	r.merges.set(targets, r.nowFn())
+}
+
+// table returns table name to select error groups data from.
+// If there are merged groups, table is wrapped into subquery joining the merges table
+// to replace merged hashes with their target hashes.
+func (r *repository) table(name string) string {
+	if r.merges == nil || len(r.merges.get()) == 0 {
+		return name
+	}
+
+	join := "LEFT JOIN"
+	if r.sharded {
+		join = "GLOBAL LEFT JOIN"
+	}
+
+	return fmt.Sprintf(
+		"(SELECT t.* REPLACE (if(m.target_hash = 0, t._group_hash, m.target_hash) AS _group_hash) FROM %s AS t"+
+			" %s (SELECT _group_hash, argMax(target_hash, created_at) AS target_hash FROM %s GROUP BY _group_hash) AS m"+
+			" ON m._group_hash = t._group_hash) AS %s",
+		name, join, mergesTable, name,
+	)
+}
+
+// groupHash returns target hash if group is merged.
+func (r *repository) groupHash(hash uint64) uint64 {
+	if r.merges == nil {
+		return hash
+	}
+	if target, ok := r.merges.get()[hash]; ok {
+		return target
+	}
+	return hash
+}
+
+func (r *repository) GetErrorGroupMerges(ctx context.Context) ([]types.ErrorGroupMerge, error) {
+	q := sq.
+		Select(
+			"_group_hash",
+			"argMax(target_hash, created_at) as target",
+			"argMax(created_by, created_at) as author",
+			"max(created_at) as updated_at",
+		).
+		From(mergesTable).
+		GroupBy("_group_hash").
+		Having("target != 0").
+		OrderBy("_group_hash")
+
+	query, args := q.MustSql()
+	metricLabels := []string{mergesTable, "SELECT"}
+	rows, err := r.conn.Query(ctx, metricLabels, query, args...)
+	if err != nil {
+		incErrorMetric(err, metricLabels)
+		return nil, fmt.Errorf("failed to get error groups merges: %w", err)
+	}
+
+	var merges []types.ErrorGroupMerge
+	for rows.Next() {
+		var m types.ErrorGroupMerge
+		if err := rows.Scan(
+			&m.Hash,
+			&m.TargetHash,
+			&m.CreatedBy,
+			&m.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		merges = append(merges, m)
+	}
+
+	return merges, nil
+}
+
+// SaveErrorGroupMerges stores merges. Merge with zero target hash cancels previous merge of the group.
+func (r *repository) SaveErrorGroupMerges(ctx context.Context, merges []types.ErrorGroupMerge) error {
+	if len(merges) == 0 {
+		return nil
+	}
+
+	now := r.nowFn()
+	q := sq.
+		Insert(mergesTable).
+		Columns("_group_hash", "target_hash", "created_by", "created_at")
+	for _, m := range merges {
+		q = q.Values(m.Hash, m.TargetHash, m.CreatedBy, now)
+	}
+
+	query, args := q.MustSql()
+	metricLabels := []string{mergesTable, "INSERT"}
+	if err := r.conn.Exec(ctx, metricLabels, query, args...); err != nil {
+		incErrorMetric(err, metricLabels)
+		return fmt.Errorf("failed to save error groups merges: %w", err)
+	}
+
+	if r.merges != nil {
+		r.merges.invalidate()
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package repositorych
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	mock "github.com/ozontech/seq-ui/internal/pkg/repository_ch/mock"
+)
+
+func TestTableWithMerges(t *testing.T) {
+	tests := []struct {
+		name string
+
+		sharded bool
+		merges  *mergesCache
+		targets map[uint64]uint64
+
+		want string
+	}{
+		{
+			name: "no_cache",
+			want: "error_groups",
+		},
+		{
+			name:   "no_merges",
+			merges: newMergesCache(),
+			want:   "error_groups",
+		},
+		{
+			name:   "with_merges",
+			merges: newMergesCache(),
+			targets: map[uint64]uint64{
+				3:                    1,
+				2:                    1,
+				18446744073709551615: 5,
+			},
+			want: "(SELECT t.* REPLACE (if(m.target_hash = 0, t._group_hash, m.target_hash) AS _group_hash) FROM error_groups AS t" +
+				" LEFT JOIN (SELECT _group_hash, argMax(target_hash, created_at) AS target_hash FROM error_groups_merges GROUP BY _group_hash) AS m" +
+				" ON m._group_hash = t._group_hash) AS error_groups",
+		},
+		{
+			name:    "with_merges_sharded",
+			sharded: true,
+			merges:  newMergesCache(),
+			targets: map[uint64]uint64{2: 1},
+			want: "(SELECT t.* REPLACE (if(m.target_hash = 0, t._group_hash, m.target_hash) AS _group_hash) FROM error_groups AS t" +
+				" GLOBAL LEFT JOIN (SELECT _group_hash, argMax(target_hash, created_at) AS target_hash FROM error_groups_merges GROUP BY _group_hash) AS m" +
+				" ON m._group_hash = t._group_hash) AS error_groups",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newRepo(nil, tt.sharded, nil, time.Now)
+			repo.merges = tt.merges
+			if tt.targets != nil {
+				repo.merges.set(tt.targets, time.Now())
+			}
+
+			require.Equal(t, tt.want, repo.table("error_groups"))
+		})
+	}
+}
+
+func TestGroupHash(t *testing.T) {
+	repo := newRepo(nil, false, nil, time.Now)
+	require.Equal(t, uint64(2), repo.groupHash(2))
+
+	repo.merges = newMergesCache()
+	repo.merges.set(map[uint64]uint64{2: 1}, time.Now())
+	require.Equal(t, uint64(1), repo.groupHash(2))
+	require.Equal(t, uint64(3), repo.groupHash(3))
+}
+
+func TestGetErrorGroupMerges(t *testing.T) {
+	someErr := errors.New("some err")
+
+	tests := []struct {
+		name string
+
+		wantCount int
+		wantErr   bool
+
+		mockConn *mockConnRows
+	}{
+		{
+			name:      "ok",
+			wantCount: 2,
+
+			mockConn: &mockConnRows{
+				query: "" +
+					"SELECT _group_hash, argMax(target_hash, created_at) as target, argMax(created_by, created_at) as author, max(created_at) as updated_at" +
+					" FROM error_groups_merges" +
+					" GROUP BY _group_hash" +
+					" HAVING target != 0" +
+					" ORDER BY _group_hash",
+				rows: &mockRowsCount{
+					count: 2,
+				},
+			},
+		},
+		{
+			name:    "err_query",
+			wantErr: true,
+
+			mockConn: &mockConnRows{
+				err: someErr,
+			},
+		},
+		{
+			name:    "err_scan",
+			wantErr: true,
+
+			mockConn: &mockConnRows{
+				rows: &mockRowsCount{
+					scanErr: someErr,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockedConn := initMockConnRows(t, tt.mockConn)
+			repo := newRepo(mockedConn, false, nil, time.Now)
+
+			got, err := repo.GetErrorGroupMerges(context.Background())
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.wantCount, len(got))
+		})
+	}
+}
+
+func TestSaveErrorGroupMerges(t *testing.T) {
+	var (
+		now     = time.Now()
+		user    = "user"
+		someErr = errors.New("some err")
+	)
+
+	tests := []struct {
+		name string
+
+		merges  []types.ErrorGroupMerge
+		wantErr bool
+
+		query string
+		args  []any
+		err   error
+	}{
+		{
+			name: "ok",
+			merges: []types.ErrorGroupMerge{
+				{Hash: 2, TargetHash: 1, CreatedBy: user},
+				{Hash: 3, TargetHash: 0, CreatedBy: user},
+			},
+			query: "INSERT INTO error_groups_merges (_group_hash,target_hash,created_by,created_at) VALUES (?,?,?,?),(?,?,?,?)",
+			args:  []any{uint64(2), uint64(1), user, now, uint64(3), uint64(0), user, now},
+		},
+		{
+			name: "ok_empty",
+		},
+		{
+			name: "err_exec",
+			merges: []types.ErrorGroupMerge{
+				{Hash: 2, TargetHash: 1, CreatedBy: user},
+			},
+			wantErr: true,
+			query:   "INSERT INTO error_groups_merges (_group_hash,target_hash,created_by,created_at) VALUES (?,?,?,?)",
+			args:    []any{uint64(2), uint64(1), user, now},
+			err:     someErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedConn := mock.NewMockConn(ctrl)
+			if tt.query != "" {
+				mockedConn.EXPECT().
+					Exec(gomock.Any(), tt.query, tt.args...).
+					Return(tt.err).
+					Times(1)
+			}
+
+			repo := newRepo(mockedConn, false, nil, fakeNow(now))
+			repo.merges = newMergesCache()
+			repo.merges.set(map[uint64]uint64{}, now)
+
+			err := repo.SaveErrorGroupMerges(context.Background(), tt.merges)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.query != "" && !tt.wantErr {
+				require.True(t, repo.merges.isStale(now))
+			}
+		})
+	}
+}
+
+func TestGetErrorDetailsWithMerges(t *testing.T) {
+	now := time.Now()
+
+	mockedConn := initMockConnRow(t, &mockConnRow{
+		query: "" +
+			"SELECT _group_hash, source, any(message) as message, countMerge(seen_total) as seen_total, minMerge(first_seen_at) as first_seen_at, maxMerge(last_seen_at) as last_seen_at, max(log_tags) as log_tags" +
+			" FROM (SELECT t.* REPLACE (if(m.target_hash = 0, t._group_hash, m.target_hash) AS _group_hash) FROM error_groups AS t" +
+			" LEFT JOIN (SELECT _group_hash, argMax(target_hash, created_at) AS target_hash FROM error_groups_merges GROUP BY _group_hash) AS m" +
+			" ON m._group_hash = t._group_hash) AS error_groups" +
+			" WHERE _group_hash = ?" +
+			" GROUP BY _group_hash, source",
+		args: []any{uint64(1)},
+	})
+
+	repo := newRepo(mockedConn, false, nil, fakeNow(now))
+	repo.merges = newMergesCache()
+	repo.merges.set(map[uint64]uint64{2: 1}, now)
+
+	_, err := repo.GetErrorDetails(context.Background(), types.GetErrorGroupDetailsRequest{GroupHash: 2})
+	require.NoError(t, err)
+}
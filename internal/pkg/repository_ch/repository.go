@@ -1,7 +1,6 @@
 package repositorych
 
 import (
-	"context"
 	"iter"
 	"maps"
 	"slices"
@@ -9,41 +8,24 @@ import (
 
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 
-	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/errorgroups"
 )
 
-type Repository interface {
-	GetErrorGroups(context.Context, types.GetErrorGroupsRequest) ([]types.ErrorGroup, error)
-	GetErrorGroupsTotal(context.Context, types.GetErrorGroupsRequest) (uint64, error)
-
-	GetNewErrorGroups(context.Context, types.GetErrorGroupsRequest) ([]types.ErrorGroup, error)
-	GetNewErrorGroupsTotal(context.Context, types.GetErrorGroupsRequest) (uint64, error)
-
-	GetTopErrorGroups(context.Context, types.GetTopErrorGroupsRequest) ([]types.TopErrorGroup, error)
-	GetTopErrorGroupsTotal(context.Context, types.GetTopErrorGroupsRequest) (uint64, error)
-
-	GetErrorHist(context.Context, types.GetErrorHistRequest) (types.ErrorHist, error)
-	GetErrorDetails(context.Context, types.GetErrorGroupDetailsRequest) (types.ErrorGroupDetails, error)
-	GetErrorCounts(context.Context, types.GetErrorGroupDetailsRequest) (types.ErrorGroupCounts, error)
-
-	GetServices(context.Context, types.GetServicesRequest) ([]string, error)
-	GetReleases(context.Context, types.GetReleasesRequest) ([]string, error)
-
-	DiffByReleases(context.Context, types.DiffByReleasesRequest) ([]types.DiffGroup, error)
-	DiffByReleasesTotal(context.Context, types.DiffByReleasesRequest) (uint64, error)
-}
-
 type repository struct {
 	conn    *conn
 	sharded bool
 
 	queryFilter map[string]string
 
+	merges *mergesCache
+
 	nowFn func() time.Time // for testing
 }
 
-func New(conn driver.Conn, sharded bool, queryFilter map[string]string) Repository {
-	return newRepo(conn, sharded, queryFilter, time.Now)
+func New(conn driver.Conn, sharded bool, queryFilter map[string]string) errorgroups.Repository {
+	r := newRepo(conn, sharded, queryFilter, time.Now)
+	r.merges = newMergesCache()
+	return r
 }
 
 func newRepo(conn driver.Conn, sharded bool, queryFilter map[string]string, nowFn func() time.Time) *repository {
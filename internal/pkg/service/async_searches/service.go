@@ -2,6 +2,7 @@ package asyncsearches
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/querypolicy"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
 	"github.com/ozontech/seq-ui/internal/pkg/repository"
@@ -63,6 +66,8 @@ func (s *service) StartAsyncSearch(ctx context.Context, req *seqapi.StartAsyncSe
 	if utf8.RuneCountInString(req.Query) > s.cfg.ListQueryLengthLimit {
 		metric.AsyncSearchQueryTooLong.Inc()
 	}
+	restriction := querypolicy.ClientRestriction(ctx, s.seqDB).Query()
+
 	resp, err := s.seqDB.StartAsyncSearch(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start async search: %w", err)
@@ -71,10 +76,11 @@ func (s *service) StartAsyncSearch(ctx context.Context, req *seqapi.StartAsyncSe
 	// we can retry multiple times after the async search saved in seq-db
 	err = backoff.Retry(func() error {
 		return s.repo.SaveAsyncSearch(ctx, types.SaveAsyncSearchRequest{
-			SearchID:  resp.SearchId,
-			OwnerID:   ownerID,
-			ExpiresAt: time.Now().Add(req.Retention.AsDuration()),
-			Meta:      req.Meta,
+			SearchID:    resp.SearchId,
+			OwnerID:     ownerID,
+			ExpiresAt:   time.Now().Add(req.Retention.AsDuration()),
+			Meta:        req.Meta,
+			Restriction: restriction,
 		})
 	}, backoff.NewExponentialBackOff(
 		backoff.WithInitialInterval(backoffInitialInterval),
@@ -144,11 +150,21 @@ func (s *service) CancelAsyncSearch(ctx context.Context, req *seqapi.CancelAsync
 	return resp, nil
 }
 
+// FetchAsyncSearchResult returns the result to the restricted user only if the search was started
+// with the same query policy filter, since other searches may contain data unavailable to the user.
 func (s *service) FetchAsyncSearchResult(ctx context.Context, req *seqapi.FetchAsyncSearchResultRequest) (*seqapi.FetchAsyncSearchResultResponse, error) {
+	restriction := querypolicy.ClientRestriction(ctx, s.seqDB).Query()
+
 	searchInfo, err := s.repo.GetAsyncSearchById(ctx, req.SearchId)
 	if err != nil {
+		if restriction != "" && errors.Is(err, types.ErrNotFound) {
+			return nil, types.NewErrPermissionDenied("fetch async search result")
+		}
 		return nil, fmt.Errorf("failed to get async search by id: %w", err)
 	}
+	if restriction != "" && searchInfo.Restriction != restriction {
+		return nil, types.NewErrPermissionDenied("fetch async search result")
+	}
 
 	resp, err := s.seqDB.FetchAsyncSearchResult(ctx, req)
 	if err != nil {
@@ -193,6 +209,10 @@ func (s *service) GetAsyncSearchesList(ctx context.Context, req *seqapi.GetAsync
 }
 
 func (s *service) isAdmin(ctx context.Context) bool {
+	if rbac.HasPermission(ctx, rbac.PermAsyncSearchAdmin) {
+		return true
+	}
+
 	userName, err := types.GetUserKey(ctx)
 	if err != nil {
 		return false
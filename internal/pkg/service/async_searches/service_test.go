@@ -0,0 +1,105 @@
+package asyncsearches
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/querypolicy"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	mock_seqdb "github.com/ozontech/seq-ui/internal/pkg/client/seqdb/mock"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+func TestFetchAsyncSearchResultRestriction(t *testing.T) {
+	const (
+		searchID    = "c9a34cf8-4c66-484e-9cc2-42979d848656"
+		restriction = "service:payments"
+	)
+
+	e, err := querypolicy.NewStatic(querypolicy.File{
+		Policies: []querypolicy.Policy{
+			{
+				Name:            "payments",
+				SeqAPIEnvAccess: config.SeqAPIEnvAccess{Users: []string{"alice"}},
+				Filter:          querypolicy.Filter{"service": {"payments"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+
+		user        string
+		stored      string
+		notFound    bool
+		wantFetched bool
+	}{
+		{
+			name:        "ok_same_restriction",
+			user:        "alice",
+			stored:      restriction,
+			wantFetched: true,
+		},
+		{
+			name:        "ok_unrestricted_user",
+			user:        "bob",
+			stored:      "",
+			wantFetched: true,
+		},
+		{
+			// the query of unrestricted search may end with the filter of the user
+			name:   "err_unrestricted_search",
+			user:   "alice",
+			stored: "",
+		},
+		{
+			name:   "err_other_restriction",
+			user:   "alice",
+			stored: "service:orders",
+		},
+		{
+			name:     "err_no_record",
+			user:     "alice",
+			notFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := repo_mock.NewMockAsyncSearches(ctrl)
+			seqDB := mock_seqdb.NewMockClient(ctrl)
+			s := &service{repo: repo, seqDB: querypolicy.NewClient(seqDB, e)}
+
+			ctx := context.WithValue(context.Background(), types.UserKey{}, tt.user)
+			req := &seqapi.FetchAsyncSearchResultRequest{SearchId: searchID}
+
+			if tt.notFound {
+				repo.EXPECT().GetAsyncSearchById(gomock.Any(), searchID).
+					Return(types.AsyncSearchInfo{}, types.NewErrNotFound("async_searches"))
+			} else {
+				repo.EXPECT().GetAsyncSearchById(gomock.Any(), searchID).
+					Return(types.AsyncSearchInfo{SearchID: searchID, Restriction: tt.stored}, nil)
+			}
+			if tt.wantFetched {
+				seqDB.EXPECT().FetchAsyncSearchResult(gomock.Any(), req).
+					Return(&seqapi.FetchAsyncSearchResultResponse{}, nil)
+			}
+
+			_, err := s.FetchAsyncSearchResult(ctx, req)
+			if !tt.wantFetched {
+				require.ErrorIs(t, err, types.ErrPermissionDenied)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozontech/seq-ui/internal/pkg/service/audit (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=internal/pkg/service/audit/mock/service.go github.com/ozontech/seq-ui/internal/pkg/service/audit Service
+//
+
+// Package mock_audit is a generated GoMock package.
+package mock_audit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/ozontech/seq-ui/internal/app/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAuditLog mocks base method.
+func (m *MockService) GetAuditLog(arg0 context.Context, arg1 types.GetAuditLogRequest) (types.AuditRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", arg0, arg1)
+	ret0, _ := ret[0].(types.AuditRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockServiceMockRecorder) GetAuditLog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockService)(nil).GetAuditLog), arg0, arg1)
+}
+
+// Record mocks base method.
+func (m *MockService) Record(arg0 context.Context, arg1 types.AuditRecord) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", arg0, arg1)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockServiceMockRecorder) Record(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockService)(nil).Record), arg0, arg1)
+}
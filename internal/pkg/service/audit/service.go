@@ -0,0 +1,208 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/repository"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/metric"
+)
+
+const (
+	deleteExpiredInterval = 10 * time.Minute
+
+	defaultGetLimit = 100
+	maxGetLimit     = 1000
+)
+
+// Recorder records the users' actions to the audit log.
+type Recorder interface {
+	// Record enqueues the record without blocking, the record is dropped if the queue is full.
+	// Actions of anonymous users are not recorded.
+	Record(context.Context, types.AuditRecord)
+}
+
+type Service interface {
+	Recorder
+	// GetAuditLog returns the audit log stored in postgres, it's available only to the admins.
+	GetAuditLog(context.Context, types.GetAuditLogRequest) (types.AuditRecords, error)
+}
+
+type service struct {
+	cfg  config.Audit
+	sink Sink
+	repo repository.AuditLog
+
+	queue chan types.AuditRecord
+	nowFn func() time.Time
+}
+
+// New returns audit service writing to the sink from config. Repository is required only for postgres sink.
+func New(ctx context.Context, cfg config.Audit, repo repository.AuditLog) (Service, error) {
+	var sink Sink
+	switch cfg.Sink {
+	case config.AuditSinkPostgres:
+		if repo == nil {
+			return nil, errors.New("postgres sink requires db")
+		}
+		sink = &postgresSink{repo: repo}
+	case config.AuditSinkFile:
+		sink = newFileSink(*cfg.File)
+		repo = nil
+	case config.AuditSinkHTTP:
+		sink = newHTTPSink(*cfg.HTTP)
+		repo = nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q", cfg.Sink)
+	}
+
+	s := newService(cfg, sink, repo)
+
+	go s.run(ctx)
+	if repo != nil && cfg.Retention > 0 {
+		go s.deleteExpired(ctx)
+	}
+
+	return s, nil
+}
+
+func newService(cfg config.Audit, sink Sink, repo repository.AuditLog) *service {
+	return &service{
+		cfg:   cfg,
+		sink:  sink,
+		repo:  repo,
+		queue: make(chan types.AuditRecord, cfg.QueueSize),
+		nowFn: time.Now,
+	}
+}
+
+func (s *service) Record(ctx context.Context, r types.AuditRecord) {
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return
+	}
+	r.UserName = userName
+	r.CreatedAt = s.nowFn()
+
+	select {
+	case s.queue <- r:
+	default:
+		metric.AuditRecordsDropped.Inc()
+	}
+}
+
+func (s *service) GetAuditLog(ctx context.Context, req types.GetAuditLogRequest) (types.AuditRecords, error) {
+	if !s.isAdmin(ctx) {
+		return nil, types.NewErrPermissionDenied("get audit log")
+	}
+	if s.repo == nil {
+		return nil, errors.New("audit log is not stored in postgres")
+	}
+
+	for _, a := range req.Actions {
+		if !slices.Contains(types.AuditActions, a) {
+			return nil, types.NewErrInvalidRequestField(fmt.Sprintf("unknown action %q", a))
+		}
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultGetLimit
+	}
+	if req.Limit > maxGetLimit {
+		return nil, types.NewErrInvalidRequestField(fmt.Sprintf("limit must not exceed %d", maxGetLimit))
+	}
+	if req.Offset < 0 {
+		return nil, types.NewErrInvalidRequestField("negative offset")
+	}
+
+	return s.repo.Get(ctx, req)
+}
+
+func (s *service) isAdmin(ctx context.Context) bool {
+	if rbac.HasPermission(ctx, rbac.PermAuditView) {
+		return true
+	}
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(s.cfg.AdminUsers, userName)
+}
+
+func (s *service) run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make(types.AuditRecords, 0, s.cfg.BatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			// the pending records are written on shutdown, since losing audit records is worse than delay
+			s.flush(context.WithoutCancel(ctx), s.drain(batch))
+			return
+		case r := <-s.queue:
+			batch = append(batch, r)
+			if len(batch) < s.cfg.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		s.flush(ctx, batch)
+		batch = batch[:0]
+	}
+}
+
+func (s *service) drain(batch types.AuditRecords) types.AuditRecords {
+	for {
+		select {
+		case r := <-s.queue:
+			batch = append(batch, r)
+		default:
+			return batch
+		}
+	}
+}
+
+func (s *service) flush(ctx context.Context, batch types.AuditRecords) {
+	if len(batch) == 0 {
+		return
+	}
+
+	status := "ok"
+	if err := s.sink.Write(ctx, batch); err != nil {
+		status = "error"
+		logger.Error("failed to write audit records", zap.Int("records", len(batch)), zap.Error(err))
+	}
+	metric.AuditRecordsWritten.WithLabelValues(status).Add(float64(len(batch)))
+}
+
+func (s *service) deleteExpired(ctx context.Context) {
+	ticker := time.NewTicker(deleteExpiredInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.repo.DeleteExpired(ctx, s.nowFn().Add(-s.cfg.Retention))
+			if err != nil {
+				logger.Error("DeleteExpired audit log error", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+)
+
+type sinkFunc func(context.Context, types.AuditRecords) error
+
+func (f sinkFunc) Write(ctx context.Context, records types.AuditRecords) error {
+	return f(ctx, records)
+}
+
+func userCtx(name string) context.Context {
+	return context.WithValue(context.Background(), types.UserKey{}, name)
+}
+
+func TestRecord(t *testing.T) {
+	now := time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC)
+
+	written := make(chan types.AuditRecords, 1)
+	s := newService(config.Audit{
+		QueueSize:     2,
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	}, sinkFunc(func(_ context.Context, records types.AuditRecords) error {
+		written <- append(types.AuditRecords{}, records...)
+		return nil
+	}), nil)
+	s.nowFn = func() time.Time { return now }
+
+	s.Record(userCtx("user1"), types.AuditRecord{Action: types.AuditActionSearch, Query: "q1"})
+	// anonymous requests are not recorded
+	s.Record(context.Background(), types.AuditRecord{Action: types.AuditActionSearch, Query: "q2"})
+	s.Record(userCtx("user2"), types.AuditRecord{Action: types.AuditActionTokenRevoke, Target: "id"})
+	// queue is full
+	s.Record(userCtx("user1"), types.AuditRecord{Action: types.AuditActionSearch, Query: "q4"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.run(ctx)
+
+	select {
+	case records := <-written:
+		require.Equal(t, types.AuditRecords{
+			{UserName: "user1", Action: types.AuditActionSearch, Query: "q1", CreatedAt: now},
+			{UserName: "user2", Action: types.AuditActionTokenRevoke, Target: "id", CreatedAt: now},
+		}, records)
+	case <-time.After(5 * time.Second):
+		t.Fatal("records are not flushed")
+	}
+}
+
+func TestRecordFlushOnShutdown(t *testing.T) {
+	written := make(chan types.AuditRecords, 1)
+	s := newService(config.Audit{
+		QueueSize:     10,
+		BatchSize:     10,
+		FlushInterval: time.Hour,
+	}, sinkFunc(func(ctx context.Context, records types.AuditRecords) error {
+		require.NoError(t, ctx.Err())
+		written <- append(types.AuditRecords{}, records...)
+		return nil
+	}), nil)
+
+	s.Record(userCtx("user"), types.AuditRecord{Action: types.AuditActionSearch})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.run(ctx)
+
+	select {
+	case records := <-written:
+		require.Len(t, records, 1)
+	default:
+		t.Fatal("records are not flushed on shutdown")
+	}
+}
+
+func TestGetAuditLog(t *testing.T) {
+	userName := "user"
+	records := types.AuditRecords{{ID: 1, UserName: userName, Action: types.AuditActionSearch}}
+	errRepo := errors.New("repo error")
+
+	adminCtx := userCtx("admin")
+	rbacAdminCtx := rbac.WithAccess(userCtx("auditor"), rbac.Access{
+		Permissions: []string{rbac.PermAuditView},
+	})
+
+	tests := []struct {
+		name string
+
+		ctx        context.Context
+		req        types.GetAuditLogRequest
+		noRepo     bool
+		wantErr    error
+		wantAnyErr bool
+
+		repoReq *types.GetAuditLogRequest
+		repoErr error
+	}{
+		{
+			name: "ok_admin_user",
+			ctx:  adminCtx,
+			req: types.GetAuditLogRequest{
+				UserName: &userName,
+				Actions:  []types.AuditAction{types.AuditActionSearch},
+			},
+			repoReq: &types.GetAuditLogRequest{
+				UserName: &userName,
+				Actions:  []types.AuditAction{types.AuditActionSearch},
+				Limit:    defaultGetLimit,
+			},
+		},
+		{
+			name: "ok_rbac_permission",
+			ctx:  rbacAdminCtx,
+			req:  types.GetAuditLogRequest{Limit: 10, Offset: 20},
+			repoReq: &types.GetAuditLogRequest{
+				Limit:  10,
+				Offset: 20,
+			},
+		},
+		{
+			name:    "err_not_admin",
+			ctx:     userCtx(userName),
+			wantErr: types.ErrPermissionDenied,
+		},
+		{
+			name:    "err_anonymous",
+			ctx:     context.Background(),
+			wantErr: types.ErrPermissionDenied,
+		},
+		{
+			name:    "err_unknown_action",
+			ctx:     adminCtx,
+			req:     types.GetAuditLogRequest{Actions: []types.AuditAction{"unknown"}},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:    "err_limit",
+			ctx:     adminCtx,
+			req:     types.GetAuditLogRequest{Limit: maxGetLimit + 1},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:    "err_offset",
+			ctx:     adminCtx,
+			req:     types.GetAuditLogRequest{Offset: -1},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:       "err_no_repo",
+			ctx:        adminCtx,
+			noRepo:     true,
+			wantAnyErr: true,
+		},
+		{
+			name:       "err_repo",
+			ctx:        adminCtx,
+			repoReq:    &types.GetAuditLogRequest{Limit: defaultGetLimit},
+			repoErr:    errRepo,
+			wantAnyErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := repo_mock.NewMockAuditLog(gomock.NewController(t))
+			if tt.repoReq != nil {
+				repo.EXPECT().
+					Get(gomock.Any(), *tt.repoReq).
+					Return(records, tt.repoErr).
+					Times(1)
+			}
+
+			s := newService(config.Audit{AdminUsers: []string{"admin"}}, nil, repo)
+			if tt.noRepo {
+				s.repo = nil
+			}
+
+			got, err := s.GetAuditLog(tt.ctx, tt.req)
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			case tt.wantAnyErr:
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, records, got)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/repository"
+)
+
+// Sink stores the audit records.
+type Sink interface {
+	Write(context.Context, types.AuditRecords) error
+}
+
+type postgresSink struct {
+	repo repository.AuditLog
+}
+
+func (s *postgresSink) Write(ctx context.Context, records types.AuditRecords) error {
+	return s.repo.Add(ctx, records)
+}
+
+// fileSink appends the records to JSONL file.
+type fileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newFileSink(cfg config.AuditFileSink) *fileSink {
+	return &fileSink{path: cfg.Path}
+}
+
+func (s *fileSink) Write(_ context.Context, records types.AuditRecords) error {
+	data, err := marshalJSONL(records)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("open audit file: %w", err)
+	}
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write audit file: %w", err)
+	}
+	return f.Close()
+}
+
+// httpSink sends the records as JSONL to HTTP endpoint, e.g. file.d http input.
+type httpSink struct {
+	cfg    config.AuditHTTPSink
+	client *http.Client
+}
+
+func newHTTPSink(cfg config.AuditHTTPSink) *httpSink {
+	return &httpSink{
+		cfg: cfg,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+	}
+}
+
+func (s *httpSink) Write(ctx context.Context, records types.AuditRecords) error {
+	data, err := marshalJSONL(records)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create audit request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	for k, v := range s.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send audit request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("audit endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func marshalJSONL(records types.AuditRecords) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return nil, fmt.Errorf("marshal audit record: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+var testRecords = types.AuditRecords{
+	{
+		UserName:  "user",
+		Action:    types.AuditActionSearch,
+		Method:    "POST /seqapi/v1/search",
+		Query:     "service:api",
+		Status:    "OK",
+		CreatedAt: time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC),
+	},
+	{
+		UserName:  "user",
+		Action:    types.AuditActionDashboardDelete,
+		Method:    "DELETE /dashboards/v1/{uuid}",
+		Target:    "uuid",
+		Status:    "Forbidden",
+		CreatedAt: time.Date(2024, time.December, 31, 10, 0, 1, 0, time.UTC),
+	},
+}
+
+const testRecordsJSONL = `{"user_name":"user","action":"search","method":"POST /seqapi/v1/search","query":"service:api","status":"OK","created_at":"2024-12-31T10:00:00Z"}
+{"user_name":"user","action":"dashboard_delete","method":"DELETE /dashboards/v1/{uuid}","target":"uuid","status":"Forbidden","created_at":"2024-12-31T10:00:01Z"}
+`
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	s := newFileSink(config.AuditFileSink{Path: path})
+
+	require.NoError(t, s.Write(context.Background(), testRecords[:1]))
+	require.NoError(t, s.Write(context.Background(), testRecords[1:]))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, testRecordsJSONL, string(data))
+}
+
+func TestHTTPSink(t *testing.T) {
+	tests := []struct {
+		name     string
+		respCode int
+		wantErr  bool
+	}{
+		{
+			name:     "ok",
+			respCode: http.StatusOK,
+		},
+		{
+			name:     "err_status",
+			respCode: http.StatusServiceUnavailable,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assertReq := func() bool {
+					body, err := io.ReadAll(r.Body)
+					return err == nil &&
+						r.Method == http.MethodPost &&
+						r.Header.Get("Content-Type") == "application/x-ndjson" &&
+						r.Header.Get("X-Token") == "secret" &&
+						string(body) == testRecordsJSONL
+				}
+				if !assertReq() {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(tt.respCode)
+			}))
+			defer srv.Close()
+
+			s := newHTTPSink(config.AuditHTTPSink{
+				URL:     srv.URL,
+				Headers: map[string]string{"X-Token": "secret"},
+				Timeout: time.Second,
+			})
+
+			err := s.Write(context.Background(), testRecords)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
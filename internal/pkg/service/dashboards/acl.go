@@ -0,0 +1,187 @@
+package dashboards
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+)
+
+type accessLevel int
+
+const (
+	accessNone accessLevel = iota
+	accessView
+	accessEdit
+	accessOwner
+)
+
+// getUser returns user accessing dashboards and creates its profile if it doesn't exist.
+func getUser(ctx context.Context) (types.DashboardUser, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return types.DashboardUser{}, err
+	}
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return types.DashboardUser{}, err
+	}
+
+	return types.DashboardUser{
+		ProfileID: profileID,
+		Name:      userName,
+		Groups:    types.GetUserGroups(ctx),
+	}, nil
+}
+
+// getAccessLevel returns the highest access level granted to the user by acl.
+func getAccessLevel(acl types.DashboardACL, u types.DashboardUser) accessLevel {
+	if acl.OwnerID == u.ProfileID {
+		return accessOwner
+	}
+
+	level := accessNone
+	switch acl.Visibility {
+	case types.DashboardVisibilityPublic:
+		level = accessView
+	case types.DashboardVisibilityTeam:
+		if slices.Contains(u.Groups, acl.Team) {
+			level = accessView
+		}
+	}
+
+	for _, c := range acl.Collaborators {
+		granted := c.Kind == types.DashboardCollaboratorUser && c.Name == u.Name ||
+			c.Kind == types.DashboardCollaboratorGroup && slices.Contains(u.Groups, c.Name)
+		if !granted {
+			continue
+		}
+		if c.Role == types.DashboardRoleEditor {
+			return accessEdit
+		}
+		level = max(level, accessView)
+	}
+
+	return level
+}
+
+// checkAccess checks that the user has at least the required access level to the dashboard.
+func (s *service) checkAccess(
+	ctx context.Context,
+	id string,
+	u types.DashboardUser,
+	required accessLevel,
+	operation string,
+) (types.DashboardACL, error) {
+	acl, err := s.repo.GetACL(ctx, id)
+	if err != nil {
+		return acl, err
+	}
+
+	if getAccessLevel(acl, u) < required {
+		return acl, types.NewErrPermissionDenied(operation)
+	}
+
+	return acl, nil
+}
+
+func (s *service) GetDashboardSharing(ctx context.Context, id string) (types.DashboardACL, error) {
+	u, err := getUser(ctx)
+	if err != nil {
+		return types.DashboardACL{}, err
+	}
+
+	if err := checkUUID(id); err != nil {
+		return types.DashboardACL{}, err
+	}
+
+	return s.checkAccess(ctx, id, u, accessView, "get dashboard sharing")
+}
+
+func (s *service) UpdateDashboardSharing(ctx context.Context, req types.UpdateDashboardSharingRequest) error {
+	u, err := getUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return err
+	}
+	if req.Visibility == "" {
+		req.Visibility = types.DashboardVisibilityPublic
+	}
+	if err := checkSharing(req); err != nil {
+		return err
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessOwner, "update dashboard sharing"); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateSharing(ctx, req)
+}
+
+func (s *service) TransferDashboardOwnership(ctx context.Context, req types.TransferDashboardOwnershipRequest) error {
+	u, err := getUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return err
+	}
+	if req.NewOwnerName == "" {
+		return types.NewErrInvalidRequestField("empty 'new_owner_name'")
+	}
+	if req.NewOwnerName == u.Name {
+		return types.NewErrInvalidRequestField("'new_owner_name' is already the owner")
+	}
+
+	acl, err := s.checkAccess(ctx, req.UUID, u, accessOwner, "transfer dashboard ownership")
+	if err != nil {
+		return err
+	}
+	req.PrevOwnerName = acl.OwnerName
+
+	return s.repo.TransferOwnership(ctx, req)
+}
+
+func checkSharing(req types.UpdateDashboardSharingRequest) error {
+	if !req.Visibility.IsValid() {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("unknown 'visibility' %q", req.Visibility))
+	}
+	if req.Visibility == types.DashboardVisibilityTeam && req.Team == "" {
+		return types.NewErrInvalidRequestField("'team' must be set for team visibility")
+	}
+	if req.Visibility != types.DashboardVisibilityTeam && req.Team != "" {
+		return types.NewErrInvalidRequestField("'team' can be set only for team visibility")
+	}
+
+	type key struct {
+		kind types.DashboardCollaboratorKind
+		name string
+	}
+	seen := make(map[key]struct{}, len(req.Collaborators))
+	for _, c := range req.Collaborators {
+		if !c.Kind.IsValid() {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("unknown collaborator kind %q", c.Kind))
+		}
+		if c.Name == "" {
+			return types.NewErrInvalidRequestField("empty collaborator 'name'")
+		}
+		if !c.Role.IsValid() {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("unknown collaborator role %q", c.Role))
+		}
+
+		k := key{kind: c.Kind, name: c.Name}
+		if _, ok := seen[k]; ok {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("duplicate collaborator %s %q", c.Kind, c.Name))
+		}
+		seen[k] = struct{}{}
+	}
+
+	return nil
+}
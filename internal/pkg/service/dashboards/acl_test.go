@@ -0,0 +1,203 @@
+package dashboards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestGetAccessLevel(t *testing.T) {
+	user := types.DashboardUser{
+		ProfileID: 2,
+		Name:      "user",
+		Groups:    []string{"team1", "team2"},
+	}
+
+	tests := []struct {
+		name string
+
+		acl  types.DashboardACL
+		want accessLevel
+	}{
+		{
+			name: "owner",
+			acl: types.DashboardACL{
+				OwnerID:    2,
+				Visibility: types.DashboardVisibilityPrivate,
+			},
+			want: accessOwner,
+		},
+		{
+			name: "public",
+			acl: types.DashboardACL{
+				OwnerID:    1,
+				Visibility: types.DashboardVisibilityPublic,
+			},
+			want: accessView,
+		},
+		{
+			name: "private",
+			acl: types.DashboardACL{
+				OwnerID:    1,
+				Visibility: types.DashboardVisibilityPrivate,
+			},
+			want: accessNone,
+		},
+		{
+			name: "team_member",
+			acl: types.DashboardACL{
+				OwnerID:    1,
+				Visibility: types.DashboardVisibilityTeam,
+				Team:       "team2",
+			},
+			want: accessView,
+		},
+		{
+			name: "team_not_member",
+			acl: types.DashboardACL{
+				OwnerID:    1,
+				Visibility: types.DashboardVisibilityTeam,
+				Team:       "team3",
+			},
+			want: accessNone,
+		},
+		{
+			name: "user_viewer",
+			acl: types.DashboardACL{
+				OwnerID:    1,
+				Visibility: types.DashboardVisibilityPrivate,
+				Collaborators: types.DashboardCollaborators{
+					{Kind: types.DashboardCollaboratorUser, Name: "user", Role: types.DashboardRoleViewer},
+				},
+			},
+			want: accessView,
+		},
+		{
+			name: "group_editor",
+			acl: types.DashboardACL{
+				OwnerID:    1,
+				Visibility: types.DashboardVisibilityPublic,
+				Collaborators: types.DashboardCollaborators{
+					{Kind: types.DashboardCollaboratorUser, Name: "user", Role: types.DashboardRoleViewer},
+					{Kind: types.DashboardCollaboratorGroup, Name: "team1", Role: types.DashboardRoleEditor},
+				},
+			},
+			want: accessEdit,
+		},
+		{
+			name: "other_collaborators",
+			acl: types.DashboardACL{
+				OwnerID:    1,
+				Visibility: types.DashboardVisibilityPrivate,
+				Collaborators: types.DashboardCollaborators{
+					{Kind: types.DashboardCollaboratorUser, Name: "team1", Role: types.DashboardRoleEditor},
+					{Kind: types.DashboardCollaboratorGroup, Name: "user", Role: types.DashboardRoleEditor},
+				},
+			},
+			want: accessNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, getAccessLevel(tt.acl, user))
+		})
+	}
+}
+
+func TestCheckSharing(t *testing.T) {
+	tests := []struct {
+		name string
+
+		req     types.UpdateDashboardSharingRequest
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: types.DashboardVisibilityTeam,
+				Team:       "team1",
+				Collaborators: types.DashboardCollaborators{
+					{Kind: types.DashboardCollaboratorUser, Name: "name", Role: types.DashboardRoleEditor},
+					{Kind: types.DashboardCollaboratorGroup, Name: "name", Role: types.DashboardRoleViewer},
+				},
+			},
+		},
+		{
+			name: "err_visibility",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: "internal",
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_team_empty",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: types.DashboardVisibilityTeam,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_team_not_team_visibility",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: types.DashboardVisibilityPrivate,
+				Team:       "team1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_kind",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: types.DashboardVisibilityPrivate,
+				Collaborators: types.DashboardCollaborators{
+					{Kind: "role", Name: "name", Role: types.DashboardRoleViewer},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_role",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: types.DashboardVisibilityPrivate,
+				Collaborators: types.DashboardCollaborators{
+					{Kind: types.DashboardCollaboratorUser, Name: "name", Role: "admin"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_empty_name",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: types.DashboardVisibilityPrivate,
+				Collaborators: types.DashboardCollaborators{
+					{Kind: types.DashboardCollaboratorUser, Role: types.DashboardRoleViewer},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_duplicate",
+			req: types.UpdateDashboardSharingRequest{
+				Visibility: types.DashboardVisibilityPrivate,
+				Collaborators: types.DashboardCollaborators{
+					{Kind: types.DashboardCollaboratorUser, Name: "name", Role: types.DashboardRoleViewer},
+					{Kind: types.DashboardCollaboratorUser, Name: "name", Role: types.DashboardRoleEditor},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkSharing(tt.req)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package dashboards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+const maxBundleDashboards = 1000
+
+// ExportDashboards returns bundle of the dashboards which can be imported into another installation.
+// Folders are not exported since they are specific to the installation.
+func (s *service) ExportDashboards(ctx context.Context, ids []string) (types.DashboardsBundle, error) {
+	u, err := getUser(ctx)
+	if err != nil {
+		return types.DashboardsBundle{}, err
+	}
+
+	if len(ids) == 0 {
+		return types.DashboardsBundle{}, types.NewErrInvalidRequestField("empty 'uuids'")
+	}
+	if len(ids) > maxBundleDashboards {
+		return types.DashboardsBundle{}, types.NewErrInvalidRequestField(
+			fmt.Sprintf("too many 'uuids', max %d", maxBundleDashboards),
+		)
+	}
+
+	bundle := types.DashboardsBundle{
+		Version:    types.DashboardsBundleVersion,
+		Dashboards: make([]types.DashboardsBundleItem, 0, len(ids)),
+	}
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if err := checkUUID(id); err != nil {
+			return types.DashboardsBundle{}, err
+		}
+		if _, err := s.checkAccess(ctx, id, u, accessView, "export dashboard"); err != nil {
+			return types.DashboardsBundle{}, err
+		}
+
+		d, err := s.repo.GetByUUID(ctx, id)
+		if err != nil {
+			return types.DashboardsBundle{}, err
+		}
+
+		bundle.Dashboards = append(bundle.Dashboards, types.DashboardsBundleItem{
+			UUID: id,
+			Name: d.Name,
+			Meta: d.Meta,
+			Tags: d.Tags,
+		})
+	}
+
+	return bundle, nil
+}
+
+// ImportDashboards creates dashboards from the bundle owned by the current user.
+// Dashboards keep their UUIDs, so importing the same bundle again hits the conflict policy.
+func (s *service) ImportDashboards(ctx context.Context, req types.ImportDashboardsRequest) (types.DashboardImportResults, error) {
+	u, err := getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.ProfileID = u.ProfileID
+
+	if req.Conflict == "" {
+		req.Conflict = types.DashboardImportConflictSkip
+	}
+	if !req.Conflict.IsValid() {
+		return nil, types.NewErrInvalidRequestField(fmt.Sprintf("unknown 'conflict' %q", req.Conflict))
+	}
+	if err := s.checkBundle(&req.Bundle); err != nil {
+		return nil, err
+	}
+
+	results := make(types.DashboardImportResults, 0, len(req.Bundle.Dashboards))
+	for _, item := range req.Bundle.Dashboards {
+		res, err := s.importDashboard(ctx, u, item, req.Conflict)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import dashboard %q: %w", item.UUID, err)
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (s *service) importDashboard(
+	ctx context.Context,
+	u types.DashboardUser,
+	item types.DashboardsBundleItem,
+	conflict types.DashboardImportConflict,
+) (types.DashboardImportResult, error) {
+	res := types.DashboardImportResult{
+		SourceUUID: item.UUID,
+		Status:     types.DashboardImportStatusCreated,
+	}
+
+	create := func(id string) (types.DashboardImportResult, error) {
+		var err error
+		res.UUID, err = s.repo.Create(ctx, types.CreateDashboardRequest{
+			UUID:      id,
+			ProfileID: u.ProfileID,
+			Name:      item.Name,
+			Meta:      item.Meta,
+			Tags:      item.Tags,
+		})
+		return res, err
+	}
+
+	if item.UUID == "" || conflict == types.DashboardImportConflictNewUUID {
+		return create("")
+	}
+
+	acl, err := s.repo.GetACL(ctx, item.UUID)
+	if errors.Is(err, types.ErrNotFound) {
+		return create(item.UUID)
+	}
+	if err != nil {
+		return res, err
+	}
+
+	res.UUID = item.UUID
+	if conflict == types.DashboardImportConflictSkip {
+		res.Status = types.DashboardImportStatusSkipped
+		return res, nil
+	}
+
+	if getAccessLevel(acl, u) < accessEdit {
+		return res, types.NewErrPermissionDenied("overwrite dashboard")
+	}
+	if err := s.repo.Update(ctx, types.UpdateDashboardRequest{
+		UUID:      item.UUID,
+		ProfileID: u.ProfileID,
+		Name:      &item.Name,
+		Meta:      &item.Meta,
+		Tags:      &item.Tags,
+	}); err != nil {
+		return res, err
+	}
+
+	res.Status = types.DashboardImportStatusOverwritten
+	return res, nil
+}
+
+// checkBundle validates the bundle and panels of its dashboards and normalizes tags.
+func (s *service) checkBundle(b *types.DashboardsBundle) error {
+	if b.Version != types.DashboardsBundleVersion {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("unsupported bundle version %d", b.Version))
+	}
+	if len(b.Dashboards) == 0 {
+		return types.NewErrInvalidRequestField("empty bundle")
+	}
+	if len(b.Dashboards) > maxBundleDashboards {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("too many dashboards in bundle, max %d", maxBundleDashboards))
+	}
+
+	seen := make(map[string]struct{}, len(b.Dashboards))
+	for i := range b.Dashboards {
+		d := &b.Dashboards[i]
+
+		if d.UUID != "" {
+			if err := checkUUID(d.UUID); err != nil {
+				return err
+			}
+			if _, ok := seen[d.UUID]; ok {
+				return types.NewErrInvalidRequestField(fmt.Sprintf("duplicate dashboard %q in bundle", d.UUID))
+			}
+			seen[d.UUID] = struct{}{}
+		}
+		if d.Name == "" {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("empty 'name' of dashboard #%d in bundle", i))
+		}
+		if d.Meta == "" {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("empty 'meta' of dashboard #%d in bundle", i))
+		}
+		if err := s.checkMeta(d.Meta); err != nil {
+			return fmt.Errorf("dashboard #%d in bundle: %w", i, err)
+		}
+
+		tags, err := normalizeTags(d.Tags)
+		if err != nil {
+			return err
+		}
+		d.Tags = tags
+	}
+
+	return nil
+}
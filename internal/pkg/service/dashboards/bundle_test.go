@@ -0,0 +1,196 @@
+package dashboards
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+)
+
+func TestCheckBundle(t *testing.T) {
+	const id = "064dc707-02b8-7000-8201-02a7f396738a"
+
+	tests := []struct {
+		name string
+
+		bundle   types.DashboardsBundle
+		wantTags [][]string
+		wantErr  bool
+	}{
+		{
+			name: "ok",
+			bundle: types.DashboardsBundle{
+				Version: types.DashboardsBundleVersion,
+				Dashboards: []types.DashboardsBundleItem{
+					{UUID: id, Name: "d1", Meta: "{}", Tags: []string{" prod ", "prod"}},
+					{Name: "d2", Meta: "{}"},
+				},
+			},
+			wantTags: [][]string{{"prod"}, {}},
+		},
+		{
+			name: "err_version",
+			bundle: types.DashboardsBundle{
+				Version:    2,
+				Dashboards: []types.DashboardsBundleItem{{Name: "d1", Meta: "{}"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "err_empty",
+			bundle:  types.DashboardsBundle{Version: types.DashboardsBundleVersion},
+			wantErr: true,
+		},
+		{
+			name: "err_uuid",
+			bundle: types.DashboardsBundle{
+				Version:    types.DashboardsBundleVersion,
+				Dashboards: []types.DashboardsBundleItem{{UUID: "abc", Name: "d1", Meta: "{}"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_duplicate",
+			bundle: types.DashboardsBundle{
+				Version: types.DashboardsBundleVersion,
+				Dashboards: []types.DashboardsBundleItem{
+					{UUID: id, Name: "d1", Meta: "{}"},
+					{UUID: id, Name: "d2", Meta: "{}"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_meta",
+			bundle: types.DashboardsBundle{
+				Version:    types.DashboardsBundleVersion,
+				Dashboards: []types.DashboardsBundleItem{{Name: "d1"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := (&service{}).checkBundle(&tt.bundle)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				require.True(t, errors.Is(err, types.ErrInvalidRequestField))
+				return
+			}
+
+			for i, d := range tt.bundle.Dashboards {
+				require.Equal(t, tt.wantTags[i], d.Tags)
+			}
+		})
+	}
+}
+
+func TestImportDashboard(t *testing.T) {
+	const (
+		id    = "064dc707-02b8-7000-8201-02a7f396738a"
+		newID = "064dc707-12b9-7000-a238-682b044c908b"
+	)
+
+	var (
+		user = types.DashboardUser{ProfileID: 2, Name: "user"}
+		item = types.DashboardsBundleItem{UUID: id, Name: "d1", Meta: "{}", Tags: []string{"prod"}}
+
+		createReq = func(id string) types.CreateDashboardRequest {
+			return types.CreateDashboardRequest{
+				UUID:      id,
+				ProfileID: user.ProfileID,
+				Name:      item.Name,
+				Meta:      item.Meta,
+				Tags:      item.Tags,
+			}
+		}
+		aclOwn    = types.DashboardACL{OwnerID: 2, Visibility: types.DashboardVisibilityPrivate}
+		aclPublic = types.DashboardACL{OwnerID: 1, Visibility: types.DashboardVisibilityPublic}
+	)
+
+	tests := []struct {
+		name string
+
+		conflict types.DashboardImportConflict
+		mock     func(m *repo_mock.MockDashboards)
+		want     types.DashboardImportResult
+		wantErr  error
+	}{
+		{
+			name:     "new_uuid",
+			conflict: types.DashboardImportConflictNewUUID,
+			mock: func(m *repo_mock.MockDashboards) {
+				m.EXPECT().Create(gomock.Any(), createReq("")).Return(newID, nil)
+			},
+			want: types.DashboardImportResult{SourceUUID: id, UUID: newID, Status: types.DashboardImportStatusCreated},
+		},
+		{
+			name:     "not_exists",
+			conflict: types.DashboardImportConflictSkip,
+			mock: func(m *repo_mock.MockDashboards) {
+				m.EXPECT().GetACL(gomock.Any(), id).Return(types.DashboardACL{}, types.NewErrNotFound("dashboard"))
+				m.EXPECT().Create(gomock.Any(), createReq(id)).Return(id, nil)
+			},
+			want: types.DashboardImportResult{SourceUUID: id, UUID: id, Status: types.DashboardImportStatusCreated},
+		},
+		{
+			name:     "skip",
+			conflict: types.DashboardImportConflictSkip,
+			mock: func(m *repo_mock.MockDashboards) {
+				m.EXPECT().GetACL(gomock.Any(), id).Return(aclPublic, nil)
+			},
+			want: types.DashboardImportResult{SourceUUID: id, UUID: id, Status: types.DashboardImportStatusSkipped},
+		},
+		{
+			name:     "overwrite",
+			conflict: types.DashboardImportConflictOverwrite,
+			mock: func(m *repo_mock.MockDashboards) {
+				m.EXPECT().GetACL(gomock.Any(), id).Return(aclOwn, nil)
+				m.EXPECT().Update(gomock.Any(), types.UpdateDashboardRequest{
+					UUID:      id,
+					ProfileID: user.ProfileID,
+					Name:      &item.Name,
+					Meta:      &item.Meta,
+					Tags:      &item.Tags,
+				}).Return(nil)
+			},
+			want: types.DashboardImportResult{SourceUUID: id, UUID: id, Status: types.DashboardImportStatusOverwritten},
+		},
+		{
+			name:     "overwrite_permission_denied",
+			conflict: types.DashboardImportConflictOverwrite,
+			mock: func(m *repo_mock.MockDashboards) {
+				m.EXPECT().GetACL(gomock.Any(), id).Return(aclPublic, nil)
+			},
+			wantErr: types.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := repo_mock.NewMockDashboards(ctrl)
+			tt.mock(repo)
+
+			s := &service{repo: repo}
+			got, err := s.importDashboard(context.Background(), user, item, tt.conflict)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package dashboards
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+// diffMeta returns changes between two dashboard metas.
+// Meta that is not a valid JSON is compared as a plain string.
+func diffMeta(from, to string) []types.DashboardDiffChange {
+	var changes []types.DashboardDiffChange
+	diffValues(&changes, "", parseMeta(from), parseMeta(to))
+	return changes
+}
+
+func parseMeta(meta string) any {
+	var v any
+	if err := json.Unmarshal([]byte(meta), &v); err != nil {
+		return meta
+	}
+	return v
+}
+
+func diffValues(changes *[]types.DashboardDiffChange, path string, from, to any) {
+	switch f := from.(type) {
+	case map[string]any:
+		if t, ok := to.(map[string]any); ok {
+			diffObjects(changes, path, f, t)
+			return
+		}
+	case []any:
+		if t, ok := to.([]any); ok {
+			diffArrays(changes, path, f, t)
+			return
+		}
+	}
+
+	fromRaw, toRaw := encodeValue(from), encodeValue(to)
+	if fromRaw == toRaw {
+		return
+	}
+	*changes = append(*changes, types.DashboardDiffChange{
+		Path:     path,
+		Op:       types.DashboardDiffOpChanged,
+		OldValue: fromRaw,
+		NewValue: toRaw,
+	})
+}
+
+func diffObjects(changes *[]types.DashboardDiffChange, path string, from, to map[string]any) {
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		keyPath := path + "/" + escapePointerToken(k)
+		f, inFrom := from[k]
+		t, inTo := to[k]
+		switch {
+		case !inFrom:
+			*changes = append(*changes, types.DashboardDiffChange{
+				Path:     keyPath,
+				Op:       types.DashboardDiffOpAdded,
+				NewValue: encodeValue(t),
+			})
+		case !inTo:
+			*changes = append(*changes, types.DashboardDiffChange{
+				Path:     keyPath,
+				Op:       types.DashboardDiffOpRemoved,
+				OldValue: encodeValue(f),
+			})
+		default:
+			diffValues(changes, keyPath, f, t)
+		}
+	}
+}
+
+func diffArrays(changes *[]types.DashboardDiffChange, path string, from, to []any) {
+	for i := range max(len(from), len(to)) {
+		idxPath := path + "/" + strconv.Itoa(i)
+		switch {
+		case i >= len(from):
+			*changes = append(*changes, types.DashboardDiffChange{
+				Path:     idxPath,
+				Op:       types.DashboardDiffOpAdded,
+				NewValue: encodeValue(to[i]),
+			})
+		case i >= len(to):
+			*changes = append(*changes, types.DashboardDiffChange{
+				Path:     idxPath,
+				Op:       types.DashboardDiffOpRemoved,
+				OldValue: encodeValue(from[i]),
+			})
+		default:
+			diffValues(changes, idxPath, from[i], to[i])
+		}
+	}
+}
+
+func encodeValue(v any) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(raw)
+}
+
+var pointerTokenReplacer = strings.NewReplacer("~", "~0", "/", "~1")
+
+func escapePointerToken(s string) string {
+	return pointerTokenReplacer.Replace(s)
+}
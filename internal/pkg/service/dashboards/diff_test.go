@@ -0,0 +1,68 @@
+package dashboards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestDiffMeta(t *testing.T) {
+	tests := []struct {
+		name string
+
+		from string
+		to   string
+		want []types.DashboardDiffChange
+	}{
+		{
+			name: "equal",
+			from: `{"query":"level:error","columns":["level"]}`,
+			to:   `{"columns":["level"],"query":"level:error"}`,
+		},
+		{
+			name: "object",
+			from: `{"query":"level:error","histogram":false,"a/b":1}`,
+			to:   `{"query":"level:warn","columns":["level"],"a/b":1}`,
+			want: []types.DashboardDiffChange{
+				{Path: "/columns", Op: types.DashboardDiffOpAdded, NewValue: `["level"]`},
+				{Path: "/histogram", Op: types.DashboardDiffOpRemoved, OldValue: `false`},
+				{Path: "/query", Op: types.DashboardDiffOpChanged, OldValue: `"level:error"`, NewValue: `"level:warn"`},
+			},
+		},
+		{
+			name: "nested_arrays",
+			from: `{"aggregations":[{"fn":"count","field":"level"},{"fn":"sum","field":"x"}]}`,
+			to:   `{"aggregations":[{"fn":"avg","field":"level"}]}`,
+			want: []types.DashboardDiffChange{
+				{Path: "/aggregations/0/fn", Op: types.DashboardDiffOpChanged, OldValue: `"count"`, NewValue: `"avg"`},
+				{Path: "/aggregations/1", Op: types.DashboardDiffOpRemoved, OldValue: `{"field":"x","fn":"sum"}`},
+			},
+		},
+		{
+			name: "type_changed",
+			from: `{"columns":["level"]}`,
+			to:   `{"columns":{"level":true}}`,
+			want: []types.DashboardDiffChange{
+				{Path: "/columns", Op: types.DashboardDiffOpChanged, OldValue: `["level"]`, NewValue: `{"level":true}`},
+			},
+		},
+		{
+			name: "not_json",
+			from: "my_meta",
+			to:   "my_new_meta",
+			want: []types.DashboardDiffChange{
+				{Path: "", Op: types.DashboardDiffOpChanged, OldValue: `"my_meta"`, NewValue: `"my_new_meta"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, diffMeta(tt.from, tt.to))
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package dashboards
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+)
+
+const (
+	maxTags          = 32
+	maxTagLen        = 64
+	maxFolderNameLen = 64
+)
+
+func (s *service) GetDashboardFolders(ctx context.Context) (types.DashboardFolders, error) {
+	return s.repo.GetFolders(ctx)
+}
+
+func (s *service) CreateDashboardFolder(ctx context.Context, req types.CreateDashboardFolderRequest) (int64, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	req.ProfileID = profileID
+
+	if err := checkFolderName(req.Name); err != nil {
+		return 0, err
+	}
+
+	if req.ParentID != nil {
+		folders, err := s.repo.GetFolders(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := findFolder(folders, *req.ParentID); !ok {
+			return 0, types.NewErrNotFound("parent folder")
+		}
+	}
+
+	return s.repo.CreateFolder(ctx, req)
+}
+
+func (s *service) UpdateDashboardFolder(ctx context.Context, req types.UpdateDashboardFolderRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if req.IsEmpty() {
+		return types.ErrEmptyUpdateRequest
+	}
+	if req.Name != nil {
+		if err := checkFolderName(*req.Name); err != nil {
+			return err
+		}
+	}
+
+	folders, err := s.checkFolderOwner(ctx, req.ID, req.ProfileID, "update dashboard folder")
+	if err != nil {
+		return err
+	}
+
+	if req.ParentID != nil && *req.ParentID != 0 {
+		if _, ok := findFolder(folders, *req.ParentID); !ok {
+			return types.NewErrNotFound("parent folder")
+		}
+		if isFolderDescendant(folders, *req.ParentID, req.ID) {
+			return types.NewErrInvalidRequestField("folder can't be moved into itself or its subfolder")
+		}
+	}
+
+	return s.repo.UpdateFolder(ctx, req)
+}
+
+func (s *service) DeleteDashboardFolder(ctx context.Context, req types.DeleteDashboardFolderRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if _, err := s.checkFolderOwner(ctx, req.ID, req.ProfileID, "delete dashboard folder"); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteFolder(ctx, req)
+}
+
+func (s *service) StarDashboard(ctx context.Context, id string) error {
+	u, err := getUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := checkUUID(id); err != nil {
+		return err
+	}
+
+	if _, err := s.checkAccess(ctx, id, u, accessView, "star dashboard"); err != nil {
+		return err
+	}
+
+	return s.repo.Star(ctx, types.StarDashboardRequest{
+		UUID:      id,
+		ProfileID: u.ProfileID,
+	})
+}
+
+func (s *service) UnstarDashboard(ctx context.Context, id string) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := checkUUID(id); err != nil {
+		return err
+	}
+
+	return s.repo.Unstar(ctx, types.StarDashboardRequest{
+		UUID:      id,
+		ProfileID: profileID,
+	})
+}
+
+// checkFolderOwner checks that the folder exists and belongs to the user.
+// It returns all folders to allow further checks of the hierarchy.
+func (s *service) checkFolderOwner(ctx context.Context, id, profileID int64, operation string) (types.DashboardFolders, error) {
+	folders, err := s.repo.GetFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := findFolder(folders, id)
+	if !ok {
+		return nil, types.NewErrNotFound("dashboard folder")
+	}
+	if f.OwnerID != profileID {
+		return nil, types.NewErrPermissionDenied(operation)
+	}
+
+	return folders, nil
+}
+
+// checkFolderExists checks that the dashboard can be placed into the folder, zero means the root.
+func (s *service) checkFolderExists(ctx context.Context, id *int64) error {
+	if id == nil || *id == 0 {
+		return nil
+	}
+
+	folders, err := s.repo.GetFolders(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := findFolder(folders, *id); !ok {
+		return types.NewErrNotFound("dashboard folder")
+	}
+	return nil
+}
+
+func findFolder(folders types.DashboardFolders, id int64) (types.DashboardFolder, bool) {
+	i := slices.IndexFunc(folders, func(f types.DashboardFolder) bool {
+		return f.ID == id
+	})
+	if i < 0 {
+		return types.DashboardFolder{}, false
+	}
+	return folders[i], true
+}
+
+// isFolderDescendant reports whether the folder is the ancestor itself or one of its subfolders.
+func isFolderDescendant(folders types.DashboardFolders, id, ancestorID int64) bool {
+	// the number of steps is limited to protect from the cycles already stored
+	for range len(folders) + 1 {
+		if id == ancestorID {
+			return true
+		}
+		f, ok := findFolder(folders, id)
+		if !ok || f.ParentID == nil {
+			return false
+		}
+		id = *f.ParentID
+	}
+	return false
+}
+
+func checkFolderName(name string) error {
+	if name == "" {
+		return types.NewErrInvalidRequestField("empty 'name'")
+	}
+	if utf8.RuneCountInString(name) > maxFolderNameLen {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("'name' must be at most %d characters", maxFolderNameLen))
+	}
+	return nil
+}
+
+// normalizeTags trims tags and removes empty and duplicate ones keeping the order.
+func normalizeTags(tags []string) ([]string, error) {
+	res := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" || slices.Contains(res, t) {
+			continue
+		}
+		if utf8.RuneCountInString(t) > maxTagLen {
+			return nil, types.NewErrInvalidRequestField(fmt.Sprintf("tag must be at most %d characters", maxTagLen))
+		}
+		res = append(res, t)
+	}
+	if len(res) > maxTags {
+		return nil, types.NewErrInvalidRequestField(fmt.Sprintf("too many tags, max %d", maxTags))
+	}
+	return res, nil
+}
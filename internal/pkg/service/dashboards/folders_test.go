@@ -0,0 +1,93 @@
+package dashboards
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tooMany := make([]string, maxTags+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("t", i+1)
+	}
+
+	tests := []struct {
+		name string
+
+		tags    []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			tags: []string{" prod ", "api", "", "prod", "  "},
+			want: []string{"prod", "api"},
+		},
+		{
+			name: "empty",
+			tags: nil,
+			want: []string{},
+		},
+		{
+			name:    "too_long",
+			tags:    []string{strings.Repeat("a", maxTagLen+1)},
+			wantErr: true,
+		},
+		{
+			name:    "too_many",
+			tags:    tooMany,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := normalizeTags(tt.tags)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsFolderDescendant(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+
+	// 1 -> 2 -> 3, 4
+	folders := types.DashboardFolders{
+		{ID: 1},
+		{ID: 2, ParentID: ptr(1)},
+		{ID: 3, ParentID: ptr(2)},
+		{ID: 4},
+	}
+
+	tests := []struct {
+		name string
+
+		id         int64
+		ancestorID int64
+		want       bool
+	}{
+		{name: "self", id: 2, ancestorID: 2, want: true},
+		{name: "child", id: 2, ancestorID: 1, want: true},
+		{name: "grandchild", id: 3, ancestorID: 1, want: true},
+		{name: "parent", id: 1, ancestorID: 2, want: false},
+		{name: "other_tree", id: 4, ancestorID: 1, want: false},
+		{name: "unknown", id: 5, ancestorID: 1, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, isFolderDescendant(folders, tt.id, tt.ancestorID))
+		})
+	}
+}
@@ -56,6 +56,21 @@ func (mr *MockServiceMockRecorder) CreateDashboard(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDashboard", reflect.TypeOf((*MockService)(nil).CreateDashboard), arg0, arg1)
 }
 
+// CreateDashboardFolder mocks base method.
+func (m *MockService) CreateDashboardFolder(arg0 context.Context, arg1 types.CreateDashboardFolderRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDashboardFolder", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDashboardFolder indicates an expected call of CreateDashboardFolder.
+func (mr *MockServiceMockRecorder) CreateDashboardFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDashboardFolder", reflect.TypeOf((*MockService)(nil).CreateDashboardFolder), arg0, arg1)
+}
+
 // DeleteDashboard mocks base method.
 func (m *MockService) DeleteDashboard(arg0 context.Context, arg1 types.DeleteDashboardRequest) error {
 	m.ctrl.T.Helper()
@@ -70,6 +85,50 @@ func (mr *MockServiceMockRecorder) DeleteDashboard(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDashboard", reflect.TypeOf((*MockService)(nil).DeleteDashboard), arg0, arg1)
 }
 
+// DeleteDashboardFolder mocks base method.
+func (m *MockService) DeleteDashboardFolder(arg0 context.Context, arg1 types.DeleteDashboardFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDashboardFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDashboardFolder indicates an expected call of DeleteDashboardFolder.
+func (mr *MockServiceMockRecorder) DeleteDashboardFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDashboardFolder", reflect.TypeOf((*MockService)(nil).DeleteDashboardFolder), arg0, arg1)
+}
+
+// DiffDashboardVersions mocks base method.
+func (m *MockService) DiffDashboardVersions(arg0 context.Context, arg1 types.DiffDashboardVersionsRequest) (types.DashboardVersionsDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffDashboardVersions", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardVersionsDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffDashboardVersions indicates an expected call of DiffDashboardVersions.
+func (mr *MockServiceMockRecorder) DiffDashboardVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffDashboardVersions", reflect.TypeOf((*MockService)(nil).DiffDashboardVersions), arg0, arg1)
+}
+
+// ExportDashboards mocks base method.
+func (m *MockService) ExportDashboards(arg0 context.Context, arg1 []string) (types.DashboardsBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportDashboards", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardsBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportDashboards indicates an expected call of ExportDashboards.
+func (mr *MockServiceMockRecorder) ExportDashboards(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportDashboards", reflect.TypeOf((*MockService)(nil).ExportDashboards), arg0, arg1)
+}
+
 // GetAllDashboards mocks base method.
 func (m *MockService) GetAllDashboards(arg0 context.Context, arg1 types.GetAllDashboardsRequest) (types.DashboardInfosWithOwner, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +159,51 @@ func (mr *MockServiceMockRecorder) GetDashboardByUUID(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardByUUID", reflect.TypeOf((*MockService)(nil).GetDashboardByUUID), arg0, arg1)
 }
 
+// GetDashboardFolders mocks base method.
+func (m *MockService) GetDashboardFolders(arg0 context.Context) (types.DashboardFolders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDashboardFolders", arg0)
+	ret0, _ := ret[0].(types.DashboardFolders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDashboardFolders indicates an expected call of GetDashboardFolders.
+func (mr *MockServiceMockRecorder) GetDashboardFolders(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardFolders", reflect.TypeOf((*MockService)(nil).GetDashboardFolders), arg0)
+}
+
+// GetDashboardSharing mocks base method.
+func (m *MockService) GetDashboardSharing(arg0 context.Context, arg1 string) (types.DashboardACL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDashboardSharing", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDashboardSharing indicates an expected call of GetDashboardSharing.
+func (mr *MockServiceMockRecorder) GetDashboardSharing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardSharing", reflect.TypeOf((*MockService)(nil).GetDashboardSharing), arg0, arg1)
+}
+
+// GetDashboardVersion mocks base method.
+func (m *MockService) GetDashboardVersion(arg0 context.Context, arg1 types.GetDashboardVersionRequest) (types.DashboardVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDashboardVersion", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDashboardVersion indicates an expected call of GetDashboardVersion.
+func (mr *MockServiceMockRecorder) GetDashboardVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardVersion", reflect.TypeOf((*MockService)(nil).GetDashboardVersion), arg0, arg1)
+}
+
 // GetMyDashboards mocks base method.
 func (m *MockService) GetMyDashboards(arg0 context.Context, arg1 types.GetUserDashboardsRequest) (types.DashboardInfos, error) {
 	m.ctrl.T.Helper()
@@ -115,11 +219,85 @@ func (mr *MockServiceMockRecorder) GetMyDashboards(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyDashboards", reflect.TypeOf((*MockService)(nil).GetMyDashboards), arg0, arg1)
 }
 
+// ImportDashboards mocks base method.
+func (m *MockService) ImportDashboards(arg0 context.Context, arg1 types.ImportDashboardsRequest) (types.DashboardImportResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportDashboards", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardImportResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportDashboards indicates an expected call of ImportDashboards.
+func (mr *MockServiceMockRecorder) ImportDashboards(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportDashboards", reflect.TypeOf((*MockService)(nil).ImportDashboards), arg0, arg1)
+}
+
+// ListDashboardVersions mocks base method.
+func (m *MockService) ListDashboardVersions(arg0 context.Context, arg1 types.ListDashboardVersionsRequest) (types.DashboardVersionInfos, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDashboardVersions", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardVersionInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDashboardVersions indicates an expected call of ListDashboardVersions.
+func (mr *MockServiceMockRecorder) ListDashboardVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDashboardVersions", reflect.TypeOf((*MockService)(nil).ListDashboardVersions), arg0, arg1)
+}
+
+// RenderDashboard mocks base method.
+func (m *MockService) RenderDashboard(arg0 context.Context, arg1 types.RenderDashboardRequest) (types.DashboardPanelsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderDashboard", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardPanelsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderDashboard indicates an expected call of RenderDashboard.
+func (mr *MockServiceMockRecorder) RenderDashboard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderDashboard", reflect.TypeOf((*MockService)(nil).RenderDashboard), arg0, arg1)
+}
+
+// ResolveDashboardVariables mocks base method.
+func (m *MockService) ResolveDashboardVariables(arg0 context.Context, arg1 types.ResolveDashboardVariablesRequest) (types.DashboardVariablesOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDashboardVariables", arg0, arg1)
+	ret0, _ := ret[0].(types.DashboardVariablesOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDashboardVariables indicates an expected call of ResolveDashboardVariables.
+func (mr *MockServiceMockRecorder) ResolveDashboardVariables(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDashboardVariables", reflect.TypeOf((*MockService)(nil).ResolveDashboardVariables), arg0, arg1)
+}
+
+// RestoreDashboardVersion mocks base method.
+func (m *MockService) RestoreDashboardVersion(arg0 context.Context, arg1 types.RestoreDashboardVersionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDashboardVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDashboardVersion indicates an expected call of RestoreDashboardVersion.
+func (mr *MockServiceMockRecorder) RestoreDashboardVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDashboardVersion", reflect.TypeOf((*MockService)(nil).RestoreDashboardVersion), arg0, arg1)
+}
+
 // SearchDashboards mocks base method.
-func (m *MockService) SearchDashboards(arg0 context.Context, arg1 types.SearchDashboardsRequest) (types.DashboardInfosWithOwner, error) {
+func (m *MockService) SearchDashboards(arg0 context.Context, arg1 types.SearchDashboardsRequest) (types.DashboardSearchResults, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchDashboards", arg0, arg1)
-	ret0, _ := ret[0].(types.DashboardInfosWithOwner)
+	ret0, _ := ret[0].(types.DashboardSearchResults)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -130,6 +308,48 @@ func (mr *MockServiceMockRecorder) SearchDashboards(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDashboards", reflect.TypeOf((*MockService)(nil).SearchDashboards), arg0, arg1)
 }
 
+// StarDashboard mocks base method.
+func (m *MockService) StarDashboard(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StarDashboard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StarDashboard indicates an expected call of StarDashboard.
+func (mr *MockServiceMockRecorder) StarDashboard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StarDashboard", reflect.TypeOf((*MockService)(nil).StarDashboard), arg0, arg1)
+}
+
+// TransferDashboardOwnership mocks base method.
+func (m *MockService) TransferDashboardOwnership(arg0 context.Context, arg1 types.TransferDashboardOwnershipRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferDashboardOwnership", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferDashboardOwnership indicates an expected call of TransferDashboardOwnership.
+func (mr *MockServiceMockRecorder) TransferDashboardOwnership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferDashboardOwnership", reflect.TypeOf((*MockService)(nil).TransferDashboardOwnership), arg0, arg1)
+}
+
+// UnstarDashboard mocks base method.
+func (m *MockService) UnstarDashboard(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnstarDashboard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnstarDashboard indicates an expected call of UnstarDashboard.
+func (mr *MockServiceMockRecorder) UnstarDashboard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnstarDashboard", reflect.TypeOf((*MockService)(nil).UnstarDashboard), arg0, arg1)
+}
+
 // UpdateDashboard mocks base method.
 func (m *MockService) UpdateDashboard(arg0 context.Context, arg1 types.UpdateDashboardRequest) error {
 	m.ctrl.T.Helper()
@@ -143,3 +363,31 @@ func (mr *MockServiceMockRecorder) UpdateDashboard(arg0, arg1 any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDashboard", reflect.TypeOf((*MockService)(nil).UpdateDashboard), arg0, arg1)
 }
+
+// UpdateDashboardFolder mocks base method.
+func (m *MockService) UpdateDashboardFolder(arg0 context.Context, arg1 types.UpdateDashboardFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDashboardFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDashboardFolder indicates an expected call of UpdateDashboardFolder.
+func (mr *MockServiceMockRecorder) UpdateDashboardFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDashboardFolder", reflect.TypeOf((*MockService)(nil).UpdateDashboardFolder), arg0, arg1)
+}
+
+// UpdateDashboardSharing mocks base method.
+func (m *MockService) UpdateDashboardSharing(arg0 context.Context, arg1 types.UpdateDashboardSharingRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDashboardSharing", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDashboardSharing indicates an expected call of UpdateDashboardSharing.
+func (mr *MockServiceMockRecorder) UpdateDashboardSharing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDashboardSharing", reflect.TypeOf((*MockService)(nil).UpdateDashboardSharing), arg0, arg1)
+}
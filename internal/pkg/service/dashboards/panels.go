@@ -0,0 +1,190 @@
+package dashboards
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+const (
+	defaultMaxPanels = 50
+	maxVariables     = 32
+)
+
+var aggFuncs = map[string]seqapi.AggFunc{
+	"":         seqapi.AggFunc_AGG_FUNC_COUNT,
+	"count":    seqapi.AggFunc_AGG_FUNC_COUNT,
+	"sum":      seqapi.AggFunc_AGG_FUNC_SUM,
+	"min":      seqapi.AggFunc_AGG_FUNC_MIN,
+	"max":      seqapi.AggFunc_AGG_FUNC_MAX,
+	"avg":      seqapi.AggFunc_AGG_FUNC_AVG,
+	"quantile": seqapi.AggFunc_AGG_FUNC_QUANTILE,
+	"unique":   seqapi.AggFunc_AGG_FUNC_UNIQUE,
+}
+
+// parseDashboardMeta returns panels and variables of the dashboard meta.
+// Meta which is not a JSON object is kept opaque for compatibility.
+func parseDashboardMeta(meta string) (types.DashboardMeta, error) {
+	var res types.DashboardMeta
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(meta), &fields); err != nil {
+		return res, nil
+	}
+	if raw, ok := fields["panels"]; ok {
+		if err := json.Unmarshal(raw, &res.Panels); err != nil {
+			return res, types.NewErrInvalidRequestField(fmt.Sprintf("invalid 'panels' in meta: %v", err))
+		}
+	}
+	if raw, ok := fields["variables"]; ok {
+		if err := json.Unmarshal(raw, &res.Variables); err != nil {
+			return res, types.NewErrInvalidRequestField(fmt.Sprintf("invalid 'variables' in meta: %v", err))
+		}
+	}
+	return res, nil
+}
+
+// checkMeta validates panels and variables of the dashboard meta.
+func (s *service) checkMeta(meta string) error {
+	m, err := parseDashboardMeta(meta)
+	if err != nil {
+		return err
+	}
+
+	maxPanels := defaultMaxPanels
+	if s.renderer != nil {
+		maxPanels = s.renderer.cfg.MaxPanels
+	}
+	if len(m.Panels) > maxPanels {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("too many panels, max %d", maxPanels))
+	}
+	if len(m.Variables) > maxVariables {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("too many variables, max %d", maxVariables))
+	}
+
+	defined := make(map[string]struct{}, len(m.Variables))
+	for i := range m.Variables {
+		v := &m.Variables[i]
+		if err := checkVariable(v, defined); err != nil {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("invalid variable #%d: %v", i, err))
+		}
+		defined[v.Name] = struct{}{}
+	}
+	for _, v := range m.Variables {
+		if err := s.checkEnv(v.Env, defined); err != nil {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("invalid variable %q: %v", v.Name, err))
+		}
+	}
+
+	seen := make(map[string]struct{}, len(m.Panels))
+	for i := range m.Panels {
+		p := &m.Panels[i]
+		if p.ID == "" {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("empty 'id' of panel #%d", i))
+		}
+		if _, ok := seen[p.ID]; ok {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("duplicate panel %q", p.ID))
+		}
+		seen[p.ID] = struct{}{}
+
+		if err := checkPanel(p); err != nil {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("invalid panel %q: %v", p.ID, err))
+		}
+		if err := s.checkEnv(p.Env, defined); err != nil {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("invalid panel %q: %v", p.ID, err))
+		}
+	}
+
+	return nil
+}
+
+// checkEnv checks that the env is configured, envs set by variables are checked on render.
+func (s *service) checkEnv(env string, variables map[string]struct{}) error {
+	if s.renderer == nil || hasVariables(env, variables) {
+		return nil
+	}
+	_, err := s.renderer.getEnv(env)
+	return err
+}
+
+func checkPanel(p *types.DashboardPanel) error {
+	if !p.Type.IsValid() {
+		return fmt.Errorf("unknown 'type' %q", p.Type)
+	}
+	if err := checkTimeRange(p.TimeRange); err != nil {
+		return err
+	}
+
+	if p.Type == types.DashboardPanelTypeHistogram {
+		if len(p.Aggregations) > 0 {
+			return fmt.Errorf("'aggregations' are not allowed for %s panel", p.Type)
+		}
+		return checkDuration("interval", p.Interval)
+	}
+
+	if len(p.Aggregations) == 0 {
+		return fmt.Errorf("empty 'aggregations' of %s panel", p.Type)
+	}
+	for i, agg := range p.Aggregations {
+		if err := checkPanelAggregation(p.Type, agg); err != nil {
+			return fmt.Errorf("aggregation #%d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func checkPanelAggregation(t types.DashboardPanelType, agg types.DashboardPanelAggregation) error {
+	f, ok := aggFuncs[agg.Func]
+	if !ok {
+		return fmt.Errorf("unknown 'func' %q", agg.Func)
+	}
+	if agg.Field == "" {
+		return errors.New("empty 'field'")
+	}
+	if f == seqapi.AggFunc_AGG_FUNC_QUANTILE && len(agg.Quantiles) == 0 {
+		return errors.New("empty 'quantiles' of quantile aggregation")
+	}
+
+	if t == types.DashboardPanelTypeAggregationTs {
+		if err := checkDuration("interval", agg.Interval); err != nil {
+			return err
+		}
+	} else if agg.Interval != "" {
+		return fmt.Errorf("'interval' is allowed only for %s panel", types.DashboardPanelTypeAggregationTs)
+	}
+	if agg.TargetBucketRate != "" {
+		return checkDuration("target_bucket_rate", agg.TargetBucketRate)
+	}
+	return nil
+}
+
+func checkTimeRange(tr types.DashboardTimeRange) error {
+	if tr.Last != "" {
+		if tr.From != nil || tr.To != nil {
+			return errors.New("only one of 'last' and 'from'/'to' of 'time_range' must be provided")
+		}
+		return checkDuration("last", tr.Last)
+	}
+	if tr.From == nil || tr.To == nil {
+		return errors.New("either 'last' or both 'from' and 'to' of 'time_range' must be provided")
+	}
+	if !tr.From.Before(*tr.To) {
+		return errors.New("'from' must be before 'to'")
+	}
+	return nil
+}
+
+func checkDuration(name, d string) error {
+	v, err := time.ParseDuration(d)
+	if err != nil {
+		return fmt.Errorf("invalid '%s': %w", name, err)
+	}
+	if v <= 0 {
+		return fmt.Errorf("'%s' must be positive", name)
+	}
+	return nil
+}
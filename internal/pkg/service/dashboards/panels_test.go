@@ -0,0 +1,183 @@
+package dashboards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestCheckMeta(t *testing.T) {
+	tests := []struct {
+		name string
+
+		meta    string
+		wantErr bool
+	}{
+		{
+			name: "opaque",
+			meta: "my_meta",
+		},
+		{
+			name: "no_panels",
+			meta: `{"layout":[]}`,
+		},
+		{
+			name: "ok",
+			meta: `{"panels":[
+				{"id":"p1","type":"histogram","query":"level:error","time_range":{"last":"1h"},"interval":"1m","w":6},
+				{"id":"p2","type":"aggregation","env":"prod","time_range":{"from":"2024-05-01T09:00:00Z","to":"2024-05-01T10:00:00Z"},
+					"aggregations":[{"field":"service"},{"field":"took","func":"quantile","quantiles":[0.95]}]},
+				{"id":"p3","type":"aggregation_ts","time_range":{"last":"15m"},
+					"aggregations":[{"field":"service","interval":"30s","target_bucket_rate":"1s"}]}
+			]}`,
+		},
+		{
+			name:    "err_panels",
+			meta:    `{"panels":{}}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_id",
+			meta:    `{"panels":[{"type":"histogram","time_range":{"last":"1h"},"interval":"1m"}]}`,
+			wantErr: true,
+		},
+		{
+			name: "err_duplicate",
+			meta: `{"panels":[
+				{"id":"p1","type":"histogram","time_range":{"last":"1h"},"interval":"1m"},
+				{"id":"p1","type":"histogram","time_range":{"last":"1h"},"interval":"1m"}
+			]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_type",
+			meta:    `{"panels":[{"id":"p1","type":"table","time_range":{"last":"1h"}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_time_range",
+			meta:    `{"panels":[{"id":"p1","type":"histogram","time_range":{},"interval":"1m"}]}`,
+			wantErr: true,
+		},
+		{
+			name: "err_time_range_order",
+			meta: `{"panels":[{"id":"p1","type":"histogram","interval":"1m",
+				"time_range":{"from":"2024-05-01T10:00:00Z","to":"2024-05-01T09:00:00Z"}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_interval",
+			meta:    `{"panels":[{"id":"p1","type":"histogram","time_range":{"last":"1h"}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_no_aggregations",
+			meta:    `{"panels":[{"id":"p1","type":"aggregation","time_range":{"last":"1h"}}]}`,
+			wantErr: true,
+		},
+		{
+			name: "err_func",
+			meta: `{"panels":[{"id":"p1","type":"aggregation","time_range":{"last":"1h"},
+				"aggregations":[{"field":"service","func":"median"}]}]}`,
+			wantErr: true,
+		},
+		{
+			name: "err_quantiles",
+			meta: `{"panels":[{"id":"p1","type":"aggregation","time_range":{"last":"1h"},
+				"aggregations":[{"field":"took","func":"quantile"}]}]}`,
+			wantErr: true,
+		},
+		{
+			name: "err_ts_interval",
+			meta: `{"panels":[{"id":"p1","type":"aggregation_ts","time_range":{"last":"1h"},
+				"aggregations":[{"field":"service"}]}]}`,
+			wantErr: true,
+		},
+		{
+			name: "variables",
+			meta: `{"variables":[
+				{"name":"env","type":"env","default":"prod"},
+				{"name":"service","type":"aggregation","field":"service","env":"$env","time_range":{"last":"6h"}},
+				{"name":"level","type":"custom","options":["error","warn"]},
+				{"name":"svc","type":"error_groups_services","query":"api"}
+			],"panels":[
+				{"id":"p1","type":"histogram","query":"service:$service AND level:${level}","env":"$env",
+					"time_range":{"last":"1h"},"interval":"1m"}
+			]}`,
+		},
+		{
+			name:    "err_variables",
+			meta:    `{"variables":{}}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_variable_name",
+			meta:    `{"variables":[{"name":"my-var","type":"custom","options":["a"]}]}`,
+			wantErr: true,
+		},
+		{
+			name: "err_variable_duplicate",
+			meta: `{"variables":[
+				{"name":"level","type":"custom","options":["a"]},
+				{"name":"level","type":"custom","options":["b"]}
+			]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_variable_type",
+			meta:    `{"variables":[{"name":"level","type":"query"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_variable_options",
+			meta:    `{"variables":[{"name":"level","type":"custom"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_variable_field",
+			meta:    `{"variables":[{"name":"service","type":"aggregation"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_variable_self_reference",
+			meta:    `{"variables":[{"name":"service","type":"aggregation","field":"service","query":"service:$service"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_variable_env",
+			meta:    `{"variables":[{"name":"service","type":"aggregation","field":"service","env":"dev"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "err_env",
+			meta:    `{"panels":[{"id":"p1","type":"histogram","env":"dev","time_range":{"last":"1h"},"interval":"1m"}]}`,
+			wantErr: true,
+		},
+	}
+
+	s := &service{
+		renderer: &Renderer{
+			cfg: config.DashboardsRender{MaxPanels: 3},
+			envs: map[string]renderEnv{
+				"prod":    {name: "prod"},
+				"staging": {name: "staging"},
+			},
+			defaultEnv: "prod",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := s.checkMeta(tt.meta)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidRequestField)
+			}
+		})
+	}
+}
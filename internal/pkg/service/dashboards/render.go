@@ -0,0 +1,449 @@
+package dashboards
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/aggregation_ts"
+	"github.com/ozontech/seq-ui/internal/api/seqapi/v1/api_error"
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/envaccess"
+	"github.com/ozontech/seq-ui/internal/app/querypolicy"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/cache"
+	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
+	"github.com/ozontech/seq-ui/internal/pkg/mask"
+	"github.com/ozontech/seq-ui/internal/pkg/service/errorgroups"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+const renderCacheKeyPrefix = "dashboards_render_"
+
+type renderEnv struct {
+	name    string
+	client  seqdb.Client
+	options *config.SeqAPIOptions
+	masker  *mask.Masker
+	access  *config.SeqAPIEnvAccess
+}
+
+// Renderer executes panel queries of the dashboards in seq-db.
+type Renderer struct {
+	cfg        config.DashboardsRender
+	envs       map[string]renderEnv
+	defaultEnv string
+	cache      cache.Cache
+	// errorGroups resolves services variables, may be nil if error groups aren't configured.
+	errorGroups errorgroups.Service
+	nowFn       func() time.Time
+}
+
+// NewRenderer creates renderer using the same seq-db clients, limits and masking as seq-api.
+func NewRenderer(
+	cfg config.DashboardsRender,
+	seqAPI config.SeqAPI,
+	seqDBClients map[string]seqdb.Client,
+	c cache.Cache,
+	errorGroups errorgroups.Service,
+) (*Renderer, error) {
+	r := &Renderer{
+		cfg:         cfg,
+		envs:        make(map[string]renderEnv),
+		defaultEnv:  seqAPI.DefaultEnv,
+		cache:       c,
+		errorGroups: errorGroups,
+		nowFn:       time.Now,
+	}
+
+	if len(seqAPI.Envs) == 0 {
+		client, ok := seqDBClients[config.DefaultSeqDBClientID]
+		if !ok {
+			return nil, fmt.Errorf("seq-db client %q not found", config.DefaultSeqDBClientID)
+		}
+		masker, err := mask.New(seqAPI.Masking)
+		if err != nil {
+			return nil, fmt.Errorf("failed to init masking: %w", err)
+		}
+		r.envs[""] = renderEnv{client: client, options: seqAPI.SeqAPIOptions, masker: masker}
+		return r, nil
+	}
+
+	for name, env := range seqAPI.Envs {
+		client, ok := seqDBClients[env.SeqDB]
+		if !ok {
+			return nil, fmt.Errorf("seq-db client %q of env %q not found", env.SeqDB, name)
+		}
+		masker, err := mask.New(env.Options.Masking)
+		if err != nil {
+			return nil, fmt.Errorf("failed to init masking of env %q: %w", name, err)
+		}
+		r.envs[name] = renderEnv{name: name, client: client, options: env.Options, masker: masker, access: env.Access}
+	}
+	return r, nil
+}
+
+// getEnv resolves env of the panel. Like in seq-api, env is ignored if envs aren't configured.
+func (r *Renderer) getEnv(name string) (renderEnv, error) {
+	if len(r.envs) == 1 {
+		if env, ok := r.envs[""]; ok {
+			return env, nil
+		}
+	}
+	if name == "" {
+		name = r.defaultEnv
+	}
+	env, ok := r.envs[name]
+	if !ok {
+		return renderEnv{}, fmt.Errorf("env '%s' not found", name)
+	}
+	return env, nil
+}
+
+// getAllowedEnv resolves env like getEnv and checks that the user from context may query it.
+func (r *Renderer) getAllowedEnv(ctx context.Context, name string) (renderEnv, error) {
+	env, err := r.getEnv(name)
+	if err != nil {
+		return env, err
+	}
+	if !envaccess.Allowed(ctx, env.access) {
+		return renderEnv{}, types.NewErrPermissionDenied(fmt.Sprintf("access env '%s'", env.name))
+	}
+	return env, nil
+}
+
+// RenderDashboard executes queries of all panels in parallel substituting values of the variables.
+// Failed panels are returned with an error, so the rest of the dashboard is still rendered.
+func (s *service) RenderDashboard(ctx context.Context, req types.RenderDashboardRequest) (types.DashboardPanelsData, error) {
+	if s.renderer == nil {
+		return nil, errors.New("dashboards rendering is not configured")
+	}
+
+	u, err := getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return nil, err
+	}
+	if (req.From == nil) != (req.To == nil) {
+		return nil, types.NewErrInvalidRequestField("both 'from' and 'to' must be provided")
+	}
+	if req.From != nil && !req.From.Before(*req.To) {
+		return nil, types.NewErrInvalidRequestField("'from' must be before 'to'")
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessView, "render dashboard"); err != nil {
+		return nil, err
+	}
+
+	d, err := s.repo.GetByUUID(ctx, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := parseDashboardMeta(d.Meta)
+	if err != nil {
+		return nil, err
+	}
+
+	values := variableValues(m.Variables, req.Variables)
+	return s.renderer.render(ctx, m.Panels, values, req.From, req.To), nil
+}
+
+func (r *Renderer) render(
+	ctx context.Context,
+	panels []types.DashboardPanel,
+	values map[string]string,
+	from, to *time.Time,
+) types.DashboardPanelsData {
+	res := make(types.DashboardPanelsData, len(panels))
+
+	// relative time ranges are aligned to the cache TTL to share cached results between viewers
+	now := r.nowFn().Truncate(r.cfg.CacheTTL)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(r.cfg.MaxParallelRequests)
+	for i, p := range panels {
+		data := &res[i]
+		data.ID = p.ID
+		data.From, data.To = panelTimeRange(p.TimeRange, now)
+		if from != nil {
+			data.From, data.To = *from, *to
+		}
+
+		eg.Go(func() error {
+			// errors are returned per panel to render the rest of the dashboard
+			err := applyVariables(&p, values)
+			if err == nil {
+				err = r.renderPanel(ctx, p, data)
+			}
+			if err != nil {
+				data.Error = err.Error()
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	return res
+}
+
+// applyVariables substitutes values of the variables into query and env of the panel.
+func applyVariables(p *types.DashboardPanel, values map[string]string) error {
+	var err error
+	if p.Query, err = substituteVariables(p.Query, values, true); err != nil {
+		return err
+	}
+	p.Env, err = substituteVariables(p.Env, values, false)
+	return err
+}
+
+func panelTimeRange(tr types.DashboardTimeRange, now time.Time) (time.Time, time.Time) {
+	if tr.Last != "" {
+		last, _ := time.ParseDuration(tr.Last)
+		return now.Add(-last), now
+	}
+	if tr.From == nil || tr.To == nil {
+		return now, now
+	}
+	return *tr.From, *tr.To
+}
+
+func (r *Renderer) renderPanel(ctx context.Context, p types.DashboardPanel, data *types.DashboardPanelData) error {
+	if err := checkPanel(&p); err != nil {
+		return err
+	}
+
+	env, err := r.getAllowedEnv(ctx, p.Env)
+	if err != nil {
+		return err
+	}
+
+	if p.Type == types.DashboardPanelTypeHistogram {
+		resp, err := r.getHistogram(ctx, env, &seqapi.GetHistogramRequest{
+			Query:    p.Query,
+			Interval: p.Interval,
+			From:     timestamppb.New(data.From),
+			To:       timestamppb.New(data.To),
+		})
+		if err != nil {
+			return err
+		}
+		if err := responseError(resp.Error); err != nil {
+			return err
+		}
+		data.Histogram = histogramFromProto(resp.Histogram)
+		return nil
+	}
+
+	aggs := make([]*seqapi.AggregationQuery, len(p.Aggregations))
+	for i, agg := range p.Aggregations {
+		aggs[i] = &seqapi.AggregationQuery{
+			Field:     agg.Field,
+			GroupBy:   agg.GroupBy,
+			Func:      aggFuncs[agg.Func],
+			Quantiles: agg.Quantiles,
+		}
+		if agg.Interval != "" {
+			aggs[i].Interval = &agg.Interval
+		}
+		if agg.TargetBucketRate != "" {
+			aggs[i].TargetBucketRate = &agg.TargetBucketRate
+		}
+	}
+
+	if err := api_error.CheckAggregationsCount(len(aggs), env.options.MaxAggregationsPerRequest); err != nil {
+		return err
+	}
+	for _, agg := range aggs {
+		if agg.Interval == nil {
+			continue
+		}
+		if err := api_error.CheckAggregationTsInterval(*agg.Interval, data.From, data.To,
+			env.options.MaxBucketsPerAggregationTs,
+		); err != nil {
+			return err
+		}
+	}
+
+	resp, err := r.getAggregation(ctx, env, &seqapi.GetAggregationRequest{
+		Query:        p.Query,
+		From:         timestamppb.New(data.From),
+		To:           timestamppb.New(data.To),
+		Aggregations: aggs,
+	})
+	if err != nil {
+		return err
+	}
+	if err := responseError(resp.Error); err != nil {
+		return err
+	}
+
+	if env.masker != nil {
+		maskAggregations(env.masker, aggs, resp.Aggregations)
+	}
+	if err := aggregation_ts.NormalizeBuckets(aggs, resp.Aggregations); err != nil {
+		return err
+	}
+
+	data.Aggregations = aggregationsFromProto(resp.Aggregations)
+	return nil
+}
+
+func (r *Renderer) getHistogram(ctx context.Context, env renderEnv, req *seqapi.GetHistogramRequest) (*seqapi.GetHistogramResponse, error) {
+	key := renderCacheKey(ctx, env, req)
+	resp := &seqapi.GetHistogramResponse{}
+	if r.getCached(ctx, key, resp) {
+		return resp, nil
+	}
+
+	resp, err := env.client.GetHistogram(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	r.setCached(ctx, key, resp)
+	return resp, nil
+}
+
+func (r *Renderer) getAggregation(ctx context.Context, env renderEnv, req *seqapi.GetAggregationRequest) (*seqapi.GetAggregationResponse, error) {
+	key := renderCacheKey(ctx, env, req)
+	resp := &seqapi.GetAggregationResponse{}
+	if r.getCached(ctx, key, resp) {
+		return resp, nil
+	}
+
+	resp, err := env.client.GetAggregation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	r.setCached(ctx, key, resp)
+	return resp, nil
+}
+
+// getCached reads the raw seq-db response, masking is applied on every read like for events.
+func (r *Renderer) getCached(ctx context.Context, key string, resp proto.Message) bool {
+	cached, err := r.cache.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, cache.ErrNotFound) {
+			logger.Error("failed to get cached panel data", zap.String("key", key), zap.Error(err))
+		}
+		return false
+	}
+
+	if err := proto.Unmarshal([]byte(cached), resp); err != nil {
+		logger.Error("failed to unmarshal cached panel data", zap.String("key", key), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func (r *Renderer) setCached(ctx context.Context, key string, resp proto.Message) {
+	// partial results must not be cached
+	if e, ok := resp.(interface{ GetError() *seqapi.Error }); ok && responseError(e.GetError()) != nil {
+		return
+	}
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		logger.Error("failed to marshal panel data for caching", zap.String("key", key), zap.Error(err))
+		return
+	}
+	if err := r.cache.SetWithTTL(ctx, key, string(data), r.cfg.CacheTTL); err != nil {
+		logger.Error("failed to cache panel data", zap.String("key", key), zap.Error(err))
+	}
+}
+
+func responseError(e *seqapi.Error) error {
+	if e == nil || e.Code == seqapi.ErrorCode_ERROR_CODE_UNSPECIFIED || e.Code == seqapi.ErrorCode_ERROR_CODE_NO {
+		return nil
+	}
+	return errors.New(e.Message)
+}
+
+// renderCacheKey includes the query policy restriction of the user, since the client adds it to the request.
+func renderCacheKey(ctx context.Context, env renderEnv, req proto.Message) string {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+
+	h := sha256.New()
+	h.Write([]byte(env.name))
+	h.Write([]byte{0})
+	h.Write([]byte(querypolicy.ClientRestriction(ctx, env.client).Query()))
+	h.Write([]byte{0})
+	h.Write(data)
+	return renderCacheKeyPrefix + hex.EncodeToString(h.Sum(nil))
+}
+
+func maskAggregations(m *mask.Masker, queries []*seqapi.AggregationQuery, aggs []*seqapi.Aggregation) {
+	buf := make([]string, 0)
+	for i, agg := range aggs {
+		if agg == nil || i >= len(queries) {
+			continue
+		}
+
+		buf = buf[:0]
+		for _, b := range agg.Buckets {
+			buf = append(buf, b.GetKey())
+		}
+
+		field := queries[i].Field
+		if queries[i].GroupBy != "" {
+			field = queries[i].GroupBy
+		}
+
+		buf = m.MaskAgg(field, buf)
+
+		for j, key := range buf {
+			if agg.Buckets[j] != nil {
+				agg.Buckets[j].Key = key
+			}
+		}
+	}
+}
+
+func histogramFromProto(h *seqapi.Histogram) []types.DashboardHistogramBucket {
+	res := make([]types.DashboardHistogramBucket, 0, len(h.GetBuckets()))
+	for _, b := range h.GetBuckets() {
+		res = append(res, types.DashboardHistogramBucket{
+			Key:      b.GetKey(),
+			DocCount: b.GetDocCount(),
+		})
+	}
+	return res
+}
+
+func aggregationsFromProto(aggs []*seqapi.Aggregation) []types.DashboardAggregation {
+	res := make([]types.DashboardAggregation, len(aggs))
+	for i, agg := range aggs {
+		buckets := make([]types.DashboardAggregationBucket, 0, len(agg.GetBuckets()))
+		for _, b := range agg.GetBuckets() {
+			bucket := types.DashboardAggregationBucket{
+				Key:       b.GetKey(),
+				Value:     b.Value,
+				NotExists: b.GetNotExists(),
+				Quantiles: b.GetQuantiles(),
+			}
+			if b.Ts != nil {
+				ts := b.Ts.AsTime()
+				bucket.Ts = &ts
+			}
+			buckets = append(buckets, bucket)
+		}
+		res[i] = types.DashboardAggregation{
+			Buckets:          buckets,
+			NotExists:        agg.GetNotExists(),
+			TargetBucketRate: agg.GetTargetBucketRate(),
+		}
+	}
+	return res
+}
@@ -0,0 +1,233 @@
+package dashboards
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/cache"
+	mock_cache "github.com/ozontech/seq-ui/internal/pkg/cache/mock"
+	mock_seqdb "github.com/ozontech/seq-ui/internal/pkg/client/seqdb/mock"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+func TestRender(t *testing.T) {
+	var (
+		now   = time.Date(2024, 5, 1, 10, 0, 20, 0, time.UTC)
+		to    = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		from  = to.Add(-time.Hour)
+		value = 2.0
+
+		histPanel = types.DashboardPanel{
+			ID:        "hist",
+			Type:      types.DashboardPanelTypeHistogram,
+			Query:     "level:error",
+			TimeRange: types.DashboardTimeRange{Last: "1h"},
+			Interval:  "1m",
+		}
+		aggPanel = types.DashboardPanel{
+			ID:           "agg",
+			Type:         types.DashboardPanelTypeAggregation,
+			Query:        "level:error",
+			TimeRange:    types.DashboardTimeRange{Last: "1h"},
+			Aggregations: []types.DashboardPanelAggregation{{Field: "service"}},
+		}
+		tooManyAggsPanel = types.DashboardPanel{
+			ID:        "too_many_aggs",
+			Type:      types.DashboardPanelTypeAggregation,
+			TimeRange: types.DashboardTimeRange{Last: "1h"},
+			Aggregations: []types.DashboardPanelAggregation{
+				{Field: "service"}, {Field: "level"},
+			},
+		}
+
+		histResp = &seqapi.GetHistogramResponse{
+			Histogram: &seqapi.Histogram{
+				Buckets: []*seqapi.Histogram_Bucket{{Key: 1714554000000, DocCount: 3}},
+			},
+		}
+		aggResp = &seqapi.GetAggregationResponse{
+			Aggregations: []*seqapi.Aggregation{{
+				Buckets: []*seqapi.Aggregation_Bucket{{Key: "svc1", Value: &value}},
+			}},
+		}
+	)
+
+	ctrl := gomock.NewController(t)
+	client := mock_seqdb.NewMockClient(ctrl)
+	c := mock_cache.NewMockCache(ctrl)
+
+	r := &Renderer{
+		cfg: config.DashboardsRender{CacheTTL: time.Minute, MaxParallelRequests: 2},
+		envs: map[string]renderEnv{
+			"": {client: client, options: &config.SeqAPIOptions{MaxAggregationsPerRequest: 1}},
+		},
+		cache: c,
+		nowFn: func() time.Time { return now },
+	}
+
+	// histogram isn't cached yet
+	histKey := renderCacheKey(context.Background(), r.envs[""], &seqapi.GetHistogramRequest{
+		Query:    histPanel.Query,
+		Interval: histPanel.Interval,
+		From:     timestamppb.New(from),
+		To:       timestamppb.New(to),
+	})
+	c.EXPECT().Get(gomock.Any(), histKey).Return("", cache.ErrNotFound)
+	client.EXPECT().GetHistogram(gomock.Any(), gomock.Any()).Return(histResp, nil)
+	histData, _ := proto.Marshal(histResp)
+	c.EXPECT().SetWithTTL(gomock.Any(), histKey, string(histData), time.Minute).Return(nil)
+
+	// aggregation is cached
+	aggData, _ := proto.Marshal(aggResp)
+	c.EXPECT().Get(gomock.Any(), gomock.Not(histKey)).Return(string(aggData), nil)
+
+	got := r.render(context.Background(), []types.DashboardPanel{histPanel, aggPanel, tooManyAggsPanel}, nil, nil, nil)
+
+	require.Equal(t, types.DashboardPanelsData{
+		{
+			ID: "hist", From: from, To: to,
+			Histogram: []types.DashboardHistogramBucket{{Key: 1714554000000, DocCount: 3}},
+		},
+		{
+			ID: "agg", From: from, To: to,
+			Aggregations: []types.DashboardAggregation{{
+				Buckets: []types.DashboardAggregationBucket{{Key: "svc1", Value: &value}},
+			}},
+		},
+		{
+			ID: "too_many_aggs", From: from, To: to,
+			Error: "too many aggregations requested, limit is 1 aggregations per request",
+		},
+	}, got)
+}
+
+func TestRenderPanelError(t *testing.T) {
+	var (
+		from = time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+		to   = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+		panel = types.DashboardPanel{
+			ID:        "hist",
+			Type:      types.DashboardPanelTypeHistogram,
+			Query:     "service:$service",
+			TimeRange: types.DashboardTimeRange{Last: "1h"},
+			Interval:  "1m",
+		}
+	)
+
+	tests := []struct {
+		name string
+
+		values  map[string]string
+		mock    func(client *mock_seqdb.MockClient, c *mock_cache.MockCache)
+		wantErr string
+	}{
+		{
+			name:    "err_variable",
+			values:  map[string]string{"service": ""},
+			mock:    func(_ *mock_seqdb.MockClient, _ *mock_cache.MockCache) {},
+			wantErr: "variable 'service' has no value",
+		},
+		{
+			name:   "err_client",
+			values: map[string]string{"service": "api gateway"},
+			mock: func(client *mock_seqdb.MockClient, c *mock_cache.MockCache) {
+				c.EXPECT().Get(gomock.Any(), gomock.Any()).Return("", cache.ErrNotFound)
+				client.EXPECT().GetHistogram(gomock.Any(), &histogramRequestMatcher{query: `service:"api gateway"`}).
+					Return(nil, errors.New("unavailable"))
+			},
+			wantErr: "unavailable",
+		},
+		{
+			name: "err_partial_response",
+			mock: func(client *mock_seqdb.MockClient, c *mock_cache.MockCache) {
+				c.EXPECT().Get(gomock.Any(), gomock.Any()).Return("", cache.ErrNotFound)
+				client.EXPECT().GetHistogram(gomock.Any(), gomock.Any()).Return(&seqapi.GetHistogramResponse{
+					Error: &seqapi.Error{
+						Code:    seqapi.ErrorCode_ERROR_CODE_PARTIAL_RESPONSE,
+						Message: "partial response",
+					},
+				}, nil)
+			},
+			wantErr: "partial response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := mock_seqdb.NewMockClient(ctrl)
+			c := mock_cache.NewMockCache(ctrl)
+			tt.mock(client, c)
+
+			r := &Renderer{
+				cfg:   config.DashboardsRender{CacheTTL: time.Minute, MaxParallelRequests: 1},
+				envs:  map[string]renderEnv{"": {client: client, options: &config.SeqAPIOptions{}}},
+				cache: c,
+				nowFn: time.Now,
+			}
+
+			got := r.render(context.Background(), []types.DashboardPanel{panel}, tt.values, &from, &to)
+			require.Len(t, got, 1)
+			require.Equal(t, tt.wantErr, got[0].Error)
+			require.Equal(t, from, got[0].From)
+		})
+	}
+}
+
+type histogramRequestMatcher struct {
+	query string
+}
+
+func (m *histogramRequestMatcher) Matches(x any) bool {
+	req, ok := x.(*seqapi.GetHistogramRequest)
+	return ok && req.Query == m.query
+}
+
+func (m *histogramRequestMatcher) String() string {
+	return "histogram request with query " + m.query
+}
+
+func TestRenderEnvAccessDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock_seqdb.NewMockClient(ctrl)
+
+	r := &Renderer{
+		cfg: config.DashboardsRender{CacheTTL: time.Minute, MaxParallelRequests: 1},
+		envs: map[string]renderEnv{
+			"prod": {
+				name:    "prod",
+				client:  client,
+				options: &config.SeqAPIOptions{},
+				access:  &config.SeqAPIEnvAccess{Users: []string{"alice"}},
+			},
+			"dev": {name: "dev", client: client, options: &config.SeqAPIOptions{}},
+		},
+		defaultEnv: "prod",
+		nowFn:      time.Now,
+	}
+
+	ctx := context.WithValue(context.Background(), types.UserKey{}, "bob")
+	panel := types.DashboardPanel{
+		ID:        "hist",
+		Type:      types.DashboardPanelTypeHistogram,
+		Query:     "level:error",
+		Interval:  "1m",
+		TimeRange: types.DashboardTimeRange{Last: "1h"},
+	}
+
+	got := r.render(ctx, []types.DashboardPanel{panel}, nil, nil, nil)
+	require.Len(t, got, 1)
+	require.Equal(t, "permission denied: access env 'prod'", got[0].Error)
+}
@@ -2,12 +2,17 @@ package dashboards
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
 
+	"github.com/ozontech/seq-ui/internal/app/rbac"
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/internal/pkg/repository"
 	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+	"github.com/ozontech/seq-ui/logger"
 )
 
 type Service interface {
@@ -17,24 +22,45 @@ type Service interface {
 	CreateDashboard(context.Context, types.CreateDashboardRequest) (string, error)
 	UpdateDashboard(context.Context, types.UpdateDashboardRequest) error
 	DeleteDashboard(context.Context, types.DeleteDashboardRequest) error
-	SearchDashboards(context.Context, types.SearchDashboardsRequest) (types.DashboardInfosWithOwner, error)
+	SearchDashboards(context.Context, types.SearchDashboardsRequest) (types.DashboardSearchResults, error)
+	ListDashboardVersions(context.Context, types.ListDashboardVersionsRequest) (types.DashboardVersionInfos, error)
+	GetDashboardVersion(context.Context, types.GetDashboardVersionRequest) (types.DashboardVersion, error)
+	RestoreDashboardVersion(context.Context, types.RestoreDashboardVersionRequest) error
+	DiffDashboardVersions(context.Context, types.DiffDashboardVersionsRequest) (types.DashboardVersionsDiff, error)
+	GetDashboardSharing(context.Context, string) (types.DashboardACL, error)
+	UpdateDashboardSharing(context.Context, types.UpdateDashboardSharingRequest) error
+	TransferDashboardOwnership(context.Context, types.TransferDashboardOwnershipRequest) error
+	StarDashboard(context.Context, string) error
+	UnstarDashboard(context.Context, string) error
+	GetDashboardFolders(context.Context) (types.DashboardFolders, error)
+	CreateDashboardFolder(context.Context, types.CreateDashboardFolderRequest) (int64, error)
+	UpdateDashboardFolder(context.Context, types.UpdateDashboardFolderRequest) error
+	DeleteDashboardFolder(context.Context, types.DeleteDashboardFolderRequest) error
+	ExportDashboards(context.Context, []string) (types.DashboardsBundle, error)
+	ImportDashboards(context.Context, types.ImportDashboardsRequest) (types.DashboardImportResults, error)
+	RenderDashboard(context.Context, types.RenderDashboardRequest) (types.DashboardPanelsData, error)
+	ResolveDashboardVariables(context.Context, types.ResolveDashboardVariablesRequest) (types.DashboardVariablesOptions, error)
 }
 
 type service struct {
-	repo repository.Dashboards
+	repo     repository.Dashboards
+	renderer *Renderer
 }
 
-func New(repo repository.Dashboards) Service {
+// New creates dashboards service, renderer may be nil if dashboards aren't rendered server-side.
+func New(repo repository.Dashboards, renderer *Renderer) Service {
 	return &service{
-		repo: repo,
+		repo:     repo,
+		renderer: renderer,
 	}
 }
 
 func (s *service) GetAllDashboards(ctx context.Context, req types.GetAllDashboardsRequest) (types.DashboardInfosWithOwner, error) {
-	// check auth and create profile if its doesn't exist
-	if _, err := profiles.GetIDFromContext(ctx); err != nil {
+	u, err := getUser(ctx)
+	if err != nil {
 		return nil, err
 	}
+	req.User = u
 
 	if err := checkLimitOffset(req.Limit, req.Offset); err != nil {
 		return nil, err
@@ -58,8 +84,8 @@ func (s *service) GetMyDashboards(ctx context.Context, req types.GetUserDashboar
 }
 
 func (s *service) GetDashboardByUUID(ctx context.Context, id string) (types.Dashboard, error) {
-	// check auth and create profile if its doesn't exist
-	if _, err := profiles.GetIDFromContext(ctx); err != nil {
+	u, err := getUser(ctx)
+	if err != nil {
 		return types.Dashboard{}, err
 	}
 
@@ -67,7 +93,24 @@ func (s *service) GetDashboardByUUID(ctx context.Context, id string) (types.Dash
 		return types.Dashboard{}, err
 	}
 
-	return s.repo.GetByUUID(ctx, id)
+	if _, err := s.checkAccess(ctx, id, u, accessView, "get dashboard"); err != nil {
+		return types.Dashboard{}, err
+	}
+
+	dashboard, err := s.repo.GetByUUID(ctx, id)
+	if err != nil {
+		return types.Dashboard{}, err
+	}
+
+	// failed view tracking only affects sorting by recently viewed, so it doesn't fail the request
+	if err := s.repo.MarkViewed(ctx, types.MarkDashboardViewedRequest{
+		UUID:      id,
+		ProfileID: u.ProfileID,
+	}); err != nil {
+		logger.Error("failed to mark dashboard viewed", zap.String("uuid", id), zap.Error(err))
+	}
+
+	return dashboard, nil
 }
 
 func (s *service) CreateDashboard(ctx context.Context, req types.CreateDashboardRequest) (string, error) {
@@ -83,16 +126,25 @@ func (s *service) CreateDashboard(ctx context.Context, req types.CreateDashboard
 	if req.Meta == "" {
 		return "", types.NewErrInvalidRequestField("empty 'meta'")
 	}
+	if err := s.checkMeta(req.Meta); err != nil {
+		return "", err
+	}
+	if req.Tags, err = normalizeTags(req.Tags); err != nil {
+		return "", err
+	}
+	if err := s.checkFolderExists(ctx, req.FolderID); err != nil {
+		return "", err
+	}
 
 	return s.repo.Create(ctx, req)
 }
 
 func (s *service) UpdateDashboard(ctx context.Context, req types.UpdateDashboardRequest) error {
-	profileID, err := profiles.GetIDFromContext(ctx)
+	u, err := getUser(ctx)
 	if err != nil {
 		return err
 	}
-	req.ProfileID = profileID
+	req.ProfileID = u.ProfileID
 
 	if err := checkUUID(req.UUID); err != nil {
 		return err
@@ -100,37 +152,195 @@ func (s *service) UpdateDashboard(ctx context.Context, req types.UpdateDashboard
 	if req.IsEmpty() {
 		return types.ErrEmptyUpdateRequest
 	}
+	if req.Meta != nil {
+		if err := s.checkMeta(*req.Meta); err != nil {
+			return err
+		}
+	}
+	if req.Tags != nil {
+		tags, err := normalizeTags(*req.Tags)
+		if err != nil {
+			return err
+		}
+		req.Tags = &tags
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessEdit, "update dashboard"); err != nil {
+		return err
+	}
+	if err := s.checkFolderExists(ctx, req.FolderID); err != nil {
+		return err
+	}
 
 	return s.repo.Update(ctx, req)
 }
 
 func (s *service) DeleteDashboard(ctx context.Context, req types.DeleteDashboardRequest) error {
-	profileID, err := profiles.GetIDFromContext(ctx)
+	u, err := getUser(ctx)
 	if err != nil {
 		return err
 	}
-	req.ProfileID = profileID
+	req.ProfileID = u.ProfileID
 
 	if err := checkUUID(req.UUID); err != nil {
 		return err
 	}
 
+	// only the owner can delete the dashboard, unless the user is allowed to delete any
+	required := accessOwner
+	if rbac.HasPermission(ctx, rbac.PermDashboardsDeleteAny) {
+		required = accessNone
+	}
+
+	_, err = s.checkAccess(ctx, req.UUID, u, required, "delete dashboard")
+	if errors.Is(err, types.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
 	return s.repo.Delete(ctx, req)
 }
 
-func (s *service) SearchDashboards(ctx context.Context, req types.SearchDashboardsRequest) (types.DashboardInfosWithOwner, error) {
-	// check auth and create profile if its doesn't exist
-	if _, err := profiles.GetIDFromContext(ctx); err != nil {
+func (s *service) SearchDashboards(ctx context.Context, req types.SearchDashboardsRequest) (types.DashboardSearchResults, error) {
+	u, err := getUser(ctx)
+	if err != nil {
 		return nil, err
 	}
+	req.User = u
 
 	if err := checkLimitOffset(req.Limit, req.Offset); err != nil {
 		return nil, err
 	}
+	if req.Sort == "" {
+		req.Sort = types.DashboardsSortName
+	}
+	if !req.Sort.IsValid() {
+		return nil, types.NewErrInvalidRequestField("invalid 'sort'")
+	}
 
 	return s.repo.Search(ctx, req)
 }
 
+func (s *service) ListDashboardVersions(ctx context.Context, req types.ListDashboardVersionsRequest) (types.DashboardVersionInfos, error) {
+	u, err := getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return nil, err
+	}
+	if err := checkLimitOffset(req.Limit, req.Offset); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessView, "list dashboard versions"); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListVersions(ctx, req)
+}
+
+func (s *service) GetDashboardVersion(ctx context.Context, req types.GetDashboardVersionRequest) (types.DashboardVersion, error) {
+	u, err := getUser(ctx)
+	if err != nil {
+		return types.DashboardVersion{}, err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return types.DashboardVersion{}, err
+	}
+	if err := checkVersion(req.Version, "version"); err != nil {
+		return types.DashboardVersion{}, err
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessView, "get dashboard version"); err != nil {
+		return types.DashboardVersion{}, err
+	}
+
+	return s.repo.GetVersion(ctx, req)
+}
+
+// RestoreDashboardVersion rolls the dashboard back to the state of the specified version.
+// Restoring is an ordinary update, so it appends a new version instead of dropping the newer ones.
+func (s *service) RestoreDashboardVersion(ctx context.Context, req types.RestoreDashboardVersionRequest) error {
+	u, err := getUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return err
+	}
+	if err := checkVersion(req.Version, "version"); err != nil {
+		return err
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessEdit, "restore dashboard version"); err != nil {
+		return err
+	}
+
+	v, err := s.repo.GetVersion(ctx, types.GetDashboardVersionRequest{
+		UUID:    req.UUID,
+		Version: req.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, types.UpdateDashboardRequest{
+		UUID:      req.UUID,
+		ProfileID: u.ProfileID,
+		Name:      &v.Name,
+		Meta:      &v.Meta,
+	})
+}
+
+func (s *service) DiffDashboardVersions(ctx context.Context, req types.DiffDashboardVersionsRequest) (types.DashboardVersionsDiff, error) {
+	u, err := getUser(ctx)
+	if err != nil {
+		return types.DashboardVersionsDiff{}, err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return types.DashboardVersionsDiff{}, err
+	}
+	if err := checkVersion(req.FromVersion, "from_version"); err != nil {
+		return types.DashboardVersionsDiff{}, err
+	}
+	if err := checkVersion(req.ToVersion, "to_version"); err != nil {
+		return types.DashboardVersionsDiff{}, err
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessView, "diff dashboard versions"); err != nil {
+		return types.DashboardVersionsDiff{}, err
+	}
+
+	from, err := s.repo.GetVersion(ctx, types.GetDashboardVersionRequest{
+		UUID:    req.UUID,
+		Version: req.FromVersion,
+	})
+	if err != nil {
+		return types.DashboardVersionsDiff{}, err
+	}
+
+	to, err := s.repo.GetVersion(ctx, types.GetDashboardVersionRequest{
+		UUID:    req.UUID,
+		Version: req.ToVersion,
+	})
+	if err != nil {
+		return types.DashboardVersionsDiff{}, err
+	}
+
+	return types.DashboardVersionsDiff{
+		From:    from.DashboardVersionInfo,
+		To:      to.DashboardVersionInfo,
+		Changes: diffMeta(from.Meta, to.Meta),
+	}, nil
+}
+
 func checkUUID(v string) error {
 	if _, err := uuid.FromString(v); err != nil {
 		return types.NewErrInvalidRequestField("invalid uuid")
@@ -138,6 +348,13 @@ func checkUUID(v string) error {
 	return nil
 }
 
+func checkVersion(v int64, field string) error {
+	if v <= 0 {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("'%s' must be greater than 0", field))
+	}
+	return nil
+}
+
 func checkLimitOffset(limit, offset int) error {
 	if limit <= 0 {
 		return types.NewErrInvalidRequestField("'limit' must be greater than 0")
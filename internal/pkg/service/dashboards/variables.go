@@ -0,0 +1,290 @@
+package dashboards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+const (
+	maxVariableOptions = 1000
+
+	defaultVariableTimeRange = time.Hour
+)
+
+var (
+	variableNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// variableRefRe matches "$name" and "${name}" references.
+	variableRefRe = regexp.MustCompile(`\$(?:\{([A-Za-z_][A-Za-z0-9_]*)\}|([A-Za-z_][A-Za-z0-9_]*))`)
+	// plainValueRe matches values which can be substituted into the query without quoting.
+	plainValueRe = regexp.MustCompile(`^[A-Za-z0-9_.\-/@*]+$`)
+)
+
+// ResolveDashboardVariables returns options of all dashboard variables.
+// Variables referring to others are resolved with the requested or default values of them.
+func (s *service) ResolveDashboardVariables(
+	ctx context.Context,
+	req types.ResolveDashboardVariablesRequest,
+) (types.DashboardVariablesOptions, error) {
+	if s.renderer == nil {
+		return nil, errors.New("dashboards rendering is not configured")
+	}
+
+	u, err := getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkUUID(req.UUID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkAccess(ctx, req.UUID, u, accessView, "resolve dashboard variables"); err != nil {
+		return nil, err
+	}
+
+	d, err := s.repo.GetByUUID(ctx, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := parseDashboardMeta(d.Meta)
+	if err != nil {
+		return nil, err
+	}
+
+	values := variableValues(m.Variables, req.Variables)
+	res := make(types.DashboardVariablesOptions, len(m.Variables))
+	for i, v := range m.Variables {
+		res[i] = types.DashboardVariableOptions{
+			Name:  v.Name,
+			Value: values[v.Name],
+		}
+
+		opts, err := s.renderer.resolveVariable(ctx, v, values)
+		if err != nil {
+			res[i].Error = err.Error()
+			continue
+		}
+		res[i].Options = opts
+	}
+
+	return res, nil
+}
+
+func (r *Renderer) resolveVariable(ctx context.Context, v types.DashboardVariable, values map[string]string) ([]string, error) {
+	switch v.Type {
+	case types.DashboardVariableTypeCustom:
+		return v.Options, nil
+	case types.DashboardVariableTypeEnv:
+		return r.envNames(), nil
+	case types.DashboardVariableTypeErrorGroupsServices:
+		return r.resolveServices(ctx, v, values)
+	case types.DashboardVariableTypeAggregation:
+		return r.resolveAggregation(ctx, v, values)
+	default:
+		return nil, fmt.Errorf("unknown variable type %q", v.Type)
+	}
+}
+
+func (r *Renderer) resolveServices(ctx context.Context, v types.DashboardVariable, values map[string]string) ([]string, error) {
+	if r.errorGroups == nil {
+		return nil, errors.New("error groups are not configured")
+	}
+
+	query, err := substituteVariables(v.Query, values, false)
+	if err != nil {
+		return nil, err
+	}
+	req := types.GetServicesRequest{
+		Query: query,
+		Limit: maxVariableOptions,
+	}
+	if v.Env != "" {
+		env, err := substituteVariables(v.Env, values, false)
+		if err != nil {
+			return nil, err
+		}
+		req.Env = &env
+	}
+
+	return r.errorGroups.GetServices(ctx, req)
+}
+
+func (r *Renderer) resolveAggregation(ctx context.Context, v types.DashboardVariable, values map[string]string) ([]string, error) {
+	query, err := substituteVariables(v.Query, values, true)
+	if err != nil {
+		return nil, err
+	}
+	envName, err := substituteVariables(v.Env, values, false)
+	if err != nil {
+		return nil, err
+	}
+	env, err := r.getAllowedEnv(ctx, envName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.nowFn().Truncate(r.cfg.CacheTTL)
+	from, to := now.Add(-defaultVariableTimeRange), now
+	if v.TimeRange != nil {
+		from, to = panelTimeRange(*v.TimeRange, now)
+	}
+
+	aggs := []*seqapi.AggregationQuery{{
+		Field: v.Field,
+		Func:  seqapi.AggFunc_AGG_FUNC_COUNT,
+	}}
+	resp, err := r.getAggregation(ctx, env, &seqapi.GetAggregationRequest{
+		Query:        query,
+		From:         timestamppb.New(from),
+		To:           timestamppb.New(to),
+		Aggregations: aggs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Error); err != nil {
+		return nil, err
+	}
+	if env.masker != nil {
+		maskAggregations(env.masker, aggs, resp.Aggregations)
+	}
+
+	var opts []string
+	for _, agg := range resp.Aggregations {
+		for _, b := range agg.GetBuckets() {
+			if len(opts) == maxVariableOptions {
+				return opts, nil
+			}
+			if !slices.Contains(opts, b.GetKey()) {
+				opts = append(opts, b.GetKey())
+			}
+		}
+	}
+	return opts, nil
+}
+
+func (r *Renderer) envNames() []string {
+	names := make([]string, 0, len(r.envs))
+	for name := range r.envs {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// variableValues returns values of all defined variables, empty value means the variable is not set.
+func variableValues(vars []types.DashboardVariable, requested map[string]string) map[string]string {
+	res := make(map[string]string, len(vars))
+	for _, v := range vars {
+		if value, ok := requested[v.Name]; ok && value != "" {
+			res[v.Name] = value
+			continue
+		}
+		res[v.Name] = v.Default
+	}
+	return res
+}
+
+// substituteVariables replaces references to the defined variables with their values.
+// References to undefined names are kept as is since "$" may be a part of the query.
+func substituteVariables(s string, values map[string]string, quote bool) (string, error) {
+	var err error
+	res := variableRefRe.ReplaceAllStringFunc(s, func(ref string) string {
+		name := variableRefName(ref)
+		value, ok := values[name]
+		if !ok {
+			return ref
+		}
+		if value == "" {
+			if err == nil {
+				err = fmt.Errorf("variable '%s' has no value", name)
+			}
+			return ref
+		}
+		if quote {
+			return quoteValue(value)
+		}
+		return value
+	})
+	return res, err
+}
+
+func variableRefName(ref string) string {
+	m := variableRefRe.FindStringSubmatch(ref)
+	if m[1] != "" {
+		return m[1]
+	}
+	return m[2]
+}
+
+// hasVariables reports whether the string refers to any of the variables.
+func hasVariables(s string, variables map[string]struct{}) bool {
+	for _, ref := range variableRefRe.FindAllString(s, -1) {
+		if _, ok := variables[variableRefName(ref)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteValue quotes the value unless it's a plain word or a wildcard.
+func quoteValue(v string) string {
+	if plainValueRe.MatchString(v) {
+		return v
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+}
+
+func checkVariable(v *types.DashboardVariable, defined map[string]struct{}) error {
+	if !variableNameRe.MatchString(v.Name) {
+		return fmt.Errorf("invalid 'name' %q", v.Name)
+	}
+	if _, ok := defined[v.Name]; ok {
+		return fmt.Errorf("duplicate variable %q", v.Name)
+	}
+	if !v.Type.IsValid() {
+		return fmt.Errorf("unknown 'type' %q", v.Type)
+	}
+
+	if v.TimeRange != nil {
+		if err := checkTimeRange(*v.TimeRange); err != nil {
+			return err
+		}
+	}
+
+	switch v.Type {
+	case types.DashboardVariableTypeCustom:
+		if len(v.Options) == 0 {
+			return errors.New("empty 'options' of custom variable")
+		}
+		if len(v.Options) > maxVariableOptions {
+			return fmt.Errorf("too many 'options', max %d", maxVariableOptions)
+		}
+	case types.DashboardVariableTypeAggregation:
+		if v.Field == "" {
+			return errors.New("empty 'field' of aggregation variable")
+		}
+	}
+
+	for _, s := range []string{v.Query, v.Env} {
+		for _, ref := range variableRefRe.FindAllString(s, -1) {
+			if name := variableRefName(ref); name == v.Name {
+				return errors.New("variable can't refer to itself")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package dashboards
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/cache"
+	mock_cache "github.com/ozontech/seq-ui/internal/pkg/cache/mock"
+	mock_seqdb "github.com/ozontech/seq-ui/internal/pkg/client/seqdb/mock"
+	mock_errorgroups "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+func TestSubstituteVariables(t *testing.T) {
+	values := map[string]string{
+		"service": "api",
+		"level":   "error",
+		"message": `can't "connect"`,
+		"all":     "*",
+		"empty":   "",
+	}
+
+	tests := []struct {
+		name string
+
+		s       string
+		quote   bool
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "plain",
+			s:    "service:$service AND level:${level}",
+			want: "service:api AND level:error",
+		},
+		{
+			name:  "quoted",
+			s:     "message:$message AND service:$all",
+			quote: true,
+			want:  `message:"can't \"connect\"" AND service:*`,
+		},
+		{
+			name: "unknown",
+			s:    "price:$10 AND user:$user",
+			want: "price:$10 AND user:$user",
+		},
+		{
+			name:    "err_no_value",
+			s:       "service:$empty",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := substituteVariables(tt.s, values, tt.quote)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVariableValues(t *testing.T) {
+	vars := []types.DashboardVariable{
+		{Name: "env", Default: "prod"},
+		{Name: "service"},
+		{Name: "level", Default: "error"},
+	}
+
+	got := variableValues(vars, map[string]string{"env": "staging", "level": "", "unknown": "x"})
+	require.Equal(t, map[string]string{"env": "staging", "service": "", "level": "error"}, got)
+}
+
+func TestResolveVariable(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	values := map[string]string{"env": "prod", "service": "api"}
+
+	tests := []struct {
+		name string
+
+		variable types.DashboardVariable
+		mock     func(client *mock_seqdb.MockClient, c *mock_cache.MockCache, eg *mock_errorgroups.MockService)
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name: "custom",
+			variable: types.DashboardVariable{
+				Name: "level", Type: types.DashboardVariableTypeCustom, Options: []string{"error", "warn"},
+			},
+			want: []string{"error", "warn"},
+		},
+		{
+			name:     "env",
+			variable: types.DashboardVariable{Name: "env", Type: types.DashboardVariableTypeEnv},
+			want:     []string{"prod", "staging"},
+		},
+		{
+			name: "error_groups_services",
+			variable: types.DashboardVariable{
+				Name: "svc", Type: types.DashboardVariableTypeErrorGroupsServices, Query: "$service", Env: "$env",
+			},
+			mock: func(_ *mock_seqdb.MockClient, _ *mock_cache.MockCache, eg *mock_errorgroups.MockService) {
+				env := "prod"
+				eg.EXPECT().GetServices(gomock.Any(), types.GetServicesRequest{
+					Query: "api",
+					Env:   &env,
+					Limit: maxVariableOptions,
+				}).Return([]string{"api", "api-gateway"}, nil)
+			},
+			want: []string{"api", "api-gateway"},
+		},
+		{
+			name: "aggregation",
+			variable: types.DashboardVariable{
+				Name: "pod", Type: types.DashboardVariableTypeAggregation,
+				Field: "k8s_pod", Query: "service:$service", Env: "$env",
+			},
+			mock: func(client *mock_seqdb.MockClient, c *mock_cache.MockCache, _ *mock_errorgroups.MockService) {
+				c.EXPECT().Get(gomock.Any(), gomock.Any()).Return("", cache.ErrNotFound)
+				client.EXPECT().GetAggregation(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *seqapi.GetAggregationRequest) (*seqapi.GetAggregationResponse, error) {
+						require.Equal(t, "service:api", req.Query)
+						require.Equal(t, now.Add(-defaultVariableTimeRange), req.From.AsTime())
+						require.Equal(t, "k8s_pod", req.Aggregations[0].Field)
+						return &seqapi.GetAggregationResponse{
+							Aggregations: []*seqapi.Aggregation{{
+								Buckets: []*seqapi.Aggregation_Bucket{{Key: "pod-1"}, {Key: "pod-2"}},
+							}},
+						}, nil
+					})
+				c.EXPECT().SetWithTTL(gomock.Any(), gomock.Any(), gomock.Any(), time.Minute).Return(nil)
+			},
+			want: []string{"pod-1", "pod-2"},
+		},
+		{
+			name: "err_aggregation",
+			variable: types.DashboardVariable{
+				Name: "pod", Type: types.DashboardVariableTypeAggregation, Field: "k8s_pod",
+			},
+			mock: func(client *mock_seqdb.MockClient, c *mock_cache.MockCache, _ *mock_errorgroups.MockService) {
+				c.EXPECT().Get(gomock.Any(), gomock.Any()).Return("", cache.ErrNotFound)
+				client.EXPECT().GetAggregation(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := mock_seqdb.NewMockClient(ctrl)
+			c := mock_cache.NewMockCache(ctrl)
+			eg := mock_errorgroups.NewMockService(ctrl)
+			if tt.mock != nil {
+				tt.mock(client, c, eg)
+			}
+
+			r := &Renderer{
+				cfg: config.DashboardsRender{CacheTTL: time.Minute},
+				envs: map[string]renderEnv{
+					"prod":    {name: "prod", client: client},
+					"staging": {name: "staging", client: client},
+				},
+				defaultEnv:  "prod",
+				cache:       c,
+				errorGroups: eg,
+				nowFn:       func() time.Time { return now },
+			}
+
+			got, err := r.resolveVariable(context.Background(), tt.variable, values)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
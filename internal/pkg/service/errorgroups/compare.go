@@ -0,0 +1,252 @@
+package errorgroups
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+const (
+	defaultSignificanceLevel = 0.05
+
+	// minTimeExposure prevents huge rates for releases seen only for a moment.
+	minTimeExposure = time.Minute
+)
+
+func (s *service) CompareReleases(
+	ctx context.Context,
+	req types.CompareReleasesRequest,
+) (types.CompareReleasesResult, error) {
+	var result types.CompareReleasesResult
+
+	if req.Service == "" {
+		return result, types.NewErrInvalidRequestField("'service' must not be empty")
+	}
+	if req.BaselineRelease == "" || req.CandidateRelease == "" {
+		return result, types.NewErrInvalidRequestField("'baseline_release' and 'candidate_release' must not be empty")
+	}
+	if req.BaselineRelease == req.CandidateRelease {
+		return result, types.NewErrInvalidRequestField("'baseline_release' and 'candidate_release' must be different")
+	}
+
+	alpha := defaultSignificanceLevel
+	if req.SignificanceLevel != nil {
+		alpha = *req.SignificanceLevel
+		if alpha <= 0 || alpha >= 1 {
+			return result, types.NewErrInvalidRequestField("'significance_level' must be in range (0, 1)")
+		}
+	}
+
+	switch req.Exposure {
+	case types.ExposureTime:
+	case types.ExposureRequests:
+		if req.BaselineRequests == nil || *req.BaselineRequests == 0 ||
+			req.CandidateRequests == nil || *req.CandidateRequests == 0 {
+			return result, types.NewErrInvalidRequestField("'baseline_requests' and 'candidate_requests' must be positive for requests exposure")
+		}
+	default:
+		return result, types.NewErrInvalidRequestField("unknown 'exposure'")
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultLimit
+	}
+
+	counts, err := s.repo.GetReleasesGroupCounts(ctx, req)
+	if err != nil {
+		return result, fmt.Errorf("compare releases failed: %w", err)
+	}
+
+	result.Baseline = releaseExposure(counts, req.BaselineRelease, req.Exposure, req.BaselineRequests)
+	result.Candidate = releaseExposure(counts, req.CandidateRelease, req.Exposure, req.CandidateRequests)
+
+	type groupKey struct {
+		hash   uint64
+		source string
+	}
+	var (
+		groups   []types.CompareGroup
+		idxByKey = map[groupKey]int{}
+	)
+	for _, c := range counts {
+		key := groupKey{hash: c.Hash, source: c.Source}
+		idx, ok := idxByKey[key]
+		if !ok {
+			groups = append(groups, types.CompareGroup{
+				Hash:    c.Hash,
+				Source:  c.Source,
+				Message: c.Message,
+			})
+			idx = len(groups) - 1
+			idxByKey[key] = idx
+		}
+
+		switch c.Release {
+		case req.BaselineRelease:
+			groups[idx].BaselineSeenTotal += c.SeenTotal
+		case req.CandidateRelease:
+			groups[idx].CandidateSeenTotal += c.SeenTotal
+		}
+	}
+
+	for i := range groups {
+		compareGroup(&groups[i], result.Baseline.Exposure, result.Candidate.Exposure)
+	}
+	adjustPValues(groups)
+
+	filtered := groups[:0]
+	for _, g := range groups {
+		classifyGroup(&g, alpha)
+
+		switch g.Status {
+		case types.CompareStatusNew:
+			result.Summary.New++
+		case types.CompareStatusRegressed:
+			result.Summary.Regressed++
+		case types.CompareStatusFixed:
+			result.Summary.Fixed++
+		case types.CompareStatusUnchanged:
+			result.Summary.Unchanged++
+		}
+
+		if len(req.Statuses) > 0 && !slices.Contains(req.Statuses, g.Status) {
+			continue
+		}
+		filtered = append(filtered, g)
+	}
+
+	slices.SortStableFunc(filtered, func(a, b types.CompareGroup) int {
+		return cmp.Or(
+			cmp.Compare(statusPriority(a.Status), statusPriority(b.Status)),
+			cmp.Compare(a.PValue, b.PValue),
+			cmp.Compare(b.CandidateSeenTotal, a.CandidateSeenTotal),
+			cmp.Compare(a.Hash, b.Hash),
+		)
+	})
+
+	result.Total = uint64(len(filtered))
+	from := min(int(req.Offset), len(filtered))
+	to := min(from+int(req.Limit), len(filtered))
+	result.Groups = filtered[from:to]
+
+	return result, nil
+}
+
+// releaseExposure calculates exposure of the release:
+// hours the release was active or number of requests served by it.
+func releaseExposure(
+	counts []types.ReleaseGroupCount,
+	release string,
+	kind types.ReleaseExposureKind,
+	requests *uint64,
+) types.ReleaseExposure {
+	exp := types.ReleaseExposure{Release: release}
+	for _, c := range counts {
+		if c.Release != release {
+			continue
+		}
+		exp.SeenTotal += c.SeenTotal
+		if exp.FirstSeenAt.IsZero() || c.FirstSeenAt.Before(exp.FirstSeenAt) {
+			exp.FirstSeenAt = c.FirstSeenAt
+		}
+		if c.LastSeenAt.After(exp.LastSeenAt) {
+			exp.LastSeenAt = c.LastSeenAt
+		}
+	}
+
+	switch {
+	case kind == types.ExposureRequests && requests != nil:
+		exp.Exposure = float64(*requests)
+	case exp.SeenTotal > 0:
+		exp.Exposure = max(exp.LastSeenAt.Sub(exp.FirstSeenAt), minTimeExposure).Hours()
+	}
+
+	return exp
+}
+
+// compareGroup calculates rates of the group in both releases and compares them
+// using two-sided z-test for the log of Poisson rate ratio.
+// Counts are increased by 0.5 (Haldane correction) to handle zero counts.
+func compareGroup(g *types.CompareGroup, baselineExposure, candidateExposure float64) {
+	baseline, candidate := float64(g.BaselineSeenTotal), float64(g.CandidateSeenTotal)
+
+	g.PValue = 1
+	if baselineExposure == 0 || candidateExposure == 0 {
+		if candidateExposure > 0 {
+			g.CandidateRate = candidate / candidateExposure
+		}
+		if baselineExposure > 0 {
+			g.BaselineRate = baseline / baselineExposure
+		}
+		return
+	}
+
+	g.BaselineRate = baseline / baselineExposure
+	g.CandidateRate = candidate / candidateExposure
+
+	const correction = 0.5
+	ratio := ((candidate + correction) / candidateExposure) / ((baseline + correction) / baselineExposure)
+	se := math.Sqrt(1/(baseline+correction) + 1/(candidate+correction))
+	z := math.Log(ratio) / se
+
+	g.RateRatio = ratio
+	g.PValue = math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// adjustPValues applies Benjamini-Hochberg correction to p-values of the groups
+// to control the false discovery rate across all compared groups.
+func adjustPValues(groups []types.CompareGroup) {
+	order := make([]int, len(groups))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Compare(groups[a].PValue, groups[b].PValue)
+	})
+
+	m := float64(len(groups))
+	adjusted := 1.0
+	for rank := len(order); rank > 0; rank-- {
+		g := &groups[order[rank-1]]
+		adjusted = min(adjusted, g.PValue*m/float64(rank))
+		g.PValue = adjusted
+	}
+}
+
+// classifyGroup sets status of the group by its adjusted p-value.
+// Groups seen only in one of the releases must pass the same test
+// to be reported as new or fixed.
+func classifyGroup(g *types.CompareGroup, alpha float64) {
+	g.Significant = g.PValue < alpha
+	g.Status = types.CompareStatusUnchanged
+	if !g.Significant {
+		return
+	}
+
+	switch {
+	case g.RateRatio > 1 && g.BaselineSeenTotal == 0:
+		g.Status = types.CompareStatusNew
+	case g.RateRatio > 1:
+		g.Status = types.CompareStatusRegressed
+	case g.RateRatio < 1 && g.CandidateSeenTotal == 0:
+		g.Status = types.CompareStatusFixed
+	}
+}
+
+func statusPriority(s types.ReleaseCompareStatus) int {
+	switch s {
+	case types.CompareStatusRegressed:
+		return 0
+	case types.CompareStatusNew:
+		return 1
+	case types.CompareStatusFixed:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -0,0 +1,393 @@
+package errorgroups
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func TestCompareReleases(t *testing.T) {
+	var (
+		service   = "test-svc"
+		baseline  = "v1"
+		candidate = "v2"
+		now       = time.Now()
+		someErr   = errors.New("some err")
+		badAlpha  = 1.0
+
+		baselineRequests  uint64 = 10000
+		candidateRequests uint64 = 1000
+	)
+
+	// baseline is active for 10h, candidate for 1h
+	counts := []types.ReleaseGroupCount{
+		{Hash: 1, Release: baseline, SeenTotal: 1000, FirstSeenAt: now.Add(-10 * time.Hour), LastSeenAt: now},
+		{Hash: 1, Release: candidate, SeenTotal: 100, FirstSeenAt: now.Add(-time.Hour), LastSeenAt: now},
+		{Hash: 2, Release: baseline, SeenTotal: 100, FirstSeenAt: now.Add(-5 * time.Hour), LastSeenAt: now},
+		{Hash: 2, Release: candidate, SeenTotal: 100, FirstSeenAt: now.Add(-time.Hour), LastSeenAt: now},
+		{Hash: 3, Release: candidate, SeenTotal: 50, FirstSeenAt: now.Add(-time.Hour), LastSeenAt: now},
+		{Hash: 4, Release: baseline, SeenTotal: 500, FirstSeenAt: now.Add(-10 * time.Hour), LastSeenAt: now},
+	}
+
+	type mockArgs struct {
+		counts []types.ReleaseGroupCount
+		err    error
+	}
+
+	tests := []struct {
+		name string
+
+		req         types.CompareReleasesRequest
+		wantHashes  []uint64
+		wantStatus  []types.ReleaseCompareStatus
+		wantSummary types.CompareReleasesSummary
+		wantTotal   uint64
+		wantErr     bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok_time_exposure",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+			},
+			wantHashes: []uint64{2, 3, 4, 1},
+			wantStatus: []types.ReleaseCompareStatus{
+				types.CompareStatusRegressed,
+				types.CompareStatusNew,
+				types.CompareStatusFixed,
+				types.CompareStatusUnchanged,
+			},
+			wantSummary: types.CompareReleasesSummary{New: 1, Regressed: 1, Fixed: 1, Unchanged: 1},
+			wantTotal:   4,
+
+			mockArgs: &mockArgs{counts: counts},
+		},
+		{
+			name: "ok_requests_exposure_with_filter",
+
+			req: types.CompareReleasesRequest{
+				Service:           service,
+				BaselineRelease:   baseline,
+				CandidateRelease:  candidate,
+				Exposure:          types.ExposureRequests,
+				Statuses:          []types.ReleaseCompareStatus{types.CompareStatusRegressed},
+				BaselineRequests:  &baselineRequests,
+				CandidateRequests: &candidateRequests,
+			},
+			wantHashes:  []uint64{2},
+			wantStatus:  []types.ReleaseCompareStatus{types.CompareStatusRegressed},
+			wantSummary: types.CompareReleasesSummary{New: 1, Regressed: 1, Fixed: 1, Unchanged: 1},
+			wantTotal:   1,
+
+			mockArgs: &mockArgs{counts: counts},
+		},
+		{
+			name: "ok_single_events_not_significant",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+			},
+			wantHashes: []uint64{2, 1},
+			wantStatus: []types.ReleaseCompareStatus{
+				types.CompareStatusUnchanged,
+				types.CompareStatusUnchanged,
+			},
+			wantSummary: types.CompareReleasesSummary{Unchanged: 2},
+			wantTotal:   2,
+
+			mockArgs: &mockArgs{counts: []types.ReleaseGroupCount{
+				{Hash: 1, Release: baseline, SeenTotal: 1, FirstSeenAt: now.Add(-time.Hour), LastSeenAt: now},
+				{Hash: 2, Release: candidate, SeenTotal: 1, FirstSeenAt: now.Add(-time.Hour), LastSeenAt: now},
+			}},
+		},
+		{
+			name: "ok_pagination",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+				Limit:            2,
+				Offset:           1,
+			},
+			wantHashes: []uint64{3, 4},
+			wantStatus: []types.ReleaseCompareStatus{
+				types.CompareStatusNew,
+				types.CompareStatusFixed,
+			},
+			wantSummary: types.CompareReleasesSummary{New: 1, Regressed: 1, Fixed: 1, Unchanged: 1},
+			wantTotal:   4,
+
+			mockArgs: &mockArgs{counts: counts},
+		},
+		{
+			name: "err_empty_service",
+
+			req: types.CompareReleasesRequest{
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_empty_release",
+
+			req: types.CompareReleasesRequest{
+				Service:         service,
+				BaselineRelease: baseline,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_same_releases",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: baseline,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_significance_level",
+
+			req: types.CompareReleasesRequest{
+				Service:           service,
+				BaselineRelease:   baseline,
+				CandidateRelease:  candidate,
+				SignificanceLevel: &badAlpha,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_unknown_exposure",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+				Exposure:         types.ReleaseExposureKind(100),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_requests_exposure_without_requests",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+				Exposure:         types.ExposureRequests,
+				BaselineRequests: &baselineRequests,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_repo",
+
+			req: types.CompareReleasesRequest{
+				Service:          service,
+				BaselineRelease:  baseline,
+				CandidateRelease: candidate,
+			},
+			wantErr: true,
+
+			mockArgs: &mockArgs{err: someErr},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedRepo := mock.NewMockRepository(ctrl)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedRepo.EXPECT().
+					GetReleasesGroupCounts(gomock.Any(), gomock.Any()).
+					Return(ma.counts, ma.err).
+					Times(1)
+			}
+
+			s := New(mockedRepo, config.ErrorGroups{})
+			got, err := s.CompareReleases(context.Background(), tt.req)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+
+			hashes := make([]uint64, 0, len(got.Groups))
+			statuses := make([]types.ReleaseCompareStatus, 0, len(got.Groups))
+			for _, g := range got.Groups {
+				hashes = append(hashes, g.Hash)
+				statuses = append(statuses, g.Status)
+			}
+			require.Equal(t, tt.wantHashes, hashes)
+			require.Equal(t, tt.wantStatus, statuses)
+			require.Equal(t, tt.wantSummary, got.Summary)
+			require.Equal(t, tt.wantTotal, got.Total)
+		})
+	}
+}
+
+func TestCompareGroup(t *testing.T) {
+	tests := []struct {
+		name string
+
+		baseline, candidate                 uint64
+		baselineExposure, candidateExposure float64
+
+		wantStatus      types.ReleaseCompareStatus
+		wantSignificant bool
+		wantRatio       float64
+	}{
+		{
+			name:              "same_rate",
+			baseline:          1000,
+			candidate:         100,
+			baselineExposure:  10,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusUnchanged,
+			wantRatio:         (100.5 / 1) / (1000.5 / 10),
+		},
+		{
+			name:              "regressed",
+			baseline:          100,
+			candidate:         100,
+			baselineExposure:  5,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusRegressed,
+			wantSignificant:   true,
+			wantRatio:         5,
+		},
+		{
+			name:              "not_significant_increase",
+			baseline:          1,
+			candidate:         2,
+			baselineExposure:  1,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusUnchanged,
+			wantRatio:         2.5 / 1.5,
+		},
+		{
+			name:              "new",
+			candidate:         50,
+			baselineExposure:  1,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusNew,
+			wantSignificant:   true,
+			wantRatio:         50.5 / 0.5,
+		},
+		{
+			name:              "not_significant_new",
+			candidate:         1,
+			baselineExposure:  1,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusUnchanged,
+			wantRatio:         1.5 / 0.5,
+		},
+		{
+			name:              "fixed",
+			baseline:          50,
+			baselineExposure:  1,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusFixed,
+			wantSignificant:   true,
+			wantRatio:         0.5 / 50.5,
+		},
+		{
+			name:              "not_significant_fixed",
+			baseline:          1,
+			baselineExposure:  1,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusUnchanged,
+			wantRatio:         0.5 / 1.5,
+		},
+		{
+			name:              "no_baseline_exposure",
+			candidate:         10,
+			candidateExposure: 1,
+			wantStatus:        types.CompareStatusUnchanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := types.CompareGroup{
+				BaselineSeenTotal:  tt.baseline,
+				CandidateSeenTotal: tt.candidate,
+			}
+			compareGroup(&g, tt.baselineExposure, tt.candidateExposure)
+			classifyGroup(&g, defaultSignificanceLevel)
+
+			require.Equal(t, tt.wantStatus, g.Status)
+			require.Equal(t, tt.wantSignificant, g.Significant)
+			require.InDelta(t, tt.wantRatio, g.RateRatio, 1e-9)
+		})
+	}
+}
+
+func TestAdjustPValues(t *testing.T) {
+	tests := []struct {
+		name string
+
+		pValues []float64
+		want    []float64
+	}{
+		{
+			name:    "empty",
+			pValues: []float64{},
+			want:    []float64{},
+		},
+		{
+			name:    "single",
+			pValues: []float64{0.03},
+			want:    []float64{0.03},
+		},
+		{
+			name:    "monotone",
+			pValues: []float64{0.04, 0.01, 0.03, 0.02},
+			want:    []float64{0.04, 0.04, 0.04, 0.04},
+		},
+		{
+			name:    "mixed",
+			pValues: []float64{0.5, 0.001, 0.04, 0.9},
+			want:    []float64{0.5 * 4 / 3, 0.004, 0.08, 0.9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			groups := make([]types.CompareGroup, 0, len(tt.pValues))
+			for _, p := range tt.pValues {
+				groups = append(groups, types.CompareGroup{PValue: p})
+			}
+			adjustPValues(groups)
+
+			got := make([]float64, 0, len(groups))
+			for _, g := range groups {
+				got = append(got, g.PValue)
+			}
+			require.InDeltaSlice(t, tt.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package digest
+
+import (
+	"time"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+// Digest is a report of error groups of the service for the period.
+type Digest struct {
+	Service string
+	Env     string
+	Period  types.DigestPeriod
+	From    time.Time
+	To      time.Time
+
+	// NewGroups are groups first seen during the period.
+	NewGroups      []types.ErrorGroup
+	NewGroupsTotal uint64
+	// TopGroups are the most frequent groups during the period.
+	TopGroups []types.TopErrorGroup
+}
@@ -0,0 +1,78 @@
+package digest
+
+import (
+	"bytes"
+	_ "embed"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+var (
+	//go:embed templates/digest.md.tmpl
+	markdownTemplateRaw string
+	//go:embed templates/digest.html.tmpl
+	htmlTemplateRaw string
+
+	templateFuncs = map[string]any{
+		"formatTime": func(t time.Time) string {
+			return t.UTC().Format(time.DateTime)
+		},
+		"mdEscape": markdownEscape,
+	}
+
+	markdownTemplate = texttemplate.Must(texttemplate.New("digest.md").Funcs(templateFuncs).Parse(markdownTemplateRaw))
+	htmlTemplate     = htmltemplate.Must(htmltemplate.New("digest.html").Funcs(templateFuncs).Parse(htmlTemplateRaw))
+)
+
+var markdownReplacer = strings.NewReplacer(
+	"\\", "\\\\",
+	"|", "\\|",
+	"`", "\\`",
+	"*", "\\*",
+	"_", "\\_",
+	"<", "&lt;",
+	">", "&gt;",
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// markdownEscape makes text safe to use in markdown table cell.
+func markdownEscape(s string) string {
+	return markdownReplacer.Replace(s)
+}
+
+// Render renders digest in the format.
+func Render(d Digest, format types.DigestFormat) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var err error
+	switch format {
+	case types.DigestFormatHTML:
+		err = htmlTemplate.Execute(&buf, d)
+	default:
+		err = markdownTemplate.Execute(&buf, d)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func contentType(format types.DigestFormat) string {
+	if format == types.DigestFormatHTML {
+		return "text/html; charset=utf-8"
+	}
+	return "text/markdown; charset=utf-8"
+}
+
+func fileExtension(format types.DigestFormat) string {
+	if format == types.DigestFormatHTML {
+		return "html"
+	}
+	return "md"
+}
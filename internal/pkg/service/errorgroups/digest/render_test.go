@@ -0,0 +1,90 @@
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestRender(t *testing.T) {
+	var (
+		from = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = from.Add(24 * time.Hour)
+	)
+
+	d := Digest{
+		Service: "svc",
+		Env:     "prod",
+		Period:  types.DigestPeriodDaily,
+		From:    from,
+		To:      to,
+		NewGroups: []types.ErrorGroup{
+			{Hash: 1, Source: "src", Message: "new | <b>error</b>\nline", Count: 3, FirstSeenAt: from.Add(time.Hour)},
+		},
+		NewGroupsTotal: 1,
+	}
+
+	tests := []struct {
+		name   string
+		format types.DigestFormat
+		want   string
+	}{
+		{
+			name:   "markdown",
+			format: types.DigestFormatMarkdown,
+			want: `# Error groups daily digest: svc (prod)
+
+Period: 2024-01-01 00:00:00 — 2024-01-02 00:00:00 UTC
+
+## New error groups (1)
+
+| Hash | Source | Message | Count | First seen |
+|------|--------|---------|-------|------------|
+| 1 | src | new \| &lt;b&gt;error&lt;/b&gt; line | 3 | 2024-01-01 01:00:00 |
+
+## Top error groups
+
+No errors.
+`,
+		},
+		{
+			name:   "html",
+			format: types.DigestFormatHTML,
+			want: `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Error groups daily digest: svc</title>
+</head>
+<body>
+<h1>Error groups daily digest: svc (prod)</h1>
+<p>Period: 2024-01-01 00:00:00 — 2024-01-02 00:00:00 UTC</p>
+
+<h2>New error groups (1)</h2>
+<table border="1" cellpadding="4" cellspacing="0">
+<tr><th>Hash</th><th>Source</th><th>Message</th><th>Count</th><th>First seen</th></tr>
+<tr><td>1</td><td>src</td><td>new | &lt;b&gt;error&lt;/b&gt;
+line</td><td>3</td><td>2024-01-01 01:00:00</td></tr>
+</table>
+
+<h2>Top error groups</h2>
+<p>No errors.</p>
+</body>
+</html>
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Render(d, tt.format)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
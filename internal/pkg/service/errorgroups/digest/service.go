@@ -0,0 +1,270 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/repository"
+	"github.com/ozontech/seq-ui/internal/pkg/service/errorgroups"
+	"github.com/ozontech/seq-ui/internal/pkg/service/massexport/filestore"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/metric"
+)
+
+// Service periodically sends error groups digests to subscribers.
+type Service struct {
+	cfg config.ErrorGroupsDigest
+
+	errorGroups   errorgroups.Service
+	subscriptions repository.ErrorGroupsSubscriptions
+	// fileStore is nil if mass export is not configured.
+	fileStore  filestore.FileStore
+	httpClient *http.Client
+
+	nowFn func() time.Time
+}
+
+// New creates digest service and starts sending digests in background.
+func New(
+	ctx context.Context,
+	cfg config.ErrorGroupsDigest,
+	errorGroups errorgroups.Service,
+	subscriptions repository.ErrorGroupsSubscriptions,
+	fileStore filestore.FileStore,
+) *Service {
+	s := newService(cfg, errorGroups, subscriptions, fileStore, time.Now)
+
+	go s.run(ctx)
+
+	return s
+}
+
+func newService(
+	cfg config.ErrorGroupsDigest,
+	errorGroups errorgroups.Service,
+	subscriptions repository.ErrorGroupsSubscriptions,
+	fileStore filestore.FileStore,
+	nowFn func() time.Time,
+) *Service {
+	return &Service{
+		cfg:           cfg,
+		errorGroups:   errorGroups,
+		subscriptions: subscriptions,
+		fileStore:     fileStore,
+		httpClient: &http.Client{
+			Timeout: cfg.WebhookTimeout,
+			// redirects may lead outside the webhook allowlist
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		nowFn: nowFn,
+	}
+}
+
+func (s *Service) run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.SendDue(ctx); err != nil {
+				logger.Error("failed to send error groups digests", zap.Error(err))
+			}
+		}
+	}
+}
+
+// SendDue sends digests of all subscriptions whose period has passed since the last digest.
+// Failed digest is not resent until the next period, errors are logged.
+func (s *Service) SendDue(ctx context.Context) error {
+	subscriptions, err := s.subscriptions.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("get subscriptions: %w", err)
+	}
+
+	now := s.nowFn()
+	for _, sub := range subscriptions {
+		if !sub.IsDue(now) {
+			continue
+		}
+
+		// mark subscription first to not send the same digest from several instances
+		ok, err := s.subscriptions.MarkSent(ctx, types.MarkErrorGroupsSubscriptionSentRequest{
+			ID:         sub.ID,
+			PrevSentAt: sub.LastSentAt,
+			SentAt:     now,
+		})
+		if err != nil {
+			logger.Error("failed to mark error groups subscription",
+				zap.Int64("subscription_id", sub.ID), zap.Error(err),
+			)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		err = s.send(ctx, sub, now)
+		status := "ok"
+		if err != nil {
+			status = "error"
+			logger.Error("failed to send error groups digest",
+				zap.Int64("subscription_id", sub.ID),
+				zap.String("service", sub.Service),
+				zap.String("delivery", string(sub.Delivery)),
+				zap.Error(err),
+			)
+		}
+		metric.ErrorGroupsDigestsSent.WithLabelValues(string(sub.Delivery), status).Inc()
+	}
+
+	return nil
+}
+
+func (s *Service) send(ctx context.Context, sub types.ErrorGroupsSubscription, now time.Time) error {
+	d, err := s.Build(ctx, sub, now)
+	if err != nil {
+		return fmt.Errorf("build digest: %w", err)
+	}
+
+	content, err := Render(d, sub.Format)
+	if err != nil {
+		return fmt.Errorf("render digest: %w", err)
+	}
+
+	switch sub.Delivery {
+	case types.DigestDeliveryFileStore:
+		return s.putToFileStore(ctx, sub, now, content)
+	default:
+		return s.postToWebhook(ctx, sub, content)
+	}
+}
+
+// Build collects new and top error groups of the subscribed service for the last period.
+func (s *Service) Build(ctx context.Context, sub types.ErrorGroupsSubscription, now time.Time) (Digest, error) {
+	d := Digest{
+		Service: sub.Service,
+		Env:     sub.Env,
+		Period:  sub.Period,
+		From:    now.Add(-sub.Period.Duration()),
+		To:      now,
+	}
+
+	req := types.GetErrorGroupsRequest{
+		Service: sub.Service,
+		TimeRange: &types.TimeRange{
+			From: d.From,
+			To:   d.To,
+		},
+		Limit:     s.cfg.GroupsLimit,
+		Order:     types.OrderFrequent,
+		WithTotal: true,
+	}
+	if sub.Env != "" {
+		req.Env = &sub.Env
+	}
+
+	var err error
+	d.NewGroups, d.NewGroupsTotal, err = s.errorGroups.GetNewErrorGroups(ctx, req)
+	if err != nil {
+		return d, fmt.Errorf("get new error groups: %w", err)
+	}
+
+	// counts of the top groups must be taken from the period, not all-time
+	d.TopGroups, _, err = s.errorGroups.GetTopErrorGroups(ctx, types.GetTopErrorGroupsRequest{
+		Service:   &sub.Service,
+		Env:       req.Env,
+		TimeRange: req.TimeRange,
+		Limit:     s.cfg.GroupsLimit,
+	})
+	if err != nil {
+		return d, fmt.Errorf("get top error groups: %w", err)
+	}
+
+	return d, nil
+}
+
+func (s *Service) postToWebhook(ctx context.Context, sub types.ErrorGroupsSubscription, content []byte) error {
+	// the allowlist may have changed since the subscription was created
+	if !WebhookAllowed(s.cfg.WebhookAllowlist, sub.WebhookURL) {
+		return fmt.Errorf("webhook url is not allowed")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.WebhookURL, bytes.NewReader(content))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType(sub.Format))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *Service) putToFileStore(
+	ctx context.Context,
+	sub types.ErrorGroupsSubscription,
+	now time.Time,
+	content []byte,
+) error {
+	if s.fileStore == nil {
+		return fmt.Errorf("file store is not configured")
+	}
+
+	if err := s.fileStore.PutObject(ctx, s.objectName(sub, now), bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("put digest to file store: %w", err)
+	}
+
+	return nil
+}
+
+// objectName returns file store path of the digest:
+// <prefix>/<service>/<env>/<period>_<date>_<subscription_id>.<ext>
+func (s *Service) objectName(sub types.ErrorGroupsSubscription, now time.Time) string {
+	env := pathSegment(sub.Env)
+	if env == "" {
+		env = "all"
+	}
+
+	name := fmt.Sprintf("%s_%s_%s.%s",
+		sub.Period,
+		now.UTC().Format("2006-01-02T15-04-05"),
+		strconv.FormatInt(sub.ID, 10),
+		fileExtension(sub.Format),
+	)
+
+	return path.Join(s.cfg.FileStorePrefix, pathSegment(sub.Service), env, name)
+}
+
+// pathSegment prevents values from escaping their place in the object path.
+func pathSegment(s string) string {
+	s = strings.ReplaceAll(s, "/", "_")
+	if s == "." || s == ".." {
+		return "_"
+	}
+	return s
+}
@@ -0,0 +1,281 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+	svc_mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+type fakeFileStore struct {
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func (fs *fakeFileStore) PutObject(_ context.Context, objectName string, reader io.Reader) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.objects[objectName] = string(data)
+	return nil
+}
+
+func TestSendDue(t *testing.T) {
+	var (
+		now        = time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+		recently   = now.Add(-time.Hour)
+		dayAgo     = now.Add(-24 * time.Hour)
+		env        = "prod"
+		someErr    = errors.New("some err")
+		someGroups = []types.ErrorGroup{{Hash: 1, Source: "src", Message: "err", Count: 1}}
+		topGroups  = []types.TopErrorGroup{{Hash: 1, Source: "src", Message: "err", Count: 1}}
+	)
+
+	var (
+		webhookMu     sync.Mutex
+		webhookBodies []string
+		webhookTypes  []string
+	)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		webhookMu.Lock()
+		webhookBodies = append(webhookBodies, string(body))
+		webhookTypes = append(webhookTypes, r.Header.Get("Content-Type"))
+		webhookMu.Unlock()
+	}))
+	defer webhook.Close()
+
+	cfg := config.ErrorGroupsDigest{GroupsLimit: 5, FileStorePrefix: "digests", WebhookAllowlist: []string{webhook.URL}}
+
+	subscriptions := types.ErrorGroupsSubscriptions{
+		// due: never sent
+		{ID: 1, Service: "svc1", Env: env, Period: types.DigestPeriodDaily, Format: types.DigestFormatMarkdown, Delivery: types.DigestDeliveryWebhook, WebhookURL: webhook.URL},
+		// not due: sent recently
+		{ID: 2, Service: "svc2", Period: types.DigestPeriodDaily, Delivery: types.DigestDeliveryWebhook, LastSentAt: &recently},
+		// due: sent day ago
+		{ID: 3, Service: "svc3", Period: types.DigestPeriodDaily, Format: types.DigestFormatHTML, Delivery: types.DigestDeliveryFileStore, LastSentAt: &dayAgo},
+		// not due: weekly sent day ago
+		{ID: 4, Service: "svc4", Period: types.DigestPeriodWeekly, Delivery: types.DigestDeliveryFileStore, LastSentAt: &dayAgo},
+		// due, but already marked by another instance
+		{ID: 5, Service: "svc5", Period: types.DigestPeriodWeekly, Delivery: types.DigestDeliveryWebhook, WebhookURL: webhook.URL},
+		// due, but failed to get groups
+		{ID: 6, Service: "svc6", Period: types.DigestPeriodDaily, Delivery: types.DigestDeliveryWebhook, WebhookURL: webhook.URL},
+	}
+
+	ctrl := gomock.NewController(t)
+	mockedRepo := repo_mock.NewMockErrorGroupsSubscriptions(ctrl)
+	mockedSvc := svc_mock.NewMockService(ctrl)
+
+	mockedRepo.EXPECT().GetAll(gomock.Any()).Return(subscriptions, nil).Times(1)
+	for _, sub := range []types.ErrorGroupsSubscription{subscriptions[0], subscriptions[2], subscriptions[4], subscriptions[5]} {
+		mockedRepo.EXPECT().
+			MarkSent(gomock.Any(), types.MarkErrorGroupsSubscriptionSentRequest{
+				ID:         sub.ID,
+				PrevSentAt: sub.LastSentAt,
+				SentAt:     now,
+			}).
+			Return(sub.ID != 5, nil).
+			Times(1)
+	}
+
+	// subscription 1
+	req1 := types.GetErrorGroupsRequest{
+		Service:   "svc1",
+		Env:       &env,
+		TimeRange: &types.TimeRange{From: dayAgo, To: now},
+		Limit:     cfg.GroupsLimit,
+		Order:     types.OrderFrequent,
+		WithTotal: true,
+	}
+	mockedSvc.EXPECT().GetNewErrorGroups(gomock.Any(), req1).Return(someGroups, uint64(1), nil).Times(1)
+	mockedSvc.EXPECT().
+		GetTopErrorGroups(gomock.Any(), types.GetTopErrorGroupsRequest{
+			Service:   &req1.Service,
+			Env:       &env,
+			TimeRange: &types.TimeRange{From: dayAgo, To: now},
+			Limit:     cfg.GroupsLimit,
+		}).
+		Return(topGroups, uint64(0), nil).
+		Times(1)
+
+	// subscription 3
+	req3 := types.GetErrorGroupsRequest{
+		Service:   "svc3",
+		TimeRange: &types.TimeRange{From: dayAgo, To: now},
+		Limit:     cfg.GroupsLimit,
+		Order:     types.OrderFrequent,
+		WithTotal: true,
+	}
+	mockedSvc.EXPECT().GetNewErrorGroups(gomock.Any(), req3).Return(nil, uint64(0), nil).Times(1)
+	mockedSvc.EXPECT().
+		GetTopErrorGroups(gomock.Any(), types.GetTopErrorGroupsRequest{
+			Service:   &req3.Service,
+			TimeRange: &types.TimeRange{From: dayAgo, To: now},
+			Limit:     cfg.GroupsLimit,
+		}).
+		Return(topGroups, uint64(0), nil).
+		Times(1)
+
+	// subscription 6
+	req6 := types.GetErrorGroupsRequest{
+		Service:   "svc6",
+		TimeRange: &types.TimeRange{From: dayAgo, To: now},
+		Limit:     cfg.GroupsLimit,
+		Order:     types.OrderFrequent,
+		WithTotal: true,
+	}
+	mockedSvc.EXPECT().GetNewErrorGroups(gomock.Any(), req6).Return(nil, uint64(0), someErr).Times(1)
+
+	fs := &fakeFileStore{objects: map[string]string{}}
+	s := newService(cfg, mockedSvc, mockedRepo, fs, func() time.Time { return now })
+
+	require.NoError(t, s.SendDue(context.Background()))
+
+	require.Len(t, webhookBodies, 1)
+	require.Contains(t, webhookBodies[0], "# Error groups daily digest: svc1 (prod)")
+	require.Equal(t, "text/markdown; charset=utf-8", webhookTypes[0])
+
+	require.Len(t, fs.objects, 1)
+	content, ok := fs.objects["digests/svc3/all/daily_2024-01-08T00-00-00_3.html"]
+	require.True(t, ok)
+	require.Contains(t, content, "<h1>Error groups daily digest: svc3</h1>")
+}
+
+func TestSendDueErrors(t *testing.T) {
+	var (
+		now     = time.Now()
+		someErr = errors.New("some err")
+	)
+
+	t.Run("err_get_all", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		mockedRepo := repo_mock.NewMockErrorGroupsSubscriptions(ctrl)
+		mockedRepo.EXPECT().GetAll(gomock.Any()).Return(nil, someErr).Times(1)
+
+		s := newService(config.ErrorGroupsDigest{}, nil, mockedRepo, nil, func() time.Time { return now })
+		require.Error(t, s.SendDue(context.Background()))
+	})
+
+	t.Run("err_no_file_store", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(config.ErrorGroupsDigest{}, nil, nil, nil, func() time.Time { return now })
+		err := s.putToFileStore(context.Background(), types.ErrorGroupsSubscription{}, now, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("err_webhook_status", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer webhook.Close()
+
+		cfg := config.ErrorGroupsDigest{WebhookAllowlist: []string{webhook.URL}}
+		s := newService(cfg, nil, nil, nil, func() time.Time { return now })
+		err := s.postToWebhook(context.Background(), types.ErrorGroupsSubscription{WebhookURL: webhook.URL}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("err_webhook_not_allowed", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		webhook := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			called = true
+		}))
+		defer webhook.Close()
+
+		cfg := config.ErrorGroupsDigest{WebhookAllowlist: []string{"https://hooks.example.com/"}}
+		s := newService(cfg, nil, nil, nil, func() time.Time { return now })
+		err := s.postToWebhook(context.Background(), types.ErrorGroupsSubscription{WebhookURL: webhook.URL}, nil)
+		require.Error(t, err)
+		require.False(t, called)
+	})
+
+	t.Run("err_webhook_redirect", func(t *testing.T) {
+		t.Parallel()
+
+		redirected := false
+		target := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			redirected = true
+		}))
+		defer target.Close()
+
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+		}))
+		defer webhook.Close()
+
+		cfg := config.ErrorGroupsDigest{WebhookAllowlist: []string{webhook.URL}}
+		s := newService(cfg, nil, nil, nil, func() time.Time { return now })
+		err := s.postToWebhook(context.Background(), types.ErrorGroupsSubscription{WebhookURL: webhook.URL}, nil)
+		require.Error(t, err)
+		require.False(t, redirected)
+	})
+}
+
+func TestWebhookAllowed(t *testing.T) {
+	allowlist := []string{"https://hooks.example.com/services/", "http://alerts.local:8080"}
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{url: "https://hooks.example.com/services/T1/B2", want: true},
+		{url: "https://HOOKS.example.com/services", want: true},
+		{url: "http://alerts.local:8080/hook", want: true},
+		{url: "http://hooks.example.com/services/T1"},
+		{url: "https://hooks.example.com/servicesX"},
+		{url: "https://hooks.example.com/admin"},
+		{url: "https://hooks.example.com/services/../admin"},
+		{url: "https://hooks.example.com.evil.com/services/T1"},
+		{url: "https://user@hooks.example.com/services/T1"},
+		{url: "http://alerts.local/hook"},
+		{url: "http://169.254.169.254/latest/meta-data"},
+		{url: "ftp://hooks.example.com/services/T1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, WebhookAllowed(allowlist, tt.url))
+		})
+	}
+	require.False(t, WebhookAllowed(nil, "https://hooks.example.com/services/T1"))
+}
+
+func TestObjectName(t *testing.T) {
+	now := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	s := newService(config.ErrorGroupsDigest{FileStorePrefix: "digests"}, nil, nil, nil, time.Now)
+
+	got := s.objectName(types.ErrorGroupsSubscription{
+		ID:      7,
+		Service: "../svc/x",
+		Env:     "..",
+		Period:  types.DigestPeriodWeekly,
+		Format:  types.DigestFormatMarkdown,
+	}, now)
+	require.Equal(t, "digests/.._svc_x/_/weekly_2024-01-08T00-00-00_7.md", got)
+}
@@ -0,0 +1,34 @@
+package digest
+
+import (
+	"net/url"
+	"strings"
+)
+
+// WebhookAllowed checks that the webhook url matches any of the allowed url prefixes.
+// Scheme and host must be equal, the path must be equal to the prefix path or be nested in it.
+func WebhookAllowed(allowlist []string, rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || u.User != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	if strings.Contains(u.Path+"/", "/../") {
+		return false
+	}
+
+	for _, prefix := range allowlist {
+		p, err := url.Parse(prefix)
+		if err != nil {
+			continue
+		}
+		if !strings.EqualFold(u.Scheme, p.Scheme) || !strings.EqualFold(u.Host, p.Host) {
+			continue
+		}
+
+		dir := strings.TrimSuffix(p.Path, "/")
+		if u.Path == dir || strings.HasPrefix(u.Path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package errorgroups
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+const (
+	defaultMinSimilarity   = 0.7
+	similarCandidatesLimit = 1000
+)
+
+func (s *service) MergeErrorGroups(
+	ctx context.Context,
+	req types.MergeErrorGroupsRequest,
+) error {
+	if !s.canMerge(ctx, req.User) {
+		return types.NewErrPermissionDenied("merge error groups")
+	}
+	if req.TargetHash == 0 {
+		return types.NewErrInvalidRequestField("'target_hash' must not be empty")
+	}
+	if len(req.Hashes) == 0 {
+		return types.NewErrInvalidRequestField("'group_hashes' must not be empty")
+	}
+	if slices.Contains(req.Hashes, 0) {
+		return types.NewErrInvalidRequestField("each element in 'group_hashes' must be non-empty")
+	}
+	if slices.Contains(req.Hashes, req.TargetHash) {
+		return types.NewErrInvalidRequestField("'group_hashes' must not contain 'target_hash'")
+	}
+
+	current, err := s.repo.GetErrorGroupMerges(ctx)
+	if err != nil {
+		return fmt.Errorf("get error groups merges failed: %w", err)
+	}
+
+	merges := make([]types.ErrorGroupMerge, 0, len(req.Hashes))
+	merged := make(map[uint64]struct{}, len(req.Hashes))
+	for _, hash := range req.Hashes {
+		if _, ok := merged[hash]; ok {
+			continue
+		}
+		merged[hash] = struct{}{}
+		merges = append(merges, types.ErrorGroupMerge{
+			Hash:       hash,
+			TargetHash: req.TargetHash,
+			CreatedBy:  req.User,
+		})
+	}
+
+	for _, m := range current {
+		if m.Hash == req.TargetHash {
+			return types.NewErrInvalidRequestField(fmt.Sprintf(
+				"'target_hash' is already merged into %d", m.TargetHash,
+			))
+		}
+		// keep merges flat: groups merged into the merging ones are moved to the new target
+		if _, ok := merged[m.TargetHash]; !ok {
+			continue
+		}
+		if _, ok := merged[m.Hash]; ok {
+			continue
+		}
+		merged[m.Hash] = struct{}{}
+		merges = append(merges, types.ErrorGroupMerge{
+			Hash:       m.Hash,
+			TargetHash: req.TargetHash,
+			CreatedBy:  req.User,
+		})
+	}
+
+	if err = s.repo.SaveErrorGroupMerges(ctx, merges); err != nil {
+		return fmt.Errorf("merge error groups failed: %w", err)
+	}
+
+	return nil
+}
+
+// canMerge checks that the user can merge and unmerge error groups.
+// If RBAC is enabled, the permission is required, otherwise the user must be listed in the admin users.
+func (s *service) canMerge(ctx context.Context, user string) bool {
+	if _, ok := rbac.GetAccess(ctx); ok {
+		return rbac.HasPermission(ctx, rbac.PermErrorGroupsMerge)
+	}
+	return user != "" && slices.Contains(s.adminUsers, user)
+}
+
+func (s *service) UnmergeErrorGroups(
+	ctx context.Context,
+	req types.UnmergeErrorGroupsRequest,
+) error {
+	if !s.canMerge(ctx, req.User) {
+		return types.NewErrPermissionDenied("unmerge error groups")
+	}
+	if len(req.Hashes) == 0 {
+		return types.NewErrInvalidRequestField("'group_hashes' must not be empty")
+	}
+	if slices.Contains(req.Hashes, 0) {
+		return types.NewErrInvalidRequestField("each element in 'group_hashes' must be non-empty")
+	}
+
+	merges := make([]types.ErrorGroupMerge, 0, len(req.Hashes))
+	for _, hash := range req.Hashes {
+		merges = append(merges, types.ErrorGroupMerge{
+			Hash:      hash,
+			CreatedBy: req.User,
+		})
+	}
+
+	if err := s.repo.SaveErrorGroupMerges(ctx, merges); err != nil {
+		return fmt.Errorf("unmerge error groups failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) GetMergedErrorGroups(
+	ctx context.Context,
+	req types.GetMergedErrorGroupsRequest,
+) ([]types.ErrorGroupMerge, error) {
+	merges, err := s.repo.GetErrorGroupMerges(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get error groups merges failed: %w", err)
+	}
+
+	if req.TargetHash == nil || *req.TargetHash == 0 {
+		return merges, nil
+	}
+
+	return slices.DeleteFunc(merges, func(m types.ErrorGroupMerge) bool {
+		return m.TargetHash != *req.TargetHash
+	}), nil
+}
+
+func (s *service) GetSimilarErrorGroups(
+	ctx context.Context,
+	req types.GetSimilarErrorGroupsRequest,
+) ([]types.SimilarErrorGroup, error) {
+	if req.Service == "" {
+		return nil, types.NewErrInvalidRequestField("'service' must not be empty")
+	}
+	if req.GroupHash == 0 {
+		return nil, types.NewErrInvalidRequestField("'group_hash' must not be empty")
+	}
+
+	minSimilarity := defaultMinSimilarity
+	if req.MinSimilarity != nil {
+		minSimilarity = *req.MinSimilarity
+		if minSimilarity < 0 || minSimilarity > 1 {
+			return nil, types.NewErrInvalidRequestField("'min_similarity' must be in range [0, 1]")
+		}
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultLimit
+	}
+
+	details, err := s.repo.GetErrorDetails(ctx, types.GetErrorGroupDetailsRequest{
+		GroupHash: req.GroupHash,
+		Service:   &req.Service,
+		Env:       req.Env,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get error details failed: %w", err)
+	}
+	if details.Hash == 0 {
+		return nil, types.NewErrNotFound("error group")
+	}
+
+	candidates, err := s.repo.GetErrorGroups(ctx, types.GetErrorGroupsRequest{
+		Service: req.Service,
+		Env:     req.Env,
+		Limit:   similarCandidatesLimit,
+		Order:   types.OrderFrequent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get error groups failed: %w", err)
+	}
+
+	tokens := messageTokens(details.Message)
+
+	var groups []types.SimilarErrorGroup
+	for _, c := range candidates {
+		if c.Hash == details.Hash {
+			continue
+		}
+
+		similarity := tokensSimilarity(tokens, messageTokens(c.Message))
+		if similarity < minSimilarity {
+			continue
+		}
+
+		groups = append(groups, types.SimilarErrorGroup{
+			Hash:       c.Hash,
+			Source:     c.Source,
+			Message:    c.Message,
+			Count:      c.Count,
+			Similarity: similarity,
+		})
+	}
+
+	slices.SortStableFunc(groups, func(a, b types.SimilarErrorGroup) int {
+		return cmp.Or(
+			cmp.Compare(b.Similarity, a.Similarity),
+			cmp.Compare(b.Count, a.Count),
+		)
+	})
+
+	if len(groups) > int(req.Limit) {
+		groups = groups[:req.Limit]
+	}
+
+	return groups, nil
+}
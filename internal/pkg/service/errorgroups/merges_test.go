@@ -0,0 +1,492 @@
+package errorgroups
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
+)
+
+func TestMergeErrorGroups(t *testing.T) {
+	var (
+		user    = "test-user"
+		someErr = errors.New("some err")
+	)
+
+	type mockArgs struct {
+		current    []types.ErrorGroupMerge
+		errCurrent error
+
+		save    []types.ErrorGroupMerge
+		errSave error
+	}
+
+	tests := []struct {
+		name string
+
+		req     types.MergeErrorGroupsRequest
+		access  *rbac.Access
+		wantErr bool
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2, 3, 2},
+				User:       user,
+			},
+
+			mockArgs: &mockArgs{
+				current: []types.ErrorGroupMerge{
+					{Hash: 4, TargetHash: 2},
+					{Hash: 5, TargetHash: 6},
+				},
+				save: []types.ErrorGroupMerge{
+					{Hash: 2, TargetHash: 1, CreatedBy: user},
+					{Hash: 3, TargetHash: 1, CreatedBy: user},
+					{Hash: 4, TargetHash: 1, CreatedBy: user},
+				},
+			},
+		},
+		{
+			name: "ok_rbac",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2},
+				User:       "other",
+			},
+			access: &rbac.Access{Permissions: []string{rbac.PermErrorGroupsMerge}},
+
+			mockArgs: &mockArgs{
+				save: []types.ErrorGroupMerge{
+					{Hash: 2, TargetHash: 1, CreatedBy: "other"},
+				},
+			},
+		},
+		{
+			name: "err_not_admin",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2},
+				User:       "other",
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_rbac_no_permission",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2},
+				User:       user,
+			},
+			access:  &rbac.Access{Permissions: []string{rbac.PermAuditView}},
+			wantErr: true,
+		},
+		{
+			name: "err_empty_target",
+
+			req: types.MergeErrorGroupsRequest{
+				Hashes: []uint64{2},
+				User:   user,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_empty_hashes",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				User:       user,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_zero_hash",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2, 0},
+				User:       user,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_target_in_hashes",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{1, 2},
+				User:       user,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_target_merged",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2},
+				User:       user,
+			},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				current: []types.ErrorGroupMerge{
+					{Hash: 1, TargetHash: 3},
+				},
+			},
+		},
+		{
+			name: "err_repo_get",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2},
+				User:       user,
+			},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				errCurrent: someErr,
+			},
+		},
+		{
+			name: "err_repo_save",
+
+			req: types.MergeErrorGroupsRequest{
+				TargetHash: 1,
+				Hashes:     []uint64{2},
+				User:       user,
+			},
+			wantErr: true,
+
+			mockArgs: &mockArgs{
+				save: []types.ErrorGroupMerge{
+					{Hash: 2, TargetHash: 1, CreatedBy: user},
+				},
+				errSave: someErr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedRepo := mock.NewMockRepository(ctrl)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedRepo.EXPECT().
+					GetErrorGroupMerges(gomock.Any()).
+					Return(ma.current, ma.errCurrent).
+					Times(1)
+
+				if ma.save != nil {
+					mockedRepo.EXPECT().
+						SaveErrorGroupMerges(gomock.Any(), ma.save).
+						Return(ma.errSave).
+						Times(1)
+				}
+			}
+
+			ctx := context.Background()
+			if tt.access != nil {
+				ctx = rbac.WithAccess(ctx, *tt.access)
+			}
+
+			s := New(mockedRepo, config.ErrorGroups{AdminUsers: []string{user}})
+			err := s.MergeErrorGroups(ctx, tt.req)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestUnmergeErrorGroups(t *testing.T) {
+	user := "test-user"
+
+	tests := []struct {
+		name string
+
+		req     types.UnmergeErrorGroupsRequest
+		save    []types.ErrorGroupMerge
+		wantErr bool
+	}{
+		{
+			name: "ok",
+
+			req: types.UnmergeErrorGroupsRequest{
+				Hashes: []uint64{2, 3},
+				User:   user,
+			},
+			save: []types.ErrorGroupMerge{
+				{Hash: 2, CreatedBy: user},
+				{Hash: 3, CreatedBy: user},
+			},
+		},
+		{
+			name:    "err_empty_hashes",
+			req:     types.UnmergeErrorGroupsRequest{User: user},
+			wantErr: true,
+		},
+		{
+			name: "err_zero_hash",
+
+			req: types.UnmergeErrorGroupsRequest{
+				Hashes: []uint64{0},
+				User:   user,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_not_admin",
+
+			req: types.UnmergeErrorGroupsRequest{
+				Hashes: []uint64{2},
+				User:   "other",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedRepo := mock.NewMockRepository(ctrl)
+
+			if tt.save != nil {
+				mockedRepo.EXPECT().
+					SaveErrorGroupMerges(gomock.Any(), tt.save).
+					Return(nil).
+					Times(1)
+			}
+
+			s := New(mockedRepo, config.ErrorGroups{AdminUsers: []string{user}})
+			err := s.UnmergeErrorGroups(context.Background(), tt.req)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestGetMergedErrorGroups(t *testing.T) {
+	targetHash := uint64(1)
+	merges := []types.ErrorGroupMerge{
+		{Hash: 2, TargetHash: 1},
+		{Hash: 3, TargetHash: 4},
+		{Hash: 5, TargetHash: 1},
+	}
+
+	tests := []struct {
+		name string
+
+		req  types.GetMergedErrorGroupsRequest
+		want []uint64
+	}{
+		{
+			name: "all",
+			want: []uint64{2, 3, 5},
+		},
+		{
+			name: "by_target",
+			req: types.GetMergedErrorGroupsRequest{
+				TargetHash: &targetHash,
+			},
+			want: []uint64{2, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedRepo := mock.NewMockRepository(ctrl)
+			mockedRepo.EXPECT().
+				GetErrorGroupMerges(gomock.Any()).
+				Return(append([]types.ErrorGroupMerge(nil), merges...), nil).
+				Times(1)
+
+			s := New(mockedRepo, config.ErrorGroups{})
+			got, err := s.GetMergedErrorGroups(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			hashes := make([]uint64, 0, len(got))
+			for _, m := range got {
+				hashes = append(hashes, m.Hash)
+			}
+			require.Equal(t, tt.want, hashes)
+		})
+	}
+}
+
+func TestGetSimilarErrorGroups(t *testing.T) {
+	var (
+		service       = "test-svc"
+		minSimilarity = 0.5
+		wrongSim      = 1.5
+	)
+
+	candidates := []types.ErrorGroup{
+		{Hash: 1, Message: "order 123 not found", Count: 100},
+		{Hash: 2, Message: "order 456 not found", Count: 10},
+		{Hash: 3, Message: "order 789 not found in cache", Count: 50},
+		{Hash: 4, Message: "connection refused", Count: 1000},
+	}
+
+	type mockArgs struct {
+		details    types.ErrorGroupDetails
+		candidates bool
+	}
+
+	tests := []struct {
+		name string
+
+		req     types.GetSimilarErrorGroupsRequest
+		want    []uint64
+		wantErr error
+
+		mockArgs *mockArgs
+	}{
+		{
+			name: "ok",
+
+			req: types.GetSimilarErrorGroupsRequest{
+				Service:   service,
+				GroupHash: 1,
+			},
+			want: []uint64{2},
+
+			mockArgs: &mockArgs{
+				details:    types.ErrorGroupDetails{Hash: 1, Message: "order 123 not found"},
+				candidates: true,
+			},
+		},
+		{
+			name: "ok_min_similarity_and_limit",
+
+			req: types.GetSimilarErrorGroupsRequest{
+				Service:       service,
+				GroupHash:     1,
+				MinSimilarity: &minSimilarity,
+				Limit:         1,
+			},
+			want: []uint64{2},
+
+			mockArgs: &mockArgs{
+				details:    types.ErrorGroupDetails{Hash: 1, Message: "order 123 not found"},
+				candidates: true,
+			},
+		},
+		{
+			name: "ok_min_similarity",
+
+			req: types.GetSimilarErrorGroupsRequest{
+				Service:       service,
+				GroupHash:     1,
+				MinSimilarity: &minSimilarity,
+			},
+			want: []uint64{2, 3},
+
+			mockArgs: &mockArgs{
+				details:    types.ErrorGroupDetails{Hash: 1, Message: "order 123 not found"},
+				candidates: true,
+			},
+		},
+		{
+			name: "err_not_found",
+
+			req: types.GetSimilarErrorGroupsRequest{
+				Service:   service,
+				GroupHash: 1,
+			},
+			wantErr: types.ErrNotFound,
+
+			mockArgs: &mockArgs{},
+		},
+		{
+			name: "err_empty_service",
+
+			req: types.GetSimilarErrorGroupsRequest{
+				GroupHash: 1,
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_empty_group_hash",
+
+			req: types.GetSimilarErrorGroupsRequest{
+				Service: service,
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_min_similarity",
+
+			req: types.GetSimilarErrorGroupsRequest{
+				Service:       service,
+				GroupHash:     1,
+				MinSimilarity: &wrongSim,
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockedRepo := mock.NewMockRepository(ctrl)
+
+			if ma := tt.mockArgs; ma != nil {
+				mockedRepo.EXPECT().
+					GetErrorDetails(gomock.Any(), types.GetErrorGroupDetailsRequest{
+						GroupHash: tt.req.GroupHash,
+						Service:   &tt.req.Service,
+					}).
+					Return(ma.details, nil).
+					Times(1)
+
+				if ma.candidates {
+					mockedRepo.EXPECT().
+						GetErrorGroups(gomock.Any(), types.GetErrorGroupsRequest{
+							Service: service,
+							Limit:   similarCandidatesLimit,
+							Order:   types.OrderFrequent,
+						}).
+						Return(candidates, nil).
+						Times(1)
+				}
+			}
+
+			s := New(mockedRepo, config.ErrorGroups{})
+			got, err := s.GetSimilarErrorGroups(context.Background(), tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			hashes := make([]uint64, 0, len(got))
+			for _, g := range got {
+				hashes = append(hashes, g.Hash)
+			}
+			require.Equal(t, tt.want, hashes)
+		})
+	}
+}
@@ -1,13 +1,13 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ozontech/seq-ui/internal/pkg/repository_ch (interfaces: Repository)
+// Source: github.com/ozontech/seq-ui/internal/pkg/service/errorgroups (interfaces: Repository)
 //
 // Generated by this command:
 //
-//	mockgen -destination=internal/pkg/repository_ch/mock/repository_ch.go -package=mock_repositorych github.com/ozontech/seq-ui/internal/pkg/repository_ch Repository
+//	mockgen -destination=internal/pkg/service/errorgroups/mock/repository.go github.com/ozontech/seq-ui/internal/pkg/service/errorgroups Repository
 //
 
-// Package mock_repositorych is a generated GoMock package.
-package mock_repositorych
+// Package mock_errorgroups is a generated GoMock package.
+package mock_errorgroups
 
 import (
 	context "context"
@@ -101,6 +101,21 @@ func (mr *MockRepositoryMockRecorder) GetErrorDetails(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetErrorDetails", reflect.TypeOf((*MockRepository)(nil).GetErrorDetails), arg0, arg1)
 }
 
+// GetErrorGroupMerges mocks base method.
+func (m *MockRepository) GetErrorGroupMerges(arg0 context.Context) ([]types.ErrorGroupMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetErrorGroupMerges", arg0)
+	ret0, _ := ret[0].([]types.ErrorGroupMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetErrorGroupMerges indicates an expected call of GetErrorGroupMerges.
+func (mr *MockRepositoryMockRecorder) GetErrorGroupMerges(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetErrorGroupMerges", reflect.TypeOf((*MockRepository)(nil).GetErrorGroupMerges), arg0)
+}
+
 // GetErrorGroups mocks base method.
 func (m *MockRepository) GetErrorGroups(arg0 context.Context, arg1 types.GetErrorGroupsRequest) ([]types.ErrorGroup, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +206,21 @@ func (mr *MockRepositoryMockRecorder) GetReleases(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleases", reflect.TypeOf((*MockRepository)(nil).GetReleases), arg0, arg1)
 }
 
+// GetReleasesGroupCounts mocks base method.
+func (m *MockRepository) GetReleasesGroupCounts(arg0 context.Context, arg1 types.CompareReleasesRequest) ([]types.ReleaseGroupCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleasesGroupCounts", arg0, arg1)
+	ret0, _ := ret[0].([]types.ReleaseGroupCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleasesGroupCounts indicates an expected call of GetReleasesGroupCounts.
+func (mr *MockRepositoryMockRecorder) GetReleasesGroupCounts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleasesGroupCounts", reflect.TypeOf((*MockRepository)(nil).GetReleasesGroupCounts), arg0, arg1)
+}
+
 // GetServices mocks base method.
 func (m *MockRepository) GetServices(arg0 context.Context, arg1 types.GetServicesRequest) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -235,3 +265,17 @@ func (mr *MockRepositoryMockRecorder) GetTopErrorGroupsTotal(arg0, arg1 any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopErrorGroupsTotal", reflect.TypeOf((*MockRepository)(nil).GetTopErrorGroupsTotal), arg0, arg1)
 }
+
+// SaveErrorGroupMerges mocks base method.
+func (m *MockRepository) SaveErrorGroupMerges(arg0 context.Context, arg1 []types.ErrorGroupMerge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveErrorGroupMerges", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveErrorGroupMerges indicates an expected call of SaveErrorGroupMerges.
+func (mr *MockRepositoryMockRecorder) SaveErrorGroupMerges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveErrorGroupMerges", reflect.TypeOf((*MockRepository)(nil).SaveErrorGroupMerges), arg0, arg1)
+}
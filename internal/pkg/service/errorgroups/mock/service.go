@@ -41,6 +41,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CompareReleases mocks base method.
+func (m *MockService) CompareReleases(arg0 context.Context, arg1 types.CompareReleasesRequest) (types.CompareReleasesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareReleases", arg0, arg1)
+	ret0, _ := ret[0].(types.CompareReleasesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareReleases indicates an expected call of CompareReleases.
+func (mr *MockServiceMockRecorder) CompareReleases(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareReleases", reflect.TypeOf((*MockService)(nil).CompareReleases), arg0, arg1)
+}
+
 // DiffByReleases mocks base method.
 func (m *MockService) DiffByReleases(arg0 context.Context, arg1 types.DiffByReleasesRequest) ([]types.DiffGroup, uint64, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +118,21 @@ func (mr *MockServiceMockRecorder) GetHist(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHist", reflect.TypeOf((*MockService)(nil).GetHist), arg0, arg1)
 }
 
+// GetMergedErrorGroups mocks base method.
+func (m *MockService) GetMergedErrorGroups(arg0 context.Context, arg1 types.GetMergedErrorGroupsRequest) ([]types.ErrorGroupMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMergedErrorGroups", arg0, arg1)
+	ret0, _ := ret[0].([]types.ErrorGroupMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMergedErrorGroups indicates an expected call of GetMergedErrorGroups.
+func (mr *MockServiceMockRecorder) GetMergedErrorGroups(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedErrorGroups", reflect.TypeOf((*MockService)(nil).GetMergedErrorGroups), arg0, arg1)
+}
+
 // GetNewErrorGroups mocks base method.
 func (m *MockService) GetNewErrorGroups(arg0 context.Context, arg1 types.GetErrorGroupsRequest) ([]types.ErrorGroup, uint64, error) {
 	m.ctrl.T.Helper()
@@ -149,6 +179,21 @@ func (mr *MockServiceMockRecorder) GetServices(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServices", reflect.TypeOf((*MockService)(nil).GetServices), arg0, arg1)
 }
 
+// GetSimilarErrorGroups mocks base method.
+func (m *MockService) GetSimilarErrorGroups(arg0 context.Context, arg1 types.GetSimilarErrorGroupsRequest) ([]types.SimilarErrorGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilarErrorGroups", arg0, arg1)
+	ret0, _ := ret[0].([]types.SimilarErrorGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilarErrorGroups indicates an expected call of GetSimilarErrorGroups.
+func (mr *MockServiceMockRecorder) GetSimilarErrorGroups(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarErrorGroups", reflect.TypeOf((*MockService)(nil).GetSimilarErrorGroups), arg0, arg1)
+}
+
 // GetTopErrorGroups mocks base method.
 func (m *MockService) GetTopErrorGroups(arg0 context.Context, arg1 types.GetTopErrorGroupsRequest) ([]types.TopErrorGroup, uint64, error) {
 	m.ctrl.T.Helper()
@@ -164,3 +209,31 @@ func (mr *MockServiceMockRecorder) GetTopErrorGroups(arg0, arg1 any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopErrorGroups", reflect.TypeOf((*MockService)(nil).GetTopErrorGroups), arg0, arg1)
 }
+
+// MergeErrorGroups mocks base method.
+func (m *MockService) MergeErrorGroups(arg0 context.Context, arg1 types.MergeErrorGroupsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeErrorGroups", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeErrorGroups indicates an expected call of MergeErrorGroups.
+func (mr *MockServiceMockRecorder) MergeErrorGroups(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeErrorGroups", reflect.TypeOf((*MockService)(nil).MergeErrorGroups), arg0, arg1)
+}
+
+// UnmergeErrorGroups mocks base method.
+func (m *MockService) UnmergeErrorGroups(arg0 context.Context, arg1 types.UnmergeErrorGroupsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmergeErrorGroups", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnmergeErrorGroups indicates an expected call of UnmergeErrorGroups.
+func (mr *MockServiceMockRecorder) UnmergeErrorGroups(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmergeErrorGroups", reflect.TypeOf((*MockService)(nil).UnmergeErrorGroups), arg0, arg1)
+}
@@ -2,21 +2,35 @@ package errorgroups
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"slices"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ozontech/seq-ui/internal/app/config"
 	"github.com/ozontech/seq-ui/internal/app/types"
-	repositorych "github.com/ozontech/seq-ui/internal/pkg/repository_ch"
 )
 
 const (
 	defaultLimit uint32 = 25
+
+	maxHistBuckets = 1000
 )
 
+// histIntervalsRetention contains supported intervals of hist buckets
+// and retention of the rollups they are calculated from.
+// The 1h rollup is backfilled from the 10min one by migrations, the 1min rollup has no data before its creation.
+var histIntervalsRetention = map[time.Duration]time.Duration{
+	types.HistIntervalMinute:    7 * types.HistIntervalDay,
+	types.HistInterval10Minutes: 90 * types.HistIntervalDay,
+	types.HistIntervalHour:      365 * types.HistIntervalDay,
+	types.HistIntervalDay:       2 * 365 * types.HistIntervalDay,
+	types.HistIntervalWeek:      2 * 365 * types.HistIntervalDay,
+}
+
 type Service interface {
 	GetErrorGroups(context.Context, types.GetErrorGroupsRequest) ([]types.ErrorGroup, uint64, error)
 	GetNewErrorGroups(context.Context, types.GetErrorGroupsRequest) ([]types.ErrorGroup, uint64, error)
@@ -29,17 +43,51 @@ type Service interface {
 	GetReleases(context.Context, types.GetReleasesRequest) ([]string, error)
 
 	DiffByReleases(context.Context, types.DiffByReleasesRequest) ([]types.DiffGroup, uint64, error)
+	CompareReleases(context.Context, types.CompareReleasesRequest) (types.CompareReleasesResult, error)
+
+	MergeErrorGroups(context.Context, types.MergeErrorGroupsRequest) error
+	UnmergeErrorGroups(context.Context, types.UnmergeErrorGroupsRequest) error
+	GetMergedErrorGroups(context.Context, types.GetMergedErrorGroupsRequest) ([]types.ErrorGroupMerge, error)
+	GetSimilarErrorGroups(context.Context, types.GetSimilarErrorGroupsRequest) ([]types.SimilarErrorGroup, error)
+}
+
+// Repository is the storage of error groups implemented by clickhouse and postgres repositories.
+type Repository interface {
+	GetErrorGroups(context.Context, types.GetErrorGroupsRequest) ([]types.ErrorGroup, error)
+	GetErrorGroupsTotal(context.Context, types.GetErrorGroupsRequest) (uint64, error)
+
+	GetNewErrorGroups(context.Context, types.GetErrorGroupsRequest) ([]types.ErrorGroup, error)
+	GetNewErrorGroupsTotal(context.Context, types.GetErrorGroupsRequest) (uint64, error)
+
+	GetTopErrorGroups(context.Context, types.GetTopErrorGroupsRequest) ([]types.TopErrorGroup, error)
+	GetTopErrorGroupsTotal(context.Context, types.GetTopErrorGroupsRequest) (uint64, error)
+
+	GetErrorHist(context.Context, types.GetErrorHistRequest) (types.ErrorHist, error)
+	GetErrorDetails(context.Context, types.GetErrorGroupDetailsRequest) (types.ErrorGroupDetails, error)
+	GetErrorCounts(context.Context, types.GetErrorGroupDetailsRequest) (types.ErrorGroupCounts, error)
+
+	GetServices(context.Context, types.GetServicesRequest) ([]string, error)
+	GetReleases(context.Context, types.GetReleasesRequest) ([]string, error)
+
+	DiffByReleases(context.Context, types.DiffByReleasesRequest) ([]types.DiffGroup, error)
+	DiffByReleasesTotal(context.Context, types.DiffByReleasesRequest) (uint64, error)
+	GetReleasesGroupCounts(context.Context, types.CompareReleasesRequest) ([]types.ReleaseGroupCount, error)
+
+	GetErrorGroupMerges(context.Context) ([]types.ErrorGroupMerge, error)
+	SaveErrorGroupMerges(context.Context, []types.ErrorGroupMerge) error
 }
 
 type service struct {
-	repo           repositorych.Repository
+	repo           Repository
 	logTagsMapping config.LogTagsMapping
+	adminUsers     []string
 }
 
-func New(repo repositorych.Repository, logTagsMapping config.LogTagsMapping) Service {
+func New(repo Repository, cfg config.ErrorGroups) Service {
 	return &service{
 		repo:           repo,
-		logTagsMapping: logTagsMapping,
+		logTagsMapping: cfg.LogTagsMapping,
+		adminUsers:     cfg.AdminUsers,
 	}
 }
 
@@ -153,6 +201,9 @@ func (s *service) GetHist(
 	if err := validateTimeRange(req.TimeRange); err != nil {
 		return types.ErrorHist{}, types.NewErrInvalidRequestField(err.Error())
 	}
+	if err := validateHistInterval(req.Interval, req.TimeRange, time.Now()); err != nil {
+		return types.ErrorHist{}, types.NewErrInvalidRequestField(err.Error())
+	}
 
 	return s.repo.GetErrorHist(ctx, req)
 }
@@ -329,3 +380,43 @@ func validateTimeRange(tr *types.TimeRange) error {
 	}
 	return nil
 }
+
+// validateHistInterval checks that hist with requested interval
+// can be calculated from rollups for the time range.
+func validateHistInterval(interval time.Duration, tr *types.TimeRange, now time.Time) error {
+	if interval == 0 {
+		return nil
+	}
+
+	retention, ok := histIntervalsRetention[interval]
+	if !ok {
+		return errors.New("'interval' must be one of: 1m, 10m, 1h, 24h, 168h")
+	}
+
+	if tr.IsEmpty() {
+		if interval < types.HistIntervalDay {
+			return errors.New("'time_range' is required for 'interval' less than 24h")
+		}
+		return nil
+	}
+
+	var (
+		from     time.Time
+		duration time.Duration
+	)
+	if tr.IsAbsolute() {
+		from, duration = tr.From, tr.AbsoluteDuration()
+	} else {
+		duration = tr.Duration.Abs()
+		from = now.Add(-duration)
+	}
+
+	if now.Sub(from) > retention {
+		return fmt.Errorf("'interval' %s is available only for the last %s", interval, retention)
+	}
+	if duration/interval > maxHistBuckets {
+		return fmt.Errorf("too many buckets for 'interval' %s, max is %d", interval, maxHistBuckets)
+	}
+
+	return nil
+}
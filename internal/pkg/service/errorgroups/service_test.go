@@ -12,7 +12,7 @@ import (
 
 	"github.com/ozontech/seq-ui/internal/app/config"
 	"github.com/ozontech/seq-ui/internal/app/types"
-	mock "github.com/ozontech/seq-ui/internal/pkg/repository_ch/mock"
+	mock "github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/mock"
 )
 
 func TestValidateTimeRange(t *testing.T) {
@@ -283,7 +283,7 @@ func TestGetErrorGroups(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, config.LogTagsMapping{})
+			svc := New(mockedRepo, config.ErrorGroups{})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
@@ -514,7 +514,7 @@ func TestGetNewErrorGroups(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, config.LogTagsMapping{})
+			svc := New(mockedRepo, config.ErrorGroups{})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
@@ -701,7 +701,7 @@ func TestGetTopErrorGroups(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, config.LogTagsMapping{})
+			svc := New(mockedRepo, config.ErrorGroups{})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
@@ -787,6 +787,80 @@ func TestGetHist(t *testing.T) {
 				bucketsCount: 50,
 			},
 		},
+		{
+			name: "ok_interval",
+
+			req: types.GetErrorHistRequest{
+				TimeRange: &types.TimeRange{
+					Duration: duration,
+				},
+				Interval: types.HistInterval10Minutes,
+				GroupBy:  types.HistGroupByEnv,
+			},
+			wantBucketsCount: 6,
+
+			mockArgs: &mockArgs{
+				req: types.GetErrorHistRequest{
+					TimeRange: &types.TimeRange{
+						Duration: duration,
+					},
+					Interval: types.HistInterval10Minutes,
+					GroupBy:  types.HistGroupByEnv,
+				},
+
+				bucketsCount: 6,
+			},
+		},
+		{
+			name: "err_interval_unsupported",
+
+			req: types.GetErrorHistRequest{
+				TimeRange: &types.TimeRange{
+					Duration: duration,
+				},
+				Interval: 5 * time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_interval_month",
+
+			req: types.GetErrorHistRequest{
+				Interval: 31 * types.HistIntervalDay,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_interval_without_timerange",
+
+			req: types.GetErrorHistRequest{
+				Interval: types.HistIntervalMinute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_interval_retention",
+
+			req: types.GetErrorHistRequest{
+				TimeRange: &types.TimeRange{
+					From: time.Now().Add(-30 * types.HistIntervalDay),
+					To:   time.Now().Add(-29 * types.HistIntervalDay),
+				},
+				Interval: types.HistIntervalMinute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "err_interval_too_many_buckets",
+
+			req: types.GetErrorHistRequest{
+				TimeRange: &types.TimeRange{
+					Duration: 5 * types.HistIntervalDay,
+				},
+				Interval: types.HistIntervalMinute,
+			},
+			wantErr: true,
+		},
 		{
 			name: "err_timerange",
 
@@ -816,7 +890,7 @@ func TestGetHist(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, config.LogTagsMapping{})
+			svc := New(mockedRepo, config.ErrorGroups{})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
@@ -1085,7 +1159,7 @@ func TestGetDetails(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, tt.logTagsMapping)
+			svc := New(mockedRepo, config.ErrorGroups{LogTagsMapping: tt.logTagsMapping})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
@@ -1165,7 +1239,7 @@ func TestGetServices(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, config.LogTagsMapping{})
+			svc := New(mockedRepo, config.ErrorGroups{})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
@@ -1255,7 +1329,7 @@ func TestGetReleases(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, config.LogTagsMapping{})
+			svc := New(mockedRepo, config.ErrorGroups{})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
@@ -1447,7 +1521,7 @@ func TestDiffByReleases(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedRepo := mock.NewMockRepository(ctrl)
 
-			svc := New(mockedRepo, config.LogTagsMapping{})
+			svc := New(mockedRepo, config.ErrorGroups{})
 
 			if ma := tt.mockArgs; ma != nil {
 				mockedRepo.EXPECT().
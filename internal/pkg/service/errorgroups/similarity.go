@@ -0,0 +1,60 @@
+package errorgroups
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// messageNormalizers replace variable parts of error messages with placeholders.
+// Order matters: more specific patterns go first.
+var messageNormalizers = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	{regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`), "<uuid>"},
+	{regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}(?::\d+)?\b`), "<ip>"},
+	{regexp.MustCompile(`"[^"]*"|'[^']*'`), "<str>"},
+	{regexp.MustCompile(`\b(?:0x[0-9a-fA-F]+|[0-9a-fA-F]{16,})\b`), "<hex>"},
+	{regexp.MustCompile(`\b(?:\d+(?:\.\d+)?(?:ns|us|µs|ms|s|m|h))+\b`), "<duration>"},
+	{regexp.MustCompile(`\d+(?:\.\d+)?`), "<num>"},
+}
+
+// normalizeMessage returns error message with variable parts
+// (identifiers, addresses, durations, numbers, etc.) replaced by placeholders.
+func normalizeMessage(msg string) string {
+	for _, n := range messageNormalizers {
+		msg = n.re.ReplaceAllString(msg, n.repl)
+	}
+	return strings.ToLower(msg)
+}
+
+// messageTokens returns set of tokens of normalized error message.
+func messageTokens(msg string) map[string]struct{} {
+	fields := strings.FieldsFunc(normalizeMessage(msg), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '<' && r != '>' && r != '_'
+	})
+
+	tokens := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		tokens[f] = struct{}{}
+	}
+	return tokens
+}
+
+// tokensSimilarity returns Jaccard similarity of token sets in range [0, 1].
+func tokensSimilarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	intersection := 0
+	for t := range a {
+		if _, ok := b[t]; ok {
+			intersection++
+		}
+	}
+	union := len(a) + len(b) - intersection
+
+	return float64(intersection) / float64(union)
+}
@@ -0,0 +1,94 @@
+package errorgroups
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{
+			name: "uuid",
+			msg:  "user 1b4e28ba-2fa1-11d2-883f-0016d3cca427 not found",
+			want: "user <uuid> not found",
+		},
+		{
+			name: "ip",
+			msg:  "dial tcp 10.0.0.1:5432: connection refused",
+			want: "dial tcp <ip>: connection refused",
+		},
+		{
+			name: "quoted",
+			msg:  `unknown field "foo" in 'bar'`,
+			want: "unknown field <str> in <str>",
+		},
+		{
+			name: "hex",
+			msg:  "bad pointer 0xc000123abc, trace deadbeefdeadbeef01",
+			want: "bad pointer <hex>, trace <hex>",
+		},
+		{
+			name: "duration",
+			msg:  "request timed out after 1m30.5s (limit 500ms)",
+			want: "request timed out after <duration> (limit <duration>)",
+		},
+		{
+			name: "numbers",
+			msg:  "Order 12345 has invalid amount 10.5 for user42",
+			want: "order <num> has invalid amount <num> for user<num>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, normalizeMessage(tt.msg))
+		})
+	}
+}
+
+func TestTokensSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{
+			name: "empty",
+			want: 1,
+		},
+		{
+			name: "same_after_normalization",
+			a:    "order 123 not found",
+			b:    "order 456 not found",
+			want: 1,
+		},
+		{
+			name: "partial",
+			a:    "failed to get user: timeout",
+			b:    "failed to get order: timeout",
+			want: 4.0 / 6.0,
+		},
+		{
+			name: "different",
+			a:    "connection refused",
+			b:    "invalid argument",
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tokensSimilarity(messageTokens(tt.a), messageTokens(tt.b))
+			require.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/querypolicy"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/internal/pkg/client/seqdb"
 	"github.com/ozontech/seq-ui/internal/pkg/service/massexport/filestore"
@@ -42,6 +44,8 @@ type exportService struct {
 
 	authEnabled  bool
 	allowedUsers []string
+
+	queryPolicy *querypolicy.Engine
 }
 
 const (
@@ -56,6 +60,7 @@ func NewService(
 	sessionStore sessionstore.SessionStore,
 	fileStore filestore.FileStore,
 	client seqdb.Client,
+	queryPolicy *querypolicy.Engine,
 ) (Service, error) {
 	batchSize := defaultBatchSize
 	if cfg.BatchSize > 0 {
@@ -114,11 +119,13 @@ func NewService(
 
 		authEnabled:  authEnabled,
 		allowedUsers: cfg.AllowedUsers,
+
+		queryPolicy: queryPolicy,
 	}, nil
 }
 
 func (s *exportService) StartExport(ctx context.Context, req types.StartExportRequest) (types.StartExportResponse, error) {
-	userName, err := s.auth(ctx)
+	userName, err := s.auth(ctx, rbac.PermMassExportStart)
 	if err != nil {
 		return types.StartExportResponse{}, err
 	}
@@ -130,6 +137,11 @@ func (s *exportService) StartExport(ctx context.Context, req types.StartExportRe
 		return types.StartExportResponse{}, fmt.Errorf("'window' is larger then part length (%s)", s.partLength)
 	}
 
+	query, err := s.queryPolicy.ApplyQuery(ctx, req.Query)
+	if err != nil {
+		return types.StartExportResponse{}, err
+	}
+
 	curTime := time.Now().UnixMilli()
 	jobID := fmt.Sprintf("%s_%d", req.Name, curTime)
 	sessionID := fmt.Sprintf("job#%s#export#%s", userName, jobID)
@@ -152,7 +164,7 @@ func (s *exportService) StartExport(ctx context.Context, req types.StartExportRe
 
 		From:       from,
 		To:         to,
-		Query:      req.Query,
+		Query:      query,
 		Window:     req.Window,
 		BatchSize:  s.batchSize,
 		PartLength: s.partLength,
@@ -170,7 +182,7 @@ func (s *exportService) StartExport(ctx context.Context, req types.StartExportRe
 }
 
 func (s *exportService) CheckExport(ctx context.Context, sessionID string) (types.ExportInfo, error) {
-	if _, err := s.auth(ctx); err != nil {
+	if _, err := s.auth(ctx, rbac.PermMassExportView); err != nil {
 		return types.ExportInfo{}, err
 	}
 
@@ -185,7 +197,7 @@ func (s *exportService) CheckExport(ctx context.Context, sessionID string) (type
 }
 
 func (s *exportService) CancelExport(ctx context.Context, sessionID string) error {
-	if _, err := s.auth(ctx); err != nil {
+	if _, err := s.auth(ctx, rbac.PermMassExportManage); err != nil {
 		return err
 	}
 
@@ -193,7 +205,7 @@ func (s *exportService) CancelExport(ctx context.Context, sessionID string) erro
 }
 
 func (s *exportService) RestoreExport(ctx context.Context, sessionID string) error {
-	if _, err := s.auth(ctx); err != nil {
+	if _, err := s.auth(ctx, rbac.PermMassExportManage); err != nil {
 		return err
 	}
 
@@ -228,7 +240,7 @@ func (s *exportService) RestoreExport(ctx context.Context, sessionID string) err
 }
 
 func (s *exportService) GetAll(ctx context.Context) ([]types.ExportInfo, error) {
-	if _, err := s.auth(ctx); err != nil {
+	if _, err := s.auth(ctx, rbac.PermMassExportView); err != nil {
 		return nil, err
 	}
 
@@ -244,7 +256,13 @@ func (s *exportService) GetAll(ctx context.Context) ([]types.ExportInfo, error)
 	return values, nil
 }
 
-func (s *exportService) auth(ctx context.Context) (string, error) {
+// auth checks that the user is allowed to export.
+// If RBAC is enabled, the user must have the permission, the allowed users list is checked anyway.
+func (s *exportService) auth(ctx context.Context, perm string) (string, error) {
+	if _, ok := rbac.GetAccess(ctx); ok && !rbac.HasPermission(ctx, perm) {
+		return "", fmt.Errorf("%w: permission %q required", types.ErrPermissionDenied, perm)
+	}
+
 	if !s.authEnabled {
 		return "anonymous", nil
 	}
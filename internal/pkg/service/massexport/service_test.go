@@ -0,0 +1,87 @@
+package massexport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestAuth(t *testing.T) {
+	const user = "alice"
+
+	tests := []struct {
+		name string
+
+		allowedUsers []string
+		access       *rbac.Access
+
+		wantUser string
+		wantErr  bool
+	}{
+		{
+			name:     "ok_auth_disabled",
+			wantUser: "anonymous",
+		},
+		{
+			name:         "ok_allowed_user",
+			allowedUsers: []string{user},
+			wantUser:     user,
+		},
+		{
+			name:         "err_not_allowed_user",
+			allowedUsers: []string{"bob"},
+			wantErr:      true,
+		},
+		{
+			name:         "ok_rbac_allowed_user",
+			allowedUsers: []string{user},
+			access:       &rbac.Access{Permissions: []string{rbac.PermMassExportStart}},
+			wantUser:     user,
+		},
+		{
+			name:     "ok_rbac_without_allowed_users",
+			access:   &rbac.Access{Permissions: []string{rbac.PermMassExportStart}},
+			wantUser: "anonymous",
+		},
+		{
+			name:         "err_rbac_not_allowed_user",
+			allowedUsers: []string{"bob"},
+			access:       &rbac.Access{Permissions: []string{rbac.PermMassExportStart}},
+			wantErr:      true,
+		},
+		{
+			name:         "err_rbac_no_permission",
+			allowedUsers: []string{user},
+			access:       &rbac.Access{Permissions: []string{rbac.PermMassExportView}},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &exportService{
+				authEnabled:  len(tt.allowedUsers) > 0,
+				allowedUsers: tt.allowedUsers,
+			}
+
+			ctx := context.WithValue(context.Background(), types.UserKey{}, user)
+			if tt.access != nil {
+				ctx = rbac.WithAccess(ctx, *tt.access)
+			}
+
+			got, err := s.auth(ctx, rbac.PermMassExportStart)
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrPermissionDenied)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantUser, got)
+		})
+	}
+}
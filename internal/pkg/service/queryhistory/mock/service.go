@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozontech/seq-ui/internal/pkg/service/queryhistory (interfaces: Recorder)
+//
+// Generated by this command:
+//
+//	mockgen -destination=internal/pkg/service/queryhistory/mock/service.go github.com/ozontech/seq-ui/internal/pkg/service/queryhistory Recorder
+//
+
+// Package mock_queryhistory is a generated GoMock package.
+package mock_queryhistory
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/ozontech/seq-ui/internal/app/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecorder is a mock of Recorder interface.
+type MockRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockRecorderMockRecorder is the mock recorder for MockRecorder.
+type MockRecorderMockRecorder struct {
+	mock *MockRecorder
+}
+
+// NewMockRecorder creates a new mock instance.
+func NewMockRecorder(ctrl *gomock.Controller) *MockRecorder {
+	mock := &MockRecorder{ctrl: ctrl}
+	mock.recorder = &MockRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecorder) EXPECT() *MockRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockRecorder) Record(arg0 context.Context, arg1 types.QueryHistoryEntry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", arg0, arg1)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockRecorderMockRecorder) Record(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockRecorder)(nil).Record), arg0, arg1)
+}
@@ -0,0 +1,164 @@
+package queryhistory
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/repository"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+	"github.com/ozontech/seq-ui/logger"
+	"github.com/ozontech/seq-ui/metric"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+const deleteExpiredInterval = 10 * time.Minute
+
+// Recorder records the users' requests to the query history.
+type Recorder interface {
+	// Record enqueues the entry without blocking, the entry is dropped if the queue is full.
+	// Requests of anonymous users are not recorded.
+	Record(context.Context, types.QueryHistoryEntry)
+}
+
+type record struct {
+	userName string
+	entry    types.QueryHistoryEntry
+}
+
+type service struct {
+	repo repository.QueryHistory
+	cfg  config.QueryHistory
+
+	queue chan record
+	nowFn func() time.Time
+}
+
+func New(ctx context.Context, repo repository.QueryHistory, cfg config.QueryHistory) Recorder {
+	s := newService(repo, cfg)
+
+	go s.run(ctx)
+	go s.deleteExpired(ctx)
+
+	return s
+}
+
+func newService(repo repository.QueryHistory, cfg config.QueryHistory) *service {
+	return &service{
+		repo:  repo,
+		cfg:   cfg,
+		queue: make(chan record, cfg.QueueSize),
+		nowFn: time.Now,
+	}
+}
+
+func (s *service) Record(ctx context.Context, e types.QueryHistoryEntry) {
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return
+	}
+	e.CreatedAt = s.nowFn()
+
+	select {
+	case s.queue <- record{userName: userName, entry: e}:
+	default:
+		metric.QueryHistoryEntriesDropped.Inc()
+	}
+}
+
+func (s *service) run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]record, 0, s.cfg.BatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-s.queue:
+			batch = append(batch, r)
+			if len(batch) < s.cfg.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		s.flush(ctx, batch)
+		batch = batch[:0]
+	}
+}
+
+func (s *service) flush(ctx context.Context, batch []record) {
+	entries := make(types.QueryHistoryEntries, 0, len(batch))
+	for _, r := range batch {
+		profileID, err := profiles.GetIDFromContext(context.WithValue(ctx, types.UserKey{}, r.userName))
+		if err != nil {
+			logger.Error("failed to get profile of query history entry",
+				zap.String("user", r.userName), zap.Error(err))
+			continue
+		}
+		r.entry.ProfileID = profileID
+		entries = append(entries, r.entry)
+	}
+
+	err := s.repo.Add(ctx, types.AddQueryHistoryRequest{
+		Entries:           entries,
+		MaxEntriesPerUser: s.cfg.MaxEntriesPerUser,
+	})
+	if err != nil {
+		logger.Error("failed to add query history", zap.Int("entries", len(entries)), zap.Error(err))
+	}
+}
+
+func (s *service) deleteExpired(ctx context.Context) {
+	ticker := time.NewTicker(deleteExpiredInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.repo.DeleteExpired(ctx, s.nowFn().Add(-s.cfg.Retention))
+			if err != nil {
+				logger.Error("DeleteExpired query history error", zap.Error(err))
+			}
+		}
+	}
+}
+
+// ErrorCode returns code of the failed request, empty if the request succeeded.
+func ErrorCode(respErr *seqapi.Error, err error) string {
+	if err != nil {
+		return status.Code(err).String()
+	}
+	if code := respErr.GetCode(); code != seqapi.ErrorCode_ERROR_CODE_UNSPECIFIED && code != seqapi.ErrorCode_ERROR_CODE_NO {
+		return code.String()
+	}
+	return ""
+}
+
+// HistogramTotal returns number of events in the histogram.
+func HistogramTotal(h *seqapi.Histogram) int64 {
+	var total int64
+	for _, b := range h.GetBuckets() {
+		total += int64(b.GetDocCount())
+	}
+	return total
+}
+
+// AggregationsTotal returns number of buckets in the aggregations.
+func AggregationsTotal(aggs []*seqapi.Aggregation) int64 {
+	var total int64
+	for _, agg := range aggs {
+		total += int64(len(agg.GetBuckets()))
+	}
+	return total
+}
@@ -0,0 +1,93 @@
+package queryhistory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+	"github.com/ozontech/seq-ui/pkg/seqapi/v1"
+)
+
+func TestRecord(t *testing.T) {
+	profileIDs := map[string]int64{"user1": 1, "user2": 2}
+	profiles.InitProfiles(func(_ context.Context, req types.GetOrCreateUserProfileRequest) (types.UserProfile, error) {
+		return types.UserProfile{ID: profileIDs[req.UserName]}, nil
+	})
+
+	now := time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC)
+	repo := repo_mock.NewMockQueryHistory(gomock.NewController(t))
+	s := newService(repo, config.QueryHistory{
+		MaxEntriesPerUser: 100,
+		QueueSize:         2,
+		BatchSize:         2,
+		FlushInterval:     time.Hour,
+	})
+	s.nowFn = func() time.Time { return now }
+
+	userCtx := func(name string) context.Context {
+		return context.WithValue(context.Background(), types.UserKey{}, name)
+	}
+
+	s.Record(userCtx("user1"), types.QueryHistoryEntry{Kind: types.QueryHistoryKindSearch, Query: "q1"})
+	// anonymous requests are not recorded
+	s.Record(context.Background(), types.QueryHistoryEntry{Kind: types.QueryHistoryKindSearch, Query: "q2"})
+	s.Record(userCtx("user2"), types.QueryHistoryEntry{Kind: types.QueryHistoryKindHistogram, Query: "q3"})
+	// queue is full
+	s.Record(userCtx("user1"), types.QueryHistoryEntry{Kind: types.QueryHistoryKindSearch, Query: "q4"})
+
+	done := make(chan struct{})
+	repo.EXPECT().
+		Add(gomock.Any(), types.AddQueryHistoryRequest{
+			Entries: types.QueryHistoryEntries{
+				{ProfileID: 1, Kind: types.QueryHistoryKindSearch, Query: "q1", CreatedAt: now},
+				{ProfileID: 2, Kind: types.QueryHistoryKindHistogram, Query: "q3", CreatedAt: now},
+			},
+			MaxEntriesPerUser: 100,
+		}).
+		DoAndReturn(func(context.Context, types.AddQueryHistoryRequest) error {
+			close(done)
+			return nil
+		}).
+		Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.run(ctx)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("entries are not flushed")
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	require.Equal(t, "", ErrorCode(nil, nil))
+	require.Equal(t, "", ErrorCode(&seqapi.Error{Code: seqapi.ErrorCode_ERROR_CODE_NO}, nil))
+	require.Equal(t, "ERROR_CODE_PARTIAL_RESPONSE",
+		ErrorCode(&seqapi.Error{Code: seqapi.ErrorCode_ERROR_CODE_PARTIAL_RESPONSE}, nil))
+	require.Equal(t, "Unavailable", ErrorCode(nil, status.Error(codes.Unavailable, "unavailable")))
+	require.Equal(t, "Unknown", ErrorCode(nil, errors.New("something went wrong")))
+}
+
+func TestTotals(t *testing.T) {
+	require.Equal(t, int64(6), HistogramTotal(&seqapi.Histogram{
+		Buckets: []*seqapi.Histogram_Bucket{{DocCount: 1}, {DocCount: 5}},
+	}))
+	require.Equal(t, int64(0), HistogramTotal(nil))
+	require.Equal(t, int64(3), AggregationsTotal([]*seqapi.Aggregation{
+		{Buckets: []*seqapi.Aggregation_Bucket{{}, {}}},
+		{Buckets: []*seqapi.Aggregation_Bucket{{}}},
+		nil,
+	}))
+}
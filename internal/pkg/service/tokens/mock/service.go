@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozontech/seq-ui/internal/pkg/service/tokens (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=internal/pkg/service/tokens/mock/service.go github.com/ozontech/seq-ui/internal/pkg/service/tokens Service
+//
+
+// Package mock_tokens is a generated GoMock package.
+package mock_tokens
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/ozontech/seq-ui/internal/app/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MockService) CreateToken(arg0 context.Context, arg1 types.CreateAPITokenRequest) (types.APIToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", arg0, arg1)
+	ret0, _ := ret[0].(types.APIToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockServiceMockRecorder) CreateToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockService)(nil).CreateToken), arg0, arg1)
+}
+
+// GetTokens mocks base method.
+func (m *MockService) GetTokens(arg0 context.Context, arg1 types.GetAPITokensRequest) (types.APITokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokens", arg0, arg1)
+	ret0, _ := ret[0].(types.APITokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokens indicates an expected call of GetTokens.
+func (mr *MockServiceMockRecorder) GetTokens(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokens", reflect.TypeOf((*MockService)(nil).GetTokens), arg0, arg1)
+}
+
+// IsRevoked mocks base method.
+func (m *MockService) IsRevoked(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockServiceMockRecorder) IsRevoked(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockService)(nil).IsRevoked), ctx, id)
+}
+
+// RevokeToken mocks base method.
+func (m *MockService) RevokeToken(arg0 context.Context, arg1 types.RevokeAPITokenRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockServiceMockRecorder) RevokeToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockService)(nil).RevokeToken), arg0, arg1)
+}
@@ -0,0 +1,232 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-ui/internal/app/auth"
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/cache"
+	"github.com/ozontech/seq-ui/internal/pkg/repository"
+	"github.com/ozontech/seq-ui/logger"
+)
+
+const (
+	maxTokenNameLen = 100
+
+	revokedCacheKeyPrefix = "api_token_revoked_"
+	revokedCacheValue     = "1"
+	activeCacheValue      = "0"
+)
+
+type Service interface {
+	// CreateToken stores the new token and returns it with the signed token string.
+	CreateToken(context.Context, types.CreateAPITokenRequest) (types.APIToken, string, error)
+	GetTokens(context.Context, types.GetAPITokensRequest) (types.APITokens, error)
+	RevokeToken(context.Context, types.RevokeAPITokenRequest) error
+	// IsRevoked checks whether the token was revoked or removed.
+	IsRevoked(ctx context.Context, id string) (bool, error)
+}
+
+type service struct {
+	repo  repository.APITokens
+	jwt   auth.JWTProvider
+	cache cache.Cache
+	cfg   config.APITokens
+
+	nowFn func() time.Time
+}
+
+func New(repo repository.APITokens, jwt auth.JWTProvider, cache cache.Cache, cfg config.APITokens) Service {
+	return &service{
+		repo:  repo,
+		jwt:   jwt,
+		cache: cache,
+		cfg:   cfg,
+		nowFn: time.Now,
+	}
+}
+
+func (s *service) CreateToken(ctx context.Context, req types.CreateAPITokenRequest) (types.APIToken, string, error) {
+	var token types.APIToken
+
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return token, "", err
+	}
+	if req.UserName == "" {
+		req.UserName = userName
+	}
+	if req.UserName != userName && !s.isAdmin(ctx, userName) {
+		return token, "", types.NewErrPermissionDenied("create api token for another user")
+	}
+
+	if err = s.validateCreateRequest(&req); err != nil {
+		return token, "", err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return token, "", fmt.Errorf("failed to generate token id: %w", err)
+	}
+
+	now := s.nowFn().Truncate(time.Second)
+	token = types.APIToken{
+		ID:        id.String(),
+		UserName:  req.UserName,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		CreatedBy: userName,
+		CreatedAt: now,
+		ExpiresAt: now.Add(req.TTL),
+	}
+
+	signed, err := s.jwt.IssueTokenWithClaims(auth.JWTClaims{
+		Name:   token.UserName,
+		Scopes: token.Scopes,
+		StandardClaims: jwt.StandardClaims{
+			Id:        token.ID,
+			IssuedAt:  token.CreatedAt.Unix(),
+			ExpiresAt: token.ExpiresAt.Unix(),
+		},
+	})
+	if err != nil {
+		return types.APIToken{}, "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	if err = s.repo.Create(ctx, token); err != nil {
+		return types.APIToken{}, "", err
+	}
+
+	return token, signed, nil
+}
+
+func (s *service) validateCreateRequest(req *types.CreateAPITokenRequest) error {
+	if req.Name == "" {
+		return types.NewErrInvalidRequestField("empty token name")
+	}
+	if utf8.RuneCountInString(req.Name) > maxTokenNameLen {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("token name must be at most %d characters", maxTokenNameLen))
+	}
+
+	if len(req.Scopes) == 0 {
+		return types.NewErrInvalidRequestField("empty token scopes")
+	}
+	for i, scope := range req.Scopes {
+		if !slices.Contains(types.APITokenScopes, scope) {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("unknown token scope '%s'", scope))
+		}
+		if slices.Contains(req.Scopes[:i], scope) {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("duplicate token scope '%s'", scope))
+		}
+	}
+
+	switch {
+	case req.TTL < 0:
+		return types.NewErrInvalidRequestField("negative token ttl")
+	case req.TTL == 0:
+		req.TTL = s.cfg.DefaultTTL
+	case req.TTL > s.cfg.MaxTTL:
+		return types.NewErrInvalidRequestField(fmt.Sprintf("token ttl must be at most %s", s.cfg.MaxTTL))
+	}
+
+	return nil
+}
+
+func (s *service) GetTokens(ctx context.Context, req types.GetAPITokensRequest) (types.APITokens, error) {
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserName == "" {
+		req.UserName = userName
+	}
+	if req.UserName != userName && !s.isAdmin(ctx, userName) {
+		return nil, types.NewErrPermissionDenied("get api tokens of another user")
+	}
+
+	return s.repo.GetByUser(ctx, req.UserName)
+}
+
+func (s *service) RevokeToken(ctx context.Context, req types.RevokeAPITokenRequest) error {
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return err
+	}
+	if req.ID == "" {
+		return types.NewErrInvalidRequestField("empty token id")
+	}
+
+	token, err := s.repo.Get(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if token.UserName != userName && !s.isAdmin(ctx, userName) {
+		return types.NewErrPermissionDenied("revoke api token of another user")
+	}
+
+	if err = s.repo.Revoke(ctx, req.ID); err != nil {
+		return err
+	}
+
+	// the token expires by itself, so there is no need to cache its revocation for longer
+	ttl := min(s.cfg.RevocationCacheTTL, time.Until(token.ExpiresAt))
+	if ttl > 0 {
+		s.setRevokedCache(ctx, req.ID, true, ttl)
+	}
+
+	return nil
+}
+
+func (s *service) IsRevoked(ctx context.Context, id string) (bool, error) {
+	v, err := s.cache.Get(ctx, revokedCacheKeyPrefix+id)
+	if err == nil {
+		return v == revokedCacheValue, nil
+	} else if !errors.Is(err, cache.ErrNotFound) {
+		logger.Error("failed to get api token revocation from cache", zap.String("id", id), zap.Error(err))
+	}
+
+	revoked := false
+	token, err := s.repo.Get(ctx, id)
+	switch {
+	case errors.Is(err, types.ErrNotFound):
+		revoked = true
+	case err != nil:
+		return false, err
+	default:
+		revoked = token.RevokedAt != nil
+	}
+
+	s.setRevokedCache(ctx, id, revoked, s.cfg.RevocationCacheTTL)
+
+	return revoked, nil
+}
+
+func (s *service) setRevokedCache(ctx context.Context, id string, revoked bool, ttl time.Duration) {
+	v := activeCacheValue
+	if revoked {
+		v = revokedCacheValue
+	}
+	if err := s.cache.SetWithTTL(ctx, revokedCacheKeyPrefix+id, v, ttl); err != nil {
+		logger.Error("failed to cache api token revocation", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// isAdmin checks that the user can manage tokens of other users.
+// If RBAC is enabled, the permission is required, otherwise the user must be listed in the admin users.
+func (s *service) isAdmin(ctx context.Context, userName string) bool {
+	if _, ok := rbac.GetAccess(ctx); ok {
+		return rbac.HasPermission(ctx, rbac.PermTokensAdmin)
+	}
+	return slices.Contains(s.cfg.AdminUsers, userName)
+}
@@ -0,0 +1,336 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/auth"
+	"github.com/ozontech/seq-ui/internal/app/config"
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/cache"
+	mock_cache "github.com/ozontech/seq-ui/internal/pkg/cache/mock"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+)
+
+const (
+	testUser  = "user"
+	testAdmin = "admin"
+)
+
+var testCfg = config.APITokens{
+	AdminUsers:         []string{testAdmin},
+	DefaultTTL:         24 * time.Hour,
+	MaxTTL:             30 * 24 * time.Hour,
+	RevocationCacheTTL: time.Minute,
+}
+
+func withUser(userName string) context.Context {
+	return context.WithValue(context.Background(), types.UserKey{}, userName)
+}
+
+func withAccess(userName string, perms ...string) context.Context {
+	return rbac.WithAccess(withUser(userName), rbac.Access{Permissions: perms})
+}
+
+func TestCreateToken(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	tests := []struct {
+		name string
+
+		user    string
+		req     types.CreateAPITokenRequest
+		wantTTL time.Duration
+		wantErr error
+	}{
+		{
+			name:    "ok_default_ttl",
+			user:    testUser,
+			req:     types.CreateAPITokenRequest{Name: "ci", Scopes: []string{"seqapi"}},
+			wantTTL: testCfg.DefaultTTL,
+		},
+		{
+			name: "ok_ttl",
+			user: testUser,
+			req: types.CreateAPITokenRequest{
+				Name: "ci", Scopes: []string{"seqapi", "dashboards"}, TTL: time.Hour,
+			},
+			wantTTL: time.Hour,
+		},
+		{
+			name: "ok_admin_for_another_user",
+			user: testAdmin,
+			req: types.CreateAPITokenRequest{
+				UserName: "api@ci", Name: "ci", Scopes: []string{"seqapi"},
+			},
+			wantTTL: testCfg.DefaultTTL,
+		},
+		{
+			name: "err_another_user",
+			user: testUser,
+			req: types.CreateAPITokenRequest{
+				UserName: "api@ci", Name: "ci", Scopes: []string{"seqapi"},
+			},
+			wantErr: types.ErrPermissionDenied,
+		},
+		{
+			name:    "err_empty_name",
+			user:    testUser,
+			req:     types.CreateAPITokenRequest{Scopes: []string{"seqapi"}},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:    "err_empty_scopes",
+			user:    testUser,
+			req:     types.CreateAPITokenRequest{Name: "ci"},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:    "err_unknown_scope",
+			user:    testUser,
+			req:     types.CreateAPITokenRequest{Name: "ci", Scopes: []string{"tokens"}},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:    "err_duplicate_scope",
+			user:    testUser,
+			req:     types.CreateAPITokenRequest{Name: "ci", Scopes: []string{"seqapi", "seqapi"}},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_ttl_too_long",
+			user: testUser,
+			req: types.CreateAPITokenRequest{
+				Name: "ci", Scopes: []string{"seqapi"}, TTL: testCfg.MaxTTL + time.Hour,
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := repo_mock.NewMockAPITokens(ctrl)
+			jwtProvider := auth.NewJWTProvider("secret")
+
+			s := New(repo, jwtProvider, mock_cache.NewMockCache(ctrl), testCfg).(*service)
+			s.nowFn = func() time.Time { return now }
+
+			wantOwner := tt.req.UserName
+			if wantOwner == "" {
+				wantOwner = tt.user
+			}
+
+			if tt.wantErr == nil {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token types.APIToken) error {
+						require.Equal(t, wantOwner, token.UserName)
+						require.Equal(t, tt.user, token.CreatedBy)
+						require.Equal(t, now.Add(tt.wantTTL), token.ExpiresAt)
+						return nil
+					}).
+					Times(1)
+			}
+
+			token, signed, err := s.CreateToken(withUser(tt.user), tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, token.ID)
+
+			claims, err := jwtProvider.Verify(signed)
+			require.NoError(t, err)
+			require.Equal(t, token.ID, claims.Id)
+			require.Equal(t, wantOwner, claims.Name)
+			require.Equal(t, tt.req.Scopes, claims.Scopes)
+			require.Equal(t, token.ExpiresAt.Unix(), claims.ExpiresAt)
+		})
+	}
+}
+
+func TestGetTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repo_mock.NewMockAPITokens(ctrl)
+	s := New(repo, nil, nil, testCfg)
+
+	want := types.APITokens{{ID: "1", UserName: testUser}}
+	repo.EXPECT().GetByUser(gomock.Any(), testUser).Return(want, nil).Times(3)
+
+	got, err := s.GetTokens(withUser(testUser), types.GetAPITokensRequest{})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	got, err = s.GetTokens(withUser(testAdmin), types.GetAPITokensRequest{UserName: testUser})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = s.GetTokens(withUser("other"), types.GetAPITokensRequest{UserName: testUser})
+	require.ErrorIs(t, err, types.ErrPermissionDenied)
+
+	// with RBAC enabled admin users are ignored
+	got, err = s.GetTokens(withAccess("other", rbac.PermTokensAdmin), types.GetAPITokensRequest{UserName: testUser})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = s.GetTokens(withAccess(testAdmin), types.GetAPITokensRequest{UserName: testUser})
+	require.ErrorIs(t, err, types.ErrPermissionDenied)
+}
+
+func TestRevokeToken(t *testing.T) {
+	token := types.APIToken{
+		ID:        "token-id",
+		UserName:  testUser,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		getErr  error
+		wantErr error
+	}{
+		{
+			name: "ok_owner",
+			ctx:  withUser(testUser),
+		},
+		{
+			name: "ok_admin",
+			ctx:  withUser(testAdmin),
+		},
+		{
+			name: "ok_rbac_admin",
+			ctx:  withAccess("other", rbac.PermTokensAdmin),
+		},
+		{
+			name:    "err_another_user",
+			ctx:     withUser("other"),
+			wantErr: types.ErrPermissionDenied,
+		},
+		{
+			name:    "err_rbac_admin_user_without_permission",
+			ctx:     withAccess(testAdmin, rbac.PermAuditView),
+			wantErr: types.ErrPermissionDenied,
+		},
+		{
+			name:    "err_not_found",
+			ctx:     withUser(testUser),
+			getErr:  types.NewErrNotFound("api token"),
+			wantErr: types.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := repo_mock.NewMockAPITokens(ctrl)
+			c := mock_cache.NewMockCache(ctrl)
+			s := New(repo, nil, c, testCfg)
+
+			repo.EXPECT().Get(gomock.Any(), token.ID).Return(token, tt.getErr).Times(1)
+			if tt.wantErr == nil {
+				repo.EXPECT().Revoke(gomock.Any(), token.ID).Return(nil).Times(1)
+				c.EXPECT().
+					SetWithTTL(gomock.Any(), revokedCacheKeyPrefix+token.ID, revokedCacheValue, testCfg.RevocationCacheTTL).
+					Return(nil).
+					Times(1)
+			}
+
+			err := s.RevokeToken(tt.ctx, types.RevokeAPITokenRequest{ID: token.ID})
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestIsRevoked(t *testing.T) {
+	const id = "token-id"
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name string
+
+		cached   string
+		cacheErr error
+		token    types.APIToken
+		getErr   error
+
+		want      bool
+		wantCache string
+		wantErr   bool
+	}{
+		{
+			name:   "ok_cached_active",
+			cached: activeCacheValue,
+			want:   false,
+		},
+		{
+			name:   "ok_cached_revoked",
+			cached: revokedCacheValue,
+			want:   true,
+		},
+		{
+			name:      "ok_active",
+			cacheErr:  cache.ErrNotFound,
+			token:     types.APIToken{ID: id},
+			want:      false,
+			wantCache: activeCacheValue,
+		},
+		{
+			name:      "ok_revoked",
+			cacheErr:  cache.ErrNotFound,
+			token:     types.APIToken{ID: id, RevokedAt: &revokedAt},
+			want:      true,
+			wantCache: revokedCacheValue,
+		},
+		{
+			name:      "ok_not_found",
+			cacheErr:  errors.New("cache is unavailable"),
+			getErr:    types.NewErrNotFound("api token"),
+			want:      true,
+			wantCache: revokedCacheValue,
+		},
+		{
+			name:     "err_repo",
+			cacheErr: cache.ErrNotFound,
+			getErr:   errors.New("db is unavailable"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := repo_mock.NewMockAPITokens(ctrl)
+			c := mock_cache.NewMockCache(ctrl)
+			s := New(repo, nil, c, testCfg)
+
+			c.EXPECT().Get(gomock.Any(), revokedCacheKeyPrefix+id).Return(tt.cached, tt.cacheErr).Times(1)
+			if tt.cacheErr != nil {
+				repo.EXPECT().Get(gomock.Any(), id).Return(tt.token, tt.getErr).Times(1)
+			}
+			if tt.wantCache != "" {
+				c.EXPECT().
+					SetWithTTL(gomock.Any(), revokedCacheKeyPrefix+id, tt.wantCache, testCfg.RevocationCacheTTL).
+					Return(nil).
+					Times(1)
+			}
+
+			got, err := s.IsRevoked(context.Background(), id)
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
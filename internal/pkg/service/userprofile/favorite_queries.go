@@ -0,0 +1,208 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+)
+
+const maxFavoriteQueryFolderNameLen = 64
+
+func (s *service) UpdateFavoriteQuery(ctx context.Context, req types.UpdateFavoriteQueryRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if req.ID <= 0 {
+		return types.NewErrInvalidRequestField("invalid id")
+	}
+	if req.IsEmpty() {
+		return types.ErrEmptyUpdateRequest
+	}
+	if req.Query != nil && *req.Query == "" {
+		return types.NewErrInvalidRequestField("empty query")
+	}
+	if req.FolderID != nil && *req.FolderID != 0 {
+		if err = s.checkFavoriteQueryFolder(ctx, profileID, *req.FolderID); err != nil {
+			return err
+		}
+	}
+
+	return s.FavoriteQueries.Update(ctx, req)
+}
+
+func (s *service) ReorderFavoriteQueries(ctx context.Context, req types.ReorderFavoriteQueriesRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if len(req.IDs) == 0 {
+		return types.NewErrInvalidRequestField("empty ids")
+	}
+	seen := make(map[int64]struct{}, len(req.IDs))
+	for _, id := range req.IDs {
+		if id <= 0 {
+			return types.NewErrInvalidRequestField("invalid id")
+		}
+		if _, ok := seen[id]; ok {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("duplicate id %d", id))
+		}
+		seen[id] = struct{}{}
+	}
+
+	return s.FavoriteQueries.Reorder(ctx, req)
+}
+
+func (s *service) GetFavoriteQueryFolders(
+	ctx context.Context,
+	req types.GetFavoriteQueryFoldersRequest,
+) (types.FavoriteQueryFolders, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.ProfileID = profileID
+
+	return s.FavoriteQueries.GetFolders(ctx, req)
+}
+
+func (s *service) CreateFavoriteQueryFolder(ctx context.Context, req types.CreateFavoriteQueryFolderRequest) (int64, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	req.ProfileID = profileID
+
+	if err = checkFavoriteQueryFolderName(req.Name); err != nil {
+		return -1, err
+	}
+
+	return s.FavoriteQueries.CreateFolder(ctx, req)
+}
+
+func (s *service) UpdateFavoriteQueryFolder(ctx context.Context, req types.UpdateFavoriteQueryFolderRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if req.ID <= 0 {
+		return types.NewErrInvalidRequestField("invalid id")
+	}
+	if err = checkFavoriteQueryFolderName(req.Name); err != nil {
+		return err
+	}
+
+	return s.FavoriteQueries.UpdateFolder(ctx, req)
+}
+
+func (s *service) DeleteFavoriteQueryFolder(ctx context.Context, req types.DeleteFavoriteQueryFolderRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if req.ID <= 0 {
+		return types.NewErrInvalidRequestField("invalid id")
+	}
+
+	return s.FavoriteQueries.DeleteFolder(ctx, req)
+}
+
+// PublishFavoriteQuery publishes the query to the library of one of the user's teams.
+func (s *service) PublishFavoriteQuery(ctx context.Context, req types.PublishFavoriteQueryRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if req.ID <= 0 {
+		return types.NewErrInvalidRequestField("invalid id")
+	}
+	if req.Team != "" && !slices.Contains(types.GetUserGroups(ctx), req.Team) {
+		return types.NewErrPermissionDenied("publish favorite query to team " + req.Team)
+	}
+
+	return s.FavoriteQueries.Publish(ctx, req)
+}
+
+// GetFavoriteQueriesLibrary returns queries published to the user's teams.
+func (s *service) GetFavoriteQueriesLibrary(
+	ctx context.Context,
+	req types.GetFavoriteQueriesLibraryRequest,
+) (types.FavoriteQueries, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.ProfileID = profileID
+	req.Groups = types.GetUserGroups(ctx)
+
+	if req.Team != nil && !slices.Contains(req.Groups, *req.Team) {
+		return nil, types.NewErrPermissionDenied("get favorite queries library of team " + *req.Team)
+	}
+
+	return s.FavoriteQueries.GetLibrary(ctx, req)
+}
+
+func (s *service) SubscribeFavoriteQuery(ctx context.Context, req types.SubscribeFavoriteQueryRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+	req.Groups = types.GetUserGroups(ctx)
+
+	if req.ID <= 0 {
+		return types.NewErrInvalidRequestField("invalid id")
+	}
+
+	return s.FavoriteQueries.Subscribe(ctx, req)
+}
+
+func (s *service) UnsubscribeFavoriteQuery(ctx context.Context, req types.UnsubscribeFavoriteQueryRequest) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if req.ID <= 0 {
+		return types.NewErrInvalidRequestField("invalid id")
+	}
+
+	return s.FavoriteQueries.Unsubscribe(ctx, req)
+}
+
+// checkFavoriteQueryFolder checks that the folder belongs to the user.
+func (s *service) checkFavoriteQueryFolder(ctx context.Context, profileID, folderID int64) error {
+	folders, err := s.FavoriteQueries.GetFolders(ctx, types.GetFavoriteQueryFoldersRequest{ProfileID: profileID})
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(folders, func(f types.FavoriteQueryFolder) bool { return f.ID == folderID }) {
+		return types.NewErrNotFound("favorite query folder")
+	}
+	return nil
+}
+
+func checkFavoriteQueryFolderName(name string) error {
+	if name == "" {
+		return types.NewErrInvalidRequestField("empty name")
+	}
+	if utf8.RuneCountInString(name) > maxFavoriteQueryFolderNameLen {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("name must be at most %d characters", maxFavoriteQueryFolderNameLen))
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package userprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+)
+
+const testProfileID int64 = 7
+
+func setupFavoriteQueriesTest(t *testing.T) (*service, *repo_mock.MockFavoriteQueries) {
+	profiles.InitProfiles(func(context.Context, types.GetOrCreateUserProfileRequest) (types.UserProfile, error) {
+		return types.UserProfile{ID: testProfileID}, nil
+	})
+
+	repo := repo_mock.NewMockFavoriteQueries(gomock.NewController(t))
+	return &service{FavoriteQueries: repo}, repo
+}
+
+func withUserGroups(groups ...string) context.Context {
+	ctx := context.WithValue(context.Background(), types.UserKey{}, "user")
+	return context.WithValue(ctx, types.UserGroupsKey{}, groups)
+}
+
+func TestUpdateFavoriteQuery(t *testing.T) {
+	var (
+		query    = "level:error"
+		empty    = ""
+		folderID = int64(3)
+		rootID   = int64(0)
+	)
+
+	tests := []struct {
+		name    string
+		req     types.UpdateFavoriteQueryRequest
+		folders types.FavoriteQueryFolders
+		wantErr error
+	}{
+		{
+			name: "ok",
+			req:  types.UpdateFavoriteQueryRequest{ID: 1, Query: &query},
+		},
+		{
+			name:    "ok_folder",
+			req:     types.UpdateFavoriteQueryRequest{ID: 1, FolderID: &folderID},
+			folders: types.FavoriteQueryFolders{{ID: folderID, Name: "f"}},
+		},
+		{
+			name: "ok_root",
+			req:  types.UpdateFavoriteQueryRequest{ID: 1, FolderID: &rootID},
+		},
+		{
+			name:    "err_id",
+			req:     types.UpdateFavoriteQueryRequest{Query: &query},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:    "err_empty_request",
+			req:     types.UpdateFavoriteQueryRequest{ID: 1},
+			wantErr: types.ErrEmptyUpdateRequest,
+		},
+		{
+			name:    "err_empty_query",
+			req:     types.UpdateFavoriteQueryRequest{ID: 1, Query: &empty},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name:    "err_foreign_folder",
+			req:     types.UpdateFavoriteQueryRequest{ID: 1, FolderID: &folderID},
+			folders: types.FavoriteQueryFolders{{ID: 4, Name: "f"}},
+			wantErr: types.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := setupFavoriteQueriesTest(t)
+
+			if tt.req.FolderID != nil && *tt.req.FolderID != 0 {
+				repo.EXPECT().
+					GetFolders(gomock.Any(), types.GetFavoriteQueryFoldersRequest{ProfileID: testProfileID}).
+					Return(tt.folders, nil).
+					Times(1)
+			}
+			if tt.wantErr == nil {
+				want := tt.req
+				want.ProfileID = testProfileID
+				repo.EXPECT().Update(gomock.Any(), want).Return(nil).Times(1)
+			}
+
+			err := s.UpdateFavoriteQuery(withUserGroups(), tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReorderFavoriteQueries(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []int64
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			ids:  []int64{3, 1, 2},
+		},
+		{
+			name:    "err_empty",
+			wantErr: true,
+		},
+		{
+			name:    "err_invalid_id",
+			ids:     []int64{1, 0},
+			wantErr: true,
+		},
+		{
+			name:    "err_duplicate",
+			ids:     []int64{1, 2, 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := setupFavoriteQueriesTest(t)
+
+			if !tt.wantErr {
+				repo.EXPECT().
+					Reorder(gomock.Any(), types.ReorderFavoriteQueriesRequest{ProfileID: testProfileID, IDs: tt.ids}).
+					Return(nil).
+					Times(1)
+			}
+
+			err := s.ReorderFavoriteQueries(withUserGroups(), types.ReorderFavoriteQueriesRequest{IDs: tt.ids})
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPublishFavoriteQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		team    string
+		groups  []string
+		wantErr error
+	}{
+		{
+			name:   "ok",
+			team:   "backend",
+			groups: []string{"frontend", "backend"},
+		},
+		{
+			name: "ok_unpublish",
+		},
+		{
+			name:    "err_not_member",
+			team:    "backend",
+			groups:  []string{"frontend"},
+			wantErr: types.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := setupFavoriteQueriesTest(t)
+
+			if tt.wantErr == nil {
+				repo.EXPECT().
+					Publish(gomock.Any(), types.PublishFavoriteQueryRequest{ID: 1, ProfileID: testProfileID, Team: tt.team}).
+					Return(nil).
+					Times(1)
+			}
+
+			err := s.PublishFavoriteQuery(withUserGroups(tt.groups...), types.PublishFavoriteQueryRequest{ID: 1, Team: tt.team})
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetFavoriteQueriesLibrary(t *testing.T) {
+	var (
+		backend  = "backend"
+		frontend = "frontend"
+		groups   = []string{backend}
+	)
+
+	tests := []struct {
+		name    string
+		team    *string
+		wantErr error
+	}{
+		{
+			name: "ok_all",
+		},
+		{
+			name: "ok_team",
+			team: &backend,
+		},
+		{
+			name:    "err_not_member",
+			team:    &frontend,
+			wantErr: types.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := setupFavoriteQueriesTest(t)
+
+			want := types.FavoriteQueries{{ID: 1, Query: "q", Team: backend, OwnerName: "owner"}}
+			if tt.wantErr == nil {
+				repo.EXPECT().
+					GetLibrary(gomock.Any(), types.GetFavoriteQueriesLibraryRequest{
+						ProfileID: testProfileID,
+						Team:      tt.team,
+						Groups:    groups,
+					}).
+					Return(want, nil).
+					Times(1)
+			}
+
+			got, err := s.GetFavoriteQueriesLibrary(withUserGroups(groups...), types.GetFavoriteQueriesLibraryRequest{Team: tt.team})
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestCheckFavoriteQueryFolderName(t *testing.T) {
+	require.NoError(t, checkFavoriteQueryFolderName("team queries"))
+	require.ErrorIs(t, checkFavoriteQueryFolderName(""), types.ErrInvalidRequestField)
+	require.ErrorIs(t, checkFavoriteQueryFolderName(string(make([]rune, 65))), types.ErrInvalidRequestField)
+}
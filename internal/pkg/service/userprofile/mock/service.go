@@ -41,6 +41,50 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateErrorGroupsSubscription mocks base method.
+func (m *MockService) CreateErrorGroupsSubscription(arg0 context.Context, arg1 types.CreateErrorGroupsSubscriptionRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateErrorGroupsSubscription", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateErrorGroupsSubscription indicates an expected call of CreateErrorGroupsSubscription.
+func (mr *MockServiceMockRecorder) CreateErrorGroupsSubscription(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateErrorGroupsSubscription", reflect.TypeOf((*MockService)(nil).CreateErrorGroupsSubscription), arg0, arg1)
+}
+
+// CreateFavoriteQueryFolder mocks base method.
+func (m *MockService) CreateFavoriteQueryFolder(arg0 context.Context, arg1 types.CreateFavoriteQueryFolderRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFavoriteQueryFolder", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFavoriteQueryFolder indicates an expected call of CreateFavoriteQueryFolder.
+func (mr *MockServiceMockRecorder) CreateFavoriteQueryFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavoriteQueryFolder", reflect.TypeOf((*MockService)(nil).CreateFavoriteQueryFolder), arg0, arg1)
+}
+
+// DeleteErrorGroupsSubscription mocks base method.
+func (m *MockService) DeleteErrorGroupsSubscription(arg0 context.Context, arg1 types.DeleteErrorGroupsSubscriptionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteErrorGroupsSubscription", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteErrorGroupsSubscription indicates an expected call of DeleteErrorGroupsSubscription.
+func (mr *MockServiceMockRecorder) DeleteErrorGroupsSubscription(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteErrorGroupsSubscription", reflect.TypeOf((*MockService)(nil).DeleteErrorGroupsSubscription), arg0, arg1)
+}
+
 // DeleteFavoriteQuery mocks base method.
 func (m *MockService) DeleteFavoriteQuery(arg0 context.Context, arg1 types.DeleteFavoriteQueryRequest) error {
 	m.ctrl.T.Helper()
@@ -55,6 +99,35 @@ func (mr *MockServiceMockRecorder) DeleteFavoriteQuery(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteQuery", reflect.TypeOf((*MockService)(nil).DeleteFavoriteQuery), arg0, arg1)
 }
 
+// DeleteFavoriteQueryFolder mocks base method.
+func (m *MockService) DeleteFavoriteQueryFolder(arg0 context.Context, arg1 types.DeleteFavoriteQueryFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFavoriteQueryFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFavoriteQueryFolder indicates an expected call of DeleteFavoriteQueryFolder.
+func (mr *MockServiceMockRecorder) DeleteFavoriteQueryFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteQueryFolder", reflect.TypeOf((*MockService)(nil).DeleteFavoriteQueryFolder), arg0, arg1)
+}
+
+// GetErrorGroupsSubscriptions mocks base method.
+func (m *MockService) GetErrorGroupsSubscriptions(arg0 context.Context, arg1 types.GetErrorGroupsSubscriptionsRequest) (types.ErrorGroupsSubscriptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetErrorGroupsSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].(types.ErrorGroupsSubscriptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetErrorGroupsSubscriptions indicates an expected call of GetErrorGroupsSubscriptions.
+func (mr *MockServiceMockRecorder) GetErrorGroupsSubscriptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetErrorGroupsSubscriptions", reflect.TypeOf((*MockService)(nil).GetErrorGroupsSubscriptions), arg0, arg1)
+}
+
 // GetFavoriteQueries mocks base method.
 func (m *MockService) GetFavoriteQueries(arg0 context.Context, arg1 types.GetFavoriteQueriesRequest) (types.FavoriteQueries, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +143,36 @@ func (mr *MockServiceMockRecorder) GetFavoriteQueries(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteQueries", reflect.TypeOf((*MockService)(nil).GetFavoriteQueries), arg0, arg1)
 }
 
+// GetFavoriteQueriesLibrary mocks base method.
+func (m *MockService) GetFavoriteQueriesLibrary(arg0 context.Context, arg1 types.GetFavoriteQueriesLibraryRequest) (types.FavoriteQueries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteQueriesLibrary", arg0, arg1)
+	ret0, _ := ret[0].(types.FavoriteQueries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavoriteQueriesLibrary indicates an expected call of GetFavoriteQueriesLibrary.
+func (mr *MockServiceMockRecorder) GetFavoriteQueriesLibrary(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteQueriesLibrary", reflect.TypeOf((*MockService)(nil).GetFavoriteQueriesLibrary), arg0, arg1)
+}
+
+// GetFavoriteQueryFolders mocks base method.
+func (m *MockService) GetFavoriteQueryFolders(arg0 context.Context, arg1 types.GetFavoriteQueryFoldersRequest) (types.FavoriteQueryFolders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteQueryFolders", arg0, arg1)
+	ret0, _ := ret[0].(types.FavoriteQueryFolders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavoriteQueryFolders indicates an expected call of GetFavoriteQueryFolders.
+func (mr *MockServiceMockRecorder) GetFavoriteQueryFolders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteQueryFolders", reflect.TypeOf((*MockService)(nil).GetFavoriteQueryFolders), arg0, arg1)
+}
+
 // GetOrCreateFavoriteQuery mocks base method.
 func (m *MockService) GetOrCreateFavoriteQuery(arg0 context.Context, arg1 types.GetOrCreateFavoriteQueryRequest) (int64, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +203,120 @@ func (mr *MockServiceMockRecorder) GetOrCreateUserProfile(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateUserProfile", reflect.TypeOf((*MockService)(nil).GetOrCreateUserProfile), arg0, arg1)
 }
 
+// GetQueryHistory mocks base method.
+func (m *MockService) GetQueryHistory(arg0 context.Context, arg1 types.GetQueryHistoryRequest) (types.QueryHistoryEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryHistory", arg0, arg1)
+	ret0, _ := ret[0].(types.QueryHistoryEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryHistory indicates an expected call of GetQueryHistory.
+func (mr *MockServiceMockRecorder) GetQueryHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryHistory", reflect.TypeOf((*MockService)(nil).GetQueryHistory), arg0, arg1)
+}
+
+// PublishFavoriteQuery mocks base method.
+func (m *MockService) PublishFavoriteQuery(arg0 context.Context, arg1 types.PublishFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishFavoriteQuery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishFavoriteQuery indicates an expected call of PublishFavoriteQuery.
+func (mr *MockServiceMockRecorder) PublishFavoriteQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishFavoriteQuery", reflect.TypeOf((*MockService)(nil).PublishFavoriteQuery), arg0, arg1)
+}
+
+// ReorderFavoriteQueries mocks base method.
+func (m *MockService) ReorderFavoriteQueries(arg0 context.Context, arg1 types.ReorderFavoriteQueriesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderFavoriteQueries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderFavoriteQueries indicates an expected call of ReorderFavoriteQueries.
+func (mr *MockServiceMockRecorder) ReorderFavoriteQueries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderFavoriteQueries", reflect.TypeOf((*MockService)(nil).ReorderFavoriteQueries), arg0, arg1)
+}
+
+// SubscribeFavoriteQuery mocks base method.
+func (m *MockService) SubscribeFavoriteQuery(arg0 context.Context, arg1 types.SubscribeFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeFavoriteQuery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeFavoriteQuery indicates an expected call of SubscribeFavoriteQuery.
+func (mr *MockServiceMockRecorder) SubscribeFavoriteQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFavoriteQuery", reflect.TypeOf((*MockService)(nil).SubscribeFavoriteQuery), arg0, arg1)
+}
+
+// UnsubscribeFavoriteQuery mocks base method.
+func (m *MockService) UnsubscribeFavoriteQuery(arg0 context.Context, arg1 types.UnsubscribeFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeFavoriteQuery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeFavoriteQuery indicates an expected call of UnsubscribeFavoriteQuery.
+func (mr *MockServiceMockRecorder) UnsubscribeFavoriteQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFavoriteQuery", reflect.TypeOf((*MockService)(nil).UnsubscribeFavoriteQuery), arg0, arg1)
+}
+
+// UpdateFavoriteQuery mocks base method.
+func (m *MockService) UpdateFavoriteQuery(arg0 context.Context, arg1 types.UpdateFavoriteQueryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFavoriteQuery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFavoriteQuery indicates an expected call of UpdateFavoriteQuery.
+func (mr *MockServiceMockRecorder) UpdateFavoriteQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFavoriteQuery", reflect.TypeOf((*MockService)(nil).UpdateFavoriteQuery), arg0, arg1)
+}
+
+// UpdateFavoriteQueryFolder mocks base method.
+func (m *MockService) UpdateFavoriteQueryFolder(arg0 context.Context, arg1 types.UpdateFavoriteQueryFolderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFavoriteQueryFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFavoriteQueryFolder indicates an expected call of UpdateFavoriteQueryFolder.
+func (mr *MockServiceMockRecorder) UpdateFavoriteQueryFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFavoriteQueryFolder", reflect.TypeOf((*MockService)(nil).UpdateFavoriteQueryFolder), arg0, arg1)
+}
+
+// UpdateUserPreferences mocks base method.
+func (m *MockService) UpdateUserPreferences(arg0 context.Context, arg1 types.UpdateUserPreferencesRequest) (types.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPreferences", arg0, arg1)
+	ret0, _ := ret[0].(types.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserPreferences indicates an expected call of UpdateUserPreferences.
+func (mr *MockServiceMockRecorder) UpdateUserPreferences(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPreferences", reflect.TypeOf((*MockService)(nil).UpdateUserPreferences), arg0, arg1)
+}
+
 // UpdateUserProfile mocks base method.
 func (m *MockService) UpdateUserProfile(arg0 context.Context, arg1 types.UpdateUserProfileRequest) error {
 	m.ctrl.T.Helper()
@@ -113,3 +330,18 @@ func (mr *MockServiceMockRecorder) UpdateUserProfile(arg0, arg1 any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockService)(nil).UpdateUserProfile), arg0, arg1)
 }
+
+// WhoAmI mocks base method.
+func (m *MockService) WhoAmI(arg0 context.Context) (types.WhoAmI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhoAmI", arg0)
+	ret0, _ := ret[0].(types.WhoAmI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhoAmI indicates an expected call of WhoAmI.
+func (mr *MockServiceMockRecorder) WhoAmI(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhoAmI", reflect.TypeOf((*MockService)(nil).WhoAmI), arg0)
+}
@@ -0,0 +1,110 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+const (
+	maxPreferencesPageSize      = 1000
+	maxPreferencesDateFormatLen = 64
+	maxPreferencesFields        = 100
+)
+
+var (
+	preferencesPaths = []string{
+		"timezone", "default_env", "default_time_range", "page_size",
+		"date_format", "theme", "log_columns", "pinned_fields",
+	}
+	envPreferencesPaths = []string{"log_columns", "pinned_fields"}
+)
+
+// UpdateUserPreferences updates the preferences fields listed in the request paths,
+// other fields are kept as is.
+func (s *service) UpdateUserPreferences(
+	ctx context.Context,
+	req types.UpdateUserPreferencesRequest,
+) (types.UserPreferences, error) {
+	if len(req.Paths) == 0 {
+		return types.UserPreferences{}, types.ErrEmptyUpdateRequest
+	}
+
+	allowed := preferencesPaths
+	if req.Env != "" {
+		allowed = envPreferencesPaths
+	}
+	for _, p := range req.Paths {
+		if !slices.Contains(allowed, p) {
+			if req.Env != "" && slices.Contains(preferencesPaths, p) {
+				return types.UserPreferences{}, types.NewErrInvalidRequestField(fmt.Sprintf("'%s' can't be set per env", p))
+			}
+			return types.UserPreferences{}, types.NewErrInvalidRequestField(fmt.Sprintf("unknown update mask path '%s'", p))
+		}
+	}
+
+	if err := validatePreferences(req.Preferences, req.Env, req.Paths); err != nil {
+		return types.UserPreferences{}, err
+	}
+
+	return s.UserProfiles.UpdatePreferences(ctx, req)
+}
+
+// validatePreferences validates the fields listed in the paths.
+func validatePreferences(p types.UserPreferences, env string, paths []string) error {
+	if env != "" {
+		envPrefs := p.Envs[env]
+		p.LogColumns, p.PinnedFields = envPrefs.LogColumns, envPrefs.PinnedFields
+	}
+
+	for _, path := range paths {
+		var err error
+		switch path {
+		case "timezone":
+			if _, tzErr := time.LoadLocation(p.Timezone); tzErr != nil {
+				err = types.NewErrInvalidRequestField("invalid timezone format")
+			}
+		case "page_size":
+			if p.PageSize < 0 || p.PageSize > maxPreferencesPageSize {
+				err = types.NewErrInvalidRequestField(fmt.Sprintf("'page_size' must be in range [0, %d]", maxPreferencesPageSize))
+			}
+		case "date_format":
+			if utf8.RuneCountInString(p.DateFormat) > maxPreferencesDateFormatLen {
+				err = types.NewErrInvalidRequestField(
+					fmt.Sprintf("'date_format' must be at most %d characters", maxPreferencesDateFormatLen),
+				)
+			}
+		case "theme":
+			if !p.Theme.IsValid() {
+				err = types.NewErrInvalidRequestField("invalid theme")
+			}
+		case "log_columns":
+			err = validatePreferencesFields(path, p.LogColumns)
+		case "pinned_fields":
+			err = validatePreferencesFields(path, p.PinnedFields)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePreferencesFields(path string, fields []string) error {
+	if len(fields) > maxPreferencesFields {
+		return types.NewErrInvalidRequestField(fmt.Sprintf("'%s' must contain at most %d fields", path, maxPreferencesFields))
+	}
+	for i, f := range fields {
+		if f == "" {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("empty field in '%s'", path))
+		}
+		if slices.Contains(fields[:i], f) {
+			return types.NewErrInvalidRequestField(fmt.Sprintf("duplicate field '%s' in '%s'", f, path))
+		}
+	}
+	return nil
+}
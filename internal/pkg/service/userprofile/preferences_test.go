@@ -0,0 +1,141 @@
+package userprofile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestUpdateUserPreferencesValidation(t *testing.T) {
+	tooManyFields := make([]string, maxPreferencesFields+1)
+	for i := range tooManyFields {
+		tooManyFields[i] = strings.Repeat("f", i+1)
+	}
+
+	tests := []struct {
+		name    string
+		req     types.UpdateUserPreferencesRequest
+		wantErr error
+	}{
+		{
+			name:    "err_empty_paths",
+			req:     types.UpdateUserPreferencesRequest{},
+			wantErr: types.ErrEmptyUpdateRequest,
+		},
+		{
+			name: "err_unknown_path",
+			req: types.UpdateUserPreferencesRequest{
+				Paths: []string{"unknown"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_version_path",
+			req: types.UpdateUserPreferencesRequest{
+				Paths: []string{"version"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_env_global_path",
+			req: types.UpdateUserPreferencesRequest{
+				Env:   "prod",
+				Paths: []string{"page_size"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_timezone",
+			req: types.UpdateUserPreferencesRequest{
+				Preferences: types.UserPreferences{Timezone: "Mars/Olympus"},
+				Paths:       []string{"timezone"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_page_size",
+			req: types.UpdateUserPreferencesRequest{
+				Preferences: types.UserPreferences{PageSize: maxPreferencesPageSize + 1},
+				Paths:       []string{"page_size"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_date_format",
+			req: types.UpdateUserPreferencesRequest{
+				Preferences: types.UserPreferences{DateFormat: strings.Repeat("x", maxPreferencesDateFormatLen+1)},
+				Paths:       []string{"date_format"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_theme",
+			req: types.UpdateUserPreferencesRequest{
+				Preferences: types.UserPreferences{Theme: "sepia"},
+				Paths:       []string{"theme"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_log_columns_duplicate",
+			req: types.UpdateUserPreferencesRequest{
+				Preferences: types.UserPreferences{LogColumns: []string{"message", "message"}},
+				Paths:       []string{"log_columns"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_pinned_fields_empty",
+			req: types.UpdateUserPreferencesRequest{
+				Preferences: types.UserPreferences{PinnedFields: []string{""}},
+				Paths:       []string{"pinned_fields"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+		{
+			name: "err_env_too_many_fields",
+			req: types.UpdateUserPreferencesRequest{
+				Env: "prod",
+				Preferences: types.UserPreferences{
+					Envs: map[string]types.EnvPreferences{
+						"prod": {LogColumns: tooManyFields},
+					},
+				},
+				Paths: []string{"log_columns"},
+			},
+			wantErr: types.ErrInvalidRequestField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &service{}
+			_, err := s.UpdateUserPreferences(context.Background(), tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidatePreferences(t *testing.T) {
+	prefs := types.UserPreferences{
+		Timezone:   "Europe/Moscow",
+		PageSize:   -1,
+		DateFormat: "YYYY-MM-DD HH:mm:ss",
+		Theme:      types.ThemeSystem,
+		LogColumns: []string{"message", "level"},
+		Envs: map[string]types.EnvPreferences{
+			"prod": {PinnedFields: []string{"service"}},
+		},
+	}
+
+	// only the fields from the paths are validated
+	require.NoError(t, validatePreferences(prefs, "", []string{"timezone", "date_format", "theme", "log_columns"}))
+	require.Error(t, validatePreferences(prefs, "", []string{"page_size"}))
+	require.NoError(t, validatePreferences(prefs, "prod", []string{"pinned_fields"}))
+}
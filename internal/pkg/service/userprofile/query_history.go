@@ -0,0 +1,35 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+)
+
+const (
+	defaultQueryHistoryLimit = 50
+	maxQueryHistoryLimit     = 1000
+)
+
+// GetQueryHistory returns the user's query history, newest entries first.
+func (s *service) GetQueryHistory(ctx context.Context, req types.GetQueryHistoryRequest) (types.QueryHistoryEntries, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.ProfileID = profileID
+
+	if req.Limit < 0 || req.Limit > maxQueryHistoryLimit {
+		return nil, types.NewErrInvalidRequestField(fmt.Sprintf("'limit' must be in range [0, %d]", maxQueryHistoryLimit))
+	}
+	if req.Offset < 0 {
+		return nil, types.NewErrInvalidRequestField("'offset' must be non-negative")
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultQueryHistoryLimit
+	}
+
+	return s.QueryHistory.Get(ctx, req)
+}
@@ -0,0 +1,69 @@
+package userprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+	repo_mock "github.com/ozontech/seq-ui/internal/pkg/repository/mock"
+	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
+)
+
+func TestGetQueryHistory(t *testing.T) {
+	query := "level:error"
+
+	tests := []struct {
+		name      string
+		req       types.GetQueryHistoryRequest
+		wantLimit int32
+		wantErr   bool
+	}{
+		{
+			name:      "ok",
+			req:       types.GetQueryHistoryRequest{Query: &query, Limit: 10, Offset: 20},
+			wantLimit: 10,
+		},
+		{
+			name:      "ok_default_limit",
+			wantLimit: defaultQueryHistoryLimit,
+		},
+		{
+			name:    "err_limit",
+			req:     types.GetQueryHistoryRequest{Limit: maxQueryHistoryLimit + 1},
+			wantErr: true,
+		},
+		{
+			name:    "err_offset",
+			req:     types.GetQueryHistoryRequest{Offset: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles.InitProfiles(func(context.Context, types.GetOrCreateUserProfileRequest) (types.UserProfile, error) {
+				return types.UserProfile{ID: testProfileID}, nil
+			})
+			repo := repo_mock.NewMockQueryHistory(gomock.NewController(t))
+			s := &service{QueryHistory: repo}
+
+			want := types.QueryHistoryEntries{{ID: 1, ProfileID: testProfileID, Query: query}}
+			if !tt.wantErr {
+				wantReq := tt.req
+				wantReq.ProfileID = testProfileID
+				wantReq.Limit = tt.wantLimit
+				repo.EXPECT().Get(gomock.Any(), wantReq).Return(want, nil).Times(1)
+			}
+
+			ctx := context.WithValue(context.Background(), types.UserKey{}, "user")
+			got, err := s.GetQueryHistory(ctx, tt.req)
+			require.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
@@ -2,30 +2,62 @@ package userprofile
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/ozontech/seq-ui/internal/app/types"
 	"github.com/ozontech/seq-ui/internal/pkg/repository"
+	"github.com/ozontech/seq-ui/internal/pkg/service/errorgroups/digest"
 	"github.com/ozontech/seq-ui/internal/pkg/service/profiles"
 )
 
 type Service interface {
 	GetOrCreateUserProfile(context.Context, types.GetOrCreateUserProfileRequest) (types.UserProfile, error)
 	UpdateUserProfile(context.Context, types.UpdateUserProfileRequest) error
+	UpdateUserPreferences(context.Context, types.UpdateUserPreferencesRequest) (types.UserPreferences, error)
 	GetFavoriteQueries(context.Context, types.GetFavoriteQueriesRequest) (types.FavoriteQueries, error)
 	GetOrCreateFavoriteQuery(context.Context, types.GetOrCreateFavoriteQueryRequest) (int64, error)
+	UpdateFavoriteQuery(context.Context, types.UpdateFavoriteQueryRequest) error
 	DeleteFavoriteQuery(context.Context, types.DeleteFavoriteQueryRequest) error
+	ReorderFavoriteQueries(context.Context, types.ReorderFavoriteQueriesRequest) error
+	GetFavoriteQueryFolders(context.Context, types.GetFavoriteQueryFoldersRequest) (types.FavoriteQueryFolders, error)
+	CreateFavoriteQueryFolder(context.Context, types.CreateFavoriteQueryFolderRequest) (int64, error)
+	UpdateFavoriteQueryFolder(context.Context, types.UpdateFavoriteQueryFolderRequest) error
+	DeleteFavoriteQueryFolder(context.Context, types.DeleteFavoriteQueryFolderRequest) error
+	PublishFavoriteQuery(context.Context, types.PublishFavoriteQueryRequest) error
+	GetFavoriteQueriesLibrary(context.Context, types.GetFavoriteQueriesLibraryRequest) (types.FavoriteQueries, error)
+	SubscribeFavoriteQuery(context.Context, types.SubscribeFavoriteQueryRequest) error
+	UnsubscribeFavoriteQuery(context.Context, types.UnsubscribeFavoriteQueryRequest) error
+	GetErrorGroupsSubscriptions(context.Context, types.GetErrorGroupsSubscriptionsRequest) (types.ErrorGroupsSubscriptions, error)
+	CreateErrorGroupsSubscription(context.Context, types.CreateErrorGroupsSubscriptionRequest) (int64, error)
+	DeleteErrorGroupsSubscription(context.Context, types.DeleteErrorGroupsSubscriptionRequest) error
+	GetQueryHistory(context.Context, types.GetQueryHistoryRequest) (types.QueryHistoryEntries, error)
+	WhoAmI(context.Context) (types.WhoAmI, error)
 }
 
 type service struct {
-	UserProfiles    repository.UserProfiles
-	FavoriteQueries repository.FavoriteQueries
+	UserProfiles             repository.UserProfiles
+	FavoriteQueries          repository.FavoriteQueries
+	ErrorGroupsSubscriptions repository.ErrorGroupsSubscriptions
+	QueryHistory             repository.QueryHistory
+
+	// webhookAllowlist is the list of url prefixes allowed for digests webhook delivery.
+	webhookAllowlist []string
 }
 
-func New(up repository.UserProfiles, fq repository.FavoriteQueries) Service {
+func New(
+	up repository.UserProfiles,
+	fq repository.FavoriteQueries,
+	egs repository.ErrorGroupsSubscriptions,
+	qh repository.QueryHistory,
+	webhookAllowlist []string,
+) Service {
 	return &service{
-		UserProfiles:    up,
-		FavoriteQueries: fq,
+		UserProfiles:             up,
+		FavoriteQueries:          fq,
+		ErrorGroupsSubscriptions: egs,
+		QueryHistory:             qh,
+		webhookAllowlist:         webhookAllowlist,
 	}
 }
 
@@ -57,6 +89,7 @@ func (s *service) GetFavoriteQueries(ctx context.Context, req types.GetFavoriteQ
 		return nil, err
 	}
 	req.ProfileID = profileID
+	req.Groups = types.GetUserGroups(ctx)
 
 	return s.FavoriteQueries.GetAll(ctx, req)
 }
@@ -71,6 +104,11 @@ func (s *service) GetOrCreateFavoriteQuery(ctx context.Context, req types.GetOrC
 	if req.Query == "" {
 		return -1, types.NewErrInvalidRequestField("empty query")
 	}
+	if req.FolderID != nil {
+		if err = s.checkFavoriteQueryFolder(ctx, profileID, *req.FolderID); err != nil {
+			return -1, err
+		}
+	}
 
 	return s.FavoriteQueries.GetOrCreate(ctx, req)
 }
@@ -88,3 +126,82 @@ func (s *service) DeleteFavoriteQuery(ctx context.Context, req types.DeleteFavor
 
 	return s.FavoriteQueries.Delete(ctx, req)
 }
+
+func (s *service) GetErrorGroupsSubscriptions(
+	ctx context.Context,
+	req types.GetErrorGroupsSubscriptionsRequest,
+) (types.ErrorGroupsSubscriptions, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.ProfileID = profileID
+
+	return s.ErrorGroupsSubscriptions.GetByProfile(ctx, req)
+}
+
+func (s *service) CreateErrorGroupsSubscription(
+	ctx context.Context,
+	req types.CreateErrorGroupsSubscriptionRequest,
+) (int64, error) {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	req.ProfileID = profileID
+
+	if err = validateErrorGroupsSubscription(req, s.webhookAllowlist); err != nil {
+		return -1, err
+	}
+
+	return s.ErrorGroupsSubscriptions.Create(ctx, req)
+}
+
+func (s *service) DeleteErrorGroupsSubscription(
+	ctx context.Context,
+	req types.DeleteErrorGroupsSubscriptionRequest,
+) error {
+	profileID, err := profiles.GetIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req.ProfileID = profileID
+
+	if req.ID <= 0 {
+		return types.NewErrInvalidRequestField("invalid id")
+	}
+
+	return s.ErrorGroupsSubscriptions.Delete(ctx, req)
+}
+
+func validateErrorGroupsSubscription(req types.CreateErrorGroupsSubscriptionRequest, webhookAllowlist []string) error {
+	if req.Service == "" {
+		return types.NewErrInvalidRequestField("empty service")
+	}
+	if !req.Period.IsValid() {
+		return types.NewErrInvalidRequestField("invalid period")
+	}
+	if !req.Format.IsValid() {
+		return types.NewErrInvalidRequestField("invalid format")
+	}
+	if !req.Delivery.IsValid() {
+		return types.NewErrInvalidRequestField("invalid delivery")
+	}
+
+	if req.Delivery != types.DigestDeliveryWebhook {
+		if req.WebhookURL != "" {
+			return types.NewErrInvalidRequestField("webhook url is allowed only for webhook delivery")
+		}
+		return nil
+	}
+
+	u, err := url.Parse(req.WebhookURL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return types.NewErrInvalidRequestField("invalid webhook url")
+	}
+	if !digest.WebhookAllowed(webhookAllowlist, req.WebhookURL) {
+		return types.NewErrInvalidRequestField("webhook url is not allowed")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package userprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestValidateErrorGroupsSubscription(t *testing.T) {
+	valid := types.CreateErrorGroupsSubscriptionRequest{
+		Service:    "svc",
+		Period:     types.DigestPeriodDaily,
+		Format:     types.DigestFormatMarkdown,
+		Delivery:   types.DigestDeliveryWebhook,
+		WebhookURL: "https://example.com/hook",
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *types.CreateErrorGroupsSubscriptionRequest)
+		wantErr bool
+	}{
+		{
+			name:   "ok_webhook",
+			modify: func(*types.CreateErrorGroupsSubscriptionRequest) {},
+		},
+		{
+			name: "ok_file_store",
+			modify: func(r *types.CreateErrorGroupsSubscriptionRequest) {
+				r.Delivery = types.DigestDeliveryFileStore
+				r.WebhookURL = ""
+			},
+		},
+		{
+			name:    "err_empty_service",
+			modify:  func(r *types.CreateErrorGroupsSubscriptionRequest) { r.Service = "" },
+			wantErr: true,
+		},
+		{
+			name:    "err_period",
+			modify:  func(r *types.CreateErrorGroupsSubscriptionRequest) { r.Period = "monthly" },
+			wantErr: true,
+		},
+		{
+			name:    "err_format",
+			modify:  func(r *types.CreateErrorGroupsSubscriptionRequest) { r.Format = "pdf" },
+			wantErr: true,
+		},
+		{
+			name:    "err_delivery",
+			modify:  func(r *types.CreateErrorGroupsSubscriptionRequest) { r.Delivery = "email" },
+			wantErr: true,
+		},
+		{
+			name:    "err_webhook_url",
+			modify:  func(r *types.CreateErrorGroupsSubscriptionRequest) { r.WebhookURL = "ftp://example.com" },
+			wantErr: true,
+		},
+		{
+			name:    "err_webhook_url_not_allowed",
+			modify:  func(r *types.CreateErrorGroupsSubscriptionRequest) { r.WebhookURL = "http://169.254.169.254/latest" },
+			wantErr: true,
+		},
+		{
+			name:    "err_empty_webhook_url",
+			modify:  func(r *types.CreateErrorGroupsSubscriptionRequest) { r.WebhookURL = "" },
+			wantErr: true,
+		},
+		{
+			name: "err_webhook_url_for_file_store",
+			modify: func(r *types.CreateErrorGroupsSubscriptionRequest) {
+				r.Delivery = types.DigestDeliveryFileStore
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := valid
+			tt.modify(&req)
+
+			err := validateErrorGroupsSubscription(req, []string{"https://example.com/"})
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidRequestField)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
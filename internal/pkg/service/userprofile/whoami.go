@@ -0,0 +1,33 @@
+package userprofile
+
+import (
+	"context"
+
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func (s *service) WhoAmI(ctx context.Context) (types.WhoAmI, error) {
+	userName, err := types.GetUserKey(ctx)
+	if err != nil {
+		return types.WhoAmI{}, err
+	}
+
+	res := types.WhoAmI{
+		UserName:    userName,
+		Groups:      types.GetUserGroups(ctx),
+		Roles:       []string{},
+		Permissions: []string{},
+	}
+	if res.Groups == nil {
+		res.Groups = []string{}
+	}
+
+	if access, ok := rbac.GetAccess(ctx); ok {
+		res.RBACEnabled = true
+		res.Roles = access.Roles
+		res.Permissions = access.Effective()
+	}
+
+	return res, nil
+}
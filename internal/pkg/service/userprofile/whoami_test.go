@@ -0,0 +1,73 @@
+package userprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-ui/internal/app/rbac"
+	"github.com/ozontech/seq-ui/internal/app/types"
+)
+
+func TestWhoAmI(t *testing.T) {
+	userCtx := context.WithValue(context.Background(), types.UserKey{}, "unnamed")
+	userCtx = context.WithValue(userCtx, types.UserGroupsKey{}, []string{"sre"})
+
+	tests := []struct {
+		name string
+
+		ctx     context.Context
+		want    types.WhoAmI
+		wantErr bool
+	}{
+		{
+			name: "ok_rbac_disabled",
+			ctx:  userCtx,
+			want: types.WhoAmI{
+				UserName:    "unnamed",
+				Groups:      []string{"sre"},
+				Roles:       []string{},
+				Permissions: []string{},
+			},
+		},
+		{
+			name: "ok_rbac_enabled",
+			ctx: rbac.WithAccess(userCtx, rbac.Access{
+				Roles:       []string{"exporter"},
+				Permissions: []string{"massexport:*"},
+			}),
+			want: types.WhoAmI{
+				UserName:    "unnamed",
+				Groups:      []string{"sre"},
+				RBACEnabled: true,
+				Roles:       []string{"exporter"},
+				Permissions: []string{
+					rbac.PermMassExportManage,
+					rbac.PermMassExportStart,
+					rbac.PermMassExportView,
+				},
+			},
+		},
+		{
+			name:    "err_unauthenticated",
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &service{}
+
+			got, err := s.WhoAmI(tt.ctx)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -10,14 +10,18 @@ import (
 )
 
 const (
-	seqUINS           = "seq_ui_server"
-	serverSubsys      = "server"
-	seqDBClientSubsys = "seq_db_client"
-	authSubsys        = "auth"
-	repoSubsys        = "repository"
-	clickHouseSubsys  = "clickhouse"
-	massExportSubsys  = "mass_export"
-	asyncSearchSubsys = "async_search"
+	seqUINS            = "seq_ui_server"
+	serverSubsys       = "server"
+	seqDBClientSubsys  = "seq_db_client"
+	authSubsys         = "auth"
+	repoSubsys         = "repository"
+	clickHouseSubsys   = "clickhouse"
+	massExportSubsys   = "mass_export"
+	asyncSearchSubsys  = "async_search"
+	errorGroupsSubsys  = "error_groups"
+	queryHistorySubsys = "query_history"
+	auditSubsys        = "audit"
+	queryCostSubsys    = "query_cost"
 
 	componentLabel  = "component"
 	methodLabel     = "method"
@@ -27,6 +31,9 @@ const (
 	tableLabel      = "table"
 	queryLabel      = "query"
 	sessionIDLabel  = "session_id"
+	deliveryLabel   = "delivery"
+	statusLabel     = "status"
+	routeLabel      = "route"
 )
 
 var (
@@ -64,6 +71,30 @@ var (
 		Name:      "requests_rate_limits_total",
 		Help:      "",
 	})
+	ServerRateLimiterFallbacks = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: serverSubsys,
+		Name:      "rate_limiter_fallbacks_total",
+		Help:      "",
+	})
+	ServerConcurrencyInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: seqUINS,
+		Subsystem: serverSubsys,
+		Name:      "concurrency_in_flight",
+		Help:      "",
+	}, []string{routeLabel})
+	ServerConcurrencyQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: seqUINS,
+		Subsystem: serverSubsys,
+		Name:      "concurrency_queued",
+		Help:      "",
+	}, []string{routeLabel})
+	ServerConcurrencyRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: serverSubsys,
+		Name:      "concurrency_rejected_total",
+		Help:      "",
+	}, []string{routeLabel})
 	ServerExportRequestLimits = promauto.NewCounter(prometheus.CounterOpts{
 		Namespace: seqUINS,
 		Subsystem: serverSubsys,
@@ -218,6 +249,50 @@ var (
 		Name:      "requests_query_too_long_total",
 		Help:      "",
 	})
+
+	// error groups metrics
+	ErrorGroupsDigestsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: errorGroupsSubsys,
+		Name:      "digests_sent_total",
+		Help:      "",
+	}, []string{deliveryLabel, statusLabel})
+
+	// query history metrics
+	QueryHistoryEntriesDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: queryHistorySubsys,
+		Name:      "entries_dropped_total",
+		Help:      "",
+	})
+
+	// audit metrics
+	AuditRecordsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: auditSubsys,
+		Name:      "records_dropped_total",
+		Help:      "",
+	})
+	AuditRecordsWritten = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: auditSubsys,
+		Name:      "records_written_total",
+		Help:      "",
+	}, []string{statusLabel})
+
+	// query cost metrics
+	QueryCostCharged = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: queryCostSubsys,
+		Name:      "charged_total",
+		Help:      "",
+	})
+	QueryCostBudgetExceeded = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: seqUINS,
+		Subsystem: queryCostSubsys,
+		Name:      "budget_exceeded_total",
+		Help:      "",
+	})
 )
 
 // HandledIncomingRequest handles metrics for processed incoming request.
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS error_groups_subscriptions(
+    id BIGSERIAL PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    service text NOT NULL,
+    env text NOT NULL DEFAULT '',
+    period text NOT NULL,
+    format text NOT NULL,
+    delivery text NOT NULL,
+    webhook_url text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    last_sent_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_error_groups_subscriptions_profile_id ON error_groups_subscriptions(profile_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_error_groups_subscriptions_unique ON error_groups_subscriptions(profile_id, service, env, period);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_error_groups_subscriptions_unique;
+DROP INDEX IF EXISTS idx_error_groups_subscriptions_profile_id;
+DROP TABLE IF EXISTS error_groups_subscriptions;
+-- +goose StatementEnd
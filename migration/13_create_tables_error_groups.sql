@@ -0,0 +1,125 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Postgres storage of error groups for installations without clickhouse.
+-- Events are inserted into events_raw and are not stored there:
+-- trigger rolls them up into the aggregated tables and skips the insert.
+-- _group_hash is UInt64 in clickhouse, so it is stored as BIGINT with the same bits.
+CREATE TABLE IF NOT EXISTS events_raw(
+    timestamp timestamptz NOT NULL,
+    service text NOT NULL,
+    _group_hash BIGINT NOT NULL,
+    env text NOT NULL DEFAULT '',
+    source text NOT NULL DEFAULT '',
+    cluster text NOT NULL DEFAULT '',
+    release text NOT NULL DEFAULT '',
+    message text NOT NULL DEFAULT '',
+    log_tags jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE IF NOT EXISTS error_groups(
+    _group_hash BIGINT NOT NULL,
+    service text NOT NULL,
+    env text NOT NULL,
+    source text NOT NULL,
+    cluster text NOT NULL,
+    release text NOT NULL,
+    message text NOT NULL,
+    seen_total BIGINT NOT NULL,
+    first_seen_at timestamptz NOT NULL,
+    last_seen_at timestamptz NOT NULL,
+    log_tags jsonb NOT NULL,
+    PRIMARY KEY (_group_hash, service, env, source, cluster, release)
+);
+
+CREATE INDEX IF NOT EXISTS idx_error_groups_service ON error_groups(service, env);
+CREATE INDEX IF NOT EXISTS idx_error_groups_last_seen_at ON error_groups(last_seen_at);
+
+CREATE TABLE IF NOT EXISTS agg_events_10min(
+    start_date timestamptz NOT NULL,
+    _group_hash BIGINT NOT NULL,
+    service text NOT NULL,
+    env text NOT NULL,
+    source text NOT NULL,
+    cluster text NOT NULL,
+    release text NOT NULL,
+    counts BIGINT NOT NULL,
+    PRIMARY KEY (start_date, _group_hash, service, env, source, cluster, release)
+);
+
+CREATE INDEX IF NOT EXISTS idx_agg_events_10min_service ON agg_events_10min(service, start_date);
+
+CREATE TABLE IF NOT EXISTS agg_events_1d(
+    start_date timestamptz NOT NULL,
+    _group_hash BIGINT NOT NULL,
+    service text NOT NULL,
+    env text NOT NULL,
+    source text NOT NULL,
+    cluster text NOT NULL,
+    release text NOT NULL,
+    counts BIGINT NOT NULL,
+    PRIMARY KEY (start_date, _group_hash, service, env, source, cluster, release)
+);
+
+CREATE INDEX IF NOT EXISTS idx_agg_events_1d_service ON agg_events_1d(service, start_date);
+
+CREATE TABLE IF NOT EXISTS services(
+    env text NOT NULL,
+    cluster text NOT NULL,
+    service text NOT NULL,
+    release text NOT NULL,
+    ttl timestamptz NOT NULL,
+    PRIMARY KEY (service, env, cluster, release)
+);
+
+CREATE TABLE IF NOT EXISTS error_groups_merges(
+    _group_hash BIGINT PRIMARY KEY,
+    target_hash BIGINT NOT NULL,
+    created_by text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE OR REPLACE FUNCTION events_raw_rollup() RETURNS trigger AS $$
+DECLARE
+    ts timestamptz := date_trunc('second', NEW.timestamp);
+BEGIN
+    INSERT INTO error_groups AS t (_group_hash, service, env, source, cluster, release, message, seen_total, first_seen_at, last_seen_at, log_tags)
+    VALUES (NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, NEW.message, 1, ts, ts, NEW.log_tags)
+    ON CONFLICT (_group_hash, service, env, source, cluster, release) DO UPDATE SET
+        seen_total = t.seen_total + 1,
+        first_seen_at = LEAST(t.first_seen_at, EXCLUDED.first_seen_at),
+        last_seen_at = GREATEST(t.last_seen_at, EXCLUDED.last_seen_at);
+
+    INSERT INTO agg_events_10min AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 600) * 600), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO agg_events_1d AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 86400) * 86400), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO services AS t (env, cluster, service, release, ttl)
+    VALUES (NEW.env, NEW.cluster, NEW.service, NEW.release, ts)
+    ON CONFLICT (service, env, cluster, release) DO UPDATE SET
+        ttl = GREATEST(t.ttl, EXCLUDED.ttl);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_raw_rollup BEFORE INSERT ON events_raw
+    FOR EACH ROW EXECUTE PROCEDURE events_raw_rollup();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS events_raw_rollup ON events_raw;
+DROP FUNCTION IF EXISTS events_raw_rollup();
+DROP TABLE IF EXISTS error_groups_merges;
+DROP TABLE IF EXISTS services;
+DROP TABLE IF EXISTS agg_events_1d;
+DROP TABLE IF EXISTS agg_events_10min;
+DROP TABLE IF EXISTS error_groups;
+DROP TABLE IF EXISTS events_raw;
+-- +goose StatementEnd
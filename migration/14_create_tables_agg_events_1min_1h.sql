@@ -0,0 +1,113 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS agg_events_1min(
+    start_date timestamptz NOT NULL,
+    _group_hash BIGINT NOT NULL,
+    service text NOT NULL,
+    env text NOT NULL,
+    source text NOT NULL,
+    cluster text NOT NULL,
+    release text NOT NULL,
+    counts BIGINT NOT NULL,
+    PRIMARY KEY (start_date, _group_hash, service, env, source, cluster, release)
+);
+
+CREATE INDEX IF NOT EXISTS idx_agg_events_1min_service ON agg_events_1min(service, start_date);
+
+CREATE TABLE IF NOT EXISTS agg_events_1h(
+    start_date timestamptz NOT NULL,
+    _group_hash BIGINT NOT NULL,
+    service text NOT NULL,
+    env text NOT NULL,
+    source text NOT NULL,
+    cluster text NOT NULL,
+    release text NOT NULL,
+    counts BIGINT NOT NULL,
+    PRIMARY KEY (start_date, _group_hash, service, env, source, cluster, release)
+);
+
+CREATE INDEX IF NOT EXISTS idx_agg_events_1h_service ON agg_events_1h(service, start_date);
+
+-- backfill from 10min rollup, 1min rollup can't be backfilled, since raw events are deleted after aggregation
+INSERT INTO agg_events_1h (start_date, _group_hash, service, env, source, cluster, release, counts)
+SELECT to_timestamp(floor(extract(epoch FROM start_date) / 3600) * 3600) AS hour, _group_hash, service, env, source, cluster, release, SUM(counts)
+FROM agg_events_10min
+GROUP BY hour, _group_hash, service, env, source, cluster, release
+ON CONFLICT DO NOTHING;
+
+CREATE OR REPLACE FUNCTION events_raw_rollup() RETURNS trigger AS $$
+DECLARE
+    ts timestamptz := date_trunc('second', NEW.timestamp);
+BEGIN
+    INSERT INTO error_groups AS t (_group_hash, service, env, source, cluster, release, message, seen_total, first_seen_at, last_seen_at, log_tags)
+    VALUES (NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, NEW.message, 1, ts, ts, NEW.log_tags)
+    ON CONFLICT (_group_hash, service, env, source, cluster, release) DO UPDATE SET
+        seen_total = t.seen_total + 1,
+        first_seen_at = LEAST(t.first_seen_at, EXCLUDED.first_seen_at),
+        last_seen_at = GREATEST(t.last_seen_at, EXCLUDED.last_seen_at);
+
+    INSERT INTO agg_events_10min AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 600) * 600), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO agg_events_1min AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 60) * 60), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO agg_events_1h AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 3600) * 3600), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO agg_events_1d AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 86400) * 86400), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO services AS t (env, cluster, service, release, ttl)
+    VALUES (NEW.env, NEW.cluster, NEW.service, NEW.release, ts)
+    ON CONFLICT (service, env, cluster, release) DO UPDATE SET
+        ttl = GREATEST(t.ttl, EXCLUDED.ttl);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION events_raw_rollup() RETURNS trigger AS $$
+DECLARE
+    ts timestamptz := date_trunc('second', NEW.timestamp);
+BEGIN
+    INSERT INTO error_groups AS t (_group_hash, service, env, source, cluster, release, message, seen_total, first_seen_at, last_seen_at, log_tags)
+    VALUES (NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, NEW.message, 1, ts, ts, NEW.log_tags)
+    ON CONFLICT (_group_hash, service, env, source, cluster, release) DO UPDATE SET
+        seen_total = t.seen_total + 1,
+        first_seen_at = LEAST(t.first_seen_at, EXCLUDED.first_seen_at),
+        last_seen_at = GREATEST(t.last_seen_at, EXCLUDED.last_seen_at);
+
+    INSERT INTO agg_events_10min AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 600) * 600), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO agg_events_1d AS t (start_date, _group_hash, service, env, source, cluster, release, counts)
+    VALUES (to_timestamp(floor(extract(epoch FROM ts) / 86400) * 86400), NEW._group_hash, NEW.service, NEW.env, NEW.source, NEW.cluster, NEW.release, 1)
+    ON CONFLICT (start_date, _group_hash, service, env, source, cluster, release) DO UPDATE SET
+        counts = t.counts + 1;
+
+    INSERT INTO services AS t (env, cluster, service, release, ttl)
+    VALUES (NEW.env, NEW.cluster, NEW.service, NEW.release, ts)
+    ON CONFLICT (service, env, cluster, release) DO UPDATE SET
+        ttl = GREATEST(t.ttl, EXCLUDED.ttl);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS agg_events_1h;
+DROP TABLE IF EXISTS agg_events_1min;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dashboard_versions(
+    dashboard_uuid UUID NOT NULL REFERENCES dashboards(uuid) ON DELETE CASCADE,
+    version BIGINT NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    meta text NOT NULL,
+    author_id BIGINT NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (dashboard_uuid, version)
+);
+
+-- current state of existing dashboards becomes their first version
+INSERT INTO dashboard_versions (dashboard_uuid, version, name, meta, author_id)
+SELECT uuid, 1, name, meta, owner_id FROM dashboards
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dashboard_versions;
+-- +goose StatementEnd
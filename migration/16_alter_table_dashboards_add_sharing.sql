@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS dashboards
+    ADD COLUMN IF NOT EXISTS visibility text NOT NULL DEFAULT 'public',
+    ADD COLUMN IF NOT EXISTS team text NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS dashboard_collaborators(
+    dashboard_uuid UUID NOT NULL REFERENCES dashboards(uuid) ON DELETE CASCADE,
+    kind text NOT NULL,
+    name text NOT NULL,
+    role text NOT NULL,
+    PRIMARY KEY (dashboard_uuid, kind, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dashboard_collaborators_name ON dashboard_collaborators(kind, name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dashboard_collaborators_name;
+DROP TABLE IF EXISTS dashboard_collaborators;
+ALTER TABLE IF EXISTS dashboards
+    DROP COLUMN IF EXISTS team,
+    DROP COLUMN IF EXISTS visibility;
+-- +goose StatementEnd
@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dashboard_folders(
+    id BIGSERIAL PRIMARY KEY,
+    parent_id BIGINT REFERENCES dashboard_folders(id) ON DELETE CASCADE,
+    owner_id BIGINT NOT NULL,
+    name VARCHAR(64) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_dashboard_folders_parent_id ON dashboard_folders(parent_id);
+
+-- queries are extracted from meta to search dashboards by them
+ALTER TABLE IF EXISTS dashboards
+    ADD COLUMN IF NOT EXISTS folder_id BIGINT REFERENCES dashboard_folders(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS tags text[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS queries text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS updated_at timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS idx_dashboards_folder_id ON dashboards(folder_id);
+CREATE INDEX IF NOT EXISTS idx_dashboards_tags ON dashboards USING GIN (tags);
+
+DO $$
+DECLARE
+    r record;
+BEGIN
+    FOR r IN SELECT uuid, meta FROM dashboards LOOP
+        BEGIN
+            UPDATE dashboards SET queries = COALESCE((
+                SELECT string_agg(q #>> '{}', E'\n')
+                FROM jsonb_path_query(r.meta::jsonb, 'strict $.**.query') AS q
+                WHERE jsonb_typeof(q) = 'string'
+            ), '')
+            WHERE uuid = r.uuid;
+        EXCEPTION WHEN others THEN
+            -- meta is not a valid json
+        END;
+    END LOOP;
+END $$;
+
+CREATE TABLE IF NOT EXISTS dashboard_stars(
+    profile_id BIGINT NOT NULL,
+    dashboard_uuid UUID NOT NULL REFERENCES dashboards(uuid) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (profile_id, dashboard_uuid)
+);
+
+CREATE TABLE IF NOT EXISTS dashboard_views(
+    profile_id BIGINT NOT NULL,
+    dashboard_uuid UUID NOT NULL REFERENCES dashboards(uuid) ON DELETE CASCADE,
+    viewed_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (profile_id, dashboard_uuid)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dashboard_views;
+DROP TABLE IF EXISTS dashboard_stars;
+DROP INDEX IF EXISTS idx_dashboards_tags;
+DROP INDEX IF EXISTS idx_dashboards_folder_id;
+ALTER TABLE IF EXISTS dashboards
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS queries,
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS folder_id;
+DROP INDEX IF EXISTS idx_dashboard_folders_parent_id;
+DROP TABLE IF EXISTS dashboard_folders;
+-- +goose StatementEnd
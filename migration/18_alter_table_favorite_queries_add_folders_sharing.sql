@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS favorite_query_folders(
+    id BIGSERIAL PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    name VARCHAR(64) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_favorite_query_folders_profile_id ON favorite_query_folders(profile_id);
+
+-- team is the team library the query is published to, empty for private queries
+ALTER TABLE IF EXISTS favorite_queries
+    ADD COLUMN IF NOT EXISTS folder_id BIGINT REFERENCES favorite_query_folders(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS env text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS log_columns text[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS team text NOT NULL DEFAULT '';
+
+UPDATE favorite_queries SET position = o.position
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY profile_id ORDER BY id) AS position
+    FROM favorite_queries
+) AS o
+WHERE favorite_queries.id = o.id;
+
+CREATE INDEX IF NOT EXISTS idx_favorite_queries_team ON favorite_queries(team) WHERE team <> '';
+
+CREATE TABLE IF NOT EXISTS favorite_query_subscriptions(
+    profile_id BIGINT NOT NULL,
+    query_id BIGINT NOT NULL REFERENCES favorite_queries(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (profile_id, query_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS favorite_query_subscriptions;
+DROP INDEX IF EXISTS idx_favorite_queries_team;
+ALTER TABLE IF EXISTS favorite_queries
+    DROP COLUMN IF EXISTS team,
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS log_columns,
+    DROP COLUMN IF EXISTS env,
+    DROP COLUMN IF EXISTS folder_id;
+DROP INDEX IF EXISTS idx_favorite_query_folders_profile_id;
+DROP TABLE IF EXISTS favorite_query_folders;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS query_history(
+    id BIGSERIAL PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    kind text NOT NULL,
+    query text NOT NULL,
+    "from" timestamptz NOT NULL,
+    "to" timestamptz NOT NULL,
+    env text NOT NULL DEFAULT '',
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    total BIGINT NOT NULL DEFAULT 0,
+    error_code text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_query_history_profile_id_created_at ON query_history(profile_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_query_history_created_at ON query_history(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_query_history_created_at;
+DROP INDEX IF EXISTS idx_query_history_profile_id_created_at;
+DROP TABLE IF EXISTS query_history;
+-- +goose StatementEnd
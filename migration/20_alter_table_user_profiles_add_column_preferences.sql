@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS user_profiles ADD COLUMN IF NOT EXISTS preferences JSONB NOT NULL DEFAULT '{"version": 1}';
+
+UPDATE user_profiles SET preferences = jsonb_build_object(
+    'version', 1,
+    'timezone', timezone,
+    'log_columns', log_columns::jsonb
+);
+
+-- old columns are kept for the previous versions during rolling deploy and are dropped by the later migration,
+-- new versions don't write them
+ALTER TABLE IF EXISTS user_profiles ALTER COLUMN timezone SET DEFAULT '';
+
+-- until the old columns are dropped, trigger keeps them in sync with preferences,
+-- so the writes of the previous versions aren't lost and they see the writes of the new ones
+CREATE OR REPLACE FUNCTION user_profiles_sync_preferences() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.preferences := jsonb_build_object('timezone', NEW.timezone, 'log_columns', NEW.log_columns::jsonb) || NEW.preferences;
+    ELSIF NEW.timezone IS DISTINCT FROM OLD.timezone OR NEW.log_columns IS DISTINCT FROM OLD.log_columns THEN
+        NEW.preferences := NEW.preferences || jsonb_build_object('timezone', NEW.timezone, 'log_columns', NEW.log_columns::jsonb);
+    END IF;
+
+    NEW.timezone := COALESCE(NEW.preferences->>'timezone', '');
+    NEW.log_columns := COALESCE(NULLIF(NEW.preferences->'log_columns', 'null'::jsonb), '[]'::jsonb)::text;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER user_profiles_sync_preferences BEFORE INSERT OR UPDATE ON user_profiles
+    FOR EACH ROW EXECUTE PROCEDURE user_profiles_sync_preferences();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS user_profiles_sync_preferences ON user_profiles;
+DROP FUNCTION IF EXISTS user_profiles_sync_preferences();
+
+UPDATE user_profiles SET
+    timezone = COALESCE(preferences->>'timezone', ''),
+    log_columns = COALESCE(NULLIF(preferences->'log_columns', 'null'::jsonb), '[]'::jsonb)::text;
+
+ALTER TABLE IF EXISTS user_profiles ALTER COLUMN timezone DROP DEFAULT;
+ALTER TABLE IF EXISTS user_profiles DROP COLUMN IF EXISTS preferences;
+-- +goose StatementEnd
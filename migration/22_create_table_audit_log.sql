@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS audit_log(
+    id BIGSERIAL PRIMARY KEY,
+    user_name text NOT NULL,
+    action text NOT NULL,
+    method text NOT NULL,
+    env text NOT NULL DEFAULT '',
+    query text NOT NULL DEFAULT '',
+    "from" timestamptz,
+    "to" timestamptz,
+    target text NOT NULL DEFAULT '',
+    status text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log(created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_user_name_created_at ON audit_log(user_name, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_audit_log_user_name_created_at;
+DROP INDEX IF EXISTS idx_audit_log_created_at;
+DROP TABLE IF EXISTS audit_log;
+-- +goose StatementEnd
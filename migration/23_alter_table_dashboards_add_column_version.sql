@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version counter of the dashboard, bumped by each update and used as number of the new version
+ALTER TABLE IF EXISTS dashboards
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+UPDATE dashboards AS d
+SET version = v.version
+FROM (
+    SELECT dashboard_uuid, MAX(version) AS version
+    FROM dashboard_versions
+    GROUP BY dashboard_uuid
+) AS v
+WHERE v.dashboard_uuid = d.uuid;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS dashboards
+    DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
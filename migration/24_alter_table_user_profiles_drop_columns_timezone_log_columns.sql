@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- columns moved to preferences are read by versions before preferences were added,
+-- so during rolling deploy from them run `up-to 23` first and apply this after all instances are updated
+DROP TRIGGER IF EXISTS user_profiles_sync_preferences ON user_profiles;
+DROP FUNCTION IF EXISTS user_profiles_sync_preferences();
+
+ALTER TABLE IF EXISTS user_profiles
+    DROP COLUMN IF EXISTS timezone,
+    DROP COLUMN IF EXISTS log_columns;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS user_profiles
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS log_columns TEXT NOT NULL DEFAULT '[]';
+
+UPDATE user_profiles SET
+    timezone = COALESCE(preferences->>'timezone', ''),
+    log_columns = COALESCE(NULLIF(preferences->'log_columns', 'null'::jsonb), '[]'::jsonb)::text;
+
+CREATE OR REPLACE FUNCTION user_profiles_sync_preferences() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.preferences := jsonb_build_object('timezone', NEW.timezone, 'log_columns', NEW.log_columns::jsonb) || NEW.preferences;
+    ELSIF NEW.timezone IS DISTINCT FROM OLD.timezone OR NEW.log_columns IS DISTINCT FROM OLD.log_columns THEN
+        NEW.preferences := NEW.preferences || jsonb_build_object('timezone', NEW.timezone, 'log_columns', NEW.log_columns::jsonb);
+    END IF;
+
+    NEW.timezone := COALESCE(NEW.preferences->>'timezone', '');
+    NEW.log_columns := COALESCE(NULLIF(NEW.preferences->'log_columns', 'null'::jsonb), '[]'::jsonb)::text;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER user_profiles_sync_preferences BEFORE INSERT OR UPDATE ON user_profiles
+    FOR EACH ROW EXECUTE PROCEDURE user_profiles_sync_preferences();
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- query policy filter the search was started with, the searches started before are not available to restricted users
+ALTER TABLE IF EXISTS async_searches ADD COLUMN IF NOT EXISTS restriction text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS async_searches DROP COLUMN IF EXISTS restriction;
+-- +goose StatementEnd
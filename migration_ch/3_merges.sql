@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS seq_ui_server.error_groups_merges
+(
+    _group_hash UInt64,
+    target_hash UInt64,
+    created_by String,
+    created_at DateTime64(3)
+)
+ENGINE = ReplacingMergeTree(created_at)
+ORDER BY (_group_hash);
+
+-- +goose Down
+DROP TABLE IF EXISTS seq_ui_server.error_groups_merges;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS seq_ui_server_replicated.sharded_error_groups_merges
+(
+    _group_hash UInt64,
+    target_hash UInt64,
+    created_by String,
+    created_at DateTime64(3)
+)
+ENGINE = ReplicatedReplacingMergeTree('/clickhouse/tables/{shard}/{table}', '{replica}', created_at)
+ORDER BY (_group_hash);
+
+CREATE TABLE IF NOT EXISTS seq_ui_server_replicated.error_groups_merges AS seq_ui_server_replicated.sharded_error_groups_merges ENGINE = Distributed("seq-ui-server-replicated", seq_ui_server_replicated, sharded_error_groups_merges, _group_hash);
+
+-- +goose Down
+DROP TABLE IF EXISTS seq_ui_server_replicated.sharded_error_groups_merges;
+DROP TABLE IF EXISTS seq_ui_server_replicated.error_groups_merges;
@@ -0,0 +1,94 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS seq_ui_server_replicated.sharded_agg_events_1min
+(
+    start_date DateTime NOT NULL,
+    service String,
+    _group_hash UInt64,
+    env LowCardinality(String),
+    source LowCardinality(String),
+    cluster LowCardinality(String),
+    release String,
+    counts AggregateFunction(count)
+)
+ENGINE = ReplicatedAggregatingMergeTree('/clickhouse/tables/{shard}/{table}', '{replica}')
+PARTITION BY toStartOfHour(start_date)
+ORDER BY (cluster, source, env, service, release, _group_hash, start_date)
+TTL start_date + INTERVAL 7 DAY
+SETTINGS ttl_only_drop_parts = 1, merge_with_ttl_timeout = 1800, index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS seq_ui_server_replicated.sharded_agg_events_1min_mv TO seq_ui_server_replicated.sharded_agg_events_1min
+AS SELECT
+    toStartOfMinute(timestamp) as start_date,
+    service,
+    _group_hash,
+    env,
+    source,
+    cluster,
+    release,
+    countState() AS counts
+FROM seq_ui_server_replicated.sharded_events_raw
+GROUP BY start_date, _group_hash, service, env, release, source, cluster;
+
+CREATE TABLE IF NOT EXISTS seq_ui_server_replicated.sharded_agg_events_1h
+(
+    start_date DateTime NOT NULL,
+    service String,
+    _group_hash UInt64,
+    env LowCardinality(String),
+    source LowCardinality(String),
+    cluster LowCardinality(String),
+    release String,
+    counts AggregateFunction(count)
+)
+ENGINE = ReplicatedAggregatingMergeTree('/clickhouse/tables/{shard}/{table}', '{replica}')
+PARTITION BY toStartOfDay(start_date)
+ORDER BY (cluster, source, env, service, release, _group_hash, start_date)
+TTL start_date + INTERVAL 1 YEAR
+SETTINGS ttl_only_drop_parts = 1, merge_with_ttl_timeout = 1800;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS seq_ui_server_replicated.sharded_agg_events_1h_mv TO seq_ui_server_replicated.sharded_agg_events_1h
+AS SELECT
+    toStartOfHour(start_date) as start_date,
+    service,
+    _group_hash,
+    env,
+    source,
+    cluster,
+    release,
+    countMergeState(counts) AS counts
+FROM seq_ui_server_replicated.sharded_agg_events_10min
+GROUP BY cluster, source, env, service, release, _group_hash, start_date;
+
+-- backfill from 10min rollup after the view is created, so the rows inserted meanwhile aren't lost;
+-- only the buckets started before the view creation are taken, the later ones are aggregated by the view
+-- (except the rows of the creation bucket inserted before the view);
+-- 1min rollup can't be backfilled, since raw events are kept only for 10 minutes
+INSERT INTO seq_ui_server_replicated.sharded_agg_events_1h
+SELECT
+    toStartOfHour(start_date) as start_date,
+    service,
+    _group_hash,
+    env,
+    source,
+    cluster,
+    release,
+    countMergeState(counts) AS counts
+FROM seq_ui_server_replicated.sharded_agg_events_10min
+WHERE start_date < toStartOfTenMinutes((
+    SELECT metadata_modification_time
+    FROM system.tables
+    WHERE database = 'seq_ui_server_replicated' AND name = 'sharded_agg_events_1h_mv'
+))
+GROUP BY cluster, source, env, service, release, _group_hash, start_date;
+
+CREATE TABLE IF NOT EXISTS seq_ui_server_replicated.agg_events_1min AS seq_ui_server_replicated.sharded_agg_events_1min ENGINE = Distributed("seq-ui-server-replicated", seq_ui_server_replicated, sharded_agg_events_1min);
+CREATE TABLE IF NOT EXISTS seq_ui_server_replicated.agg_events_1h AS seq_ui_server_replicated.sharded_agg_events_1h ENGINE = Distributed("seq-ui-server-replicated", seq_ui_server_replicated, sharded_agg_events_1h);
+
+-- +goose Down
+DROP TABLE IF EXISTS seq_ui_server_replicated.sharded_agg_events_1min_mv;
+DROP TABLE IF EXISTS seq_ui_server_replicated.sharded_agg_events_1min;
+DROP TABLE IF EXISTS seq_ui_server_replicated.agg_events_1min;
+
+DROP TABLE IF EXISTS seq_ui_server_replicated.sharded_agg_events_1h_mv;
+DROP TABLE IF EXISTS seq_ui_server_replicated.sharded_agg_events_1h;
+DROP TABLE IF EXISTS seq_ui_server_replicated.agg_events_1h;
@@ -19,13 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	DashboardsService_GetAll_FullMethodName    = "/dashboards.v1.DashboardsService/GetAll"
-	DashboardsService_GetMy_FullMethodName     = "/dashboards.v1.DashboardsService/GetMy"
-	DashboardsService_GetByUUID_FullMethodName = "/dashboards.v1.DashboardsService/GetByUUID"
-	DashboardsService_Create_FullMethodName    = "/dashboards.v1.DashboardsService/Create"
-	DashboardsService_Update_FullMethodName    = "/dashboards.v1.DashboardsService/Update"
-	DashboardsService_Delete_FullMethodName    = "/dashboards.v1.DashboardsService/Delete"
-	DashboardsService_Search_FullMethodName    = "/dashboards.v1.DashboardsService/Search"
+	DashboardsService_GetAll_FullMethodName            = "/dashboards.v1.DashboardsService/GetAll"
+	DashboardsService_GetMy_FullMethodName             = "/dashboards.v1.DashboardsService/GetMy"
+	DashboardsService_GetByUUID_FullMethodName         = "/dashboards.v1.DashboardsService/GetByUUID"
+	DashboardsService_Create_FullMethodName            = "/dashboards.v1.DashboardsService/Create"
+	DashboardsService_Update_FullMethodName            = "/dashboards.v1.DashboardsService/Update"
+	DashboardsService_Delete_FullMethodName            = "/dashboards.v1.DashboardsService/Delete"
+	DashboardsService_Search_FullMethodName            = "/dashboards.v1.DashboardsService/Search"
+	DashboardsService_ListVersions_FullMethodName      = "/dashboards.v1.DashboardsService/ListVersions"
+	DashboardsService_GetVersion_FullMethodName        = "/dashboards.v1.DashboardsService/GetVersion"
+	DashboardsService_Restore_FullMethodName           = "/dashboards.v1.DashboardsService/Restore"
+	DashboardsService_DiffVersions_FullMethodName      = "/dashboards.v1.DashboardsService/DiffVersions"
+	DashboardsService_GetSharing_FullMethodName        = "/dashboards.v1.DashboardsService/GetSharing"
+	DashboardsService_UpdateSharing_FullMethodName     = "/dashboards.v1.DashboardsService/UpdateSharing"
+	DashboardsService_TransferOwnership_FullMethodName = "/dashboards.v1.DashboardsService/TransferOwnership"
+	DashboardsService_Star_FullMethodName              = "/dashboards.v1.DashboardsService/Star"
+	DashboardsService_Unstar_FullMethodName            = "/dashboards.v1.DashboardsService/Unstar"
+	DashboardsService_GetFolders_FullMethodName        = "/dashboards.v1.DashboardsService/GetFolders"
+	DashboardsService_CreateFolder_FullMethodName      = "/dashboards.v1.DashboardsService/CreateFolder"
+	DashboardsService_UpdateFolder_FullMethodName      = "/dashboards.v1.DashboardsService/UpdateFolder"
+	DashboardsService_DeleteFolder_FullMethodName      = "/dashboards.v1.DashboardsService/DeleteFolder"
+	DashboardsService_Export_FullMethodName            = "/dashboards.v1.DashboardsService/Export"
+	DashboardsService_Import_FullMethodName            = "/dashboards.v1.DashboardsService/Import"
+	DashboardsService_Render_FullMethodName            = "/dashboards.v1.DashboardsService/Render"
+	DashboardsService_ResolveVariables_FullMethodName  = "/dashboards.v1.DashboardsService/ResolveVariables"
 )
 
 // DashboardsServiceClient is the client API for DashboardsService service.
@@ -39,6 +56,23 @@ type DashboardsServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error)
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error)
+	DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error)
+	GetSharing(ctx context.Context, in *GetSharingRequest, opts ...grpc.CallOption) (*GetSharingResponse, error)
+	UpdateSharing(ctx context.Context, in *UpdateSharingRequest, opts ...grpc.CallOption) (*UpdateSharingResponse, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	Star(ctx context.Context, in *StarRequest, opts ...grpc.CallOption) (*StarResponse, error)
+	Unstar(ctx context.Context, in *UnstarRequest, opts ...grpc.CallOption) (*UnstarResponse, error)
+	GetFolders(ctx context.Context, in *GetFoldersRequest, opts ...grpc.CallOption) (*GetFoldersResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error)
+	ResolveVariables(ctx context.Context, in *ResolveVariablesRequest, opts ...grpc.CallOption) (*ResolveVariablesResponse, error)
 }
 
 type dashboardsServiceClient struct {
@@ -119,6 +153,176 @@ func (c *dashboardsServiceClient) Search(ctx context.Context, in *SearchRequest,
 	return out, nil
 }
 
+func (c *dashboardsServiceClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVersionsResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_ListVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_GetVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffVersionsResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_DiffVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) GetSharing(ctx context.Context, in *GetSharingRequest, opts ...grpc.CallOption) (*GetSharingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharingResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_GetSharing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) UpdateSharing(ctx context.Context, in *UpdateSharingRequest, opts ...grpc.CallOption) (*UpdateSharingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSharingResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_UpdateSharing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) Star(ctx context.Context, in *StarRequest, opts ...grpc.CallOption) (*StarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StarResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_Star_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) Unstar(ctx context.Context, in *UnstarRequest, opts ...grpc.CallOption) (*UnstarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnstarResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_Unstar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) GetFolders(ctx context.Context, in *GetFoldersRequest, opts ...grpc.CallOption) (*GetFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFoldersResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_GetFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_CreateFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFolderResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_UpdateFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_DeleteFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_Import_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_Render_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsServiceClient) ResolveVariables(ctx context.Context, in *ResolveVariablesRequest, opts ...grpc.CallOption) (*ResolveVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveVariablesResponse)
+	err := c.cc.Invoke(ctx, DashboardsService_ResolveVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DashboardsServiceServer is the server API for DashboardsService service.
 // All implementations should embed UnimplementedDashboardsServiceServer
 // for forward compatibility
@@ -130,6 +334,23 @@ type DashboardsServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error)
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	Restore(context.Context, *RestoreRequest) (*RestoreResponse, error)
+	DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error)
+	GetSharing(context.Context, *GetSharingRequest) (*GetSharingResponse, error)
+	UpdateSharing(context.Context, *UpdateSharingRequest) (*UpdateSharingResponse, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	Star(context.Context, *StarRequest) (*StarResponse, error)
+	Unstar(context.Context, *UnstarRequest) (*UnstarResponse, error)
+	GetFolders(context.Context, *GetFoldersRequest) (*GetFoldersResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error)
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	Import(context.Context, *ImportRequest) (*ImportResponse, error)
+	Render(context.Context, *RenderRequest) (*RenderResponse, error)
+	ResolveVariables(context.Context, *ResolveVariablesRequest) (*ResolveVariablesResponse, error)
 }
 
 // UnimplementedDashboardsServiceServer should be embedded to have forward compatible implementations.
@@ -157,6 +378,57 @@ func (UnimplementedDashboardsServiceServer) Delete(context.Context, *DeleteReque
 func (UnimplementedDashboardsServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedDashboardsServiceServer) ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedDashboardsServiceServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedDashboardsServiceServer) Restore(context.Context, *RestoreRequest) (*RestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedDashboardsServiceServer) DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffVersions not implemented")
+}
+func (UnimplementedDashboardsServiceServer) GetSharing(context.Context, *GetSharingRequest) (*GetSharingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharing not implemented")
+}
+func (UnimplementedDashboardsServiceServer) UpdateSharing(context.Context, *UpdateSharingRequest) (*UpdateSharingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSharing not implemented")
+}
+func (UnimplementedDashboardsServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedDashboardsServiceServer) Star(context.Context, *StarRequest) (*StarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Star not implemented")
+}
+func (UnimplementedDashboardsServiceServer) Unstar(context.Context, *UnstarRequest) (*UnstarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unstar not implemented")
+}
+func (UnimplementedDashboardsServiceServer) GetFolders(context.Context, *GetFoldersRequest) (*GetFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolders not implemented")
+}
+func (UnimplementedDashboardsServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedDashboardsServiceServer) UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFolder not implemented")
+}
+func (UnimplementedDashboardsServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedDashboardsServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedDashboardsServiceServer) Import(context.Context, *ImportRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedDashboardsServiceServer) Render(context.Context, *RenderRequest) (*RenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Render not implemented")
+}
+func (UnimplementedDashboardsServiceServer) ResolveVariables(context.Context, *ResolveVariablesRequest) (*ResolveVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveVariables not implemented")
+}
 
 // UnsafeDashboardsServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DashboardsServiceServer will
@@ -295,6 +567,312 @@ func _DashboardsService_Search_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DashboardsService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_ListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).ListVersions(ctx, req.(*ListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_GetVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_DiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).DiffVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_DiffVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).DiffVersions(ctx, req.(*DiffVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_GetSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).GetSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_GetSharing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).GetSharing(ctx, req.(*GetSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_UpdateSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).UpdateSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_UpdateSharing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).UpdateSharing(ctx, req.(*UpdateSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_Star_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).Star(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_Star_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).Star(ctx, req.(*StarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_Unstar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnstarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).Unstar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_Unstar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).Unstar(ctx, req.(*UnstarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_GetFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).GetFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_GetFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).GetFolders(ctx, req.(*GetFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_UpdateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).UpdateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_UpdateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).UpdateFolder(ctx, req.(*UpdateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_DeleteFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_Import_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_Render_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).Render(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_Render_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).Render(ctx, req.(*RenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DashboardsService_ResolveVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServiceServer).ResolveVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardsService_ResolveVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServiceServer).ResolveVariables(ctx, req.(*ResolveVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DashboardsService_ServiceDesc is the grpc.ServiceDesc for DashboardsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -330,6 +908,74 @@ var DashboardsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _DashboardsService_Search_Handler,
 		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _DashboardsService_ListVersions_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _DashboardsService_GetVersion_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _DashboardsService_Restore_Handler,
+		},
+		{
+			MethodName: "DiffVersions",
+			Handler:    _DashboardsService_DiffVersions_Handler,
+		},
+		{
+			MethodName: "GetSharing",
+			Handler:    _DashboardsService_GetSharing_Handler,
+		},
+		{
+			MethodName: "UpdateSharing",
+			Handler:    _DashboardsService_UpdateSharing_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _DashboardsService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "Star",
+			Handler:    _DashboardsService_Star_Handler,
+		},
+		{
+			MethodName: "Unstar",
+			Handler:    _DashboardsService_Unstar_Handler,
+		},
+		{
+			MethodName: "GetFolders",
+			Handler:    _DashboardsService_GetFolders_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _DashboardsService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "UpdateFolder",
+			Handler:    _DashboardsService_UpdateFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _DashboardsService_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _DashboardsService_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DashboardsService_Import_Handler,
+		},
+		{
+			MethodName: "Render",
+			Handler:    _DashboardsService_Render_Handler,
+		},
+		{
+			MethodName: "ResolveVariables",
+			Handler:    _DashboardsService_ResolveVariables_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dashboards/v1/dashboards.proto",
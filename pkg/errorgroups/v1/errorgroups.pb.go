@@ -71,6 +71,153 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{0}
 }
 
+type Exposure int32
+
+const (
+	Exposure_EXPOSURE_TIME     Exposure = 0
+	Exposure_EXPOSURE_REQUESTS Exposure = 1
+)
+
+// Enum value maps for Exposure.
+var (
+	Exposure_name = map[int32]string{
+		0: "EXPOSURE_TIME",
+		1: "EXPOSURE_REQUESTS",
+	}
+	Exposure_value = map[string]int32{
+		"EXPOSURE_TIME":     0,
+		"EXPOSURE_REQUESTS": 1,
+	}
+)
+
+func (x Exposure) Enum() *Exposure {
+	p := new(Exposure)
+	*p = x
+	return p
+}
+
+func (x Exposure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Exposure) Descriptor() protoreflect.EnumDescriptor {
+	return file_errorgroups_v1_errorgroups_proto_enumTypes[1].Descriptor()
+}
+
+func (Exposure) Type() protoreflect.EnumType {
+	return &file_errorgroups_v1_errorgroups_proto_enumTypes[1]
+}
+
+func (x Exposure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Exposure.Descriptor instead.
+func (Exposure) EnumDescriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{1}
+}
+
+type HistGroupBy int32
+
+const (
+	HistGroupBy_HIST_GROUP_BY_NONE    HistGroupBy = 0
+	HistGroupBy_HIST_GROUP_BY_RELEASE HistGroupBy = 1
+	HistGroupBy_HIST_GROUP_BY_ENV     HistGroupBy = 2
+)
+
+// Enum value maps for HistGroupBy.
+var (
+	HistGroupBy_name = map[int32]string{
+		0: "HIST_GROUP_BY_NONE",
+		1: "HIST_GROUP_BY_RELEASE",
+		2: "HIST_GROUP_BY_ENV",
+	}
+	HistGroupBy_value = map[string]int32{
+		"HIST_GROUP_BY_NONE":    0,
+		"HIST_GROUP_BY_RELEASE": 1,
+		"HIST_GROUP_BY_ENV":     2,
+	}
+)
+
+func (x HistGroupBy) Enum() *HistGroupBy {
+	p := new(HistGroupBy)
+	*p = x
+	return p
+}
+
+func (x HistGroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistGroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_errorgroups_v1_errorgroups_proto_enumTypes[2].Descriptor()
+}
+
+func (HistGroupBy) Type() protoreflect.EnumType {
+	return &file_errorgroups_v1_errorgroups_proto_enumTypes[2]
+}
+
+func (x HistGroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistGroupBy.Descriptor instead.
+func (HistGroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{2}
+}
+
+type CompareStatus int32
+
+const (
+	CompareStatus_COMPARE_STATUS_UNCHANGED CompareStatus = 0
+	CompareStatus_COMPARE_STATUS_NEW       CompareStatus = 1
+	CompareStatus_COMPARE_STATUS_REGRESSED CompareStatus = 2
+	CompareStatus_COMPARE_STATUS_FIXED     CompareStatus = 3
+)
+
+// Enum value maps for CompareStatus.
+var (
+	CompareStatus_name = map[int32]string{
+		0: "COMPARE_STATUS_UNCHANGED",
+		1: "COMPARE_STATUS_NEW",
+		2: "COMPARE_STATUS_REGRESSED",
+		3: "COMPARE_STATUS_FIXED",
+	}
+	CompareStatus_value = map[string]int32{
+		"COMPARE_STATUS_UNCHANGED": 0,
+		"COMPARE_STATUS_NEW":       1,
+		"COMPARE_STATUS_REGRESSED": 2,
+		"COMPARE_STATUS_FIXED":     3,
+	}
+)
+
+func (x CompareStatus) Enum() *CompareStatus {
+	p := new(CompareStatus)
+	*p = x
+	return p
+}
+
+func (x CompareStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompareStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_errorgroups_v1_errorgroups_proto_enumTypes[3].Descriptor()
+}
+
+func (CompareStatus) Type() protoreflect.EnumType {
+	return &file_errorgroups_v1_errorgroups_proto_enumTypes[3]
+}
+
+func (x CompareStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompareStatus.Descriptor instead.
+func (CompareStatus) EnumDescriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{3}
+}
+
 type TimeRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -483,6 +630,11 @@ type GetHistRequest struct {
 	Duration  *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
 	Source    *string              `protobuf:"bytes,6,opt,name=source,proto3,oneof" json:"source,omitempty"`
 	TimeRange *TimeRange           `protobuf:"bytes,7,opt,name=time_range,json=timeRange,proto3,oneof" json:"time_range,omitempty"`
+	// Interval of buckets, one of: 1m, 10m, 1h, 24h, 168h.
+	// Monthly buckets can't be requested, since months differ in length.
+	// If not set, interval is chosen by time range.
+	Interval *durationpb.Duration `protobuf:"bytes,8,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	GroupBy  HistGroupBy          `protobuf:"varint,9,opt,name=group_by,json=groupBy,proto3,enum=errorgroups.v1.HistGroupBy" json:"group_by,omitempty"`
 }
 
 func (x *GetHistRequest) Reset() {
@@ -567,6 +719,20 @@ func (x *GetHistRequest) GetTimeRange() *TimeRange {
 	return nil
 }
 
+func (x *GetHistRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *GetHistRequest) GetGroupBy() HistGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return HistGroupBy_HIST_GROUP_BY_NONE
+}
+
 type GetHistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -574,6 +740,8 @@ type GetHistResponse struct {
 
 	Buckets  []*Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
 	Interval uint64    `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Filled instead of buckets if group_by is set.
+	Series []*Series `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
 }
 
 func (x *GetHistResponse) Reset() {
@@ -622,6 +790,68 @@ func (x *GetHistResponse) GetInterval() uint64 {
 	return 0
 }
 
+func (x *GetHistResponse) GetSeries() []*Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type Series struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Buckets []*Bucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Series) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Series) GetBuckets() []*Bucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
 type Bucket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -634,7 +864,7 @@ type Bucket struct {
 func (x *Bucket) Reset() {
 	*x = Bucket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[7]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -647,7 +877,7 @@ func (x *Bucket) String() string {
 func (*Bucket) ProtoMessage() {}
 
 func (x *Bucket) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[7]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +890,7 @@ func (x *Bucket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{7}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Bucket) GetTime() *timestamppb.Timestamp {
@@ -692,7 +922,7 @@ type GetDetailsRequest struct {
 func (x *GetDetailsRequest) Reset() {
 	*x = GetDetailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[8]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +935,7 @@ func (x *GetDetailsRequest) String() string {
 func (*GetDetailsRequest) ProtoMessage() {}
 
 func (x *GetDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[8]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +948,7 @@ func (x *GetDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{8}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetDetailsRequest) GetService() string {
@@ -774,7 +1004,7 @@ type GetDetailsResponse struct {
 func (x *GetDetailsResponse) Reset() {
 	*x = GetDetailsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[9]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +1017,7 @@ func (x *GetDetailsResponse) String() string {
 func (*GetDetailsResponse) ProtoMessage() {}
 
 func (x *GetDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[9]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +1030,7 @@ func (x *GetDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDetailsResponse.ProtoReflect.Descriptor instead.
 func (*GetDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{9}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetDetailsResponse) GetGroupHash() uint64 {
@@ -871,7 +1101,7 @@ type GetReleasesRequest struct {
 func (x *GetReleasesRequest) Reset() {
 	*x = GetReleasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[10]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -884,7 +1114,7 @@ func (x *GetReleasesRequest) String() string {
 func (*GetReleasesRequest) ProtoMessage() {}
 
 func (x *GetReleasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[10]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1127,7 @@ func (x *GetReleasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReleasesRequest.ProtoReflect.Descriptor instead.
 func (*GetReleasesRequest) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{10}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetReleasesRequest) GetService() string {
@@ -925,7 +1155,7 @@ type GetReleasesResponse struct {
 func (x *GetReleasesResponse) Reset() {
 	*x = GetReleasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[11]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -938,7 +1168,7 @@ func (x *GetReleasesResponse) String() string {
 func (*GetReleasesResponse) ProtoMessage() {}
 
 func (x *GetReleasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[11]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +1181,7 @@ func (x *GetReleasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReleasesResponse.ProtoReflect.Descriptor instead.
 func (*GetReleasesResponse) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{11}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetReleasesResponse) GetReleases() []string {
@@ -975,7 +1205,7 @@ type GetServicesRequest struct {
 func (x *GetServicesRequest) Reset() {
 	*x = GetServicesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[12]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -988,7 +1218,7 @@ func (x *GetServicesRequest) String() string {
 func (*GetServicesRequest) ProtoMessage() {}
 
 func (x *GetServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[12]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,7 +1231,7 @@ func (x *GetServicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesRequest.ProtoReflect.Descriptor instead.
 func (*GetServicesRequest) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{12}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetServicesRequest) GetQuery() string {
@@ -1043,7 +1273,7 @@ type GetServicesResponse struct {
 func (x *GetServicesResponse) Reset() {
 	*x = GetServicesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[13]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1286,7 @@ func (x *GetServicesResponse) String() string {
 func (*GetServicesResponse) ProtoMessage() {}
 
 func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[13]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1299,7 @@ func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
 func (*GetServicesResponse) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{13}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetServicesResponse) GetServices() []string {
@@ -1097,7 +1327,7 @@ type DiffByReleasesRequest struct {
 func (x *DiffByReleasesRequest) Reset() {
 	*x = DiffByReleasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[14]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1110,7 +1340,7 @@ func (x *DiffByReleasesRequest) String() string {
 func (*DiffByReleasesRequest) ProtoMessage() {}
 
 func (x *DiffByReleasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[14]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,7 +1353,7 @@ func (x *DiffByReleasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffByReleasesRequest.ProtoReflect.Descriptor instead.
 func (*DiffByReleasesRequest) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{14}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DiffByReleasesRequest) GetService() string {
@@ -1194,7 +1424,7 @@ type DiffByReleasesResponse struct {
 func (x *DiffByReleasesResponse) Reset() {
 	*x = DiffByReleasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[15]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1207,7 +1437,7 @@ func (x *DiffByReleasesResponse) String() string {
 func (*DiffByReleasesResponse) ProtoMessage() {}
 
 func (x *DiffByReleasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[15]
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +1450,7 @@ func (x *DiffByReleasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffByReleasesResponse.ProtoReflect.Descriptor instead.
 func (*DiffByReleasesResponse) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{15}
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DiffByReleasesResponse) GetTotal() uint64 {
@@ -1237,31 +1467,42 @@ func (x *DiffByReleasesResponse) GetGroups() []*DiffByReleasesResponse_Group {
 	return nil
 }
 
-type GetGroupsRequest_Filter struct {
+type CompareReleasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsNew bool `protobuf:"varint,1,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
-}
-
-func (x *GetGroupsRequest_Filter) Reset() {
-	*x = GetGroupsRequest_Filter{}
+	Service           string          `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	BaselineRelease   string          `protobuf:"bytes,2,opt,name=baseline_release,json=baselineRelease,proto3" json:"baseline_release,omitempty"`
+	CandidateRelease  string          `protobuf:"bytes,3,opt,name=candidate_release,json=candidateRelease,proto3" json:"candidate_release,omitempty"`
+	Env               *string         `protobuf:"bytes,4,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	Source            *string         `protobuf:"bytes,5,opt,name=source,proto3,oneof" json:"source,omitempty"`
+	Exposure          Exposure        `protobuf:"varint,6,opt,name=exposure,proto3,enum=errorgroups.v1.Exposure" json:"exposure,omitempty"`
+	SignificanceLevel *float64        `protobuf:"fixed64,7,opt,name=significance_level,json=significanceLevel,proto3,oneof" json:"significance_level,omitempty"`
+	Statuses          []CompareStatus `protobuf:"varint,8,rep,packed,name=statuses,proto3,enum=errorgroups.v1.CompareStatus" json:"statuses,omitempty"`
+	Limit             uint32          `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            uint32          `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
+	BaselineRequests  *uint64         `protobuf:"varint,11,opt,name=baseline_requests,json=baselineRequests,proto3,oneof" json:"baseline_requests,omitempty"`
+	CandidateRequests *uint64         `protobuf:"varint,12,opt,name=candidate_requests,json=candidateRequests,proto3,oneof" json:"candidate_requests,omitempty"`
+}
+
+func (x *CompareReleasesRequest) Reset() {
+	*x = CompareReleasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[16]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGroupsRequest_Filter) String() string {
+func (x *CompareReleasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsRequest_Filter) ProtoMessage() {}
+func (*CompareReleasesRequest) ProtoMessage() {}
 
-func (x *GetGroupsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[16]
+func (x *CompareReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,133 +1513,1066 @@ func (x *GetGroupsRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsRequest_Filter.ProtoReflect.Descriptor instead.
-func (*GetGroupsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use CompareReleasesRequest.ProtoReflect.Descriptor instead.
+func (*CompareReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetGroupsRequest_Filter) GetIsNew() bool {
+func (x *CompareReleasesRequest) GetService() string {
 	if x != nil {
-		return x.IsNew
+		return x.Service
 	}
-	return false
+	return ""
 }
 
-type GetGroupsResponse_Group struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Hash        uint64                 `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Message     string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	SeenTotal   uint64                 `protobuf:"varint,3,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
-	FirstSeenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
-	LastSeenAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
-	Source      string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+func (x *CompareReleasesRequest) GetBaselineRelease() string {
+	if x != nil {
+		return x.BaselineRelease
+	}
+	return ""
 }
 
-func (x *GetGroupsResponse_Group) Reset() {
-	*x = GetGroupsResponse_Group{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CompareReleasesRequest) GetCandidateRelease() string {
+	if x != nil {
+		return x.CandidateRelease
 	}
+	return ""
 }
 
-func (x *GetGroupsResponse_Group) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CompareReleasesRequest) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
 }
 
-func (*GetGroupsResponse_Group) ProtoMessage() {}
-
-func (x *GetGroupsResponse_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CompareReleasesRequest) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetGroupsResponse_Group.ProtoReflect.Descriptor instead.
-func (*GetGroupsResponse_Group) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{2, 0}
+func (x *CompareReleasesRequest) GetExposure() Exposure {
+	if x != nil {
+		return x.Exposure
+	}
+	return Exposure_EXPOSURE_TIME
 }
 
-func (x *GetGroupsResponse_Group) GetHash() uint64 {
+func (x *CompareReleasesRequest) GetSignificanceLevel() float64 {
+	if x != nil && x.SignificanceLevel != nil {
+		return *x.SignificanceLevel
+	}
+	return 0
+}
+
+func (x *CompareReleasesRequest) GetStatuses() []CompareStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *CompareReleasesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CompareReleasesRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *CompareReleasesRequest) GetBaselineRequests() uint64 {
+	if x != nil && x.BaselineRequests != nil {
+		return *x.BaselineRequests
+	}
+	return 0
+}
+
+func (x *CompareReleasesRequest) GetCandidateRequests() uint64 {
+	if x != nil && x.CandidateRequests != nil {
+		return *x.CandidateRequests
+	}
+	return 0
+}
+
+type CompareReleasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Baseline  *CompareReleasesResponse_Release `protobuf:"bytes,1,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Candidate *CompareReleasesResponse_Release `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Summary   *CompareReleasesResponse_Summary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Total     uint64                           `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Groups    []*CompareReleasesResponse_Group `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *CompareReleasesResponse) Reset() {
+	*x = CompareReleasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareReleasesResponse) ProtoMessage() {}
+
+func (x *CompareReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareReleasesResponse.ProtoReflect.Descriptor instead.
+func (*CompareReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompareReleasesResponse) GetBaseline() *CompareReleasesResponse_Release {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *CompareReleasesResponse) GetCandidate() *CompareReleasesResponse_Release {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *CompareReleasesResponse) GetSummary() *CompareReleasesResponse_Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *CompareReleasesResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CompareReleasesResponse) GetGroups() []*CompareReleasesResponse_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type MergeGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetHash  uint64   `protobuf:"varint,1,opt,name=target_hash,json=targetHash,proto3" json:"target_hash,omitempty"`
+	GroupHashes []uint64 `protobuf:"varint,2,rep,packed,name=group_hashes,json=groupHashes,proto3" json:"group_hashes,omitempty"`
+}
+
+func (x *MergeGroupsRequest) Reset() {
+	*x = MergeGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGroupsRequest) ProtoMessage() {}
+
+func (x *MergeGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGroupsRequest.ProtoReflect.Descriptor instead.
+func (*MergeGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MergeGroupsRequest) GetTargetHash() uint64 {
+	if x != nil {
+		return x.TargetHash
+	}
+	return 0
+}
+
+func (x *MergeGroupsRequest) GetGroupHashes() []uint64 {
+	if x != nil {
+		return x.GroupHashes
+	}
+	return nil
+}
+
+type MergeGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MergeGroupsResponse) Reset() {
+	*x = MergeGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGroupsResponse) ProtoMessage() {}
+
+func (x *MergeGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGroupsResponse.ProtoReflect.Descriptor instead.
+func (*MergeGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{20}
+}
+
+type UnmergeGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupHashes []uint64 `protobuf:"varint,1,rep,packed,name=group_hashes,json=groupHashes,proto3" json:"group_hashes,omitempty"`
+}
+
+func (x *UnmergeGroupsRequest) Reset() {
+	*x = UnmergeGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmergeGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeGroupsRequest) ProtoMessage() {}
+
+func (x *UnmergeGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeGroupsRequest.ProtoReflect.Descriptor instead.
+func (*UnmergeGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnmergeGroupsRequest) GetGroupHashes() []uint64 {
+	if x != nil {
+		return x.GroupHashes
+	}
+	return nil
+}
+
+type UnmergeGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnmergeGroupsResponse) Reset() {
+	*x = UnmergeGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmergeGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeGroupsResponse) ProtoMessage() {}
+
+func (x *UnmergeGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeGroupsResponse.ProtoReflect.Descriptor instead.
+func (*UnmergeGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{22}
+}
+
+type GetMergedGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetHash *uint64 `protobuf:"varint,1,opt,name=target_hash,json=targetHash,proto3,oneof" json:"target_hash,omitempty"`
+}
+
+func (x *GetMergedGroupsRequest) Reset() {
+	*x = GetMergedGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMergedGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergedGroupsRequest) ProtoMessage() {}
+
+func (x *GetMergedGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergedGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetMergedGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetMergedGroupsRequest) GetTargetHash() uint64 {
+	if x != nil && x.TargetHash != nil {
+		return *x.TargetHash
+	}
+	return 0
+}
+
+type GetMergedGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Merges []*GetMergedGroupsResponse_Merge `protobuf:"bytes,1,rep,name=merges,proto3" json:"merges,omitempty"`
+}
+
+func (x *GetMergedGroupsResponse) Reset() {
+	*x = GetMergedGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMergedGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergedGroupsResponse) ProtoMessage() {}
+
+func (x *GetMergedGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergedGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetMergedGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMergedGroupsResponse) GetMerges() []*GetMergedGroupsResponse_Merge {
+	if x != nil {
+		return x.Merges
+	}
+	return nil
+}
+
+type GetSimilarGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service       string   `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	GroupHash     uint64   `protobuf:"varint,2,opt,name=group_hash,json=groupHash,proto3" json:"group_hash,omitempty"`
+	Env           *string  `protobuf:"bytes,3,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	Limit         uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	MinSimilarity *float64 `protobuf:"fixed64,5,opt,name=min_similarity,json=minSimilarity,proto3,oneof" json:"min_similarity,omitempty"`
+}
+
+func (x *GetSimilarGroupsRequest) Reset() {
+	*x = GetSimilarGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimilarGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarGroupsRequest) ProtoMessage() {}
+
+func (x *GetSimilarGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSimilarGroupsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetSimilarGroupsRequest) GetGroupHash() uint64 {
+	if x != nil {
+		return x.GroupHash
+	}
+	return 0
+}
+
+func (x *GetSimilarGroupsRequest) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *GetSimilarGroupsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSimilarGroupsRequest) GetMinSimilarity() float64 {
+	if x != nil && x.MinSimilarity != nil {
+		return *x.MinSimilarity
+	}
+	return 0
+}
+
+type GetSimilarGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*GetSimilarGroupsResponse_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *GetSimilarGroupsResponse) Reset() {
+	*x = GetSimilarGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimilarGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarGroupsResponse) ProtoMessage() {}
+
+func (x *GetSimilarGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilarGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSimilarGroupsResponse) GetGroups() []*GetSimilarGroupsResponse_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupsRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsNew bool `protobuf:"varint,1,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
+}
+
+func (x *GetGroupsRequest_Filter) Reset() {
+	*x = GetGroupsRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsRequest_Filter) ProtoMessage() {}
+
+func (x *GetGroupsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*GetGroupsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetGroupsRequest_Filter) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+type GetGroupsResponse_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash        uint64                 `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Message     string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SeenTotal   uint64                 `protobuf:"varint,3,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
+	FirstSeenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	Source      string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *GetGroupsResponse_Group) Reset() {
+	*x = GetGroupsResponse_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupsResponse_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsResponse_Group) ProtoMessage() {}
+
+func (x *GetGroupsResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsResponse_Group.ProtoReflect.Descriptor instead.
+func (*GetGroupsResponse_Group) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *GetGroupsResponse_Group) GetHash() uint64 {
+	if x != nil {
+		return x.Hash
+	}
+	return 0
+}
+
+func (x *GetGroupsResponse_Group) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetGroupsResponse_Group) GetSeenTotal() uint64 {
+	if x != nil {
+		return x.SeenTotal
+	}
+	return 0
+}
+
+func (x *GetGroupsResponse_Group) GetFirstSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return nil
+}
+
+func (x *GetGroupsResponse_Group) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *GetGroupsResponse_Group) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type GetTopGroupsResponse_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      uint64 `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Source    string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	SeenTotal uint64 `protobuf:"varint,4,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
+}
+
+func (x *GetTopGroupsResponse_Group) Reset() {
+	*x = GetTopGroupsResponse_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopGroupsResponse_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopGroupsResponse_Group) ProtoMessage() {}
+
+func (x *GetTopGroupsResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopGroupsResponse_Group.ProtoReflect.Descriptor instead.
+func (*GetTopGroupsResponse_Group) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *GetTopGroupsResponse_Group) GetHash() uint64 {
+	if x != nil {
+		return x.Hash
+	}
+	return 0
+}
+
+func (x *GetTopGroupsResponse_Group) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTopGroupsResponse_Group) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetTopGroupsResponse_Group) GetSeenTotal() uint64 {
+	if x != nil {
+		return x.SeenTotal
+	}
+	return 0
+}
+
+type GetDetailsResponse_Distribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value   string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Percent uint64 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *GetDetailsResponse_Distribution) Reset() {
+	*x = GetDetailsResponse_Distribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDetailsResponse_Distribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDetailsResponse_Distribution) ProtoMessage() {}
+
+func (x *GetDetailsResponse_Distribution) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDetailsResponse_Distribution.ProtoReflect.Descriptor instead.
+func (*GetDetailsResponse_Distribution) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetDetailsResponse_Distribution) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GetDetailsResponse_Distribution) GetPercent() uint64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type GetDetailsResponse_Distributions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ByEnv     []*GetDetailsResponse_Distribution `protobuf:"bytes,1,rep,name=by_env,json=byEnv,proto3" json:"by_env,omitempty"`
+	ByRelease []*GetDetailsResponse_Distribution `protobuf:"bytes,2,rep,name=by_release,json=byRelease,proto3" json:"by_release,omitempty"`
+	BySource  []*GetDetailsResponse_Distribution `protobuf:"bytes,3,rep,name=by_source,json=bySource,proto3" json:"by_source,omitempty"`
+	ByService []*GetDetailsResponse_Distribution `protobuf:"bytes,4,rep,name=by_service,json=byService,proto3" json:"by_service,omitempty"`
+}
+
+func (x *GetDetailsResponse_Distributions) Reset() {
+	*x = GetDetailsResponse_Distributions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDetailsResponse_Distributions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDetailsResponse_Distributions) ProtoMessage() {}
+
+func (x *GetDetailsResponse_Distributions) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDetailsResponse_Distributions.ProtoReflect.Descriptor instead.
+func (*GetDetailsResponse_Distributions) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *GetDetailsResponse_Distributions) GetByEnv() []*GetDetailsResponse_Distribution {
+	if x != nil {
+		return x.ByEnv
+	}
+	return nil
+}
+
+func (x *GetDetailsResponse_Distributions) GetByRelease() []*GetDetailsResponse_Distribution {
+	if x != nil {
+		return x.ByRelease
+	}
+	return nil
+}
+
+func (x *GetDetailsResponse_Distributions) GetBySource() []*GetDetailsResponse_Distribution {
+	if x != nil {
+		return x.BySource
+	}
+	return nil
+}
+
+func (x *GetDetailsResponse_Distributions) GetByService() []*GetDetailsResponse_Distribution {
+	if x != nil {
+		return x.ByService
+	}
+	return nil
+}
+
+type DiffByReleasesResponse_ReleaseInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeenTotal uint64 `protobuf:"varint,1,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
+}
+
+func (x *DiffByReleasesResponse_ReleaseInfo) Reset() {
+	*x = DiffByReleasesResponse_ReleaseInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffByReleasesResponse_ReleaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffByReleasesResponse_ReleaseInfo) ProtoMessage() {}
+
+func (x *DiffByReleasesResponse_ReleaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffByReleasesResponse_ReleaseInfo.ProtoReflect.Descriptor instead.
+func (*DiffByReleasesResponse_ReleaseInfo) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *DiffByReleasesResponse_ReleaseInfo) GetSeenTotal() uint64 {
 	if x != nil {
-		return x.Hash
+		return x.SeenTotal
 	}
 	return 0
 }
 
-func (x *GetGroupsResponse_Group) GetMessage() string {
-	if x != nil {
-		return x.Message
+type DiffByReleasesResponse_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash         uint64                                         `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Message      string                                         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FirstSeenAt  *timestamppb.Timestamp                         `protobuf:"bytes,3,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt   *timestamppb.Timestamp                         `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	Source       string                                         `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	ReleaseInfos map[string]*DiffByReleasesResponse_ReleaseInfo `protobuf:"bytes,6,rep,name=release_infos,json=releaseInfos,proto3" json:"release_infos,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DiffByReleasesResponse_Group) Reset() {
+	*x = DiffByReleasesResponse_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetGroupsResponse_Group) GetSeenTotal() uint64 {
+func (x *DiffByReleasesResponse_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffByReleasesResponse_Group) ProtoMessage() {}
+
+func (x *DiffByReleasesResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffByReleasesResponse_Group.ProtoReflect.Descriptor instead.
+func (*DiffByReleasesResponse_Group) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *DiffByReleasesResponse_Group) GetHash() uint64 {
 	if x != nil {
-		return x.SeenTotal
+		return x.Hash
 	}
 	return 0
 }
 
-func (x *GetGroupsResponse_Group) GetFirstSeenAt() *timestamppb.Timestamp {
+func (x *DiffByReleasesResponse_Group) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DiffByReleasesResponse_Group) GetFirstSeenAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FirstSeenAt
 	}
 	return nil
 }
 
-func (x *GetGroupsResponse_Group) GetLastSeenAt() *timestamppb.Timestamp {
+func (x *DiffByReleasesResponse_Group) GetLastSeenAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.LastSeenAt
 	}
 	return nil
 }
 
-func (x *GetGroupsResponse_Group) GetSource() string {
+func (x *DiffByReleasesResponse_Group) GetSource() string {
 	if x != nil {
 		return x.Source
 	}
 	return ""
 }
 
-type GetTopGroupsResponse_Group struct {
+func (x *DiffByReleasesResponse_Group) GetReleaseInfos() map[string]*DiffByReleasesResponse_ReleaseInfo {
+	if x != nil {
+		return x.ReleaseInfos
+	}
+	return nil
+}
+
+type CompareReleasesResponse_Release struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash      uint64 `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Source    string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	SeenTotal uint64 `protobuf:"varint,4,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
+	Release     string                 `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	SeenTotal   uint64                 `protobuf:"varint,2,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
+	Exposure    float64                `protobuf:"fixed64,3,opt,name=exposure,proto3" json:"exposure,omitempty"`
+	FirstSeenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
 }
 
-func (x *GetTopGroupsResponse_Group) Reset() {
-	*x = GetTopGroupsResponse_Group{}
+func (x *CompareReleasesResponse_Release) Reset() {
+	*x = CompareReleasesResponse_Release{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[18]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTopGroupsResponse_Group) String() string {
+func (x *CompareReleasesResponse_Release) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopGroupsResponse_Group) ProtoMessage() {}
+func (*CompareReleasesResponse_Release) ProtoMessage() {}
 
-func (x *GetTopGroupsResponse_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[18]
+func (x *CompareReleasesResponse_Release) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,65 +2583,74 @@ func (x *GetTopGroupsResponse_Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopGroupsResponse_Group.ProtoReflect.Descriptor instead.
-func (*GetTopGroupsResponse_Group) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use CompareReleasesResponse_Release.ProtoReflect.Descriptor instead.
+func (*CompareReleasesResponse_Release) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *GetTopGroupsResponse_Group) GetHash() uint64 {
+func (x *CompareReleasesResponse_Release) GetRelease() string {
 	if x != nil {
-		return x.Hash
+		return x.Release
+	}
+	return ""
+}
+
+func (x *CompareReleasesResponse_Release) GetSeenTotal() uint64 {
+	if x != nil {
+		return x.SeenTotal
 	}
 	return 0
 }
 
-func (x *GetTopGroupsResponse_Group) GetMessage() string {
+func (x *CompareReleasesResponse_Release) GetExposure() float64 {
 	if x != nil {
-		return x.Message
+		return x.Exposure
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTopGroupsResponse_Group) GetSource() string {
+func (x *CompareReleasesResponse_Release) GetFirstSeenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Source
+		return x.FirstSeenAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GetTopGroupsResponse_Group) GetSeenTotal() uint64 {
+func (x *CompareReleasesResponse_Release) GetLastSeenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SeenTotal
+		return x.LastSeenAt
 	}
-	return 0
+	return nil
 }
 
-type GetDetailsResponse_Distribution struct {
+type CompareReleasesResponse_Summary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value   string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Percent uint64 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	New       uint64 `protobuf:"varint,1,opt,name=new,proto3" json:"new,omitempty"`
+	Regressed uint64 `protobuf:"varint,2,opt,name=regressed,proto3" json:"regressed,omitempty"`
+	Fixed     uint64 `protobuf:"varint,3,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Unchanged uint64 `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
 }
 
-func (x *GetDetailsResponse_Distribution) Reset() {
-	*x = GetDetailsResponse_Distribution{}
+func (x *CompareReleasesResponse_Summary) Reset() {
+	*x = CompareReleasesResponse_Summary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[19]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDetailsResponse_Distribution) String() string {
+func (x *CompareReleasesResponse_Summary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDetailsResponse_Distribution) ProtoMessage() {}
+func (*CompareReleasesResponse_Summary) ProtoMessage() {}
 
-func (x *GetDetailsResponse_Distribution) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[19]
+func (x *CompareReleasesResponse_Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1478,53 +2661,74 @@ func (x *GetDetailsResponse_Distribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDetailsResponse_Distribution.ProtoReflect.Descriptor instead.
-func (*GetDetailsResponse_Distribution) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use CompareReleasesResponse_Summary.ProtoReflect.Descriptor instead.
+func (*CompareReleasesResponse_Summary) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{18, 1}
 }
 
-func (x *GetDetailsResponse_Distribution) GetValue() string {
+func (x *CompareReleasesResponse_Summary) GetNew() uint64 {
 	if x != nil {
-		return x.Value
+		return x.New
 	}
-	return ""
+	return 0
 }
 
-func (x *GetDetailsResponse_Distribution) GetPercent() uint64 {
+func (x *CompareReleasesResponse_Summary) GetRegressed() uint64 {
 	if x != nil {
-		return x.Percent
+		return x.Regressed
 	}
 	return 0
 }
 
-type GetDetailsResponse_Distributions struct {
+func (x *CompareReleasesResponse_Summary) GetFixed() uint64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *CompareReleasesResponse_Summary) GetUnchanged() uint64 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+type CompareReleasesResponse_Group struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ByEnv     []*GetDetailsResponse_Distribution `protobuf:"bytes,1,rep,name=by_env,json=byEnv,proto3" json:"by_env,omitempty"`
-	ByRelease []*GetDetailsResponse_Distribution `protobuf:"bytes,2,rep,name=by_release,json=byRelease,proto3" json:"by_release,omitempty"`
-	BySource  []*GetDetailsResponse_Distribution `protobuf:"bytes,3,rep,name=by_source,json=bySource,proto3" json:"by_source,omitempty"`
-	ByService []*GetDetailsResponse_Distribution `protobuf:"bytes,4,rep,name=by_service,json=byService,proto3" json:"by_service,omitempty"`
-}
-
-func (x *GetDetailsResponse_Distributions) Reset() {
-	*x = GetDetailsResponse_Distributions{}
+	Hash               uint64        `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Message            string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Source             string        `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	BaselineSeenTotal  uint64        `protobuf:"varint,4,opt,name=baseline_seen_total,json=baselineSeenTotal,proto3" json:"baseline_seen_total,omitempty"`
+	CandidateSeenTotal uint64        `protobuf:"varint,5,opt,name=candidate_seen_total,json=candidateSeenTotal,proto3" json:"candidate_seen_total,omitempty"`
+	BaselineRate       float64       `protobuf:"fixed64,6,opt,name=baseline_rate,json=baselineRate,proto3" json:"baseline_rate,omitempty"`
+	CandidateRate      float64       `protobuf:"fixed64,7,opt,name=candidate_rate,json=candidateRate,proto3" json:"candidate_rate,omitempty"`
+	RateRatio          float64       `protobuf:"fixed64,8,opt,name=rate_ratio,json=rateRatio,proto3" json:"rate_ratio,omitempty"`
+	PValue             float64       `protobuf:"fixed64,9,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	Significant        bool          `protobuf:"varint,10,opt,name=significant,proto3" json:"significant,omitempty"`
+	Status             CompareStatus `protobuf:"varint,11,opt,name=status,proto3,enum=errorgroups.v1.CompareStatus" json:"status,omitempty"`
+}
+
+func (x *CompareReleasesResponse_Group) Reset() {
+	*x = CompareReleasesResponse_Group{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[20]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDetailsResponse_Distributions) String() string {
+func (x *CompareReleasesResponse_Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDetailsResponse_Distributions) ProtoMessage() {}
+func (*CompareReleasesResponse_Group) ProtoMessage() {}
 
-func (x *GetDetailsResponse_Distributions) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[20]
+func (x *CompareReleasesResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1535,64 +2739,116 @@ func (x *GetDetailsResponse_Distributions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDetailsResponse_Distributions.ProtoReflect.Descriptor instead.
-func (*GetDetailsResponse_Distributions) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use CompareReleasesResponse_Group.ProtoReflect.Descriptor instead.
+func (*CompareReleasesResponse_Group) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{18, 2}
 }
 
-func (x *GetDetailsResponse_Distributions) GetByEnv() []*GetDetailsResponse_Distribution {
+func (x *CompareReleasesResponse_Group) GetHash() uint64 {
 	if x != nil {
-		return x.ByEnv
+		return x.Hash
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDetailsResponse_Distributions) GetByRelease() []*GetDetailsResponse_Distribution {
+func (x *CompareReleasesResponse_Group) GetMessage() string {
 	if x != nil {
-		return x.ByRelease
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDetailsResponse_Distributions) GetBySource() []*GetDetailsResponse_Distribution {
+func (x *CompareReleasesResponse_Group) GetSource() string {
 	if x != nil {
-		return x.BySource
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDetailsResponse_Distributions) GetByService() []*GetDetailsResponse_Distribution {
+func (x *CompareReleasesResponse_Group) GetBaselineSeenTotal() uint64 {
 	if x != nil {
-		return x.ByService
+		return x.BaselineSeenTotal
 	}
-	return nil
+	return 0
 }
 
-type DiffByReleasesResponse_ReleaseInfo struct {
+func (x *CompareReleasesResponse_Group) GetCandidateSeenTotal() uint64 {
+	if x != nil {
+		return x.CandidateSeenTotal
+	}
+	return 0
+}
+
+func (x *CompareReleasesResponse_Group) GetBaselineRate() float64 {
+	if x != nil {
+		return x.BaselineRate
+	}
+	return 0
+}
+
+func (x *CompareReleasesResponse_Group) GetCandidateRate() float64 {
+	if x != nil {
+		return x.CandidateRate
+	}
+	return 0
+}
+
+func (x *CompareReleasesResponse_Group) GetRateRatio() float64 {
+	if x != nil {
+		return x.RateRatio
+	}
+	return 0
+}
+
+func (x *CompareReleasesResponse_Group) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *CompareReleasesResponse_Group) GetSignificant() bool {
+	if x != nil {
+		return x.Significant
+	}
+	return false
+}
+
+func (x *CompareReleasesResponse_Group) GetStatus() CompareStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompareStatus_COMPARE_STATUS_UNCHANGED
+}
+
+type GetMergedGroupsResponse_Merge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SeenTotal uint64 `protobuf:"varint,1,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
+	GroupHash  uint64                 `protobuf:"varint,1,opt,name=group_hash,json=groupHash,proto3" json:"group_hash,omitempty"`
+	TargetHash uint64                 `protobuf:"varint,2,opt,name=target_hash,json=targetHash,proto3" json:"target_hash,omitempty"`
+	CreatedBy  string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *DiffByReleasesResponse_ReleaseInfo) Reset() {
-	*x = DiffByReleasesResponse_ReleaseInfo{}
+func (x *GetMergedGroupsResponse_Merge) Reset() {
+	*x = GetMergedGroupsResponse_Merge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[22]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiffByReleasesResponse_ReleaseInfo) String() string {
+func (x *GetMergedGroupsResponse_Merge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffByReleasesResponse_ReleaseInfo) ProtoMessage() {}
+func (*GetMergedGroupsResponse_Merge) ProtoMessage() {}
 
-func (x *DiffByReleasesResponse_ReleaseInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[22]
+func (x *GetMergedGroupsResponse_Merge) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,51 +2856,71 @@ func (x *DiffByReleasesResponse_ReleaseInfo) ProtoReflect() protoreflect.Message
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergedGroupsResponse_Merge.ProtoReflect.Descriptor instead.
+func (*GetMergedGroupsResponse_Merge) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *GetMergedGroupsResponse_Merge) GetGroupHash() uint64 {
+	if x != nil {
+		return x.GroupHash
+	}
+	return 0
+}
+
+func (x *GetMergedGroupsResponse_Merge) GetTargetHash() uint64 {
+	if x != nil {
+		return x.TargetHash
+	}
+	return 0
 }
 
-// Deprecated: Use DiffByReleasesResponse_ReleaseInfo.ProtoReflect.Descriptor instead.
-func (*DiffByReleasesResponse_ReleaseInfo) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{15, 0}
+func (x *GetMergedGroupsResponse_Merge) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (x *DiffByReleasesResponse_ReleaseInfo) GetSeenTotal() uint64 {
+func (x *GetMergedGroupsResponse_Merge) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SeenTotal
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type DiffByReleasesResponse_Group struct {
+type GetSimilarGroupsResponse_Group struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash         uint64                                         `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Message      string                                         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	FirstSeenAt  *timestamppb.Timestamp                         `protobuf:"bytes,3,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
-	LastSeenAt   *timestamppb.Timestamp                         `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
-	Source       string                                         `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
-	ReleaseInfos map[string]*DiffByReleasesResponse_ReleaseInfo `protobuf:"bytes,6,rep,name=release_infos,json=releaseInfos,proto3" json:"release_infos,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Hash       uint64  `protobuf:"varint,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Message    string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Source     string  `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	SeenTotal  uint64  `protobuf:"varint,4,opt,name=seen_total,json=seenTotal,proto3" json:"seen_total,omitempty"`
+	Similarity float64 `protobuf:"fixed64,5,opt,name=similarity,proto3" json:"similarity,omitempty"`
 }
 
-func (x *DiffByReleasesResponse_Group) Reset() {
-	*x = DiffByReleasesResponse_Group{}
+func (x *GetSimilarGroupsResponse_Group) Reset() {
+	*x = GetSimilarGroupsResponse_Group{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[23]
+		mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiffByReleasesResponse_Group) String() string {
+func (x *GetSimilarGroupsResponse_Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffByReleasesResponse_Group) ProtoMessage() {}
+func (*GetSimilarGroupsResponse_Group) ProtoMessage() {}
 
-func (x *DiffByReleasesResponse_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[23]
+func (x *GetSimilarGroupsResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_errorgroups_v1_errorgroups_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,51 +2931,44 @@ func (x *DiffByReleasesResponse_Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffByReleasesResponse_Group.ProtoReflect.Descriptor instead.
-func (*DiffByReleasesResponse_Group) Descriptor() ([]byte, []int) {
-	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{15, 1}
+// Deprecated: Use GetSimilarGroupsResponse_Group.ProtoReflect.Descriptor instead.
+func (*GetSimilarGroupsResponse_Group) Descriptor() ([]byte, []int) {
+	return file_errorgroups_v1_errorgroups_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *DiffByReleasesResponse_Group) GetHash() uint64 {
+func (x *GetSimilarGroupsResponse_Group) GetHash() uint64 {
 	if x != nil {
 		return x.Hash
 	}
 	return 0
 }
 
-func (x *DiffByReleasesResponse_Group) GetMessage() string {
+func (x *GetSimilarGroupsResponse_Group) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *DiffByReleasesResponse_Group) GetFirstSeenAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FirstSeenAt
-	}
-	return nil
-}
-
-func (x *DiffByReleasesResponse_Group) GetLastSeenAt() *timestamppb.Timestamp {
+func (x *GetSimilarGroupsResponse_Group) GetSource() string {
 	if x != nil {
-		return x.LastSeenAt
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *DiffByReleasesResponse_Group) GetSource() string {
+func (x *GetSimilarGroupsResponse_Group) GetSeenTotal() uint64 {
 	if x != nil {
-		return x.Source
+		return x.SeenTotal
 	}
-	return ""
+	return 0
 }
 
-func (x *DiffByReleasesResponse_Group) GetReleaseInfos() map[string]*DiffByReleasesResponse_ReleaseInfo {
+func (x *GetSimilarGroupsResponse_Group) GetSimilarity() float64 {
 	if x != nil {
-		return x.ReleaseInfos
+		return x.Similarity
 	}
-	return nil
+	return 0
 }
 
 var File_errorgroups_v1_errorgroups_proto protoreflect.FileDescriptor
@@ -1810,7 +3079,7 @@ var file_errorgroups_v1_errorgroups_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
 	0x65, 0x65, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x73, 0x65, 0x65, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xfb, 0x02, 0x0a, 0x0e, 0x47,
+	0x09, 0x73, 0x65, 0x65, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xfc, 0x03, 0x0a, 0x0e, 0x47,
 	0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
 	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
 	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
@@ -1829,18 +3098,34 @@ var file_errorgroups_v1_errorgroups_proto_rawDesc = []byte{
 	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
 	0x67, 0x65, 0x48, 0x06, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x06, 0x0a,
-	0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x06, 0x42, 0x75, 0x63,
+	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x07, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x36,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x06, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x06, 0x42, 0x75, 0x63,
 	0x6b, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
@@ -1986,56 +3271,270 @@ var file_errorgroups_v1_errorgroups_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66,
 	0x66, 0x42, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x3f, 0x0a, 0x05, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x10, 0x02, 0x32, 0x81, 0x05, 0x0a, 0x12,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
-	0x20, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x12, 0x1e,
-	0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x55, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x21, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xce, 0x04, 0x0a, 0x16, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x65,
+	0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72,
+	0x65, 0x12, 0x32, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52,
+	0x11, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30,
+	0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x10, 0x62, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x32, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x11,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xba, 0x08, 0x0a, 0x17,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x4d, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
 	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x22, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0e,
-	0x44, 0x69, 0x66, 0x66, 0x42, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x25,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x45, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0xdc, 0x01, 0x0a, 0x07,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x65, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x0d,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x1a, 0x6d, 0x0a, 0x07, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x1a, 0x8c, 0x03, 0x0a, 0x05, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x65, 0x65, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e,
+	0x74, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x58, 0x0a, 0x12, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x14, 0x55, 0x6e, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x84, 0x02,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x73,
+	0x1a, 0xa1, 0x01, 0x0a, 0x05, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x88,
+	0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0xf1, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x1a, 0x8c, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x65, 0x6e, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x2a, 0x3f, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54,
+	0x10, 0x02, 0x2a, 0x34, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x11,
+	0x0a, 0x0d, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x53, 0x10, 0x01, 0x2a, 0x57, 0x0a, 0x0b, 0x48, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x49, 0x53, 0x54, 0x5f,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x48, 0x49, 0x53, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59,
+	0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x49,
+	0x53, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x45, 0x4e, 0x56, 0x10,
+	0x02, 0x2a, 0x7d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x50,
+	0x41, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x47, 0x52, 0x45,
+	0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x03,
+	0x32, 0xf0, 0x08, 0x0a, 0x12, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x61, 0x0a, 0x0e, 0x44, 0x69, 0x66, 0x66, 0x42, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x42, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x42, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0d, 0x55, 0x6e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x27,
 	0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x42, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x42, 0x79, 0x52, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a,
-	0x6f, 0x6e, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x73, 0x65, 0x71, 0x2d, 0x75, 0x69, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x76, 0x31,
-	0x3b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x73, 0x65, 0x71, 0x2d, 0x75,
+	0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2050,89 +3549,134 @@ func file_errorgroups_v1_errorgroups_proto_rawDescGZIP() []byte {
 	return file_errorgroups_v1_errorgroups_proto_rawDescData
 }
 
-var file_errorgroups_v1_errorgroups_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_errorgroups_v1_errorgroups_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_errorgroups_v1_errorgroups_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_errorgroups_v1_errorgroups_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_errorgroups_v1_errorgroups_proto_goTypes = []any{
 	(Order)(0),                                 // 0: errorgroups.v1.Order
-	(*TimeRange)(nil),                          // 1: errorgroups.v1.TimeRange
-	(*GetGroupsRequest)(nil),                   // 2: errorgroups.v1.GetGroupsRequest
-	(*GetGroupsResponse)(nil),                  // 3: errorgroups.v1.GetGroupsResponse
-	(*GetTopGroupsRequest)(nil),                // 4: errorgroups.v1.GetTopGroupsRequest
-	(*GetTopGroupsResponse)(nil),               // 5: errorgroups.v1.GetTopGroupsResponse
-	(*GetHistRequest)(nil),                     // 6: errorgroups.v1.GetHistRequest
-	(*GetHistResponse)(nil),                    // 7: errorgroups.v1.GetHistResponse
-	(*Bucket)(nil),                             // 8: errorgroups.v1.Bucket
-	(*GetDetailsRequest)(nil),                  // 9: errorgroups.v1.GetDetailsRequest
-	(*GetDetailsResponse)(nil),                 // 10: errorgroups.v1.GetDetailsResponse
-	(*GetReleasesRequest)(nil),                 // 11: errorgroups.v1.GetReleasesRequest
-	(*GetReleasesResponse)(nil),                // 12: errorgroups.v1.GetReleasesResponse
-	(*GetServicesRequest)(nil),                 // 13: errorgroups.v1.GetServicesRequest
-	(*GetServicesResponse)(nil),                // 14: errorgroups.v1.GetServicesResponse
-	(*DiffByReleasesRequest)(nil),              // 15: errorgroups.v1.DiffByReleasesRequest
-	(*DiffByReleasesResponse)(nil),             // 16: errorgroups.v1.DiffByReleasesResponse
-	(*GetGroupsRequest_Filter)(nil),            // 17: errorgroups.v1.GetGroupsRequest.Filter
-	(*GetGroupsResponse_Group)(nil),            // 18: errorgroups.v1.GetGroupsResponse.Group
-	(*GetTopGroupsResponse_Group)(nil),         // 19: errorgroups.v1.GetTopGroupsResponse.Group
-	(*GetDetailsResponse_Distribution)(nil),    // 20: errorgroups.v1.GetDetailsResponse.Distribution
-	(*GetDetailsResponse_Distributions)(nil),   // 21: errorgroups.v1.GetDetailsResponse.Distributions
-	nil,                                        // 22: errorgroups.v1.GetDetailsResponse.LogTagsEntry
-	(*DiffByReleasesResponse_ReleaseInfo)(nil), // 23: errorgroups.v1.DiffByReleasesResponse.ReleaseInfo
-	(*DiffByReleasesResponse_Group)(nil),       // 24: errorgroups.v1.DiffByReleasesResponse.Group
-	nil,                                        // 25: errorgroups.v1.DiffByReleasesResponse.Group.ReleaseInfosEntry
-	(*durationpb.Duration)(nil),                // 26: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),              // 27: google.protobuf.Timestamp
+	(Exposure)(0),                              // 1: errorgroups.v1.Exposure
+	(HistGroupBy)(0),                           // 2: errorgroups.v1.HistGroupBy
+	(CompareStatus)(0),                         // 3: errorgroups.v1.CompareStatus
+	(*TimeRange)(nil),                          // 4: errorgroups.v1.TimeRange
+	(*GetGroupsRequest)(nil),                   // 5: errorgroups.v1.GetGroupsRequest
+	(*GetGroupsResponse)(nil),                  // 6: errorgroups.v1.GetGroupsResponse
+	(*GetTopGroupsRequest)(nil),                // 7: errorgroups.v1.GetTopGroupsRequest
+	(*GetTopGroupsResponse)(nil),               // 8: errorgroups.v1.GetTopGroupsResponse
+	(*GetHistRequest)(nil),                     // 9: errorgroups.v1.GetHistRequest
+	(*GetHistResponse)(nil),                    // 10: errorgroups.v1.GetHistResponse
+	(*Series)(nil),                             // 11: errorgroups.v1.Series
+	(*Bucket)(nil),                             // 12: errorgroups.v1.Bucket
+	(*GetDetailsRequest)(nil),                  // 13: errorgroups.v1.GetDetailsRequest
+	(*GetDetailsResponse)(nil),                 // 14: errorgroups.v1.GetDetailsResponse
+	(*GetReleasesRequest)(nil),                 // 15: errorgroups.v1.GetReleasesRequest
+	(*GetReleasesResponse)(nil),                // 16: errorgroups.v1.GetReleasesResponse
+	(*GetServicesRequest)(nil),                 // 17: errorgroups.v1.GetServicesRequest
+	(*GetServicesResponse)(nil),                // 18: errorgroups.v1.GetServicesResponse
+	(*DiffByReleasesRequest)(nil),              // 19: errorgroups.v1.DiffByReleasesRequest
+	(*DiffByReleasesResponse)(nil),             // 20: errorgroups.v1.DiffByReleasesResponse
+	(*CompareReleasesRequest)(nil),             // 21: errorgroups.v1.CompareReleasesRequest
+	(*CompareReleasesResponse)(nil),            // 22: errorgroups.v1.CompareReleasesResponse
+	(*MergeGroupsRequest)(nil),                 // 23: errorgroups.v1.MergeGroupsRequest
+	(*MergeGroupsResponse)(nil),                // 24: errorgroups.v1.MergeGroupsResponse
+	(*UnmergeGroupsRequest)(nil),               // 25: errorgroups.v1.UnmergeGroupsRequest
+	(*UnmergeGroupsResponse)(nil),              // 26: errorgroups.v1.UnmergeGroupsResponse
+	(*GetMergedGroupsRequest)(nil),             // 27: errorgroups.v1.GetMergedGroupsRequest
+	(*GetMergedGroupsResponse)(nil),            // 28: errorgroups.v1.GetMergedGroupsResponse
+	(*GetSimilarGroupsRequest)(nil),            // 29: errorgroups.v1.GetSimilarGroupsRequest
+	(*GetSimilarGroupsResponse)(nil),           // 30: errorgroups.v1.GetSimilarGroupsResponse
+	(*GetGroupsRequest_Filter)(nil),            // 31: errorgroups.v1.GetGroupsRequest.Filter
+	(*GetGroupsResponse_Group)(nil),            // 32: errorgroups.v1.GetGroupsResponse.Group
+	(*GetTopGroupsResponse_Group)(nil),         // 33: errorgroups.v1.GetTopGroupsResponse.Group
+	(*GetDetailsResponse_Distribution)(nil),    // 34: errorgroups.v1.GetDetailsResponse.Distribution
+	(*GetDetailsResponse_Distributions)(nil),   // 35: errorgroups.v1.GetDetailsResponse.Distributions
+	nil,                                        // 36: errorgroups.v1.GetDetailsResponse.LogTagsEntry
+	(*DiffByReleasesResponse_ReleaseInfo)(nil), // 37: errorgroups.v1.DiffByReleasesResponse.ReleaseInfo
+	(*DiffByReleasesResponse_Group)(nil),       // 38: errorgroups.v1.DiffByReleasesResponse.Group
+	nil,                                        // 39: errorgroups.v1.DiffByReleasesResponse.Group.ReleaseInfosEntry
+	(*CompareReleasesResponse_Release)(nil),    // 40: errorgroups.v1.CompareReleasesResponse.Release
+	(*CompareReleasesResponse_Summary)(nil),    // 41: errorgroups.v1.CompareReleasesResponse.Summary
+	(*CompareReleasesResponse_Group)(nil),      // 42: errorgroups.v1.CompareReleasesResponse.Group
+	(*GetMergedGroupsResponse_Merge)(nil),      // 43: errorgroups.v1.GetMergedGroupsResponse.Merge
+	(*GetSimilarGroupsResponse_Group)(nil),     // 44: errorgroups.v1.GetSimilarGroupsResponse.Group
+	(*durationpb.Duration)(nil),                // 45: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),              // 46: google.protobuf.Timestamp
 }
 var file_errorgroups_v1_errorgroups_proto_depIdxs = []int32{
-	26, // 0: errorgroups.v1.TimeRange.duration:type_name -> google.protobuf.Duration
-	27, // 1: errorgroups.v1.TimeRange.from:type_name -> google.protobuf.Timestamp
-	27, // 2: errorgroups.v1.TimeRange.to:type_name -> google.protobuf.Timestamp
-	26, // 3: errorgroups.v1.GetGroupsRequest.duration:type_name -> google.protobuf.Duration
+	45, // 0: errorgroups.v1.TimeRange.duration:type_name -> google.protobuf.Duration
+	46, // 1: errorgroups.v1.TimeRange.from:type_name -> google.protobuf.Timestamp
+	46, // 2: errorgroups.v1.TimeRange.to:type_name -> google.protobuf.Timestamp
+	45, // 3: errorgroups.v1.GetGroupsRequest.duration:type_name -> google.protobuf.Duration
 	0,  // 4: errorgroups.v1.GetGroupsRequest.order:type_name -> errorgroups.v1.Order
-	17, // 5: errorgroups.v1.GetGroupsRequest.filter:type_name -> errorgroups.v1.GetGroupsRequest.Filter
-	1,  // 6: errorgroups.v1.GetGroupsRequest.time_range:type_name -> errorgroups.v1.TimeRange
-	18, // 7: errorgroups.v1.GetGroupsResponse.groups:type_name -> errorgroups.v1.GetGroupsResponse.Group
-	26, // 8: errorgroups.v1.GetTopGroupsRequest.duration:type_name -> google.protobuf.Duration
-	1,  // 9: errorgroups.v1.GetTopGroupsRequest.time_range:type_name -> errorgroups.v1.TimeRange
-	19, // 10: errorgroups.v1.GetTopGroupsResponse.groups:type_name -> errorgroups.v1.GetTopGroupsResponse.Group
-	26, // 11: errorgroups.v1.GetHistRequest.duration:type_name -> google.protobuf.Duration
-	1,  // 12: errorgroups.v1.GetHistRequest.time_range:type_name -> errorgroups.v1.TimeRange
-	8,  // 13: errorgroups.v1.GetHistResponse.buckets:type_name -> errorgroups.v1.Bucket
-	27, // 14: errorgroups.v1.Bucket.time:type_name -> google.protobuf.Timestamp
-	27, // 15: errorgroups.v1.GetDetailsResponse.first_seen_at:type_name -> google.protobuf.Timestamp
-	27, // 16: errorgroups.v1.GetDetailsResponse.last_seen_at:type_name -> google.protobuf.Timestamp
-	21, // 17: errorgroups.v1.GetDetailsResponse.distributions:type_name -> errorgroups.v1.GetDetailsResponse.Distributions
-	22, // 18: errorgroups.v1.GetDetailsResponse.log_tags:type_name -> errorgroups.v1.GetDetailsResponse.LogTagsEntry
-	0,  // 19: errorgroups.v1.DiffByReleasesRequest.order:type_name -> errorgroups.v1.Order
-	24, // 20: errorgroups.v1.DiffByReleasesResponse.groups:type_name -> errorgroups.v1.DiffByReleasesResponse.Group
-	27, // 21: errorgroups.v1.GetGroupsResponse.Group.first_seen_at:type_name -> google.protobuf.Timestamp
-	27, // 22: errorgroups.v1.GetGroupsResponse.Group.last_seen_at:type_name -> google.protobuf.Timestamp
-	20, // 23: errorgroups.v1.GetDetailsResponse.Distributions.by_env:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
-	20, // 24: errorgroups.v1.GetDetailsResponse.Distributions.by_release:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
-	20, // 25: errorgroups.v1.GetDetailsResponse.Distributions.by_source:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
-	20, // 26: errorgroups.v1.GetDetailsResponse.Distributions.by_service:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
-	27, // 27: errorgroups.v1.DiffByReleasesResponse.Group.first_seen_at:type_name -> google.protobuf.Timestamp
-	27, // 28: errorgroups.v1.DiffByReleasesResponse.Group.last_seen_at:type_name -> google.protobuf.Timestamp
-	25, // 29: errorgroups.v1.DiffByReleasesResponse.Group.release_infos:type_name -> errorgroups.v1.DiffByReleasesResponse.Group.ReleaseInfosEntry
-	23, // 30: errorgroups.v1.DiffByReleasesResponse.Group.ReleaseInfosEntry.value:type_name -> errorgroups.v1.DiffByReleasesResponse.ReleaseInfo
-	2,  // 31: errorgroups.v1.ErrorGroupsService.GetGroups:input_type -> errorgroups.v1.GetGroupsRequest
-	4,  // 32: errorgroups.v1.ErrorGroupsService.GetTopGroups:input_type -> errorgroups.v1.GetTopGroupsRequest
-	6,  // 33: errorgroups.v1.ErrorGroupsService.GetHist:input_type -> errorgroups.v1.GetHistRequest
-	9,  // 34: errorgroups.v1.ErrorGroupsService.GetDetails:input_type -> errorgroups.v1.GetDetailsRequest
-	11, // 35: errorgroups.v1.ErrorGroupsService.GetReleases:input_type -> errorgroups.v1.GetReleasesRequest
-	13, // 36: errorgroups.v1.ErrorGroupsService.GetServices:input_type -> errorgroups.v1.GetServicesRequest
-	15, // 37: errorgroups.v1.ErrorGroupsService.DiffByReleases:input_type -> errorgroups.v1.DiffByReleasesRequest
-	3,  // 38: errorgroups.v1.ErrorGroupsService.GetGroups:output_type -> errorgroups.v1.GetGroupsResponse
-	5,  // 39: errorgroups.v1.ErrorGroupsService.GetTopGroups:output_type -> errorgroups.v1.GetTopGroupsResponse
-	7,  // 40: errorgroups.v1.ErrorGroupsService.GetHist:output_type -> errorgroups.v1.GetHistResponse
-	10, // 41: errorgroups.v1.ErrorGroupsService.GetDetails:output_type -> errorgroups.v1.GetDetailsResponse
-	12, // 42: errorgroups.v1.ErrorGroupsService.GetReleases:output_type -> errorgroups.v1.GetReleasesResponse
-	14, // 43: errorgroups.v1.ErrorGroupsService.GetServices:output_type -> errorgroups.v1.GetServicesResponse
-	16, // 44: errorgroups.v1.ErrorGroupsService.DiffByReleases:output_type -> errorgroups.v1.DiffByReleasesResponse
-	38, // [38:45] is the sub-list for method output_type
-	31, // [31:38] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	31, // 5: errorgroups.v1.GetGroupsRequest.filter:type_name -> errorgroups.v1.GetGroupsRequest.Filter
+	4,  // 6: errorgroups.v1.GetGroupsRequest.time_range:type_name -> errorgroups.v1.TimeRange
+	32, // 7: errorgroups.v1.GetGroupsResponse.groups:type_name -> errorgroups.v1.GetGroupsResponse.Group
+	45, // 8: errorgroups.v1.GetTopGroupsRequest.duration:type_name -> google.protobuf.Duration
+	4,  // 9: errorgroups.v1.GetTopGroupsRequest.time_range:type_name -> errorgroups.v1.TimeRange
+	33, // 10: errorgroups.v1.GetTopGroupsResponse.groups:type_name -> errorgroups.v1.GetTopGroupsResponse.Group
+	45, // 11: errorgroups.v1.GetHistRequest.duration:type_name -> google.protobuf.Duration
+	4,  // 12: errorgroups.v1.GetHistRequest.time_range:type_name -> errorgroups.v1.TimeRange
+	45, // 13: errorgroups.v1.GetHistRequest.interval:type_name -> google.protobuf.Duration
+	2,  // 14: errorgroups.v1.GetHistRequest.group_by:type_name -> errorgroups.v1.HistGroupBy
+	12, // 15: errorgroups.v1.GetHistResponse.buckets:type_name -> errorgroups.v1.Bucket
+	11, // 16: errorgroups.v1.GetHistResponse.series:type_name -> errorgroups.v1.Series
+	12, // 17: errorgroups.v1.Series.buckets:type_name -> errorgroups.v1.Bucket
+	46, // 18: errorgroups.v1.Bucket.time:type_name -> google.protobuf.Timestamp
+	46, // 19: errorgroups.v1.GetDetailsResponse.first_seen_at:type_name -> google.protobuf.Timestamp
+	46, // 20: errorgroups.v1.GetDetailsResponse.last_seen_at:type_name -> google.protobuf.Timestamp
+	35, // 21: errorgroups.v1.GetDetailsResponse.distributions:type_name -> errorgroups.v1.GetDetailsResponse.Distributions
+	36, // 22: errorgroups.v1.GetDetailsResponse.log_tags:type_name -> errorgroups.v1.GetDetailsResponse.LogTagsEntry
+	0,  // 23: errorgroups.v1.DiffByReleasesRequest.order:type_name -> errorgroups.v1.Order
+	38, // 24: errorgroups.v1.DiffByReleasesResponse.groups:type_name -> errorgroups.v1.DiffByReleasesResponse.Group
+	1,  // 25: errorgroups.v1.CompareReleasesRequest.exposure:type_name -> errorgroups.v1.Exposure
+	3,  // 26: errorgroups.v1.CompareReleasesRequest.statuses:type_name -> errorgroups.v1.CompareStatus
+	40, // 27: errorgroups.v1.CompareReleasesResponse.baseline:type_name -> errorgroups.v1.CompareReleasesResponse.Release
+	40, // 28: errorgroups.v1.CompareReleasesResponse.candidate:type_name -> errorgroups.v1.CompareReleasesResponse.Release
+	41, // 29: errorgroups.v1.CompareReleasesResponse.summary:type_name -> errorgroups.v1.CompareReleasesResponse.Summary
+	42, // 30: errorgroups.v1.CompareReleasesResponse.groups:type_name -> errorgroups.v1.CompareReleasesResponse.Group
+	43, // 31: errorgroups.v1.GetMergedGroupsResponse.merges:type_name -> errorgroups.v1.GetMergedGroupsResponse.Merge
+	44, // 32: errorgroups.v1.GetSimilarGroupsResponse.groups:type_name -> errorgroups.v1.GetSimilarGroupsResponse.Group
+	46, // 33: errorgroups.v1.GetGroupsResponse.Group.first_seen_at:type_name -> google.protobuf.Timestamp
+	46, // 34: errorgroups.v1.GetGroupsResponse.Group.last_seen_at:type_name -> google.protobuf.Timestamp
+	34, // 35: errorgroups.v1.GetDetailsResponse.Distributions.by_env:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
+	34, // 36: errorgroups.v1.GetDetailsResponse.Distributions.by_release:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
+	34, // 37: errorgroups.v1.GetDetailsResponse.Distributions.by_source:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
+	34, // 38: errorgroups.v1.GetDetailsResponse.Distributions.by_service:type_name -> errorgroups.v1.GetDetailsResponse.Distribution
+	46, // 39: errorgroups.v1.DiffByReleasesResponse.Group.first_seen_at:type_name -> google.protobuf.Timestamp
+	46, // 40: errorgroups.v1.DiffByReleasesResponse.Group.last_seen_at:type_name -> google.protobuf.Timestamp
+	39, // 41: errorgroups.v1.DiffByReleasesResponse.Group.release_infos:type_name -> errorgroups.v1.DiffByReleasesResponse.Group.ReleaseInfosEntry
+	37, // 42: errorgroups.v1.DiffByReleasesResponse.Group.ReleaseInfosEntry.value:type_name -> errorgroups.v1.DiffByReleasesResponse.ReleaseInfo
+	46, // 43: errorgroups.v1.CompareReleasesResponse.Release.first_seen_at:type_name -> google.protobuf.Timestamp
+	46, // 44: errorgroups.v1.CompareReleasesResponse.Release.last_seen_at:type_name -> google.protobuf.Timestamp
+	3,  // 45: errorgroups.v1.CompareReleasesResponse.Group.status:type_name -> errorgroups.v1.CompareStatus
+	46, // 46: errorgroups.v1.GetMergedGroupsResponse.Merge.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 47: errorgroups.v1.ErrorGroupsService.GetGroups:input_type -> errorgroups.v1.GetGroupsRequest
+	7,  // 48: errorgroups.v1.ErrorGroupsService.GetTopGroups:input_type -> errorgroups.v1.GetTopGroupsRequest
+	9,  // 49: errorgroups.v1.ErrorGroupsService.GetHist:input_type -> errorgroups.v1.GetHistRequest
+	13, // 50: errorgroups.v1.ErrorGroupsService.GetDetails:input_type -> errorgroups.v1.GetDetailsRequest
+	15, // 51: errorgroups.v1.ErrorGroupsService.GetReleases:input_type -> errorgroups.v1.GetReleasesRequest
+	17, // 52: errorgroups.v1.ErrorGroupsService.GetServices:input_type -> errorgroups.v1.GetServicesRequest
+	19, // 53: errorgroups.v1.ErrorGroupsService.DiffByReleases:input_type -> errorgroups.v1.DiffByReleasesRequest
+	21, // 54: errorgroups.v1.ErrorGroupsService.CompareReleases:input_type -> errorgroups.v1.CompareReleasesRequest
+	23, // 55: errorgroups.v1.ErrorGroupsService.MergeGroups:input_type -> errorgroups.v1.MergeGroupsRequest
+	25, // 56: errorgroups.v1.ErrorGroupsService.UnmergeGroups:input_type -> errorgroups.v1.UnmergeGroupsRequest
+	27, // 57: errorgroups.v1.ErrorGroupsService.GetMergedGroups:input_type -> errorgroups.v1.GetMergedGroupsRequest
+	29, // 58: errorgroups.v1.ErrorGroupsService.GetSimilarGroups:input_type -> errorgroups.v1.GetSimilarGroupsRequest
+	6,  // 59: errorgroups.v1.ErrorGroupsService.GetGroups:output_type -> errorgroups.v1.GetGroupsResponse
+	8,  // 60: errorgroups.v1.ErrorGroupsService.GetTopGroups:output_type -> errorgroups.v1.GetTopGroupsResponse
+	10, // 61: errorgroups.v1.ErrorGroupsService.GetHist:output_type -> errorgroups.v1.GetHistResponse
+	14, // 62: errorgroups.v1.ErrorGroupsService.GetDetails:output_type -> errorgroups.v1.GetDetailsResponse
+	16, // 63: errorgroups.v1.ErrorGroupsService.GetReleases:output_type -> errorgroups.v1.GetReleasesResponse
+	18, // 64: errorgroups.v1.ErrorGroupsService.GetServices:output_type -> errorgroups.v1.GetServicesResponse
+	20, // 65: errorgroups.v1.ErrorGroupsService.DiffByReleases:output_type -> errorgroups.v1.DiffByReleasesResponse
+	22, // 66: errorgroups.v1.ErrorGroupsService.CompareReleases:output_type -> errorgroups.v1.CompareReleasesResponse
+	24, // 67: errorgroups.v1.ErrorGroupsService.MergeGroups:output_type -> errorgroups.v1.MergeGroupsResponse
+	26, // 68: errorgroups.v1.ErrorGroupsService.UnmergeGroups:output_type -> errorgroups.v1.UnmergeGroupsResponse
+	28, // 69: errorgroups.v1.ErrorGroupsService.GetMergedGroups:output_type -> errorgroups.v1.GetMergedGroupsResponse
+	30, // 70: errorgroups.v1.ErrorGroupsService.GetSimilarGroups:output_type -> errorgroups.v1.GetSimilarGroupsResponse
+	59, // [59:71] is the sub-list for method output_type
+	47, // [47:59] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_errorgroups_v1_errorgroups_proto_init() }
@@ -2226,7 +3770,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Bucket); i {
+			switch v := v.(*Series); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2238,7 +3782,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDetailsRequest); i {
+			switch v := v.(*Bucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2250,7 +3794,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDetailsResponse); i {
+			switch v := v.(*GetDetailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2262,7 +3806,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetReleasesRequest); i {
+			switch v := v.(*GetDetailsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2274,7 +3818,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetReleasesResponse); i {
+			switch v := v.(*GetReleasesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2286,7 +3830,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GetServicesRequest); i {
+			switch v := v.(*GetReleasesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2298,7 +3842,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetServicesResponse); i {
+			switch v := v.(*GetServicesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2310,7 +3854,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*DiffByReleasesRequest); i {
+			switch v := v.(*GetServicesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2322,7 +3866,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*DiffByReleasesResponse); i {
+			switch v := v.(*DiffByReleasesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2334,7 +3878,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGroupsRequest_Filter); i {
+			switch v := v.(*DiffByReleasesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2346,7 +3890,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGroupsResponse_Group); i {
+			switch v := v.(*CompareReleasesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2358,7 +3902,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTopGroupsResponse_Group); i {
+			switch v := v.(*CompareReleasesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2370,7 +3914,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDetailsResponse_Distribution); i {
+			switch v := v.(*MergeGroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2382,7 +3926,19 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDetailsResponse_Distributions); i {
+			switch v := v.(*MergeGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*UnmergeGroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2394,7 +3950,7 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*DiffByReleasesResponse_ReleaseInfo); i {
+			switch v := v.(*UnmergeGroupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2406,6 +3962,126 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 			}
 		}
 		file_errorgroups_v1_errorgroups_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMergedGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMergedGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSimilarGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSimilarGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGroupsRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGroupsResponse_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTopGroupsResponse_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDetailsResponse_Distribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDetailsResponse_Distributions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffByReleasesResponse_ReleaseInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*DiffByReleasesResponse_Group); i {
 			case 0:
 				return &v.state
@@ -2417,21 +4093,84 @@ func file_errorgroups_v1_errorgroups_proto_init() {
 				return nil
 			}
 		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareReleasesResponse_Release); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareReleasesResponse_Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareReleasesResponse_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMergedGroupsResponse_Merge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_errorgroups_v1_errorgroups_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSimilarGroupsResponse_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_errorgroups_v1_errorgroups_proto_msgTypes[1].OneofWrappers = []any{}
 	file_errorgroups_v1_errorgroups_proto_msgTypes[3].OneofWrappers = []any{}
 	file_errorgroups_v1_errorgroups_proto_msgTypes[5].OneofWrappers = []any{}
-	file_errorgroups_v1_errorgroups_proto_msgTypes[8].OneofWrappers = []any{}
-	file_errorgroups_v1_errorgroups_proto_msgTypes[10].OneofWrappers = []any{}
-	file_errorgroups_v1_errorgroups_proto_msgTypes[12].OneofWrappers = []any{}
-	file_errorgroups_v1_errorgroups_proto_msgTypes[14].OneofWrappers = []any{}
+	file_errorgroups_v1_errorgroups_proto_msgTypes[9].OneofWrappers = []any{}
+	file_errorgroups_v1_errorgroups_proto_msgTypes[11].OneofWrappers = []any{}
+	file_errorgroups_v1_errorgroups_proto_msgTypes[13].OneofWrappers = []any{}
+	file_errorgroups_v1_errorgroups_proto_msgTypes[15].OneofWrappers = []any{}
+	file_errorgroups_v1_errorgroups_proto_msgTypes[17].OneofWrappers = []any{}
+	file_errorgroups_v1_errorgroups_proto_msgTypes[23].OneofWrappers = []any{}
+	file_errorgroups_v1_errorgroups_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_errorgroups_v1_errorgroups_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   25,
+			NumEnums:      4,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	ErrorGroupsService_GetGroups_FullMethodName      = "/errorgroups.v1.ErrorGroupsService/GetGroups"
-	ErrorGroupsService_GetTopGroups_FullMethodName   = "/errorgroups.v1.ErrorGroupsService/GetTopGroups"
-	ErrorGroupsService_GetHist_FullMethodName        = "/errorgroups.v1.ErrorGroupsService/GetHist"
-	ErrorGroupsService_GetDetails_FullMethodName     = "/errorgroups.v1.ErrorGroupsService/GetDetails"
-	ErrorGroupsService_GetReleases_FullMethodName    = "/errorgroups.v1.ErrorGroupsService/GetReleases"
-	ErrorGroupsService_GetServices_FullMethodName    = "/errorgroups.v1.ErrorGroupsService/GetServices"
-	ErrorGroupsService_DiffByReleases_FullMethodName = "/errorgroups.v1.ErrorGroupsService/DiffByReleases"
+	ErrorGroupsService_GetGroups_FullMethodName        = "/errorgroups.v1.ErrorGroupsService/GetGroups"
+	ErrorGroupsService_GetTopGroups_FullMethodName     = "/errorgroups.v1.ErrorGroupsService/GetTopGroups"
+	ErrorGroupsService_GetHist_FullMethodName          = "/errorgroups.v1.ErrorGroupsService/GetHist"
+	ErrorGroupsService_GetDetails_FullMethodName       = "/errorgroups.v1.ErrorGroupsService/GetDetails"
+	ErrorGroupsService_GetReleases_FullMethodName      = "/errorgroups.v1.ErrorGroupsService/GetReleases"
+	ErrorGroupsService_GetServices_FullMethodName      = "/errorgroups.v1.ErrorGroupsService/GetServices"
+	ErrorGroupsService_DiffByReleases_FullMethodName   = "/errorgroups.v1.ErrorGroupsService/DiffByReleases"
+	ErrorGroupsService_CompareReleases_FullMethodName  = "/errorgroups.v1.ErrorGroupsService/CompareReleases"
+	ErrorGroupsService_MergeGroups_FullMethodName      = "/errorgroups.v1.ErrorGroupsService/MergeGroups"
+	ErrorGroupsService_UnmergeGroups_FullMethodName    = "/errorgroups.v1.ErrorGroupsService/UnmergeGroups"
+	ErrorGroupsService_GetMergedGroups_FullMethodName  = "/errorgroups.v1.ErrorGroupsService/GetMergedGroups"
+	ErrorGroupsService_GetSimilarGroups_FullMethodName = "/errorgroups.v1.ErrorGroupsService/GetSimilarGroups"
 )
 
 // ErrorGroupsServiceClient is the client API for ErrorGroupsService service.
@@ -39,6 +44,11 @@ type ErrorGroupsServiceClient interface {
 	GetReleases(ctx context.Context, in *GetReleasesRequest, opts ...grpc.CallOption) (*GetReleasesResponse, error)
 	GetServices(ctx context.Context, in *GetServicesRequest, opts ...grpc.CallOption) (*GetServicesResponse, error)
 	DiffByReleases(ctx context.Context, in *DiffByReleasesRequest, opts ...grpc.CallOption) (*DiffByReleasesResponse, error)
+	CompareReleases(ctx context.Context, in *CompareReleasesRequest, opts ...grpc.CallOption) (*CompareReleasesResponse, error)
+	MergeGroups(ctx context.Context, in *MergeGroupsRequest, opts ...grpc.CallOption) (*MergeGroupsResponse, error)
+	UnmergeGroups(ctx context.Context, in *UnmergeGroupsRequest, opts ...grpc.CallOption) (*UnmergeGroupsResponse, error)
+	GetMergedGroups(ctx context.Context, in *GetMergedGroupsRequest, opts ...grpc.CallOption) (*GetMergedGroupsResponse, error)
+	GetSimilarGroups(ctx context.Context, in *GetSimilarGroupsRequest, opts ...grpc.CallOption) (*GetSimilarGroupsResponse, error)
 }
 
 type errorGroupsServiceClient struct {
@@ -119,6 +129,56 @@ func (c *errorGroupsServiceClient) DiffByReleases(ctx context.Context, in *DiffB
 	return out, nil
 }
 
+func (c *errorGroupsServiceClient) CompareReleases(ctx context.Context, in *CompareReleasesRequest, opts ...grpc.CallOption) (*CompareReleasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareReleasesResponse)
+	err := c.cc.Invoke(ctx, ErrorGroupsService_CompareReleases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *errorGroupsServiceClient) MergeGroups(ctx context.Context, in *MergeGroupsRequest, opts ...grpc.CallOption) (*MergeGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGroupsResponse)
+	err := c.cc.Invoke(ctx, ErrorGroupsService_MergeGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *errorGroupsServiceClient) UnmergeGroups(ctx context.Context, in *UnmergeGroupsRequest, opts ...grpc.CallOption) (*UnmergeGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmergeGroupsResponse)
+	err := c.cc.Invoke(ctx, ErrorGroupsService_UnmergeGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *errorGroupsServiceClient) GetMergedGroups(ctx context.Context, in *GetMergedGroupsRequest, opts ...grpc.CallOption) (*GetMergedGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMergedGroupsResponse)
+	err := c.cc.Invoke(ctx, ErrorGroupsService_GetMergedGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *errorGroupsServiceClient) GetSimilarGroups(ctx context.Context, in *GetSimilarGroupsRequest, opts ...grpc.CallOption) (*GetSimilarGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimilarGroupsResponse)
+	err := c.cc.Invoke(ctx, ErrorGroupsService_GetSimilarGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ErrorGroupsServiceServer is the server API for ErrorGroupsService service.
 // All implementations should embed UnimplementedErrorGroupsServiceServer
 // for forward compatibility
@@ -130,6 +190,11 @@ type ErrorGroupsServiceServer interface {
 	GetReleases(context.Context, *GetReleasesRequest) (*GetReleasesResponse, error)
 	GetServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error)
 	DiffByReleases(context.Context, *DiffByReleasesRequest) (*DiffByReleasesResponse, error)
+	CompareReleases(context.Context, *CompareReleasesRequest) (*CompareReleasesResponse, error)
+	MergeGroups(context.Context, *MergeGroupsRequest) (*MergeGroupsResponse, error)
+	UnmergeGroups(context.Context, *UnmergeGroupsRequest) (*UnmergeGroupsResponse, error)
+	GetMergedGroups(context.Context, *GetMergedGroupsRequest) (*GetMergedGroupsResponse, error)
+	GetSimilarGroups(context.Context, *GetSimilarGroupsRequest) (*GetSimilarGroupsResponse, error)
 }
 
 // UnimplementedErrorGroupsServiceServer should be embedded to have forward compatible implementations.
@@ -157,6 +222,21 @@ func (UnimplementedErrorGroupsServiceServer) GetServices(context.Context, *GetSe
 func (UnimplementedErrorGroupsServiceServer) DiffByReleases(context.Context, *DiffByReleasesRequest) (*DiffByReleasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffByReleases not implemented")
 }
+func (UnimplementedErrorGroupsServiceServer) CompareReleases(context.Context, *CompareReleasesRequest) (*CompareReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareReleases not implemented")
+}
+func (UnimplementedErrorGroupsServiceServer) MergeGroups(context.Context, *MergeGroupsRequest) (*MergeGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGroups not implemented")
+}
+func (UnimplementedErrorGroupsServiceServer) UnmergeGroups(context.Context, *UnmergeGroupsRequest) (*UnmergeGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmergeGroups not implemented")
+}
+func (UnimplementedErrorGroupsServiceServer) GetMergedGroups(context.Context, *GetMergedGroupsRequest) (*GetMergedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMergedGroups not implemented")
+}
+func (UnimplementedErrorGroupsServiceServer) GetSimilarGroups(context.Context, *GetSimilarGroupsRequest) (*GetSimilarGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarGroups not implemented")
+}
 
 // UnsafeErrorGroupsServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ErrorGroupsServiceServer will
@@ -295,6 +375,96 @@ func _ErrorGroupsService_DiffByReleases_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ErrorGroupsService_CompareReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErrorGroupsServiceServer).CompareReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErrorGroupsService_CompareReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErrorGroupsServiceServer).CompareReleases(ctx, req.(*CompareReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErrorGroupsService_MergeGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErrorGroupsServiceServer).MergeGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErrorGroupsService_MergeGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErrorGroupsServiceServer).MergeGroups(ctx, req.(*MergeGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErrorGroupsService_UnmergeGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmergeGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErrorGroupsServiceServer).UnmergeGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErrorGroupsService_UnmergeGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErrorGroupsServiceServer).UnmergeGroups(ctx, req.(*UnmergeGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErrorGroupsService_GetMergedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMergedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErrorGroupsServiceServer).GetMergedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErrorGroupsService_GetMergedGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErrorGroupsServiceServer).GetMergedGroups(ctx, req.(*GetMergedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErrorGroupsService_GetSimilarGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErrorGroupsServiceServer).GetSimilarGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErrorGroupsService_GetSimilarGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErrorGroupsServiceServer).GetSimilarGroups(ctx, req.(*GetSimilarGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ErrorGroupsService_ServiceDesc is the grpc.ServiceDesc for ErrorGroupsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -330,6 +500,26 @@ var ErrorGroupsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiffByReleases",
 			Handler:    _ErrorGroupsService_DiffByReleases_Handler,
 		},
+		{
+			MethodName: "CompareReleases",
+			Handler:    _ErrorGroupsService_CompareReleases_Handler,
+		},
+		{
+			MethodName: "MergeGroups",
+			Handler:    _ErrorGroupsService_MergeGroups_Handler,
+		},
+		{
+			MethodName: "UnmergeGroups",
+			Handler:    _ErrorGroupsService_UnmergeGroups_Handler,
+		},
+		{
+			MethodName: "GetMergedGroups",
+			Handler:    _ErrorGroupsService_GetMergedGroups_Handler,
+		},
+		{
+			MethodName: "GetSimilarGroups",
+			Handler:    _ErrorGroupsService_GetSimilarGroups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "errorgroups/v1/errorgroups.proto",
@@ -9,6 +9,8 @@ package userprofile
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +22,245 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DigestPeriod int32
+
+const (
+	DigestPeriod_DIGEST_PERIOD_DAILY  DigestPeriod = 0
+	DigestPeriod_DIGEST_PERIOD_WEEKLY DigestPeriod = 1
+)
+
+// Enum value maps for DigestPeriod.
+var (
+	DigestPeriod_name = map[int32]string{
+		0: "DIGEST_PERIOD_DAILY",
+		1: "DIGEST_PERIOD_WEEKLY",
+	}
+	DigestPeriod_value = map[string]int32{
+		"DIGEST_PERIOD_DAILY":  0,
+		"DIGEST_PERIOD_WEEKLY": 1,
+	}
+)
+
+func (x DigestPeriod) Enum() *DigestPeriod {
+	p := new(DigestPeriod)
+	*p = x
+	return p
+}
+
+func (x DigestPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_userprofile_v1_userprofile_proto_enumTypes[0].Descriptor()
+}
+
+func (DigestPeriod) Type() protoreflect.EnumType {
+	return &file_userprofile_v1_userprofile_proto_enumTypes[0]
+}
+
+func (x DigestPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestPeriod.Descriptor instead.
+func (DigestPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{0}
+}
+
+type DigestFormat int32
+
+const (
+	DigestFormat_DIGEST_FORMAT_MARKDOWN DigestFormat = 0
+	DigestFormat_DIGEST_FORMAT_HTML     DigestFormat = 1
+)
+
+// Enum value maps for DigestFormat.
+var (
+	DigestFormat_name = map[int32]string{
+		0: "DIGEST_FORMAT_MARKDOWN",
+		1: "DIGEST_FORMAT_HTML",
+	}
+	DigestFormat_value = map[string]int32{
+		"DIGEST_FORMAT_MARKDOWN": 0,
+		"DIGEST_FORMAT_HTML":     1,
+	}
+)
+
+func (x DigestFormat) Enum() *DigestFormat {
+	p := new(DigestFormat)
+	*p = x
+	return p
+}
+
+func (x DigestFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_userprofile_v1_userprofile_proto_enumTypes[1].Descriptor()
+}
+
+func (DigestFormat) Type() protoreflect.EnumType {
+	return &file_userprofile_v1_userprofile_proto_enumTypes[1]
+}
+
+func (x DigestFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestFormat.Descriptor instead.
+func (DigestFormat) EnumDescriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{1}
+}
+
+type DigestDelivery int32
+
+const (
+	DigestDelivery_DIGEST_DELIVERY_WEBHOOK    DigestDelivery = 0
+	DigestDelivery_DIGEST_DELIVERY_FILE_STORE DigestDelivery = 1
+)
+
+// Enum value maps for DigestDelivery.
+var (
+	DigestDelivery_name = map[int32]string{
+		0: "DIGEST_DELIVERY_WEBHOOK",
+		1: "DIGEST_DELIVERY_FILE_STORE",
+	}
+	DigestDelivery_value = map[string]int32{
+		"DIGEST_DELIVERY_WEBHOOK":    0,
+		"DIGEST_DELIVERY_FILE_STORE": 1,
+	}
+)
+
+func (x DigestDelivery) Enum() *DigestDelivery {
+	p := new(DigestDelivery)
+	*p = x
+	return p
+}
+
+func (x DigestDelivery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestDelivery) Descriptor() protoreflect.EnumDescriptor {
+	return file_userprofile_v1_userprofile_proto_enumTypes[2].Descriptor()
+}
+
+func (DigestDelivery) Type() protoreflect.EnumType {
+	return &file_userprofile_v1_userprofile_proto_enumTypes[2]
+}
+
+func (x DigestDelivery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestDelivery.Descriptor instead.
+func (DigestDelivery) EnumDescriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{2}
+}
+
+type Theme int32
+
+const (
+	Theme_THEME_UNSPECIFIED Theme = 0
+	Theme_THEME_LIGHT       Theme = 1
+	Theme_THEME_DARK        Theme = 2
+	Theme_THEME_SYSTEM      Theme = 3
+)
+
+// Enum value maps for Theme.
+var (
+	Theme_name = map[int32]string{
+		0: "THEME_UNSPECIFIED",
+		1: "THEME_LIGHT",
+		2: "THEME_DARK",
+		3: "THEME_SYSTEM",
+	}
+	Theme_value = map[string]int32{
+		"THEME_UNSPECIFIED": 0,
+		"THEME_LIGHT":       1,
+		"THEME_DARK":        2,
+		"THEME_SYSTEM":      3,
+	}
+)
+
+func (x Theme) Enum() *Theme {
+	p := new(Theme)
+	*p = x
+	return p
+}
+
+func (x Theme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Theme) Descriptor() protoreflect.EnumDescriptor {
+	return file_userprofile_v1_userprofile_proto_enumTypes[3].Descriptor()
+}
+
+func (Theme) Type() protoreflect.EnumType {
+	return &file_userprofile_v1_userprofile_proto_enumTypes[3]
+}
+
+func (x Theme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Theme.Descriptor instead.
+func (Theme) EnumDescriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{3}
+}
+
+type QueryHistoryKind int32
+
+const (
+	QueryHistoryKind_QUERY_HISTORY_KIND_SEARCH      QueryHistoryKind = 0
+	QueryHistoryKind_QUERY_HISTORY_KIND_HISTOGRAM   QueryHistoryKind = 1
+	QueryHistoryKind_QUERY_HISTORY_KIND_AGGREGATION QueryHistoryKind = 2
+)
+
+// Enum value maps for QueryHistoryKind.
+var (
+	QueryHistoryKind_name = map[int32]string{
+		0: "QUERY_HISTORY_KIND_SEARCH",
+		1: "QUERY_HISTORY_KIND_HISTOGRAM",
+		2: "QUERY_HISTORY_KIND_AGGREGATION",
+	}
+	QueryHistoryKind_value = map[string]int32{
+		"QUERY_HISTORY_KIND_SEARCH":      0,
+		"QUERY_HISTORY_KIND_HISTOGRAM":   1,
+		"QUERY_HISTORY_KIND_AGGREGATION": 2,
+	}
+)
+
+func (x QueryHistoryKind) Enum() *QueryHistoryKind {
+	p := new(QueryHistoryKind)
+	*p = x
+	return p
+}
+
+func (x QueryHistoryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryHistoryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_userprofile_v1_userprofile_proto_enumTypes[4].Descriptor()
+}
+
+func (QueryHistoryKind) Type() protoreflect.EnumType {
+	return &file_userprofile_v1_userprofile_proto_enumTypes[4]
+}
+
+func (x QueryHistoryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryHistoryKind.Descriptor instead.
+func (QueryHistoryKind) EnumDescriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{4}
+}
+
 type LogColumns struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -67,14 +308,18 @@ func (x *LogColumns) GetLogColumns() []string {
 	return nil
 }
 
-type GetUserProfileRequest struct {
+// EnvPreferences overrides the preferences in the env.
+type EnvPreferences struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LogColumns   []string `protobuf:"bytes,1,rep,name=log_columns,json=logColumns,proto3" json:"log_columns,omitempty"`
+	PinnedFields []string `protobuf:"bytes,2,rep,name=pinned_fields,json=pinnedFields,proto3" json:"pinned_fields,omitempty"`
 }
 
-func (x *GetUserProfileRequest) Reset() {
-	*x = GetUserProfileRequest{}
+func (x *EnvPreferences) Reset() {
+	*x = EnvPreferences{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -82,13 +327,13 @@ func (x *GetUserProfileRequest) Reset() {
 	}
 }
 
-func (x *GetUserProfileRequest) String() string {
+func (x *EnvPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProfileRequest) ProtoMessage() {}
+func (*EnvPreferences) ProtoMessage() {}
 
-func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *EnvPreferences) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -100,23 +345,47 @@ func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnvPreferences.ProtoReflect.Descriptor instead.
+func (*EnvPreferences) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{1}
 }
 
-type GetUserProfileResponse struct {
+func (x *EnvPreferences) GetLogColumns() []string {
+	if x != nil {
+		return x.LogColumns
+	}
+	return nil
+}
+
+func (x *EnvPreferences) GetPinnedFields() []string {
+	if x != nil {
+		return x.PinnedFields
+	}
+	return nil
+}
+
+type Preferences struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timezone          string      `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	OnboardingVersion string      `protobuf:"bytes,2,opt,name=onboarding_version,json=onboardingVersion,proto3" json:"onboarding_version,omitempty"`
-	LogColumns        *LogColumns `protobuf:"bytes,3,opt,name=log_columns,json=logColumns,proto3" json:"log_columns,omitempty"`
-}
-
-func (x *GetUserProfileResponse) Reset() {
-	*x = GetUserProfileResponse{}
+	// Version of the preferences schema, ignored in updates.
+	Version    int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Timezone   string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DefaultEnv string `protobuf:"bytes,3,opt,name=default_env,json=defaultEnv,proto3" json:"default_env,omitempty"`
+	// Default relative time range in seconds.
+	DefaultTimeRange uint64   `protobuf:"varint,4,opt,name=default_time_range,json=defaultTimeRange,proto3" json:"default_time_range,omitempty"`
+	PageSize         int32    `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	DateFormat       string   `protobuf:"bytes,6,opt,name=date_format,json=dateFormat,proto3" json:"date_format,omitempty"`
+	Theme            Theme    `protobuf:"varint,7,opt,name=theme,proto3,enum=userprofile.v1.Theme" json:"theme,omitempty"`
+	LogColumns       []string `protobuf:"bytes,8,rep,name=log_columns,json=logColumns,proto3" json:"log_columns,omitempty"`
+	// Overrides pinned fields from the config if not empty.
+	PinnedFields []string                   `protobuf:"bytes,9,rep,name=pinned_fields,json=pinnedFields,proto3" json:"pinned_fields,omitempty"`
+	Envs         map[string]*EnvPreferences `protobuf:"bytes,10,rep,name=envs,proto3" json:"envs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -124,13 +393,13 @@ func (x *GetUserProfileResponse) Reset() {
 	}
 }
 
-func (x *GetUserProfileResponse) String() string {
+func (x *Preferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProfileResponse) ProtoMessage() {}
+func (*Preferences) ProtoMessage() {}
 
-func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *Preferences) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -142,44 +411,92 @@ func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetUserProfileResponse) GetTimezone() string {
+func (x *Preferences) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Preferences) GetTimezone() string {
 	if x != nil {
 		return x.Timezone
 	}
 	return ""
 }
 
-func (x *GetUserProfileResponse) GetOnboardingVersion() string {
+func (x *Preferences) GetDefaultEnv() string {
 	if x != nil {
-		return x.OnboardingVersion
+		return x.DefaultEnv
 	}
 	return ""
 }
 
-func (x *GetUserProfileResponse) GetLogColumns() *LogColumns {
+func (x *Preferences) GetDefaultTimeRange() uint64 {
+	if x != nil {
+		return x.DefaultTimeRange
+	}
+	return 0
+}
+
+func (x *Preferences) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *Preferences) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
+
+func (x *Preferences) GetTheme() Theme {
+	if x != nil {
+		return x.Theme
+	}
+	return Theme_THEME_UNSPECIFIED
+}
+
+func (x *Preferences) GetLogColumns() []string {
 	if x != nil {
 		return x.LogColumns
 	}
 	return nil
 }
 
-type UpdateUserProfileRequest struct {
+func (x *Preferences) GetPinnedFields() []string {
+	if x != nil {
+		return x.PinnedFields
+	}
+	return nil
+}
+
+func (x *Preferences) GetEnvs() map[string]*EnvPreferences {
+	if x != nil {
+		return x.Envs
+	}
+	return nil
+}
+
+type GetUserProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timezone          *string     `protobuf:"bytes,1,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
-	OnboardingVersion *string     `protobuf:"bytes,2,opt,name=onboarding_version,json=onboardingVersion,proto3,oneof" json:"onboarding_version,omitempty"`
-	LogColumns        *LogColumns `protobuf:"bytes,3,opt,name=log_columns,json=logColumns,proto3,oneof" json:"log_columns,omitempty"`
+	// If set, env-scoped preferences are resolved for the env.
+	Env *string `protobuf:"bytes,1,opt,name=env,proto3,oneof" json:"env,omitempty"`
 }
 
-func (x *UpdateUserProfileRequest) Reset() {
-	*x = UpdateUserProfileRequest{}
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -187,13 +504,13 @@ func (x *UpdateUserProfileRequest) Reset() {
 	}
 }
 
-func (x *UpdateUserProfileRequest) String() string {
+func (x *GetUserProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserProfileRequest) ProtoMessage() {}
+func (*GetUserProfileRequest) ProtoMessage() {}
 
-func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -205,40 +522,33 @@ func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateUserProfileRequest) GetTimezone() string {
-	if x != nil && x.Timezone != nil {
-		return *x.Timezone
-	}
-	return ""
-}
-
-func (x *UpdateUserProfileRequest) GetOnboardingVersion() string {
-	if x != nil && x.OnboardingVersion != nil {
-		return *x.OnboardingVersion
+func (x *GetUserProfileRequest) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
 	}
 	return ""
 }
 
-func (x *UpdateUserProfileRequest) GetLogColumns() *LogColumns {
-	if x != nil {
-		return x.LogColumns
-	}
-	return nil
-}
-
-type UpdateUserProfileResponse struct {
+type GetUserProfileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: use preferences.timezone.
+	Timezone          string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	OnboardingVersion string `protobuf:"bytes,2,opt,name=onboarding_version,json=onboardingVersion,proto3" json:"onboarding_version,omitempty"`
+	// Deprecated: use preferences.log_columns.
+	LogColumns  *LogColumns  `protobuf:"bytes,3,opt,name=log_columns,json=logColumns,proto3" json:"log_columns,omitempty"`
+	Preferences *Preferences `protobuf:"bytes,4,opt,name=preferences,proto3" json:"preferences,omitempty"`
 }
 
-func (x *UpdateUserProfileResponse) Reset() {
-	*x = UpdateUserProfileResponse{}
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,13 +556,13 @@ func (x *UpdateUserProfileResponse) Reset() {
 	}
 }
 
-func (x *UpdateUserProfileResponse) String() string {
+func (x *GetUserProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserProfileResponse) ProtoMessage() {}
+func (*GetUserProfileResponse) ProtoMessage() {}
 
-func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,33 +574,65 @@ func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{4}
 }
 
-type GetFavoriteQueriesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetFavoriteQueriesRequest) Reset() {
-	*x = GetFavoriteQueriesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_userprofile_v1_userprofile_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetUserProfileResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
 	}
+	return ""
 }
 
-func (x *GetFavoriteQueriesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetUserProfileResponse) GetOnboardingVersion() string {
+	if x != nil {
+		return x.OnboardingVersion
+	}
+	return ""
 }
 
-func (*GetFavoriteQueriesRequest) ProtoMessage() {}
-
-func (x *GetFavoriteQueriesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserProfileResponse) GetLogColumns() *LogColumns {
+	if x != nil {
+		return x.LogColumns
+	}
+	return nil
+}
+
+func (x *GetUserProfileResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateUserProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timezone          *string     `protobuf:"bytes,1,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	OnboardingVersion *string     `protobuf:"bytes,2,opt,name=onboarding_version,json=onboardingVersion,proto3,oneof" json:"onboarding_version,omitempty"`
+	LogColumns        *LogColumns `protobuf:"bytes,3,opt,name=log_columns,json=logColumns,proto3,oneof" json:"log_columns,omitempty"`
+}
+
+func (x *UpdateUserProfileRequest) Reset() {
+	*x = UpdateUserProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileRequest) ProtoMessage() {}
+
+func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -302,21 +644,40 @@ func (x *GetFavoriteQueriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavoriteQueriesRequest.ProtoReflect.Descriptor instead.
-func (*GetFavoriteQueriesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{5}
 }
 
-type GetFavoriteQueriesResponse struct {
+func (x *UpdateUserProfileRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetOnboardingVersion() string {
+	if x != nil && x.OnboardingVersion != nil {
+		return *x.OnboardingVersion
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetLogColumns() *LogColumns {
+	if x != nil {
+		return x.LogColumns
+	}
+	return nil
+}
+
+type UpdateUserProfileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Queries []*GetFavoriteQueriesResponse_Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
 }
 
-func (x *GetFavoriteQueriesResponse) Reset() {
-	*x = GetFavoriteQueriesResponse{}
+func (x *UpdateUserProfileResponse) Reset() {
+	*x = UpdateUserProfileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -324,13 +685,13 @@ func (x *GetFavoriteQueriesResponse) Reset() {
 	}
 }
 
-func (x *GetFavoriteQueriesResponse) String() string {
+func (x *UpdateUserProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavoriteQueriesResponse) ProtoMessage() {}
+func (*UpdateUserProfileResponse) ProtoMessage() {}
 
-func (x *GetFavoriteQueriesResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -342,30 +703,25 @@ func (x *GetFavoriteQueriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavoriteQueriesResponse.ProtoReflect.Descriptor instead.
-func (*GetFavoriteQueriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetFavoriteQueriesResponse) GetQueries() []*GetFavoriteQueriesResponse_Query {
-	if x != nil {
-		return x.Queries
-	}
-	return nil
-}
-
-type CreateFavoriteQueryRequest struct {
+type UpdateUserPreferencesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query        string  `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Name         *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	RelativeFrom *uint64 `protobuf:"varint,3,opt,name=relative_from,json=relativeFrom,proto3,oneof" json:"relative_from,omitempty"` // measured in seconds
+	Preferences *Preferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// Paths of the preferences to update, e.g. "theme" or "page_size".
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// If set, env-scoped preferences ("log_columns" and "pinned_fields") are updated for the env.
+	Env *string `protobuf:"bytes,3,opt,name=env,proto3,oneof" json:"env,omitempty"`
 }
 
-func (x *CreateFavoriteQueryRequest) Reset() {
-	*x = CreateFavoriteQueryRequest{}
+func (x *UpdateUserPreferencesRequest) Reset() {
+	*x = UpdateUserPreferencesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,13 +729,13 @@ func (x *CreateFavoriteQueryRequest) Reset() {
 	}
 }
 
-func (x *CreateFavoriteQueryRequest) String() string {
+func (x *UpdateUserPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFavoriteQueryRequest) ProtoMessage() {}
+func (*UpdateUserPreferencesRequest) ProtoMessage() {}
 
-func (x *CreateFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserPreferencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,42 +747,42 @@ func (x *CreateFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFavoriteQueryRequest.ProtoReflect.Descriptor instead.
-func (*CreateFavoriteQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserPreferencesRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateFavoriteQueryRequest) GetQuery() string {
+func (x *UpdateUserPreferencesRequest) GetPreferences() *Preferences {
 	if x != nil {
-		return x.Query
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateFavoriteQueryRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *UpdateUserPreferencesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateFavoriteQueryRequest) GetRelativeFrom() uint64 {
-	if x != nil && x.RelativeFrom != nil {
-		return *x.RelativeFrom
+func (x *UpdateUserPreferencesRequest) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
 	}
-	return 0
+	return ""
 }
 
-type CreateFavoriteQueryResponse struct {
+type UpdateUserPreferencesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Preferences *Preferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 }
 
-func (x *CreateFavoriteQueryResponse) Reset() {
-	*x = CreateFavoriteQueryResponse{}
+func (x *UpdateUserPreferencesResponse) Reset() {
+	*x = UpdateUserPreferencesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -434,13 +790,13 @@ func (x *CreateFavoriteQueryResponse) Reset() {
 	}
 }
 
-func (x *CreateFavoriteQueryResponse) String() string {
+func (x *UpdateUserPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFavoriteQueryResponse) ProtoMessage() {}
+func (*UpdateUserPreferencesResponse) ProtoMessage() {}
 
-func (x *CreateFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserPreferencesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -452,28 +808,26 @@ func (x *CreateFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFavoriteQueryResponse.ProtoReflect.Descriptor instead.
-func (*CreateFavoriteQueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserPreferencesResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateFavoriteQueryResponse) GetId() int64 {
+func (x *UpdateUserPreferencesResponse) GetPreferences() *Preferences {
 	if x != nil {
-		return x.Id
+		return x.Preferences
 	}
-	return 0
+	return nil
 }
 
-type DeleteFavoriteQueryRequest struct {
+type GetFavoriteQueriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteFavoriteQueryRequest) Reset() {
-	*x = DeleteFavoriteQueryRequest{}
+func (x *GetFavoriteQueriesRequest) Reset() {
+	*x = GetFavoriteQueriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -481,13 +835,13 @@ func (x *DeleteFavoriteQueryRequest) Reset() {
 	}
 }
 
-func (x *DeleteFavoriteQueryRequest) String() string {
+func (x *GetFavoriteQueriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFavoriteQueryRequest) ProtoMessage() {}
+func (*GetFavoriteQueriesRequest) ProtoMessage() {}
 
-func (x *DeleteFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFavoriteQueriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,26 +853,21 @@ func (x *DeleteFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFavoriteQueryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFavoriteQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFavoriteQueriesRequest.ProtoReflect.Descriptor instead.
+func (*GetFavoriteQueriesRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteFavoriteQueryRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type DeleteFavoriteQueryResponse struct {
+type GetFavoriteQueriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Queries []*GetFavoriteQueriesResponse_Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
 }
 
-func (x *DeleteFavoriteQueryResponse) Reset() {
-	*x = DeleteFavoriteQueryResponse{}
+func (x *GetFavoriteQueriesResponse) Reset() {
+	*x = GetFavoriteQueriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,13 +875,13 @@ func (x *DeleteFavoriteQueryResponse) Reset() {
 	}
 }
 
-func (x *DeleteFavoriteQueryResponse) String() string {
+func (x *GetFavoriteQueriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFavoriteQueryResponse) ProtoMessage() {}
+func (*GetFavoriteQueriesResponse) ProtoMessage() {}
 
-func (x *DeleteFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFavoriteQueriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,19 +893,33 @@ func (x *DeleteFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFavoriteQueryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFavoriteQueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFavoriteQueriesResponse.ProtoReflect.Descriptor instead.
+func (*GetFavoriteQueriesResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{10}
 }
 
-type GetDashboardsRequest struct {
+func (x *GetFavoriteQueriesResponse) GetQueries() []*GetFavoriteQueriesResponse_Query {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type CreateFavoriteQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query        string      `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Name         *string     `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	RelativeFrom *uint64     `protobuf:"varint,3,opt,name=relative_from,json=relativeFrom,proto3,oneof" json:"relative_from,omitempty"` // measured in seconds
+	Env          *string     `protobuf:"bytes,4,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	LogColumns   *LogColumns `protobuf:"bytes,5,opt,name=log_columns,json=logColumns,proto3,oneof" json:"log_columns,omitempty"`
+	FolderId     *int64      `protobuf:"varint,6,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
 }
 
-func (x *GetDashboardsRequest) Reset() {
-	*x = GetDashboardsRequest{}
+func (x *CreateFavoriteQueryRequest) Reset() {
+	*x = CreateFavoriteQueryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -564,13 +927,13 @@ func (x *GetDashboardsRequest) Reset() {
 	}
 }
 
-func (x *GetDashboardsRequest) String() string {
+func (x *CreateFavoriteQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardsRequest) ProtoMessage() {}
+func (*CreateFavoriteQueryRequest) ProtoMessage() {}
 
-func (x *GetDashboardsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -582,21 +945,63 @@ func (x *GetDashboardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardsRequest.ProtoReflect.Descriptor instead.
-func (*GetDashboardsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFavoriteQueryRequest.ProtoReflect.Descriptor instead.
+func (*CreateFavoriteQueryRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{11}
 }
 
-type GetDashboardsResponse struct {
+func (x *CreateFavoriteQueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CreateFavoriteQueryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateFavoriteQueryRequest) GetRelativeFrom() uint64 {
+	if x != nil && x.RelativeFrom != nil {
+		return *x.RelativeFrom
+	}
+	return 0
+}
+
+func (x *CreateFavoriteQueryRequest) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *CreateFavoriteQueryRequest) GetLogColumns() *LogColumns {
+	if x != nil {
+		return x.LogColumns
+	}
+	return nil
+}
+
+func (x *CreateFavoriteQueryRequest) GetFolderId() int64 {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return 0
+}
+
+type CreateFavoriteQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dashboards []*GetDashboardsResponse_Dashboard `protobuf:"bytes,1,rep,name=dashboards,proto3" json:"dashboards,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetDashboardsResponse) Reset() {
-	*x = GetDashboardsResponse{}
+func (x *CreateFavoriteQueryResponse) Reset() {
+	*x = CreateFavoriteQueryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,13 +1009,13 @@ func (x *GetDashboardsResponse) Reset() {
 	}
 }
 
-func (x *GetDashboardsResponse) String() string {
+func (x *CreateFavoriteQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardsResponse) ProtoMessage() {}
+func (*CreateFavoriteQueryResponse) ProtoMessage() {}
 
-func (x *GetDashboardsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,28 +1027,28 @@ func (x *GetDashboardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardsResponse.ProtoReflect.Descriptor instead.
-func (*GetDashboardsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFavoriteQueryResponse.ProtoReflect.Descriptor instead.
+func (*CreateFavoriteQueryResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetDashboardsResponse) GetDashboards() []*GetDashboardsResponse_Dashboard {
+func (x *CreateFavoriteQueryResponse) GetId() int64 {
 	if x != nil {
-		return x.Dashboards
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetDashboardRequest struct {
+type DeleteFavoriteQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetDashboardRequest) Reset() {
-	*x = GetDashboardRequest{}
+func (x *DeleteFavoriteQueryRequest) Reset() {
+	*x = DeleteFavoriteQueryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,13 +1056,13 @@ func (x *GetDashboardRequest) Reset() {
 	}
 }
 
-func (x *GetDashboardRequest) String() string {
+func (x *DeleteFavoriteQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardRequest) ProtoMessage() {}
+func (*DeleteFavoriteQueryRequest) ProtoMessage() {}
 
-func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -669,30 +1074,26 @@ func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardRequest.ProtoReflect.Descriptor instead.
-func (*GetDashboardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFavoriteQueryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFavoriteQueryRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetDashboardRequest) GetUuid() string {
+func (x *DeleteFavoriteQueryRequest) GetId() int64 {
 	if x != nil {
-		return x.Uuid
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type GetDashboardResponse struct {
+type DeleteFavoriteQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Meta      string `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
-	OwnerName string `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
 }
 
-func (x *GetDashboardResponse) Reset() {
-	*x = GetDashboardResponse{}
+func (x *DeleteFavoriteQueryResponse) Reset() {
+	*x = DeleteFavoriteQueryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,13 +1101,13 @@ func (x *GetDashboardResponse) Reset() {
 	}
 }
 
-func (x *GetDashboardResponse) String() string {
+func (x *DeleteFavoriteQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardResponse) ProtoMessage() {}
+func (*DeleteFavoriteQueryResponse) ProtoMessage() {}
 
-func (x *GetDashboardResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,43 +1119,27 @@ func (x *GetDashboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardResponse.ProtoReflect.Descriptor instead.
-func (*GetDashboardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFavoriteQueryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFavoriteQueryResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetDashboardResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetDashboardResponse) GetMeta() string {
-	if x != nil {
-		return x.Meta
-	}
-	return ""
-}
-
-func (x *GetDashboardResponse) GetOwnerName() string {
-	if x != nil {
-		return x.OwnerName
-	}
-	return ""
-}
-
-type CreateDashboardRequest struct {
+type UpdateFavoriteQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Meta string `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id           int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query        *string     `protobuf:"bytes,2,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	Name         *string     `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	RelativeFrom *uint64     `protobuf:"varint,4,opt,name=relative_from,json=relativeFrom,proto3,oneof" json:"relative_from,omitempty"` // measured in seconds
+	Env          *string     `protobuf:"bytes,5,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	LogColumns   *LogColumns `protobuf:"bytes,6,opt,name=log_columns,json=logColumns,proto3,oneof" json:"log_columns,omitempty"`
+	FolderId     *int64      `protobuf:"varint,7,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"` // 0 moves the query to the root
 }
 
-func (x *CreateDashboardRequest) Reset() {
-	*x = CreateDashboardRequest{}
+func (x *UpdateFavoriteQueryRequest) Reset() {
+	*x = UpdateFavoriteQueryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -762,13 +1147,13 @@ func (x *CreateDashboardRequest) Reset() {
 	}
 }
 
-func (x *CreateDashboardRequest) String() string {
+func (x *UpdateFavoriteQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDashboardRequest) ProtoMessage() {}
+func (*UpdateFavoriteQueryRequest) ProtoMessage() {}
 
-func (x *CreateDashboardRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -780,35 +1165,68 @@ func (x *CreateDashboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDashboardRequest.ProtoReflect.Descriptor instead.
-func (*CreateDashboardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateFavoriteQueryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFavoriteQueryRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateDashboardRequest) GetName() string {
+func (x *UpdateFavoriteQueryRequest) GetId() int64 {
 	if x != nil {
-		return x.Name
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateFavoriteQueryRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
 	}
 	return ""
 }
 
-func (x *CreateDashboardRequest) GetMeta() string {
-	if x != nil {
-		return x.Meta
+func (x *UpdateFavoriteQueryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-type CreateDashboardResponse struct {
+func (x *UpdateFavoriteQueryRequest) GetRelativeFrom() uint64 {
+	if x != nil && x.RelativeFrom != nil {
+		return *x.RelativeFrom
+	}
+	return 0
+}
+
+func (x *UpdateFavoriteQueryRequest) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *UpdateFavoriteQueryRequest) GetLogColumns() *LogColumns {
+	if x != nil {
+		return x.LogColumns
+	}
+	return nil
+}
+
+func (x *UpdateFavoriteQueryRequest) GetFolderId() int64 {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return 0
+}
+
+type UpdateFavoriteQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *CreateDashboardResponse) Reset() {
-	*x = CreateDashboardResponse{}
+func (x *UpdateFavoriteQueryResponse) Reset() {
+	*x = UpdateFavoriteQueryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -816,13 +1234,13 @@ func (x *CreateDashboardResponse) Reset() {
 	}
 }
 
-func (x *CreateDashboardResponse) String() string {
+func (x *UpdateFavoriteQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDashboardResponse) ProtoMessage() {}
+func (*UpdateFavoriteQueryResponse) ProtoMessage() {}
 
-func (x *CreateDashboardResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -834,30 +1252,21 @@ func (x *CreateDashboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDashboardResponse.ProtoReflect.Descriptor instead.
-func (*CreateDashboardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateFavoriteQueryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFavoriteQueryResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateDashboardResponse) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-type UpdateDashboardRequest struct {
+type ReorderFavoriteQueriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string  `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Meta *string `protobuf:"bytes,3,opt,name=meta,proto3,oneof" json:"meta,omitempty"`
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *UpdateDashboardRequest) Reset() {
-	*x = UpdateDashboardRequest{}
+func (x *ReorderFavoriteQueriesRequest) Reset() {
+	*x = ReorderFavoriteQueriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -865,13 +1274,13 @@ func (x *UpdateDashboardRequest) Reset() {
 	}
 }
 
-func (x *UpdateDashboardRequest) String() string {
+func (x *ReorderFavoriteQueriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDashboardRequest) ProtoMessage() {}
+func (*ReorderFavoriteQueriesRequest) ProtoMessage() {}
 
-func (x *UpdateDashboardRequest) ProtoReflect() protoreflect.Message {
+func (x *ReorderFavoriteQueriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -883,40 +1292,26 @@ func (x *UpdateDashboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDashboardRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDashboardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderFavoriteQueriesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderFavoriteQueriesRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateDashboardRequest) GetUuid() string {
+func (x *ReorderFavoriteQueriesRequest) GetIds() []int64 {
 	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *UpdateDashboardRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateDashboardRequest) GetMeta() string {
-	if x != nil && x.Meta != nil {
-		return *x.Meta
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-type UpdateDashboardResponse struct {
+type ReorderFavoriteQueriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateDashboardResponse) Reset() {
-	*x = UpdateDashboardResponse{}
+func (x *ReorderFavoriteQueriesResponse) Reset() {
+	*x = ReorderFavoriteQueriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,13 +1319,13 @@ func (x *UpdateDashboardResponse) Reset() {
 	}
 }
 
-func (x *UpdateDashboardResponse) String() string {
+func (x *ReorderFavoriteQueriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDashboardResponse) ProtoMessage() {}
+func (*ReorderFavoriteQueriesResponse) ProtoMessage() {}
 
-func (x *UpdateDashboardResponse) ProtoReflect() protoreflect.Message {
+func (x *ReorderFavoriteQueriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,21 +1337,22 @@ func (x *UpdateDashboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDashboardResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDashboardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderFavoriteQueriesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderFavoriteQueriesResponse) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{18}
 }
 
-type DeleteDashboardRequest struct {
+type FavoriteQueryFolder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *DeleteDashboardRequest) Reset() {
-	*x = DeleteDashboardRequest{}
+func (x *FavoriteQueryFolder) Reset() {
+	*x = FavoriteQueryFolder{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -964,13 +1360,13 @@ func (x *DeleteDashboardRequest) Reset() {
 	}
 }
 
-func (x *DeleteDashboardRequest) String() string {
+func (x *FavoriteQueryFolder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDashboardRequest) ProtoMessage() {}
+func (*FavoriteQueryFolder) ProtoMessage() {}
 
-func (x *DeleteDashboardRequest) ProtoReflect() protoreflect.Message {
+func (x *FavoriteQueryFolder) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -982,26 +1378,33 @@ func (x *DeleteDashboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDashboardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDashboardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FavoriteQueryFolder.ProtoReflect.Descriptor instead.
+func (*FavoriteQueryFolder) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteDashboardRequest) GetUuid() string {
+func (x *FavoriteQueryFolder) GetId() int64 {
 	if x != nil {
-		return x.Uuid
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FavoriteQueryFolder) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteDashboardResponse struct {
+type GetFavoriteQueryFoldersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteDashboardResponse) Reset() {
-	*x = DeleteDashboardResponse{}
+func (x *GetFavoriteQueryFoldersRequest) Reset() {
+	*x = GetFavoriteQueryFoldersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1009,13 +1412,13 @@ func (x *DeleteDashboardResponse) Reset() {
 	}
 }
 
-func (x *DeleteDashboardResponse) String() string {
+func (x *GetFavoriteQueryFoldersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDashboardResponse) ProtoMessage() {}
+func (*GetFavoriteQueryFoldersRequest) ProtoMessage() {}
 
-func (x *DeleteDashboardResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFavoriteQueryFoldersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1027,24 +1430,21 @@ func (x *DeleteDashboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDashboardResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDashboardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFavoriteQueryFoldersRequest.ProtoReflect.Descriptor instead.
+func (*GetFavoriteQueryFoldersRequest) Descriptor() ([]byte, []int) {
 	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{20}
 }
 
-type GetFavoriteQueriesResponse_Query struct {
+type GetFavoriteQueryFoldersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Query        string  `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	Name         *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	RelativeFrom *uint64 `protobuf:"varint,4,opt,name=relative_from,json=relativeFrom,proto3,oneof" json:"relative_from,omitempty"` // measured in seconds
+	Folders []*FavoriteQueryFolder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
 }
 
-func (x *GetFavoriteQueriesResponse_Query) Reset() {
-	*x = GetFavoriteQueriesResponse_Query{}
+func (x *GetFavoriteQueryFoldersResponse) Reset() {
+	*x = GetFavoriteQueryFoldersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_userprofile_v1_userprofile_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,334 +1452,3204 @@ func (x *GetFavoriteQueriesResponse_Query) Reset() {
 	}
 }
 
-func (x *GetFavoriteQueriesResponse_Query) String() string {
+func (x *GetFavoriteQueryFoldersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavoriteQueriesResponse_Query) ProtoMessage() {}
+func (*GetFavoriteQueryFoldersResponse) ProtoMessage() {}
 
-func (x *GetFavoriteQueriesResponse_Query) ProtoReflect() protoreflect.Message {
+func (x *GetFavoriteQueryFoldersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_userprofile_v1_userprofile_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetFavoriteQueriesResponse_Query.ProtoReflect.Descriptor instead.
-func (*GetFavoriteQueriesResponse_Query) Descriptor() ([]byte, []int) {
-	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{6, 0}
-}
-
-func (x *GetFavoriteQueriesResponse_Query) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *GetFavoriteQueriesResponse_Query) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *GetFavoriteQueriesResponse_Query) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *GetFavoriteQueriesResponse_Query) GetRelativeFrom() uint64 {
-	if x != nil && x.RelativeFrom != nil {
-		return *x.RelativeFrom
-	}
-	return 0
-}
-
-type GetDashboardsResponse_Dashboard struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *GetDashboardsResponse_Dashboard) Reset() {
-	*x = GetDashboardsResponse_Dashboard{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_userprofile_v1_userprofile_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetDashboardsResponse_Dashboard) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDashboardsResponse_Dashboard) ProtoMessage() {}
-
-func (x *GetDashboardsResponse_Dashboard) ProtoReflect() protoreflect.Message {
-	mi := &file_userprofile_v1_userprofile_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoriteQueryFoldersResponse.ProtoReflect.Descriptor instead.
+func (*GetFavoriteQueryFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetFavoriteQueryFoldersResponse) GetFolders() []*FavoriteQueryFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type CreateFavoriteQueryFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateFavoriteQueryFolderRequest) Reset() {
+	*x = CreateFavoriteQueryFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFavoriteQueryFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFavoriteQueryFolderRequest) ProtoMessage() {}
+
+func (x *CreateFavoriteQueryFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFavoriteQueryFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFavoriteQueryFolderRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateFavoriteQueryFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateFavoriteQueryFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateFavoriteQueryFolderResponse) Reset() {
+	*x = CreateFavoriteQueryFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFavoriteQueryFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFavoriteQueryFolderResponse) ProtoMessage() {}
+
+func (x *CreateFavoriteQueryFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFavoriteQueryFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateFavoriteQueryFolderResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateFavoriteQueryFolderResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateFavoriteQueryFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UpdateFavoriteQueryFolderRequest) Reset() {
+	*x = UpdateFavoriteQueryFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFavoriteQueryFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFavoriteQueryFolderRequest) ProtoMessage() {}
+
+func (x *UpdateFavoriteQueryFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFavoriteQueryFolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFavoriteQueryFolderRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateFavoriteQueryFolderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateFavoriteQueryFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateFavoriteQueryFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateFavoriteQueryFolderResponse) Reset() {
+	*x = UpdateFavoriteQueryFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFavoriteQueryFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFavoriteQueryFolderResponse) ProtoMessage() {}
+
+func (x *UpdateFavoriteQueryFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFavoriteQueryFolderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFavoriteQueryFolderResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{25}
+}
+
+type DeleteFavoriteQueryFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteFavoriteQueryFolderRequest) Reset() {
+	*x = DeleteFavoriteQueryFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFavoriteQueryFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFavoriteQueryFolderRequest) ProtoMessage() {}
+
+func (x *DeleteFavoriteQueryFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFavoriteQueryFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFavoriteQueryFolderRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteFavoriteQueryFolderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteFavoriteQueryFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFavoriteQueryFolderResponse) Reset() {
+	*x = DeleteFavoriteQueryFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFavoriteQueryFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFavoriteQueryFolderResponse) ProtoMessage() {}
+
+func (x *DeleteFavoriteQueryFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFavoriteQueryFolderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFavoriteQueryFolderResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{27}
+}
+
+type PublishFavoriteQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Team string `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"` // empty team unpublishes the query
+}
+
+func (x *PublishFavoriteQueryRequest) Reset() {
+	*x = PublishFavoriteQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishFavoriteQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishFavoriteQueryRequest) ProtoMessage() {}
+
+func (x *PublishFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishFavoriteQueryRequest.ProtoReflect.Descriptor instead.
+func (*PublishFavoriteQueryRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublishFavoriteQueryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublishFavoriteQueryRequest) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+type PublishFavoriteQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublishFavoriteQueryResponse) Reset() {
+	*x = PublishFavoriteQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishFavoriteQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishFavoriteQueryResponse) ProtoMessage() {}
+
+func (x *PublishFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishFavoriteQueryResponse.ProtoReflect.Descriptor instead.
+func (*PublishFavoriteQueryResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{29}
+}
+
+type GetFavoriteQueriesLibraryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team *string `protobuf:"bytes,1,opt,name=team,proto3,oneof" json:"team,omitempty"`
+}
+
+func (x *GetFavoriteQueriesLibraryRequest) Reset() {
+	*x = GetFavoriteQueriesLibraryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFavoriteQueriesLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavoriteQueriesLibraryRequest) ProtoMessage() {}
+
+func (x *GetFavoriteQueriesLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoriteQueriesLibraryRequest.ProtoReflect.Descriptor instead.
+func (*GetFavoriteQueriesLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetFavoriteQueriesLibraryRequest) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return ""
+}
+
+type GetFavoriteQueriesLibraryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*GetFavoriteQueriesResponse_Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *GetFavoriteQueriesLibraryResponse) Reset() {
+	*x = GetFavoriteQueriesLibraryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFavoriteQueriesLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavoriteQueriesLibraryResponse) ProtoMessage() {}
+
+func (x *GetFavoriteQueriesLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoriteQueriesLibraryResponse.ProtoReflect.Descriptor instead.
+func (*GetFavoriteQueriesLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetFavoriteQueriesLibraryResponse) GetQueries() []*GetFavoriteQueriesResponse_Query {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type SubscribeFavoriteQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SubscribeFavoriteQueryRequest) Reset() {
+	*x = SubscribeFavoriteQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeFavoriteQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFavoriteQueryRequest) ProtoMessage() {}
+
+func (x *SubscribeFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFavoriteQueryRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeFavoriteQueryRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubscribeFavoriteQueryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SubscribeFavoriteQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeFavoriteQueryResponse) Reset() {
+	*x = SubscribeFavoriteQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeFavoriteQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFavoriteQueryResponse) ProtoMessage() {}
+
+func (x *SubscribeFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFavoriteQueryResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeFavoriteQueryResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{33}
+}
+
+type UnsubscribeFavoriteQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UnsubscribeFavoriteQueryRequest) Reset() {
+	*x = UnsubscribeFavoriteQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeFavoriteQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeFavoriteQueryRequest) ProtoMessage() {}
+
+func (x *UnsubscribeFavoriteQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeFavoriteQueryRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeFavoriteQueryRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnsubscribeFavoriteQueryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnsubscribeFavoriteQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnsubscribeFavoriteQueryResponse) Reset() {
+	*x = UnsubscribeFavoriteQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeFavoriteQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeFavoriteQueryResponse) ProtoMessage() {}
+
+func (x *UnsubscribeFavoriteQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeFavoriteQueryResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeFavoriteQueryResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{35}
+}
+
+type ErrorGroupsSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Service    string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Env        *string                `protobuf:"bytes,3,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	Period     DigestPeriod           `protobuf:"varint,4,opt,name=period,proto3,enum=userprofile.v1.DigestPeriod" json:"period,omitempty"`
+	Format     DigestFormat           `protobuf:"varint,5,opt,name=format,proto3,enum=userprofile.v1.DigestFormat" json:"format,omitempty"`
+	Delivery   DigestDelivery         `protobuf:"varint,6,opt,name=delivery,proto3,enum=userprofile.v1.DigestDelivery" json:"delivery,omitempty"`
+	WebhookUrl *string                `protobuf:"bytes,7,opt,name=webhook_url,json=webhookUrl,proto3,oneof" json:"webhook_url,omitempty"`
+	LastSentAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_sent_at,json=lastSentAt,proto3,oneof" json:"last_sent_at,omitempty"`
+}
+
+func (x *ErrorGroupsSubscription) Reset() {
+	*x = ErrorGroupsSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorGroupsSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorGroupsSubscription) ProtoMessage() {}
+
+func (x *ErrorGroupsSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorGroupsSubscription.ProtoReflect.Descriptor instead.
+func (*ErrorGroupsSubscription) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ErrorGroupsSubscription) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ErrorGroupsSubscription) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ErrorGroupsSubscription) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *ErrorGroupsSubscription) GetPeriod() DigestPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return DigestPeriod_DIGEST_PERIOD_DAILY
+}
+
+func (x *ErrorGroupsSubscription) GetFormat() DigestFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DigestFormat_DIGEST_FORMAT_MARKDOWN
+}
+
+func (x *ErrorGroupsSubscription) GetDelivery() DigestDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return DigestDelivery_DIGEST_DELIVERY_WEBHOOK
+}
+
+func (x *ErrorGroupsSubscription) GetWebhookUrl() string {
+	if x != nil && x.WebhookUrl != nil {
+		return *x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ErrorGroupsSubscription) GetLastSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return nil
+}
+
+type GetErrorGroupsSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetErrorGroupsSubscriptionsRequest) Reset() {
+	*x = GetErrorGroupsSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetErrorGroupsSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErrorGroupsSubscriptionsRequest) ProtoMessage() {}
+
+func (x *GetErrorGroupsSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErrorGroupsSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetErrorGroupsSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{37}
+}
+
+type GetErrorGroupsSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*ErrorGroupsSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *GetErrorGroupsSubscriptionsResponse) Reset() {
+	*x = GetErrorGroupsSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetErrorGroupsSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErrorGroupsSubscriptionsResponse) ProtoMessage() {}
+
+func (x *GetErrorGroupsSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErrorGroupsSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetErrorGroupsSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetErrorGroupsSubscriptionsResponse) GetSubscriptions() []*ErrorGroupsSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type CreateErrorGroupsSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service    string         `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Env        *string        `protobuf:"bytes,2,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	Period     DigestPeriod   `protobuf:"varint,3,opt,name=period,proto3,enum=userprofile.v1.DigestPeriod" json:"period,omitempty"`
+	Format     DigestFormat   `protobuf:"varint,4,opt,name=format,proto3,enum=userprofile.v1.DigestFormat" json:"format,omitempty"`
+	Delivery   DigestDelivery `protobuf:"varint,5,opt,name=delivery,proto3,enum=userprofile.v1.DigestDelivery" json:"delivery,omitempty"`
+	WebhookUrl *string        `protobuf:"bytes,6,opt,name=webhook_url,json=webhookUrl,proto3,oneof" json:"webhook_url,omitempty"`
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) Reset() {
+	*x = CreateErrorGroupsSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateErrorGroupsSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateErrorGroupsSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateErrorGroupsSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateErrorGroupsSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) GetPeriod() DigestPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return DigestPeriod_DIGEST_PERIOD_DAILY
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) GetFormat() DigestFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DigestFormat_DIGEST_FORMAT_MARKDOWN
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) GetDelivery() DigestDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return DigestDelivery_DIGEST_DELIVERY_WEBHOOK
+}
+
+func (x *CreateErrorGroupsSubscriptionRequest) GetWebhookUrl() string {
+	if x != nil && x.WebhookUrl != nil {
+		return *x.WebhookUrl
+	}
+	return ""
+}
+
+type CreateErrorGroupsSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateErrorGroupsSubscriptionResponse) Reset() {
+	*x = CreateErrorGroupsSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateErrorGroupsSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateErrorGroupsSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateErrorGroupsSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateErrorGroupsSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateErrorGroupsSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateErrorGroupsSubscriptionResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteErrorGroupsSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteErrorGroupsSubscriptionRequest) Reset() {
+	*x = DeleteErrorGroupsSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteErrorGroupsSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteErrorGroupsSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteErrorGroupsSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteErrorGroupsSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteErrorGroupsSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteErrorGroupsSubscriptionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteErrorGroupsSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteErrorGroupsSubscriptionResponse) Reset() {
+	*x = DeleteErrorGroupsSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteErrorGroupsSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteErrorGroupsSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteErrorGroupsSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteErrorGroupsSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteErrorGroupsSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{42}
+}
+
+type GetQueryHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter entries containing the text in the query, case-insensitive.
+	Query  *string `protobuf:"bytes,1,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	Limit  int32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetQueryHistoryRequest) Reset() {
+	*x = GetQueryHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueryHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryHistoryRequest) ProtoMessage() {}
+
+func (x *GetQueryHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetQueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetQueryHistoryRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *GetQueryHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetQueryHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetQueryHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*GetQueryHistoryResponse_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetQueryHistoryResponse) Reset() {
+	*x = GetQueryHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueryHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryHistoryResponse) ProtoMessage() {}
+
+func (x *GetQueryHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetQueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetQueryHistoryResponse) GetEntries() []*GetQueryHistoryResponse_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetDashboardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDashboardsRequest) Reset() {
+	*x = GetDashboardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDashboardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardsRequest) ProtoMessage() {}
+
+func (x *GetDashboardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardsRequest.ProtoReflect.Descriptor instead.
+func (*GetDashboardsRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{45}
+}
+
+type GetDashboardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dashboards []*GetDashboardsResponse_Dashboard `protobuf:"bytes,1,rep,name=dashboards,proto3" json:"dashboards,omitempty"`
+}
+
+func (x *GetDashboardsResponse) Reset() {
+	*x = GetDashboardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDashboardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardsResponse) ProtoMessage() {}
+
+func (x *GetDashboardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardsResponse.ProtoReflect.Descriptor instead.
+func (*GetDashboardsResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetDashboardsResponse) GetDashboards() []*GetDashboardsResponse_Dashboard {
+	if x != nil {
+		return x.Dashboards
+	}
+	return nil
+}
+
+type GetDashboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetDashboardRequest) Reset() {
+	*x = GetDashboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardRequest) ProtoMessage() {}
+
+func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetDashboardRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetDashboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Meta      string `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	OwnerName string `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+}
+
+func (x *GetDashboardResponse) Reset() {
+	*x = GetDashboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardResponse) ProtoMessage() {}
+
+func (x *GetDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardResponse.ProtoReflect.Descriptor instead.
+func (*GetDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetDashboardResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDashboardResponse) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *GetDashboardResponse) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+type CreateDashboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Meta string `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *CreateDashboardRequest) Reset() {
+	*x = CreateDashboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDashboardRequest) ProtoMessage() {}
+
+func (x *CreateDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDashboardRequest.ProtoReflect.Descriptor instead.
+func (*CreateDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateDashboardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDashboardRequest) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+type CreateDashboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *CreateDashboardResponse) Reset() {
+	*x = CreateDashboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDashboardResponse) ProtoMessage() {}
+
+func (x *CreateDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDashboardResponse.ProtoReflect.Descriptor instead.
+func (*CreateDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateDashboardResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type UpdateDashboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string  `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Meta *string `protobuf:"bytes,3,opt,name=meta,proto3,oneof" json:"meta,omitempty"`
+}
+
+func (x *UpdateDashboardRequest) Reset() {
+	*x = UpdateDashboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDashboardRequest) ProtoMessage() {}
+
+func (x *UpdateDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDashboardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateDashboardRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateDashboardRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDashboardRequest) GetMeta() string {
+	if x != nil && x.Meta != nil {
+		return *x.Meta
+	}
+	return ""
+}
+
+type UpdateDashboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateDashboardResponse) Reset() {
+	*x = UpdateDashboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDashboardResponse) ProtoMessage() {}
+
+func (x *UpdateDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDashboardResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{52}
+}
+
+type DeleteDashboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *DeleteDashboardRequest) Reset() {
+	*x = DeleteDashboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDashboardRequest) ProtoMessage() {}
+
+func (x *DeleteDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDashboardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteDashboardRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DeleteDashboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDashboardResponse) Reset() {
+	*x = DeleteDashboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDashboardResponse) ProtoMessage() {}
+
+func (x *DeleteDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDashboardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{54}
+}
+
+type WhoAmIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WhoAmIRequest) Reset() {
+	*x = WhoAmIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhoAmIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIRequest) ProtoMessage() {}
+
+func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{55}
+}
+
+type WhoAmIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string   `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Groups   []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// If false, the roles and permissions are not checked and empty.
+	RbacEnabled bool     `protobuf:"varint,3,opt,name=rbac_enabled,json=rbacEnabled,proto3" json:"rbac_enabled,omitempty"`
+	Roles       []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Effective permissions of the user with the wildcards expanded.
+	Permissions []string `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *WhoAmIResponse) Reset() {
+	*x = WhoAmIResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhoAmIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIResponse) ProtoMessage() {}
+
+func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
+func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WhoAmIResponse) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetRbacEnabled() bool {
+	if x != nil {
+		return x.RbacEnabled
+	}
+	return false
+}
+
+func (x *WhoAmIResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type GetFavoriteQueriesResponse_Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query        string      `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Name         *string     `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	RelativeFrom *uint64     `protobuf:"varint,4,opt,name=relative_from,json=relativeFrom,proto3,oneof" json:"relative_from,omitempty"` // measured in seconds
+	FolderId     *int64      `protobuf:"varint,5,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	Env          *string     `protobuf:"bytes,6,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	LogColumns   *LogColumns `protobuf:"bytes,7,opt,name=log_columns,json=logColumns,proto3" json:"log_columns,omitempty"`
+	Team         *string     `protobuf:"bytes,8,opt,name=team,proto3,oneof" json:"team,omitempty"`                            // team library the query is published to
+	OwnerName    *string     `protobuf:"bytes,9,opt,name=owner_name,json=ownerName,proto3,oneof" json:"owner_name,omitempty"` // set for queries subscribed from the library
+	Subscribed   bool        `protobuf:"varint,10,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+}
+
+func (x *GetFavoriteQueriesResponse_Query) Reset() {
+	*x = GetFavoriteQueriesResponse_Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFavoriteQueriesResponse_Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavoriteQueriesResponse_Query) ProtoMessage() {}
+
+func (x *GetFavoriteQueriesResponse_Query) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoriteQueriesResponse_Query.ProtoReflect.Descriptor instead.
+func (*GetFavoriteQueriesResponse_Query) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetRelativeFrom() uint64 {
+	if x != nil && x.RelativeFrom != nil {
+		return *x.RelativeFrom
+	}
+	return 0
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetFolderId() int64 {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return 0
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetLogColumns() *LogColumns {
+	if x != nil {
+		return x.LogColumns
+	}
+	return nil
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return ""
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetOwnerName() string {
+	if x != nil && x.OwnerName != nil {
+		return *x.OwnerName
+	}
+	return ""
+}
+
+func (x *GetFavoriteQueriesResponse_Query) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+type GetQueryHistoryResponse_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind       QueryHistoryKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=userprofile.v1.QueryHistoryKind" json:"kind,omitempty"`
+	Query      string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	From       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Env        *string                `protobuf:"bytes,6,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	DurationMs int64                  `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Total      int64                  `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`
+	ErrorCode  *string                `protobuf:"bytes,9,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GetQueryHistoryResponse_Entry) Reset() {
+	*x = GetQueryHistoryResponse_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueryHistoryResponse_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryHistoryResponse_Entry) ProtoMessage() {}
+
+func (x *GetQueryHistoryResponse_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryHistoryResponse_Entry.ProtoReflect.Descriptor instead.
+func (*GetQueryHistoryResponse_Entry) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetKind() QueryHistoryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return QueryHistoryKind_QUERY_HISTORY_KIND_SEARCH
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetErrorCode() string {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetQueryHistoryResponse_Entry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetDashboardsResponse_Dashboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetDashboardsResponse_Dashboard) Reset() {
+	*x = GetDashboardsResponse_Dashboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userprofile_v1_userprofile_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDashboardsResponse_Dashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardsResponse_Dashboard) ProtoMessage() {}
+
+func (x *GetDashboardsResponse_Dashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_userprofile_v1_userprofile_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardsResponse_Dashboard.ProtoReflect.Descriptor instead.
+func (*GetDashboardsResponse_Dashboard) Descriptor() ([]byte, []int) {
+	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *GetDashboardsResponse_Dashboard) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetDashboardsResponse_Dashboard) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_userprofile_v1_userprofile_proto protoreflect.FileDescriptor
+
+var file_userprofile_v1_userprofile_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x22, 0x56, 0x0a, 0x0e, 0x45, 0x6e, 0x76, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xd7, 0x03, 0x0a,
+	0x0b, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x65, 0x6e,
+	0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x45, 0x6e, 0x76, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x2b, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x6e,
+	0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x1a, 0x57, 0x0a,
+	0x09, 0x45, 0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x76,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03,
+	0x65, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x22, 0xdf,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x22, 0xe5, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32,
+	0x0a, 0x12, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x11, 0x6f, 0x6e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x48, 0x02, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6c, 0x6f, 0x67,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e,
+	0x76, 0x22, 0x5e, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf7,
+	0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x8c, 0x03, 0x0a, 0x05, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x02, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x76, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x05, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52,
+	0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x03, 0x65, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x48, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x66, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x08,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x1b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x02, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x02, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x48, 0x04, 0x52,
+	0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x1d, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x20, 0x0a,
+	0x1e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x39, 0x0a, 0x13, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x36,
+	0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x20, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x21,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x41, 0x0a, 0x1b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x61, 0x6d, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x6f, 0x0a, 0x21, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x1d, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x1e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
+	0x0a, 0x1f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x22, 0x0a, 0x20, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x03, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x65,
+	0x6e, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88,
+	0x01, 0x01, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a,
+	0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x41, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x02, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x22, 0x24, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x74, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbd, 0x02, 0x0a, 0x24, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x65,
+	0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88,
+	0x01, 0x01, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a,
+	0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x37, 0x0a, 0x25, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x36, 0x0a, 0x24, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
+	0xe8, 0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x1a, 0x83, 0x03, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a,
+	0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x0a, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x33, 0x0a,
+	0x09, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5d, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x2d,
+	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x70, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x22,
+	0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x62, 0x61, 0x63, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x72, 0x62, 0x61, 0x63, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x41, 0x0a, 0x0c, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x47, 0x45, 0x53,
+	0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0x42, 0x0a, 0x0c, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49,
+	0x47, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x54, 0x4d, 0x4c, 0x10, 0x01, 0x2a, 0x4d,
+	0x0a, 0x0e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x1e, 0x0a,
+	0x1a, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x2a, 0x51, 0x0a,
+	0x05, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x44, 0x41, 0x52, 0x4b, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x03,
+	0x2a, 0x77, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x48, 0x49,
+	0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43,
+	0x48, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x48, 0x49, 0x53,
+	0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x47,
+	0x52, 0x41, 0x4d, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x32, 0xfc, 0x13, 0x0a, 0x12, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x76, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x29, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x16,
+	0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x82, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x30, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x30, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79,
+	0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x18, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x06, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x12, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x74, 0x65, 0x63, 0x68, 0x2f,
+	0x73, 0x65, 0x71, 0x2d, 0x75, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_userprofile_v1_userprofile_proto_rawDescOnce sync.Once
+	file_userprofile_v1_userprofile_proto_rawDescData = file_userprofile_v1_userprofile_proto_rawDesc
+)
+
+func file_userprofile_v1_userprofile_proto_rawDescGZIP() []byte {
+	file_userprofile_v1_userprofile_proto_rawDescOnce.Do(func() {
+		file_userprofile_v1_userprofile_proto_rawDescData = protoimpl.X.CompressGZIP(file_userprofile_v1_userprofile_proto_rawDescData)
+	})
+	return file_userprofile_v1_userprofile_proto_rawDescData
+}
+
+var file_userprofile_v1_userprofile_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_userprofile_v1_userprofile_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_userprofile_v1_userprofile_proto_goTypes = []any{
+	(DigestPeriod)(0),                             // 0: userprofile.v1.DigestPeriod
+	(DigestFormat)(0),                             // 1: userprofile.v1.DigestFormat
+	(DigestDelivery)(0),                           // 2: userprofile.v1.DigestDelivery
+	(Theme)(0),                                    // 3: userprofile.v1.Theme
+	(QueryHistoryKind)(0),                         // 4: userprofile.v1.QueryHistoryKind
+	(*LogColumns)(nil),                            // 5: userprofile.v1.LogColumns
+	(*EnvPreferences)(nil),                        // 6: userprofile.v1.EnvPreferences
+	(*Preferences)(nil),                           // 7: userprofile.v1.Preferences
+	(*GetUserProfileRequest)(nil),                 // 8: userprofile.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),                // 9: userprofile.v1.GetUserProfileResponse
+	(*UpdateUserProfileRequest)(nil),              // 10: userprofile.v1.UpdateUserProfileRequest
+	(*UpdateUserProfileResponse)(nil),             // 11: userprofile.v1.UpdateUserProfileResponse
+	(*UpdateUserPreferencesRequest)(nil),          // 12: userprofile.v1.UpdateUserPreferencesRequest
+	(*UpdateUserPreferencesResponse)(nil),         // 13: userprofile.v1.UpdateUserPreferencesResponse
+	(*GetFavoriteQueriesRequest)(nil),             // 14: userprofile.v1.GetFavoriteQueriesRequest
+	(*GetFavoriteQueriesResponse)(nil),            // 15: userprofile.v1.GetFavoriteQueriesResponse
+	(*CreateFavoriteQueryRequest)(nil),            // 16: userprofile.v1.CreateFavoriteQueryRequest
+	(*CreateFavoriteQueryResponse)(nil),           // 17: userprofile.v1.CreateFavoriteQueryResponse
+	(*DeleteFavoriteQueryRequest)(nil),            // 18: userprofile.v1.DeleteFavoriteQueryRequest
+	(*DeleteFavoriteQueryResponse)(nil),           // 19: userprofile.v1.DeleteFavoriteQueryResponse
+	(*UpdateFavoriteQueryRequest)(nil),            // 20: userprofile.v1.UpdateFavoriteQueryRequest
+	(*UpdateFavoriteQueryResponse)(nil),           // 21: userprofile.v1.UpdateFavoriteQueryResponse
+	(*ReorderFavoriteQueriesRequest)(nil),         // 22: userprofile.v1.ReorderFavoriteQueriesRequest
+	(*ReorderFavoriteQueriesResponse)(nil),        // 23: userprofile.v1.ReorderFavoriteQueriesResponse
+	(*FavoriteQueryFolder)(nil),                   // 24: userprofile.v1.FavoriteQueryFolder
+	(*GetFavoriteQueryFoldersRequest)(nil),        // 25: userprofile.v1.GetFavoriteQueryFoldersRequest
+	(*GetFavoriteQueryFoldersResponse)(nil),       // 26: userprofile.v1.GetFavoriteQueryFoldersResponse
+	(*CreateFavoriteQueryFolderRequest)(nil),      // 27: userprofile.v1.CreateFavoriteQueryFolderRequest
+	(*CreateFavoriteQueryFolderResponse)(nil),     // 28: userprofile.v1.CreateFavoriteQueryFolderResponse
+	(*UpdateFavoriteQueryFolderRequest)(nil),      // 29: userprofile.v1.UpdateFavoriteQueryFolderRequest
+	(*UpdateFavoriteQueryFolderResponse)(nil),     // 30: userprofile.v1.UpdateFavoriteQueryFolderResponse
+	(*DeleteFavoriteQueryFolderRequest)(nil),      // 31: userprofile.v1.DeleteFavoriteQueryFolderRequest
+	(*DeleteFavoriteQueryFolderResponse)(nil),     // 32: userprofile.v1.DeleteFavoriteQueryFolderResponse
+	(*PublishFavoriteQueryRequest)(nil),           // 33: userprofile.v1.PublishFavoriteQueryRequest
+	(*PublishFavoriteQueryResponse)(nil),          // 34: userprofile.v1.PublishFavoriteQueryResponse
+	(*GetFavoriteQueriesLibraryRequest)(nil),      // 35: userprofile.v1.GetFavoriteQueriesLibraryRequest
+	(*GetFavoriteQueriesLibraryResponse)(nil),     // 36: userprofile.v1.GetFavoriteQueriesLibraryResponse
+	(*SubscribeFavoriteQueryRequest)(nil),         // 37: userprofile.v1.SubscribeFavoriteQueryRequest
+	(*SubscribeFavoriteQueryResponse)(nil),        // 38: userprofile.v1.SubscribeFavoriteQueryResponse
+	(*UnsubscribeFavoriteQueryRequest)(nil),       // 39: userprofile.v1.UnsubscribeFavoriteQueryRequest
+	(*UnsubscribeFavoriteQueryResponse)(nil),      // 40: userprofile.v1.UnsubscribeFavoriteQueryResponse
+	(*ErrorGroupsSubscription)(nil),               // 41: userprofile.v1.ErrorGroupsSubscription
+	(*GetErrorGroupsSubscriptionsRequest)(nil),    // 42: userprofile.v1.GetErrorGroupsSubscriptionsRequest
+	(*GetErrorGroupsSubscriptionsResponse)(nil),   // 43: userprofile.v1.GetErrorGroupsSubscriptionsResponse
+	(*CreateErrorGroupsSubscriptionRequest)(nil),  // 44: userprofile.v1.CreateErrorGroupsSubscriptionRequest
+	(*CreateErrorGroupsSubscriptionResponse)(nil), // 45: userprofile.v1.CreateErrorGroupsSubscriptionResponse
+	(*DeleteErrorGroupsSubscriptionRequest)(nil),  // 46: userprofile.v1.DeleteErrorGroupsSubscriptionRequest
+	(*DeleteErrorGroupsSubscriptionResponse)(nil), // 47: userprofile.v1.DeleteErrorGroupsSubscriptionResponse
+	(*GetQueryHistoryRequest)(nil),                // 48: userprofile.v1.GetQueryHistoryRequest
+	(*GetQueryHistoryResponse)(nil),               // 49: userprofile.v1.GetQueryHistoryResponse
+	(*GetDashboardsRequest)(nil),                  // 50: userprofile.v1.GetDashboardsRequest
+	(*GetDashboardsResponse)(nil),                 // 51: userprofile.v1.GetDashboardsResponse
+	(*GetDashboardRequest)(nil),                   // 52: userprofile.v1.GetDashboardRequest
+	(*GetDashboardResponse)(nil),                  // 53: userprofile.v1.GetDashboardResponse
+	(*CreateDashboardRequest)(nil),                // 54: userprofile.v1.CreateDashboardRequest
+	(*CreateDashboardResponse)(nil),               // 55: userprofile.v1.CreateDashboardResponse
+	(*UpdateDashboardRequest)(nil),                // 56: userprofile.v1.UpdateDashboardRequest
+	(*UpdateDashboardResponse)(nil),               // 57: userprofile.v1.UpdateDashboardResponse
+	(*DeleteDashboardRequest)(nil),                // 58: userprofile.v1.DeleteDashboardRequest
+	(*DeleteDashboardResponse)(nil),               // 59: userprofile.v1.DeleteDashboardResponse
+	(*WhoAmIRequest)(nil),                         // 60: userprofile.v1.WhoAmIRequest
+	(*WhoAmIResponse)(nil),                        // 61: userprofile.v1.WhoAmIResponse
+	nil,                                           // 62: userprofile.v1.Preferences.EnvsEntry
+	(*GetFavoriteQueriesResponse_Query)(nil),      // 63: userprofile.v1.GetFavoriteQueriesResponse.Query
+	(*GetQueryHistoryResponse_Entry)(nil),         // 64: userprofile.v1.GetQueryHistoryResponse.Entry
+	(*GetDashboardsResponse_Dashboard)(nil),       // 65: userprofile.v1.GetDashboardsResponse.Dashboard
+	(*fieldmaskpb.FieldMask)(nil),                 // 66: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                 // 67: google.protobuf.Timestamp
+}
+var file_userprofile_v1_userprofile_proto_depIdxs = []int32{
+	3,  // 0: userprofile.v1.Preferences.theme:type_name -> userprofile.v1.Theme
+	62, // 1: userprofile.v1.Preferences.envs:type_name -> userprofile.v1.Preferences.EnvsEntry
+	5,  // 2: userprofile.v1.GetUserProfileResponse.log_columns:type_name -> userprofile.v1.LogColumns
+	7,  // 3: userprofile.v1.GetUserProfileResponse.preferences:type_name -> userprofile.v1.Preferences
+	5,  // 4: userprofile.v1.UpdateUserProfileRequest.log_columns:type_name -> userprofile.v1.LogColumns
+	7,  // 5: userprofile.v1.UpdateUserPreferencesRequest.preferences:type_name -> userprofile.v1.Preferences
+	66, // 6: userprofile.v1.UpdateUserPreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 7: userprofile.v1.UpdateUserPreferencesResponse.preferences:type_name -> userprofile.v1.Preferences
+	63, // 8: userprofile.v1.GetFavoriteQueriesResponse.queries:type_name -> userprofile.v1.GetFavoriteQueriesResponse.Query
+	5,  // 9: userprofile.v1.CreateFavoriteQueryRequest.log_columns:type_name -> userprofile.v1.LogColumns
+	5,  // 10: userprofile.v1.UpdateFavoriteQueryRequest.log_columns:type_name -> userprofile.v1.LogColumns
+	24, // 11: userprofile.v1.GetFavoriteQueryFoldersResponse.folders:type_name -> userprofile.v1.FavoriteQueryFolder
+	63, // 12: userprofile.v1.GetFavoriteQueriesLibraryResponse.queries:type_name -> userprofile.v1.GetFavoriteQueriesResponse.Query
+	0,  // 13: userprofile.v1.ErrorGroupsSubscription.period:type_name -> userprofile.v1.DigestPeriod
+	1,  // 14: userprofile.v1.ErrorGroupsSubscription.format:type_name -> userprofile.v1.DigestFormat
+	2,  // 15: userprofile.v1.ErrorGroupsSubscription.delivery:type_name -> userprofile.v1.DigestDelivery
+	67, // 16: userprofile.v1.ErrorGroupsSubscription.last_sent_at:type_name -> google.protobuf.Timestamp
+	41, // 17: userprofile.v1.GetErrorGroupsSubscriptionsResponse.subscriptions:type_name -> userprofile.v1.ErrorGroupsSubscription
+	0,  // 18: userprofile.v1.CreateErrorGroupsSubscriptionRequest.period:type_name -> userprofile.v1.DigestPeriod
+	1,  // 19: userprofile.v1.CreateErrorGroupsSubscriptionRequest.format:type_name -> userprofile.v1.DigestFormat
+	2,  // 20: userprofile.v1.CreateErrorGroupsSubscriptionRequest.delivery:type_name -> userprofile.v1.DigestDelivery
+	64, // 21: userprofile.v1.GetQueryHistoryResponse.entries:type_name -> userprofile.v1.GetQueryHistoryResponse.Entry
+	65, // 22: userprofile.v1.GetDashboardsResponse.dashboards:type_name -> userprofile.v1.GetDashboardsResponse.Dashboard
+	6,  // 23: userprofile.v1.Preferences.EnvsEntry.value:type_name -> userprofile.v1.EnvPreferences
+	5,  // 24: userprofile.v1.GetFavoriteQueriesResponse.Query.log_columns:type_name -> userprofile.v1.LogColumns
+	4,  // 25: userprofile.v1.GetQueryHistoryResponse.Entry.kind:type_name -> userprofile.v1.QueryHistoryKind
+	67, // 26: userprofile.v1.GetQueryHistoryResponse.Entry.from:type_name -> google.protobuf.Timestamp
+	67, // 27: userprofile.v1.GetQueryHistoryResponse.Entry.to:type_name -> google.protobuf.Timestamp
+	67, // 28: userprofile.v1.GetQueryHistoryResponse.Entry.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 29: userprofile.v1.UserProfileService.GetUserProfile:input_type -> userprofile.v1.GetUserProfileRequest
+	10, // 30: userprofile.v1.UserProfileService.UpdateUserProfile:input_type -> userprofile.v1.UpdateUserProfileRequest
+	12, // 31: userprofile.v1.UserProfileService.UpdateUserPreferences:input_type -> userprofile.v1.UpdateUserPreferencesRequest
+	14, // 32: userprofile.v1.UserProfileService.GetFavoriteQueries:input_type -> userprofile.v1.GetFavoriteQueriesRequest
+	16, // 33: userprofile.v1.UserProfileService.CreateFavoriteQuery:input_type -> userprofile.v1.CreateFavoriteQueryRequest
+	20, // 34: userprofile.v1.UserProfileService.UpdateFavoriteQuery:input_type -> userprofile.v1.UpdateFavoriteQueryRequest
+	18, // 35: userprofile.v1.UserProfileService.DeleteFavoriteQuery:input_type -> userprofile.v1.DeleteFavoriteQueryRequest
+	22, // 36: userprofile.v1.UserProfileService.ReorderFavoriteQueries:input_type -> userprofile.v1.ReorderFavoriteQueriesRequest
+	25, // 37: userprofile.v1.UserProfileService.GetFavoriteQueryFolders:input_type -> userprofile.v1.GetFavoriteQueryFoldersRequest
+	27, // 38: userprofile.v1.UserProfileService.CreateFavoriteQueryFolder:input_type -> userprofile.v1.CreateFavoriteQueryFolderRequest
+	29, // 39: userprofile.v1.UserProfileService.UpdateFavoriteQueryFolder:input_type -> userprofile.v1.UpdateFavoriteQueryFolderRequest
+	31, // 40: userprofile.v1.UserProfileService.DeleteFavoriteQueryFolder:input_type -> userprofile.v1.DeleteFavoriteQueryFolderRequest
+	33, // 41: userprofile.v1.UserProfileService.PublishFavoriteQuery:input_type -> userprofile.v1.PublishFavoriteQueryRequest
+	35, // 42: userprofile.v1.UserProfileService.GetFavoriteQueriesLibrary:input_type -> userprofile.v1.GetFavoriteQueriesLibraryRequest
+	37, // 43: userprofile.v1.UserProfileService.SubscribeFavoriteQuery:input_type -> userprofile.v1.SubscribeFavoriteQueryRequest
+	39, // 44: userprofile.v1.UserProfileService.UnsubscribeFavoriteQuery:input_type -> userprofile.v1.UnsubscribeFavoriteQueryRequest
+	42, // 45: userprofile.v1.UserProfileService.GetErrorGroupsSubscriptions:input_type -> userprofile.v1.GetErrorGroupsSubscriptionsRequest
+	44, // 46: userprofile.v1.UserProfileService.CreateErrorGroupsSubscription:input_type -> userprofile.v1.CreateErrorGroupsSubscriptionRequest
+	46, // 47: userprofile.v1.UserProfileService.DeleteErrorGroupsSubscription:input_type -> userprofile.v1.DeleteErrorGroupsSubscriptionRequest
+	48, // 48: userprofile.v1.UserProfileService.GetQueryHistory:input_type -> userprofile.v1.GetQueryHistoryRequest
+	60, // 49: userprofile.v1.UserProfileService.WhoAmI:input_type -> userprofile.v1.WhoAmIRequest
+	9,  // 50: userprofile.v1.UserProfileService.GetUserProfile:output_type -> userprofile.v1.GetUserProfileResponse
+	11, // 51: userprofile.v1.UserProfileService.UpdateUserProfile:output_type -> userprofile.v1.UpdateUserProfileResponse
+	13, // 52: userprofile.v1.UserProfileService.UpdateUserPreferences:output_type -> userprofile.v1.UpdateUserPreferencesResponse
+	15, // 53: userprofile.v1.UserProfileService.GetFavoriteQueries:output_type -> userprofile.v1.GetFavoriteQueriesResponse
+	17, // 54: userprofile.v1.UserProfileService.CreateFavoriteQuery:output_type -> userprofile.v1.CreateFavoriteQueryResponse
+	21, // 55: userprofile.v1.UserProfileService.UpdateFavoriteQuery:output_type -> userprofile.v1.UpdateFavoriteQueryResponse
+	19, // 56: userprofile.v1.UserProfileService.DeleteFavoriteQuery:output_type -> userprofile.v1.DeleteFavoriteQueryResponse
+	23, // 57: userprofile.v1.UserProfileService.ReorderFavoriteQueries:output_type -> userprofile.v1.ReorderFavoriteQueriesResponse
+	26, // 58: userprofile.v1.UserProfileService.GetFavoriteQueryFolders:output_type -> userprofile.v1.GetFavoriteQueryFoldersResponse
+	28, // 59: userprofile.v1.UserProfileService.CreateFavoriteQueryFolder:output_type -> userprofile.v1.CreateFavoriteQueryFolderResponse
+	30, // 60: userprofile.v1.UserProfileService.UpdateFavoriteQueryFolder:output_type -> userprofile.v1.UpdateFavoriteQueryFolderResponse
+	32, // 61: userprofile.v1.UserProfileService.DeleteFavoriteQueryFolder:output_type -> userprofile.v1.DeleteFavoriteQueryFolderResponse
+	34, // 62: userprofile.v1.UserProfileService.PublishFavoriteQuery:output_type -> userprofile.v1.PublishFavoriteQueryResponse
+	36, // 63: userprofile.v1.UserProfileService.GetFavoriteQueriesLibrary:output_type -> userprofile.v1.GetFavoriteQueriesLibraryResponse
+	38, // 64: userprofile.v1.UserProfileService.SubscribeFavoriteQuery:output_type -> userprofile.v1.SubscribeFavoriteQueryResponse
+	40, // 65: userprofile.v1.UserProfileService.UnsubscribeFavoriteQuery:output_type -> userprofile.v1.UnsubscribeFavoriteQueryResponse
+	43, // 66: userprofile.v1.UserProfileService.GetErrorGroupsSubscriptions:output_type -> userprofile.v1.GetErrorGroupsSubscriptionsResponse
+	45, // 67: userprofile.v1.UserProfileService.CreateErrorGroupsSubscription:output_type -> userprofile.v1.CreateErrorGroupsSubscriptionResponse
+	47, // 68: userprofile.v1.UserProfileService.DeleteErrorGroupsSubscription:output_type -> userprofile.v1.DeleteErrorGroupsSubscriptionResponse
+	49, // 69: userprofile.v1.UserProfileService.GetQueryHistory:output_type -> userprofile.v1.GetQueryHistoryResponse
+	61, // 70: userprofile.v1.UserProfileService.WhoAmI:output_type -> userprofile.v1.WhoAmIResponse
+	50, // [50:71] is the sub-list for method output_type
+	29, // [29:50] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_userprofile_v1_userprofile_proto_init() }
+func file_userprofile_v1_userprofile_proto_init() {
+	if File_userprofile_v1_userprofile_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_userprofile_v1_userprofile_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*LogColumns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*EnvPreferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Preferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserPreferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserPreferencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFavoriteQueriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFavoriteQueriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFavoriteQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFavoriteQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFavoriteQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFavoriteQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFavoriteQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFavoriteQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderFavoriteQueriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderFavoriteQueriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*FavoriteQueryFolder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFavoriteQueryFoldersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFavoriteQueryFoldersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFavoriteQueryFolderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFavoriteQueryFolderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFavoriteQueryFolderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFavoriteQueryFolderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFavoriteQueryFolderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFavoriteQueryFolderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishFavoriteQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishFavoriteQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDashboardsResponse_Dashboard.ProtoReflect.Descriptor instead.
-func (*GetDashboardsResponse_Dashboard) Descriptor() ([]byte, []int) {
-	return file_userprofile_v1_userprofile_proto_rawDescGZIP(), []int{12, 0}
-}
-
-func (x *GetDashboardsResponse_Dashboard) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *GetDashboardsResponse_Dashboard) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-var File_userprofile_v1_userprofile_proto protoreflect.FileDescriptor
-
-var file_userprofile_v1_userprofile_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x22, 0x2d, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
-	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f,
-	0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xe5, 0x01,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69,
-	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
-	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x6f,
-	0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x11, 0x6f, 0x6e, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x40, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x48, 0x02, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x15,
-	0x0a, 0x13, 0x5f, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0xf6, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51,
-	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
-	0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x30, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x8b, 0x01, 0x0a, 0x05, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52,
-	0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x2d, 0x0a, 0x1b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x9d, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x64, 0x61, 0x73,
-	0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x0a,
-	0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x33, 0x0a, 0x09, 0x44, 0x61,
-	0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x16, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x17, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x70, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x19, 0x0a, 0x17,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
-	0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xb6, 0x04, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x11, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76,
-	0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f,
-	0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
-	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x74, 0x65, 0x63, 0x68,
-	0x2f, 0x73, 0x65, 0x71, 0x2d, 0x75, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_userprofile_v1_userprofile_proto_rawDescOnce sync.Once
-	file_userprofile_v1_userprofile_proto_rawDescData = file_userprofile_v1_userprofile_proto_rawDesc
-)
-
-func file_userprofile_v1_userprofile_proto_rawDescGZIP() []byte {
-	file_userprofile_v1_userprofile_proto_rawDescOnce.Do(func() {
-		file_userprofile_v1_userprofile_proto_rawDescData = protoimpl.X.CompressGZIP(file_userprofile_v1_userprofile_proto_rawDescData)
-	})
-	return file_userprofile_v1_userprofile_proto_rawDescData
-}
-
-var file_userprofile_v1_userprofile_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_userprofile_v1_userprofile_proto_goTypes = []any{
-	(*LogColumns)(nil),                       // 0: userprofile.v1.LogColumns
-	(*GetUserProfileRequest)(nil),            // 1: userprofile.v1.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),           // 2: userprofile.v1.GetUserProfileResponse
-	(*UpdateUserProfileRequest)(nil),         // 3: userprofile.v1.UpdateUserProfileRequest
-	(*UpdateUserProfileResponse)(nil),        // 4: userprofile.v1.UpdateUserProfileResponse
-	(*GetFavoriteQueriesRequest)(nil),        // 5: userprofile.v1.GetFavoriteQueriesRequest
-	(*GetFavoriteQueriesResponse)(nil),       // 6: userprofile.v1.GetFavoriteQueriesResponse
-	(*CreateFavoriteQueryRequest)(nil),       // 7: userprofile.v1.CreateFavoriteQueryRequest
-	(*CreateFavoriteQueryResponse)(nil),      // 8: userprofile.v1.CreateFavoriteQueryResponse
-	(*DeleteFavoriteQueryRequest)(nil),       // 9: userprofile.v1.DeleteFavoriteQueryRequest
-	(*DeleteFavoriteQueryResponse)(nil),      // 10: userprofile.v1.DeleteFavoriteQueryResponse
-	(*GetDashboardsRequest)(nil),             // 11: userprofile.v1.GetDashboardsRequest
-	(*GetDashboardsResponse)(nil),            // 12: userprofile.v1.GetDashboardsResponse
-	(*GetDashboardRequest)(nil),              // 13: userprofile.v1.GetDashboardRequest
-	(*GetDashboardResponse)(nil),             // 14: userprofile.v1.GetDashboardResponse
-	(*CreateDashboardRequest)(nil),           // 15: userprofile.v1.CreateDashboardRequest
-	(*CreateDashboardResponse)(nil),          // 16: userprofile.v1.CreateDashboardResponse
-	(*UpdateDashboardRequest)(nil),           // 17: userprofile.v1.UpdateDashboardRequest
-	(*UpdateDashboardResponse)(nil),          // 18: userprofile.v1.UpdateDashboardResponse
-	(*DeleteDashboardRequest)(nil),           // 19: userprofile.v1.DeleteDashboardRequest
-	(*DeleteDashboardResponse)(nil),          // 20: userprofile.v1.DeleteDashboardResponse
-	(*GetFavoriteQueriesResponse_Query)(nil), // 21: userprofile.v1.GetFavoriteQueriesResponse.Query
-	(*GetDashboardsResponse_Dashboard)(nil),  // 22: userprofile.v1.GetDashboardsResponse.Dashboard
-}
-var file_userprofile_v1_userprofile_proto_depIdxs = []int32{
-	0,  // 0: userprofile.v1.GetUserProfileResponse.log_columns:type_name -> userprofile.v1.LogColumns
-	0,  // 1: userprofile.v1.UpdateUserProfileRequest.log_columns:type_name -> userprofile.v1.LogColumns
-	21, // 2: userprofile.v1.GetFavoriteQueriesResponse.queries:type_name -> userprofile.v1.GetFavoriteQueriesResponse.Query
-	22, // 3: userprofile.v1.GetDashboardsResponse.dashboards:type_name -> userprofile.v1.GetDashboardsResponse.Dashboard
-	1,  // 4: userprofile.v1.UserProfileService.GetUserProfile:input_type -> userprofile.v1.GetUserProfileRequest
-	3,  // 5: userprofile.v1.UserProfileService.UpdateUserProfile:input_type -> userprofile.v1.UpdateUserProfileRequest
-	5,  // 6: userprofile.v1.UserProfileService.GetFavoriteQueries:input_type -> userprofile.v1.GetFavoriteQueriesRequest
-	7,  // 7: userprofile.v1.UserProfileService.CreateFavoriteQuery:input_type -> userprofile.v1.CreateFavoriteQueryRequest
-	9,  // 8: userprofile.v1.UserProfileService.DeleteFavoriteQuery:input_type -> userprofile.v1.DeleteFavoriteQueryRequest
-	2,  // 9: userprofile.v1.UserProfileService.GetUserProfile:output_type -> userprofile.v1.GetUserProfileResponse
-	4,  // 10: userprofile.v1.UserProfileService.UpdateUserProfile:output_type -> userprofile.v1.UpdateUserProfileResponse
-	6,  // 11: userprofile.v1.UserProfileService.GetFavoriteQueries:output_type -> userprofile.v1.GetFavoriteQueriesResponse
-	8,  // 12: userprofile.v1.UserProfileService.CreateFavoriteQuery:output_type -> userprofile.v1.CreateFavoriteQueryResponse
-	10, // 13: userprofile.v1.UserProfileService.DeleteFavoriteQuery:output_type -> userprofile.v1.DeleteFavoriteQueryResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
-}
-
-func init() { file_userprofile_v1_userprofile_proto_init() }
-func file_userprofile_v1_userprofile_proto_init() {
-	if File_userprofile_v1_userprofile_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_userprofile_v1_userprofile_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*LogColumns); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFavoriteQueriesLibraryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1390,8 +4660,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUserProfileRequest); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFavoriteQueriesLibraryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1402,8 +4672,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUserProfileResponse); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeFavoriteQueryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1414,8 +4684,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateUserProfileRequest); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeFavoriteQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1426,8 +4696,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateUserProfileResponse); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*UnsubscribeFavoriteQueryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1438,8 +4708,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetFavoriteQueriesRequest); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*UnsubscribeFavoriteQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1450,8 +4720,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GetFavoriteQueriesResponse); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ErrorGroupsSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1462,8 +4732,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateFavoriteQueryRequest); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GetErrorGroupsSubscriptionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1474,8 +4744,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateFavoriteQueryResponse); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetErrorGroupsSubscriptionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1486,8 +4756,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteFavoriteQueryRequest); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateErrorGroupsSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1498,8 +4768,8 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteFavoriteQueryResponse); i {
+		file_userprofile_v1_userprofile_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateErrorGroupsSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1510,7 +4780,55 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteErrorGroupsSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteErrorGroupsSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueryHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueryHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*GetDashboardsRequest); i {
 			case 0:
 				return &v.state
@@ -1522,7 +4840,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[46].Exporter = func(v any, i int) any {
 			switch v := v.(*GetDashboardsResponse); i {
 			case 0:
 				return &v.state
@@ -1534,7 +4852,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[47].Exporter = func(v any, i int) any {
 			switch v := v.(*GetDashboardRequest); i {
 			case 0:
 				return &v.state
@@ -1546,7 +4864,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[48].Exporter = func(v any, i int) any {
 			switch v := v.(*GetDashboardResponse); i {
 			case 0:
 				return &v.state
@@ -1558,7 +4876,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[49].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateDashboardRequest); i {
 			case 0:
 				return &v.state
@@ -1570,7 +4888,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateDashboardResponse); i {
 			case 0:
 				return &v.state
@@ -1582,7 +4900,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateDashboardRequest); i {
 			case 0:
 				return &v.state
@@ -1594,7 +4912,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateDashboardResponse); i {
 			case 0:
 				return &v.state
@@ -1606,7 +4924,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteDashboardRequest); i {
 			case 0:
 				return &v.state
@@ -1618,7 +4936,7 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteDashboardResponse); i {
 			case 0:
 				return &v.state
@@ -1630,7 +4948,31 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[21].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*WhoAmIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*WhoAmIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*GetFavoriteQueriesResponse_Query); i {
 			case 0:
 				return &v.state
@@ -1642,7 +4984,19 @@ func file_userprofile_v1_userprofile_proto_init() {
 				return nil
 			}
 		}
-		file_userprofile_v1_userprofile_proto_msgTypes[22].Exporter = func(v any, i int) any {
+		file_userprofile_v1_userprofile_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueryHistoryResponse_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userprofile_v1_userprofile_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*GetDashboardsResponse_Dashboard); i {
 			case 0:
 				return &v.state
@@ -1656,21 +5010,30 @@ func file_userprofile_v1_userprofile_proto_init() {
 		}
 	}
 	file_userprofile_v1_userprofile_proto_msgTypes[3].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[5].OneofWrappers = []any{}
 	file_userprofile_v1_userprofile_proto_msgTypes[7].OneofWrappers = []any{}
-	file_userprofile_v1_userprofile_proto_msgTypes[17].OneofWrappers = []any{}
-	file_userprofile_v1_userprofile_proto_msgTypes[21].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[11].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[15].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[30].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[36].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[39].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[43].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[51].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[58].OneofWrappers = []any{}
+	file_userprofile_v1_userprofile_proto_msgTypes[59].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_userprofile_v1_userprofile_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      5,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_userprofile_v1_userprofile_proto_goTypes,
 		DependencyIndexes: file_userprofile_v1_userprofile_proto_depIdxs,
+		EnumInfos:         file_userprofile_v1_userprofile_proto_enumTypes,
 		MessageInfos:      file_userprofile_v1_userprofile_proto_msgTypes,
 	}.Build()
 	File_userprofile_v1_userprofile_proto = out.File
@@ -19,11 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	UserProfileService_GetUserProfile_FullMethodName      = "/userprofile.v1.UserProfileService/GetUserProfile"
-	UserProfileService_UpdateUserProfile_FullMethodName   = "/userprofile.v1.UserProfileService/UpdateUserProfile"
-	UserProfileService_GetFavoriteQueries_FullMethodName  = "/userprofile.v1.UserProfileService/GetFavoriteQueries"
-	UserProfileService_CreateFavoriteQuery_FullMethodName = "/userprofile.v1.UserProfileService/CreateFavoriteQuery"
-	UserProfileService_DeleteFavoriteQuery_FullMethodName = "/userprofile.v1.UserProfileService/DeleteFavoriteQuery"
+	UserProfileService_GetUserProfile_FullMethodName                = "/userprofile.v1.UserProfileService/GetUserProfile"
+	UserProfileService_UpdateUserProfile_FullMethodName             = "/userprofile.v1.UserProfileService/UpdateUserProfile"
+	UserProfileService_UpdateUserPreferences_FullMethodName         = "/userprofile.v1.UserProfileService/UpdateUserPreferences"
+	UserProfileService_GetFavoriteQueries_FullMethodName            = "/userprofile.v1.UserProfileService/GetFavoriteQueries"
+	UserProfileService_CreateFavoriteQuery_FullMethodName           = "/userprofile.v1.UserProfileService/CreateFavoriteQuery"
+	UserProfileService_UpdateFavoriteQuery_FullMethodName           = "/userprofile.v1.UserProfileService/UpdateFavoriteQuery"
+	UserProfileService_DeleteFavoriteQuery_FullMethodName           = "/userprofile.v1.UserProfileService/DeleteFavoriteQuery"
+	UserProfileService_ReorderFavoriteQueries_FullMethodName        = "/userprofile.v1.UserProfileService/ReorderFavoriteQueries"
+	UserProfileService_GetFavoriteQueryFolders_FullMethodName       = "/userprofile.v1.UserProfileService/GetFavoriteQueryFolders"
+	UserProfileService_CreateFavoriteQueryFolder_FullMethodName     = "/userprofile.v1.UserProfileService/CreateFavoriteQueryFolder"
+	UserProfileService_UpdateFavoriteQueryFolder_FullMethodName     = "/userprofile.v1.UserProfileService/UpdateFavoriteQueryFolder"
+	UserProfileService_DeleteFavoriteQueryFolder_FullMethodName     = "/userprofile.v1.UserProfileService/DeleteFavoriteQueryFolder"
+	UserProfileService_PublishFavoriteQuery_FullMethodName          = "/userprofile.v1.UserProfileService/PublishFavoriteQuery"
+	UserProfileService_GetFavoriteQueriesLibrary_FullMethodName     = "/userprofile.v1.UserProfileService/GetFavoriteQueriesLibrary"
+	UserProfileService_SubscribeFavoriteQuery_FullMethodName        = "/userprofile.v1.UserProfileService/SubscribeFavoriteQuery"
+	UserProfileService_UnsubscribeFavoriteQuery_FullMethodName      = "/userprofile.v1.UserProfileService/UnsubscribeFavoriteQuery"
+	UserProfileService_GetErrorGroupsSubscriptions_FullMethodName   = "/userprofile.v1.UserProfileService/GetErrorGroupsSubscriptions"
+	UserProfileService_CreateErrorGroupsSubscription_FullMethodName = "/userprofile.v1.UserProfileService/CreateErrorGroupsSubscription"
+	UserProfileService_DeleteErrorGroupsSubscription_FullMethodName = "/userprofile.v1.UserProfileService/DeleteErrorGroupsSubscription"
+	UserProfileService_GetQueryHistory_FullMethodName               = "/userprofile.v1.UserProfileService/GetQueryHistory"
+	UserProfileService_WhoAmI_FullMethodName                        = "/userprofile.v1.UserProfileService/WhoAmI"
 )
 
 // UserProfileServiceClient is the client API for UserProfileService service.
@@ -32,9 +48,25 @@ const (
 type UserProfileServiceClient interface {
 	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
 	UpdateUserProfile(ctx context.Context, in *UpdateUserProfileRequest, opts ...grpc.CallOption) (*UpdateUserProfileResponse, error)
+	UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*UpdateUserPreferencesResponse, error)
 	GetFavoriteQueries(ctx context.Context, in *GetFavoriteQueriesRequest, opts ...grpc.CallOption) (*GetFavoriteQueriesResponse, error)
 	CreateFavoriteQuery(ctx context.Context, in *CreateFavoriteQueryRequest, opts ...grpc.CallOption) (*CreateFavoriteQueryResponse, error)
+	UpdateFavoriteQuery(ctx context.Context, in *UpdateFavoriteQueryRequest, opts ...grpc.CallOption) (*UpdateFavoriteQueryResponse, error)
 	DeleteFavoriteQuery(ctx context.Context, in *DeleteFavoriteQueryRequest, opts ...grpc.CallOption) (*DeleteFavoriteQueryResponse, error)
+	ReorderFavoriteQueries(ctx context.Context, in *ReorderFavoriteQueriesRequest, opts ...grpc.CallOption) (*ReorderFavoriteQueriesResponse, error)
+	GetFavoriteQueryFolders(ctx context.Context, in *GetFavoriteQueryFoldersRequest, opts ...grpc.CallOption) (*GetFavoriteQueryFoldersResponse, error)
+	CreateFavoriteQueryFolder(ctx context.Context, in *CreateFavoriteQueryFolderRequest, opts ...grpc.CallOption) (*CreateFavoriteQueryFolderResponse, error)
+	UpdateFavoriteQueryFolder(ctx context.Context, in *UpdateFavoriteQueryFolderRequest, opts ...grpc.CallOption) (*UpdateFavoriteQueryFolderResponse, error)
+	DeleteFavoriteQueryFolder(ctx context.Context, in *DeleteFavoriteQueryFolderRequest, opts ...grpc.CallOption) (*DeleteFavoriteQueryFolderResponse, error)
+	PublishFavoriteQuery(ctx context.Context, in *PublishFavoriteQueryRequest, opts ...grpc.CallOption) (*PublishFavoriteQueryResponse, error)
+	GetFavoriteQueriesLibrary(ctx context.Context, in *GetFavoriteQueriesLibraryRequest, opts ...grpc.CallOption) (*GetFavoriteQueriesLibraryResponse, error)
+	SubscribeFavoriteQuery(ctx context.Context, in *SubscribeFavoriteQueryRequest, opts ...grpc.CallOption) (*SubscribeFavoriteQueryResponse, error)
+	UnsubscribeFavoriteQuery(ctx context.Context, in *UnsubscribeFavoriteQueryRequest, opts ...grpc.CallOption) (*UnsubscribeFavoriteQueryResponse, error)
+	GetErrorGroupsSubscriptions(ctx context.Context, in *GetErrorGroupsSubscriptionsRequest, opts ...grpc.CallOption) (*GetErrorGroupsSubscriptionsResponse, error)
+	CreateErrorGroupsSubscription(ctx context.Context, in *CreateErrorGroupsSubscriptionRequest, opts ...grpc.CallOption) (*CreateErrorGroupsSubscriptionResponse, error)
+	DeleteErrorGroupsSubscription(ctx context.Context, in *DeleteErrorGroupsSubscriptionRequest, opts ...grpc.CallOption) (*DeleteErrorGroupsSubscriptionResponse, error)
+	GetQueryHistory(ctx context.Context, in *GetQueryHistoryRequest, opts ...grpc.CallOption) (*GetQueryHistoryResponse, error)
+	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
 }
 
 type userProfileServiceClient struct {
@@ -65,6 +97,16 @@ func (c *userProfileServiceClient) UpdateUserProfile(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *userProfileServiceClient) UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*UpdateUserPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_UpdateUserPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userProfileServiceClient) GetFavoriteQueries(ctx context.Context, in *GetFavoriteQueriesRequest, opts ...grpc.CallOption) (*GetFavoriteQueriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetFavoriteQueriesResponse)
@@ -85,6 +127,16 @@ func (c *userProfileServiceClient) CreateFavoriteQuery(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *userProfileServiceClient) UpdateFavoriteQuery(ctx context.Context, in *UpdateFavoriteQueryRequest, opts ...grpc.CallOption) (*UpdateFavoriteQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFavoriteQueryResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_UpdateFavoriteQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userProfileServiceClient) DeleteFavoriteQuery(ctx context.Context, in *DeleteFavoriteQueryRequest, opts ...grpc.CallOption) (*DeleteFavoriteQueryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteFavoriteQueryResponse)
@@ -95,15 +147,171 @@ func (c *userProfileServiceClient) DeleteFavoriteQuery(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *userProfileServiceClient) ReorderFavoriteQueries(ctx context.Context, in *ReorderFavoriteQueriesRequest, opts ...grpc.CallOption) (*ReorderFavoriteQueriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderFavoriteQueriesResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_ReorderFavoriteQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GetFavoriteQueryFolders(ctx context.Context, in *GetFavoriteQueryFoldersRequest, opts ...grpc.CallOption) (*GetFavoriteQueryFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFavoriteQueryFoldersResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_GetFavoriteQueryFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) CreateFavoriteQueryFolder(ctx context.Context, in *CreateFavoriteQueryFolderRequest, opts ...grpc.CallOption) (*CreateFavoriteQueryFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFavoriteQueryFolderResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_CreateFavoriteQueryFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) UpdateFavoriteQueryFolder(ctx context.Context, in *UpdateFavoriteQueryFolderRequest, opts ...grpc.CallOption) (*UpdateFavoriteQueryFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFavoriteQueryFolderResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_UpdateFavoriteQueryFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) DeleteFavoriteQueryFolder(ctx context.Context, in *DeleteFavoriteQueryFolderRequest, opts ...grpc.CallOption) (*DeleteFavoriteQueryFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFavoriteQueryFolderResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_DeleteFavoriteQueryFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) PublishFavoriteQuery(ctx context.Context, in *PublishFavoriteQueryRequest, opts ...grpc.CallOption) (*PublishFavoriteQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishFavoriteQueryResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_PublishFavoriteQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GetFavoriteQueriesLibrary(ctx context.Context, in *GetFavoriteQueriesLibraryRequest, opts ...grpc.CallOption) (*GetFavoriteQueriesLibraryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFavoriteQueriesLibraryResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_GetFavoriteQueriesLibrary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) SubscribeFavoriteQuery(ctx context.Context, in *SubscribeFavoriteQueryRequest, opts ...grpc.CallOption) (*SubscribeFavoriteQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeFavoriteQueryResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_SubscribeFavoriteQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) UnsubscribeFavoriteQuery(ctx context.Context, in *UnsubscribeFavoriteQueryRequest, opts ...grpc.CallOption) (*UnsubscribeFavoriteQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeFavoriteQueryResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_UnsubscribeFavoriteQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GetErrorGroupsSubscriptions(ctx context.Context, in *GetErrorGroupsSubscriptionsRequest, opts ...grpc.CallOption) (*GetErrorGroupsSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetErrorGroupsSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_GetErrorGroupsSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) CreateErrorGroupsSubscription(ctx context.Context, in *CreateErrorGroupsSubscriptionRequest, opts ...grpc.CallOption) (*CreateErrorGroupsSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateErrorGroupsSubscriptionResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_CreateErrorGroupsSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) DeleteErrorGroupsSubscription(ctx context.Context, in *DeleteErrorGroupsSubscriptionRequest, opts ...grpc.CallOption) (*DeleteErrorGroupsSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteErrorGroupsSubscriptionResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_DeleteErrorGroupsSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GetQueryHistory(ctx context.Context, in *GetQueryHistoryRequest, opts ...grpc.CallOption) (*GetQueryHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueryHistoryResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_GetQueryHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhoAmIResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_WhoAmI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserProfileServiceServer is the server API for UserProfileService service.
 // All implementations should embed UnimplementedUserProfileServiceServer
 // for forward compatibility
 type UserProfileServiceServer interface {
 	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
 	UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*UpdateUserProfileResponse, error)
+	UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*UpdateUserPreferencesResponse, error)
 	GetFavoriteQueries(context.Context, *GetFavoriteQueriesRequest) (*GetFavoriteQueriesResponse, error)
 	CreateFavoriteQuery(context.Context, *CreateFavoriteQueryRequest) (*CreateFavoriteQueryResponse, error)
+	UpdateFavoriteQuery(context.Context, *UpdateFavoriteQueryRequest) (*UpdateFavoriteQueryResponse, error)
 	DeleteFavoriteQuery(context.Context, *DeleteFavoriteQueryRequest) (*DeleteFavoriteQueryResponse, error)
+	ReorderFavoriteQueries(context.Context, *ReorderFavoriteQueriesRequest) (*ReorderFavoriteQueriesResponse, error)
+	GetFavoriteQueryFolders(context.Context, *GetFavoriteQueryFoldersRequest) (*GetFavoriteQueryFoldersResponse, error)
+	CreateFavoriteQueryFolder(context.Context, *CreateFavoriteQueryFolderRequest) (*CreateFavoriteQueryFolderResponse, error)
+	UpdateFavoriteQueryFolder(context.Context, *UpdateFavoriteQueryFolderRequest) (*UpdateFavoriteQueryFolderResponse, error)
+	DeleteFavoriteQueryFolder(context.Context, *DeleteFavoriteQueryFolderRequest) (*DeleteFavoriteQueryFolderResponse, error)
+	PublishFavoriteQuery(context.Context, *PublishFavoriteQueryRequest) (*PublishFavoriteQueryResponse, error)
+	GetFavoriteQueriesLibrary(context.Context, *GetFavoriteQueriesLibraryRequest) (*GetFavoriteQueriesLibraryResponse, error)
+	SubscribeFavoriteQuery(context.Context, *SubscribeFavoriteQueryRequest) (*SubscribeFavoriteQueryResponse, error)
+	UnsubscribeFavoriteQuery(context.Context, *UnsubscribeFavoriteQueryRequest) (*UnsubscribeFavoriteQueryResponse, error)
+	GetErrorGroupsSubscriptions(context.Context, *GetErrorGroupsSubscriptionsRequest) (*GetErrorGroupsSubscriptionsResponse, error)
+	CreateErrorGroupsSubscription(context.Context, *CreateErrorGroupsSubscriptionRequest) (*CreateErrorGroupsSubscriptionResponse, error)
+	DeleteErrorGroupsSubscription(context.Context, *DeleteErrorGroupsSubscriptionRequest) (*DeleteErrorGroupsSubscriptionResponse, error)
+	GetQueryHistory(context.Context, *GetQueryHistoryRequest) (*GetQueryHistoryResponse, error)
+	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
 }
 
 // UnimplementedUserProfileServiceServer should be embedded to have forward compatible implementations.
@@ -116,15 +324,63 @@ func (UnimplementedUserProfileServiceServer) GetUserProfile(context.Context, *Ge
 func (UnimplementedUserProfileServiceServer) UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*UpdateUserProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserProfile not implemented")
 }
+func (UnimplementedUserProfileServiceServer) UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*UpdateUserPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPreferences not implemented")
+}
 func (UnimplementedUserProfileServiceServer) GetFavoriteQueries(context.Context, *GetFavoriteQueriesRequest) (*GetFavoriteQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteQueries not implemented")
 }
 func (UnimplementedUserProfileServiceServer) CreateFavoriteQuery(context.Context, *CreateFavoriteQueryRequest) (*CreateFavoriteQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFavoriteQuery not implemented")
 }
+func (UnimplementedUserProfileServiceServer) UpdateFavoriteQuery(context.Context, *UpdateFavoriteQueryRequest) (*UpdateFavoriteQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFavoriteQuery not implemented")
+}
 func (UnimplementedUserProfileServiceServer) DeleteFavoriteQuery(context.Context, *DeleteFavoriteQueryRequest) (*DeleteFavoriteQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavoriteQuery not implemented")
 }
+func (UnimplementedUserProfileServiceServer) ReorderFavoriteQueries(context.Context, *ReorderFavoriteQueriesRequest) (*ReorderFavoriteQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderFavoriteQueries not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GetFavoriteQueryFolders(context.Context, *GetFavoriteQueryFoldersRequest) (*GetFavoriteQueryFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteQueryFolders not implemented")
+}
+func (UnimplementedUserProfileServiceServer) CreateFavoriteQueryFolder(context.Context, *CreateFavoriteQueryFolderRequest) (*CreateFavoriteQueryFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFavoriteQueryFolder not implemented")
+}
+func (UnimplementedUserProfileServiceServer) UpdateFavoriteQueryFolder(context.Context, *UpdateFavoriteQueryFolderRequest) (*UpdateFavoriteQueryFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFavoriteQueryFolder not implemented")
+}
+func (UnimplementedUserProfileServiceServer) DeleteFavoriteQueryFolder(context.Context, *DeleteFavoriteQueryFolderRequest) (*DeleteFavoriteQueryFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavoriteQueryFolder not implemented")
+}
+func (UnimplementedUserProfileServiceServer) PublishFavoriteQuery(context.Context, *PublishFavoriteQueryRequest) (*PublishFavoriteQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishFavoriteQuery not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GetFavoriteQueriesLibrary(context.Context, *GetFavoriteQueriesLibraryRequest) (*GetFavoriteQueriesLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteQueriesLibrary not implemented")
+}
+func (UnimplementedUserProfileServiceServer) SubscribeFavoriteQuery(context.Context, *SubscribeFavoriteQueryRequest) (*SubscribeFavoriteQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeFavoriteQuery not implemented")
+}
+func (UnimplementedUserProfileServiceServer) UnsubscribeFavoriteQuery(context.Context, *UnsubscribeFavoriteQueryRequest) (*UnsubscribeFavoriteQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeFavoriteQuery not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GetErrorGroupsSubscriptions(context.Context, *GetErrorGroupsSubscriptionsRequest) (*GetErrorGroupsSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetErrorGroupsSubscriptions not implemented")
+}
+func (UnimplementedUserProfileServiceServer) CreateErrorGroupsSubscription(context.Context, *CreateErrorGroupsSubscriptionRequest) (*CreateErrorGroupsSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateErrorGroupsSubscription not implemented")
+}
+func (UnimplementedUserProfileServiceServer) DeleteErrorGroupsSubscription(context.Context, *DeleteErrorGroupsSubscriptionRequest) (*DeleteErrorGroupsSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteErrorGroupsSubscription not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GetQueryHistory(context.Context, *GetQueryHistoryRequest) (*GetQueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryHistory not implemented")
+}
+func (UnimplementedUserProfileServiceServer) WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
+}
 
 // UnsafeUserProfileServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to UserProfileServiceServer will
@@ -173,6 +429,24 @@ func _UserProfileService_UpdateUserProfile_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserProfileService_UpdateUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).UpdateUserPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_UpdateUserPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).UpdateUserPreferences(ctx, req.(*UpdateUserPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserProfileService_GetFavoriteQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFavoriteQueriesRequest)
 	if err := dec(in); err != nil {
@@ -209,6 +483,24 @@ func _UserProfileService_CreateFavoriteQuery_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserProfileService_UpdateFavoriteQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFavoriteQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).UpdateFavoriteQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_UpdateFavoriteQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).UpdateFavoriteQuery(ctx, req.(*UpdateFavoriteQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserProfileService_DeleteFavoriteQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteFavoriteQueryRequest)
 	if err := dec(in); err != nil {
@@ -227,6 +519,258 @@ func _UserProfileService_DeleteFavoriteQuery_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserProfileService_ReorderFavoriteQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderFavoriteQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).ReorderFavoriteQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_ReorderFavoriteQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).ReorderFavoriteQueries(ctx, req.(*ReorderFavoriteQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GetFavoriteQueryFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFavoriteQueryFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetFavoriteQueryFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetFavoriteQueryFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetFavoriteQueryFolders(ctx, req.(*GetFavoriteQueryFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_CreateFavoriteQueryFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFavoriteQueryFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).CreateFavoriteQueryFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_CreateFavoriteQueryFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).CreateFavoriteQueryFolder(ctx, req.(*CreateFavoriteQueryFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_UpdateFavoriteQueryFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFavoriteQueryFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).UpdateFavoriteQueryFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_UpdateFavoriteQueryFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).UpdateFavoriteQueryFolder(ctx, req.(*UpdateFavoriteQueryFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_DeleteFavoriteQueryFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoriteQueryFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).DeleteFavoriteQueryFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_DeleteFavoriteQueryFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).DeleteFavoriteQueryFolder(ctx, req.(*DeleteFavoriteQueryFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_PublishFavoriteQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishFavoriteQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).PublishFavoriteQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_PublishFavoriteQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).PublishFavoriteQuery(ctx, req.(*PublishFavoriteQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GetFavoriteQueriesLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFavoriteQueriesLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetFavoriteQueriesLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetFavoriteQueriesLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetFavoriteQueriesLibrary(ctx, req.(*GetFavoriteQueriesLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_SubscribeFavoriteQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeFavoriteQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).SubscribeFavoriteQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_SubscribeFavoriteQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).SubscribeFavoriteQuery(ctx, req.(*SubscribeFavoriteQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_UnsubscribeFavoriteQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeFavoriteQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).UnsubscribeFavoriteQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_UnsubscribeFavoriteQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).UnsubscribeFavoriteQuery(ctx, req.(*UnsubscribeFavoriteQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GetErrorGroupsSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetErrorGroupsSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetErrorGroupsSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetErrorGroupsSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetErrorGroupsSubscriptions(ctx, req.(*GetErrorGroupsSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_CreateErrorGroupsSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateErrorGroupsSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).CreateErrorGroupsSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_CreateErrorGroupsSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).CreateErrorGroupsSubscription(ctx, req.(*CreateErrorGroupsSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_DeleteErrorGroupsSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteErrorGroupsSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).DeleteErrorGroupsSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_DeleteErrorGroupsSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).DeleteErrorGroupsSubscription(ctx, req.(*DeleteErrorGroupsSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GetQueryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetQueryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetQueryHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetQueryHistory(ctx, req.(*GetQueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoAmIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).WhoAmI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_WhoAmI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).WhoAmI(ctx, req.(*WhoAmIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserProfileService_ServiceDesc is the grpc.ServiceDesc for UserProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -242,6 +786,10 @@ var UserProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserProfile",
 			Handler:    _UserProfileService_UpdateUserProfile_Handler,
 		},
+		{
+			MethodName: "UpdateUserPreferences",
+			Handler:    _UserProfileService_UpdateUserPreferences_Handler,
+		},
 		{
 			MethodName: "GetFavoriteQueries",
 			Handler:    _UserProfileService_GetFavoriteQueries_Handler,
@@ -250,10 +798,70 @@ var UserProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateFavoriteQuery",
 			Handler:    _UserProfileService_CreateFavoriteQuery_Handler,
 		},
+		{
+			MethodName: "UpdateFavoriteQuery",
+			Handler:    _UserProfileService_UpdateFavoriteQuery_Handler,
+		},
 		{
 			MethodName: "DeleteFavoriteQuery",
 			Handler:    _UserProfileService_DeleteFavoriteQuery_Handler,
 		},
+		{
+			MethodName: "ReorderFavoriteQueries",
+			Handler:    _UserProfileService_ReorderFavoriteQueries_Handler,
+		},
+		{
+			MethodName: "GetFavoriteQueryFolders",
+			Handler:    _UserProfileService_GetFavoriteQueryFolders_Handler,
+		},
+		{
+			MethodName: "CreateFavoriteQueryFolder",
+			Handler:    _UserProfileService_CreateFavoriteQueryFolder_Handler,
+		},
+		{
+			MethodName: "UpdateFavoriteQueryFolder",
+			Handler:    _UserProfileService_UpdateFavoriteQueryFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFavoriteQueryFolder",
+			Handler:    _UserProfileService_DeleteFavoriteQueryFolder_Handler,
+		},
+		{
+			MethodName: "PublishFavoriteQuery",
+			Handler:    _UserProfileService_PublishFavoriteQuery_Handler,
+		},
+		{
+			MethodName: "GetFavoriteQueriesLibrary",
+			Handler:    _UserProfileService_GetFavoriteQueriesLibrary_Handler,
+		},
+		{
+			MethodName: "SubscribeFavoriteQuery",
+			Handler:    _UserProfileService_SubscribeFavoriteQuery_Handler,
+		},
+		{
+			MethodName: "UnsubscribeFavoriteQuery",
+			Handler:    _UserProfileService_UnsubscribeFavoriteQuery_Handler,
+		},
+		{
+			MethodName: "GetErrorGroupsSubscriptions",
+			Handler:    _UserProfileService_GetErrorGroupsSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateErrorGroupsSubscription",
+			Handler:    _UserProfileService_CreateErrorGroupsSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteErrorGroupsSubscription",
+			Handler:    _UserProfileService_DeleteErrorGroupsSubscription_Handler,
+		},
+		{
+			MethodName: "GetQueryHistory",
+			Handler:    _UserProfileService_GetQueryHistory_Handler,
+		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _UserProfileService_WhoAmI_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "userprofile/v1/userprofile.proto",
@@ -37,6 +37,10 @@ func (sp SpecPath) getOperation(method string) *spec.Operation {
 	}
 }
 
+func (sp SpecPath) HasOperation(method string) bool {
+	return sp.getOperation(method) != nil
+}
+
 func (sp SpecPath) HasSecurity(method string) bool {
 	op := sp.getOperation(method)
 	return op != nil && len(op.Security) != 0